@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// CrossrefStandIn imitates the parts of the Crossref deposit API the
+// registrar uses, for development and demos. Deposits are checked with
+// core.ParseCrossrefDeposit when uploaded and processed immediately, so the
+// first submission log request already reports their outcome.
+type CrossrefStandIn struct {
+	username string
+	password string
+	mu       sync.Mutex
+	batches  map[string]crossrefStandInBatch
+}
+
+type crossrefStandInBatch struct {
+	dois []string
+	err  error
+}
+
+func NewCrossrefStandIn(username, password string) *CrossrefStandIn {
+	return &CrossrefStandIn{username: username, password: password, batches: make(map[string]crossrefStandInBatch)}
+}
+
+func (s *CrossrefStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/servlet/deposit":
+		s.deposit(w, r)
+	case "/servlet/submissionDownload":
+		s.submissionLog(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *CrossrefStandIn) authorized(username, password string) bool {
+	return username == s.username && password == s.password
+}
+
+func (s *CrossrefStandIn) deposit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		http.Error(w, "invalid upload", http.StatusBadRequest)
+		return
+	}
+	if r.FormValue("operation") != "doMDUpload" {
+		http.Error(w, "unsupported operation", http.StatusBadRequest)
+		return
+	}
+	if !s.authorized(r.FormValue("login_id"), r.FormValue("login_passwd")) {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+
+	file, _, err := r.FormFile("fname")
+	if err != nil {
+		http.Error(w, "missing deposit file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "failed to read deposit file", http.StatusBadRequest)
+		return
+	}
+
+	// Crossref accepts any well-formed upload and reports schema errors in
+	// the submission log, so only unreadable uploads are rejected here
+	deposit, err := core.ParseCrossrefDeposit(data)
+	var batch crossrefStandInBatch
+	if err != nil {
+		var probe struct {
+			BatchID string `xml:"head>doi_batch_id"`
+		}
+		if xml.Unmarshal(data, &probe) != nil || probe.BatchID == "" {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		deposit.Head.BatchID = probe.BatchID
+		batch.err = err
+	}
+	for _, article := range deposit.Journal.Articles {
+		batch.dois = append(batch.dois, article.DOIData.DOI)
+	}
+
+	s.mu.Lock()
+	s.batches[deposit.Head.BatchID] = batch
+	s.mu.Unlock()
+
+	fmt.Fprintln(w, "SUCCESS: your batch submission was successfully received")
+}
+
+func (s *CrossrefStandIn) submissionLog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if !s.authorized(query.Get("usr"), query.Get("pwd")) {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+
+	batchID := query.Get("doi_batch_id")
+	s.mu.Lock()
+	batch, ok := s.batches[batchID]
+	s.mu.Unlock()
+
+	diagnostic := crossrefDiagnostic{Status: "completed"}
+	switch {
+	case !ok:
+		diagnostic.Status = "unknown_submission"
+	case batch.err != nil:
+		diagnostic.Records = append(diagnostic.Records, crossrefRecord{Status: "Failure", Message: batch.err.Error()})
+	default:
+		for _, doi := range batch.dois {
+			diagnostic.Records = append(diagnostic.Records, crossrefRecord{Status: "Success", DOI: doi, Message: "Successfully added"})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(diagnostic)
+}
@@ -0,0 +1,133 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// GRPCJournalDirectory looks up journals and issues through the journal
+// service's gRPC API
+type GRPCJournalDirectory struct {
+	client  proto.JournalServiceClient
+	timeout time.Duration
+}
+
+func NewGRPCJournalDirectory(conn grpc.ClientConnInterface, timeout time.Duration) *GRPCJournalDirectory {
+	return &GRPCJournalDirectory{client: proto.NewJournalServiceClient(conn), timeout: timeout}
+}
+
+func (d *GRPCJournalDirectory) GetJournal(id string) (core.JournalInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	res, err := d.client.GetJournal(ctx, &proto.GetJournalRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return core.JournalInfo{}, core.ErrJournalNotFound
+		}
+		return core.JournalInfo{}, fmt.Errorf("failed to get journal %s: %w", id, err)
+	}
+
+	return toJournalInfo(res.Journal), nil
+}
+
+func (d *GRPCJournalDirectory) FindJournalByISSN(issn string) (core.JournalInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	res, err := d.client.GetJournalByISSN(ctx, &proto.GetJournalByISSNRequest{Issn: issn})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return core.JournalInfo{}, core.ErrJournalNotFound
+		}
+		return core.JournalInfo{}, fmt.Errorf("failed to get journal by ISSN %s: %w", issn, err)
+	}
+	return toJournalInfo(res.Journal), nil
+}
+
+func (d *GRPCJournalDirectory) FindJournalsByName(name string) ([]core.JournalInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	res, err := d.client.ListJournals(ctx, &proto.ListJournalsRequest{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find journals named %q: %w", name, err)
+	}
+
+	journals := make([]core.JournalInfo, 0, len(res.Journals))
+	for _, journal := range res.Journals {
+		journals = append(journals, toJournalInfo(journal))
+	}
+	return journals, nil
+}
+
+func (d *GRPCJournalDirectory) CreateJournal(journal core.JournalInfo) (core.JournalInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	res, err := d.client.CreateJournal(ctx, &proto.CreateJournalRequest{Journal: &proto.Journal{
+		Id:             journal.ID,
+		Name:           journal.Name,
+		PrintIssn:      journal.PrintISSN,
+		ElectronicIssn: journal.ElectronicISSN,
+	}})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return core.JournalInfo{}, fmt.Errorf("%w: %s", core.ErrInvalidImport, status.Convert(err).Message())
+		}
+		return core.JournalInfo{}, fmt.Errorf("failed to create journal %s: %w", journal.Name, err)
+	}
+	return toJournalInfo(res.Journal), nil
+}
+
+func (d *GRPCJournalDirectory) GetIssue(id string) (core.IssueInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	res, err := d.client.GetIssue(ctx, &proto.GetIssueRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return core.IssueInfo{}, core.ErrIssueNotFound
+		}
+		return core.IssueInfo{}, fmt.Errorf("failed to get issue %s: %w", id, err)
+	}
+
+	issue := core.IssueInfo{
+		ID:        res.Issue.Id,
+		JournalID: res.Issue.JournalId,
+		Number:    int(res.Issue.Number),
+		Published: res.Issue.Status == "published",
+	}
+
+	// Issues refer to their volume by ID only
+	volumes, err := d.client.ListVolumes(ctx, &proto.ListVolumesRequest{JournalId: res.Issue.JournalId})
+	if err != nil {
+		return core.IssueInfo{}, fmt.Errorf("failed to list volumes of journal %s: %w", res.Issue.JournalId, err)
+	}
+	for _, volume := range volumes.Volumes {
+		if volume.Id == res.Issue.VolumeId {
+			issue.Volume = int(volume.Number)
+		}
+	}
+	return issue, nil
+}
+
+func toJournalInfo(journal *proto.Journal) core.JournalInfo {
+	return core.JournalInfo{
+		ID:                  journal.Id,
+		Name:                journal.Name,
+		PrintISSN:           journal.PrintIssn,
+		ElectronicISSN:      journal.ElectronicIssn,
+		ISSNL:               journal.IssnL,
+		UniqueArticleTitles: journal.UniqueArticleTitles,
+		SubjectScope:        journal.SubjectScope,
+	}
+}
@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// HTTPCrossrefRegistrar submits deposits through the Crossref HTTPS deposit
+// API and reads back the submission logs. Pointed at a CrossrefStandIn it
+// works without Crossref credentials.
+type HTTPCrossrefRegistrar struct {
+	baseURL  string
+	username string
+	password string
+	client   *http.Client
+}
+
+func NewHTTPCrossrefRegistrar(baseURL, username, password string, timeout time.Duration) *HTTPCrossrefRegistrar {
+	return &HTTPCrossrefRegistrar{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		username: username,
+		password: password,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Submit uploads the deposit as a doMDUpload. Crossref queues the deposit;
+// its outcome is available from Result once processed.
+func (r *HTTPCrossrefRegistrar) Submit(batchID string, deposit []byte) error {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range map[string]string{
+		"operation":    "doMDUpload",
+		"login_id":     r.username,
+		"login_passwd": r.password,
+	} {
+		if err := form.WriteField(name, value); err != nil {
+			return fmt.Errorf("failed to build deposit request: %w", err)
+		}
+	}
+	file, err := form.CreateFormFile("fname", batchID+".xml")
+	if err != nil {
+		return fmt.Errorf("failed to build deposit request: %w", err)
+	}
+	if _, err := file.Write(deposit); err != nil {
+		return fmt.Errorf("failed to build deposit request: %w", err)
+	}
+	if err := form.Close(); err != nil {
+		return fmt.Errorf("failed to build deposit request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.baseURL+"/servlet/deposit", &body)
+	if err != nil {
+		return fmt.Errorf("failed to build deposit request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to submit deposit: %w", err)
+	}
+	defer resp.Body.Close()
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("deposit rejected with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// crossrefDiagnostic is the submission log Crossref returns for a batch
+type crossrefDiagnostic struct {
+	XMLName xml.Name         `xml:"doi_batch_diagnostic"`
+	Status  string           `xml:"status,attr"`
+	Records []crossrefRecord `xml:"record_diagnostic"`
+}
+
+type crossrefRecord struct {
+	Status  string `xml:"status,attr"`
+	DOI     string `xml:"doi,omitempty"`
+	Message string `xml:"msg"`
+}
+
+// Result fetches the submission log of a batch. Deposits count as
+// successful when every record in them succeeded.
+func (r *HTTPCrossrefRegistrar) Result(batchID string) (core.DepositResult, error) {
+	query := url.Values{
+		"usr":          {r.username},
+		"pwd":          {r.password},
+		"doi_batch_id": {batchID},
+		"type":         {"result"},
+	}
+	resp, err := r.client.Get(r.baseURL + "/servlet/submissionDownload?" + query.Encode())
+	if err != nil {
+		return core.DepositResult{}, fmt.Errorf("failed to fetch submission log: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return core.DepositResult{}, fmt.Errorf("submission log request failed with status %d", resp.StatusCode)
+	}
+
+	var diagnostic crossrefDiagnostic
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&diagnostic); err != nil {
+		return core.DepositResult{}, fmt.Errorf("failed to decode submission log: %w", err)
+	}
+
+	switch diagnostic.Status {
+	case "queued", "in_process", "unknown_submission":
+		// Unknown submissions are usually still being received
+		return core.DepositResult{}, nil
+	case "completed":
+	default:
+		return core.DepositResult{}, fmt.Errorf("unknown submission status %q", diagnostic.Status)
+	}
+
+	result := core.DepositResult{Completed: true, Success: len(diagnostic.Records) > 0}
+	var messages []string
+	for _, record := range diagnostic.Records {
+		if record.Status != "Success" {
+			result.Success = false
+		}
+		if record.Message != "" {
+			messages = append(messages, record.Message)
+		}
+	}
+	result.Message = strings.Join(messages, "; ")
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type authorMetricsKey struct {
+	authorID  string
+	journalID string
+}
+
+type InMemoryAuthorMetricsRepository struct {
+	mu sync.RWMutex
+	// contributions maps article IDs to their entries, one per author
+	contributions map[string][]core.AuthorArticle
+	metrics       map[authorMetricsKey]core.AuthorMetrics
+}
+
+func NewInMemoryAuthorMetricsRepository() *InMemoryAuthorMetricsRepository {
+	return &InMemoryAuthorMetricsRepository{
+		contributions: make(map[string][]core.AuthorArticle),
+		metrics:       make(map[authorMetricsKey]core.AuthorMetrics),
+	}
+}
+
+func (r *InMemoryAuthorMetricsRepository) ListArticleContributions(articleID string) ([]core.AuthorArticle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]core.AuthorArticle(nil), r.contributions[articleID]...), nil
+}
+
+func (r *InMemoryAuthorMetricsRepository) ListAuthorArticles(authorID string) ([]core.AuthorArticle, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.AuthorArticle
+	for _, contributions := range r.contributions {
+		for _, contribution := range contributions {
+			if contribution.AuthorID == authorID {
+				articles = append(articles, contribution)
+			}
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ArticleID < articles[j].ArticleID })
+	return articles, nil
+}
+
+func (r *InMemoryAuthorMetricsRepository) ApplyArticleContributions(articleID string, contributions []core.AuthorArticle, metrics []core.AuthorMetrics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(contributions) == 0 {
+		delete(r.contributions, articleID)
+	} else {
+		r.contributions[articleID] = append([]core.AuthorArticle(nil), contributions...)
+	}
+
+	for _, m := range metrics {
+		key := authorMetricsKey{authorID: m.AuthorID, journalID: m.JournalID}
+		if m.Publications == 0 {
+			delete(r.metrics, key)
+			continue
+		}
+		r.metrics[key] = m
+	}
+	return nil
+}
+
+func (r *InMemoryAuthorMetricsRepository) GetAuthorMetrics(authorID, journalID string) (core.AuthorMetrics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	metrics, ok := r.metrics[authorMetricsKey{authorID: authorID, journalID: journalID}]
+	if !ok {
+		return core.AuthorMetrics{}, core.ErrAuthorMetricsNotFound
+	}
+	return metrics, nil
+}
+
+func (r *InMemoryAuthorMetricsRepository) ListJournalAuthorMetrics(journalID string, order core.LeaderboardOrder, limit int) ([]core.AuthorMetrics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var ranked []core.AuthorMetrics
+	for key, metrics := range r.metrics {
+		if key.journalID == journalID {
+			ranked = append(ranked, metrics)
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if a, b := order.Value(ranked[i]), order.Value(ranked[j]); a != b {
+			return a > b
+		}
+		if ranked[i].TotalCitations != ranked[j].TotalCitations {
+			return ranked[i].TotalCitations > ranked[j].TotalCitations
+		}
+		return ranked[i].AuthorID < ranked[j].AuthorID
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked, nil
+}
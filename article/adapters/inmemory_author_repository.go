@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type InMemoryAuthorRepository struct {
+	mu      sync.RWMutex
+	authors map[string]core.Author
+	merges  []core.AuthorMerge
+}
+
+func NewInMemoryAuthorRepository() *InMemoryAuthorRepository {
+	return &InMemoryAuthorRepository{authors: make(map[string]core.Author)}
+}
+
+func (r *InMemoryAuthorRepository) CreateAuthor(author core.Author) (core.Author, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.authors[author.ID] = author
+	return author, nil
+}
+
+func (r *InMemoryAuthorRepository) DeleteAuthor(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.authors, id)
+	return nil
+}
+
+func (r *InMemoryAuthorRepository) GetAuthor(id string) (core.Author, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	author, ok := r.authors[id]
+	if !ok {
+		return core.Author{}, core.ErrAuthorNotFound
+	}
+	return author, nil
+}
+
+func (r *InMemoryAuthorRepository) GetAuthorByORCID(orcid string) (core.Author, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, author := range r.authors {
+		if author.ORCID == orcid {
+			return author, nil
+		}
+	}
+	return core.Author{}, core.ErrAuthorNotFound
+}
+
+func (r *InMemoryAuthorRepository) ListAuthors() ([]core.Author, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	authors := make([]core.Author, 0, len(r.authors))
+	for _, author := range r.authors {
+		authors = append(authors, author)
+	}
+	sort.Slice(authors, func(i, j int) bool { return authors[i].ID < authors[j].ID })
+	return authors, nil
+}
+
+func (r *InMemoryAuthorRepository) UpdateAuthor(author core.Author) (core.Author, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.authors[author.ID]; !ok {
+		return core.Author{}, core.ErrAuthorNotFound
+	}
+	r.authors[author.ID] = author
+	return author, nil
+}
+
+func (r *InMemoryAuthorRepository) MergeAuthor(merge core.AuthorMerge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.authors[merge.Source.ID]; !ok {
+		return core.ErrAuthorNotFound
+	}
+	if _, ok := r.authors[merge.Target.ID]; !ok {
+		return core.ErrAuthorNotFound
+	}
+
+	delete(r.authors, merge.Source.ID)
+	r.authors[merge.Target.ID] = merge.Target
+	r.merges = append(r.merges, merge)
+	return nil
+}
+
+func (r *InMemoryAuthorRepository) UndoMerge(merge core.AuthorMerge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, stored := range r.merges {
+		if stored.ID != merge.ID {
+			continue
+		}
+		if stored.UndoneAt != nil {
+			return fmt.Errorf("%w: merge %s was already undone", core.ErrInvalidMerge, merge.ID)
+		}
+		if _, ok := r.authors[merge.Source.ID]; ok {
+			return fmt.Errorf("%w: author %s exists again", core.ErrInvalidMerge, merge.Source.ID)
+		}
+
+		r.authors[merge.Source.ID] = merge.Source
+		r.authors[merge.TargetBefore.ID] = merge.TargetBefore
+		r.merges[i].UndoneAt = merge.UndoneAt
+		return nil
+	}
+	return core.ErrMergeNotFound
+}
+
+func (r *InMemoryAuthorRepository) GetMerge(id string) (core.AuthorMerge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, merge := range r.merges {
+		if merge.ID == id {
+			return merge, nil
+		}
+	}
+	return core.AuthorMerge{}, core.ErrMergeNotFound
+}
+
+func (r *InMemoryAuthorRepository) ListMerges() ([]core.AuthorMerge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]core.AuthorMerge(nil), r.merges...), nil
+}
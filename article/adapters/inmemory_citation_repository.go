@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) ReplaceReferences(articleID string, references []core.Reference, events ...core.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.articles[articleID]; !ok {
+		return core.ErrArticleNotFound
+	}
+	for _, reference := range references {
+		if !reference.Internal() {
+			continue
+		}
+		if _, ok := r.articles[reference.TargetArticleID]; !ok {
+			return fmt.Errorf("%w: reference %d cites unknown article %s",
+				core.ErrInvalidReference, reference.Position, reference.TargetArticleID)
+		}
+	}
+
+	r.adjustCitationCounts(r.references[articleID], -1)
+	r.adjustCitationCounts(references, 1)
+	if len(references) == 0 {
+		delete(r.references, articleID)
+	} else {
+		r.references[articleID] = append([]core.Reference(nil), references...)
+	}
+	r.appendEvents(events)
+	return nil
+}
+
+func (r *InMemoryArticleRepository) ListReferences(articleID string) ([]core.Reference, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]core.Reference(nil), r.references[articleID]...), nil
+}
+
+func (r *InMemoryArticleRepository) ListReferencesOf(articleIDs []string) ([]core.Reference, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sorted := append([]string(nil), articleIDs...)
+	sort.Strings(sorted)
+	var references []core.Reference
+	for i, articleID := range sorted {
+		if i > 0 && articleID == sorted[i-1] {
+			continue
+		}
+		references = append(references, r.references[articleID]...)
+	}
+	return references, nil
+}
+
+func (r *InMemoryArticleRepository) ListCitedBy(articleID string) ([]core.Reference, error) {
+	return r.citing(func(reference core.Reference) bool { return reference.TargetArticleID == articleID }), nil
+}
+
+func (r *InMemoryArticleRepository) ListCitedByDOI(doi string) ([]core.Reference, error) {
+	return r.citing(func(reference core.Reference) bool { return reference.DOI == doi }), nil
+}
+
+// citing returns the references that match, ordered by citing article
+func (r *InMemoryArticleRepository) citing(match func(core.Reference) bool) []core.Reference {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var citations []core.Reference
+	for _, references := range r.references {
+		for _, reference := range references {
+			if match(reference) {
+				citations = append(citations, reference)
+			}
+		}
+	}
+	sort.Slice(citations, func(i, j int) bool { return citations[i].CitingArticleID < citations[j].CitingArticleID })
+	return citations
+}
+
+// adjustCitationCounts must be called with the write lock held
+func (r *InMemoryArticleRepository) adjustCitationCounts(references []core.Reference, delta int) {
+	for _, reference := range references {
+		if article, ok := r.articles[reference.TargetArticleID]; ok && reference.Internal() {
+			article.CitationCount += delta
+			touch(&article)
+			r.articles[reference.TargetArticleID] = article
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) SaveDOIDeposit(articleID, doi string, deposit core.DOIDeposit, events ...core.Event) (core.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	article, ok := r.articles[articleID]
+	if !ok {
+		return core.Article{}, core.ErrArticleNotFound
+	}
+	if article.DOI != "" && article.DOI != doi {
+		return core.Article{}, fmt.Errorf("%w: article %s already has DOI %s", core.ErrInvalidDOI, articleID, article.DOI)
+	}
+	for _, other := range r.articles {
+		if other.ID != articleID && other.DOI == doi {
+			return core.Article{}, fmt.Errorf("%w: DOI %s already belongs to article %s", core.ErrInvalidDOI, doi, other.ID)
+		}
+	}
+
+	article.DOI = doi
+	article.Deposit = &deposit
+	touch(&article)
+	r.articles[articleID] = article
+	r.appendEvents(events)
+	return article, nil
+}
+
+func (r *InMemoryArticleRepository) ListArticlesByDepositStatus(status core.DepositStatus, limit int) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if article.Deposit != nil && article.Deposit.Status == status {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool {
+		return articles[i].Deposit.SubmittedAt.Before(articles[j].Deposit.SubmittedAt)
+	})
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// InMemoryDuplicateIndex keeps MinHash signatures and their LSH buckets in
+// memory. It is rebuilt from the article repository on startup.
+type InMemoryDuplicateIndex struct {
+	mu         sync.RWMutex
+	signatures map[string]core.MinHashSignature
+	// buckets maps LSH band keys to the IDs of the articles in them
+	buckets map[string]map[string]bool
+}
+
+func NewInMemoryDuplicateIndex() *InMemoryDuplicateIndex {
+	return &InMemoryDuplicateIndex{
+		signatures: make(map[string]core.MinHashSignature),
+		buckets:    make(map[string]map[string]bool),
+	}
+}
+
+func (i *InMemoryDuplicateIndex) IndexSignature(articleID string, signature core.MinHashSignature) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(articleID)
+	i.signatures[articleID] = signature
+	for _, key := range signature.BandKeys() {
+		articleIDs, ok := i.buckets[key]
+		if !ok {
+			articleIDs = make(map[string]bool)
+			i.buckets[key] = articleIDs
+		}
+		articleIDs[articleID] = true
+	}
+	return nil
+}
+
+func (i *InMemoryDuplicateIndex) RemoveSignature(articleID string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(articleID)
+	return nil
+}
+
+// remove must be called with the write lock held
+func (i *InMemoryDuplicateIndex) remove(articleID string) {
+	signature, ok := i.signatures[articleID]
+	if !ok {
+		return
+	}
+	for _, key := range signature.BandKeys() {
+		delete(i.buckets[key], articleID)
+		if len(i.buckets[key]) == 0 {
+			delete(i.buckets, key)
+		}
+	}
+	delete(i.signatures, articleID)
+}
+
+func (i *InMemoryDuplicateIndex) FindCandidates(signature core.MinHashSignature) ([]core.IndexedSignature, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var candidates []core.IndexedSignature
+	for _, key := range signature.BandKeys() {
+		for articleID := range i.buckets[key] {
+			if !seen[articleID] {
+				seen[articleID] = true
+				candidates = append(candidates, core.IndexedSignature{ArticleID: articleID, Signature: i.signatures[articleID]})
+			}
+		}
+	}
+	sort.Slice(candidates, func(a, b int) bool { return candidates[a].ArticleID < candidates[b].ArticleID })
+	return candidates, nil
+}
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) ListPublishedArticles(query core.HarvestQuery) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if article.Status != core.StatusPublished || (query.JournalID != "" && article.JournalID != query.JournalID) {
+			continue
+		}
+		datestamp := article.Datestamp()
+		if (!query.From.IsZero() && datestamp.Before(query.From)) || (!query.Until.IsZero() && !datestamp.Before(query.Until)) {
+			continue
+		}
+		if after := query.After; after != nil {
+			if datestamp.Before(after.Datestamp) || (datestamp.Equal(after.Datestamp) && article.ID <= after.ArticleID) {
+				continue
+			}
+		}
+		articles = append(articles, article)
+	}
+	sort.Slice(articles, func(i, j int) bool {
+		if a, b := articles[i].Datestamp(), articles[j].Datestamp(); !a.Equal(b) {
+			return a.Before(b)
+		}
+		return articles[i].ID < articles[j].ID
+	})
+	if query.Limit > 0 && len(articles) > query.Limit {
+		articles = articles[:query.Limit]
+	}
+	return articles, nil
+}
+
+func (r *InMemoryArticleRepository) ListPublishedJournalIDs() ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var journalIDs []string
+	for _, article := range r.articles {
+		if article.Status == core.StatusPublished && !seen[article.JournalID] {
+			seen[article.JournalID] = true
+			journalIDs = append(journalIDs, article.JournalID)
+		}
+	}
+	sort.Strings(journalIDs)
+	return journalIDs, nil
+}
+
+func (r *InMemoryArticleRepository) ListRecentlyPublishedArticles(journalID string, limit int) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if article.Status == core.StatusPublished && article.JournalID == journalID && article.PublishedAt != nil {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool {
+		if a, b := *articles[i].PublishedAt, *articles[j].PublishedAt; !a.Equal(b) {
+			return a.After(b)
+		}
+		return articles[i].ID > articles[j].ID
+	})
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// InMemoryJournalDirectory serves journals and issues for running the
+// article service without the journal service. Journals registered by
+// imports are kept in memory.
+type InMemoryJournalDirectory struct {
+	mu       sync.RWMutex
+	journals map[string]core.JournalInfo
+	issues   map[string]core.IssueInfo
+}
+
+func NewInMemoryJournalDirectory(journals ...core.JournalInfo) *InMemoryJournalDirectory {
+	d := &InMemoryJournalDirectory{journals: make(map[string]core.JournalInfo), issues: make(map[string]core.IssueInfo)}
+	for _, journal := range journals {
+		d.journals[journal.ID] = journal
+	}
+	return d
+}
+
+// WithIssues adds issues to the directory and returns it
+func (d *InMemoryJournalDirectory) WithIssues(issues ...core.IssueInfo) *InMemoryJournalDirectory {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, issue := range issues {
+		d.issues[issue.ID] = issue
+	}
+	return d
+}
+
+func (d *InMemoryJournalDirectory) GetJournal(id string) (core.JournalInfo, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	journal, ok := d.journals[id]
+	if !ok {
+		return core.JournalInfo{}, core.ErrJournalNotFound
+	}
+	return journal, nil
+}
+
+func (d *InMemoryJournalDirectory) GetIssue(id string) (core.IssueInfo, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	issue, ok := d.issues[id]
+	if !ok {
+		return core.IssueInfo{}, core.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+func (d *InMemoryJournalDirectory) FindJournalByISSN(issn string) (core.JournalInfo, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, journal := range d.journals {
+		if journal.PrintISSN == issn || journal.ElectronicISSN == issn {
+			return journal, nil
+		}
+	}
+	return core.JournalInfo{}, core.ErrJournalNotFound
+}
+
+func (d *InMemoryJournalDirectory) FindJournalsByName(name string) ([]core.JournalInfo, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var journals []core.JournalInfo
+	for _, journal := range d.journals {
+		if strings.EqualFold(strings.Join(strings.Fields(journal.Name), " "), strings.Join(strings.Fields(name), " ")) {
+			journals = append(journals, journal)
+		}
+	}
+	sort.Slice(journals, func(i, j int) bool { return journals[i].ID < journals[j].ID })
+	return journals, nil
+}
+
+func (d *InMemoryJournalDirectory) CreateJournal(journal core.JournalInfo) (core.JournalInfo, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.journals[journal.ID]; ok {
+		return core.JournalInfo{}, fmt.Errorf("%w: journal %s already exists", core.ErrInvalidImport, journal.ID)
+	}
+	d.journals[journal.ID] = journal
+	return journal, nil
+}
@@ -0,0 +1,50 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) SavePlacement(placement core.ArticlePlacement, events ...core.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.articles[placement.ArticleID]; !ok {
+		return core.ErrArticleNotFound
+	}
+	for _, other := range r.placements {
+		if other.ArticleID != placement.ArticleID && other.IssueID == placement.IssueID && other.Sequence == placement.Sequence {
+			return fmt.Errorf("%w: position %d is taken by article %s", core.ErrInvalidPlacement, placement.Sequence, other.ArticleID)
+		}
+	}
+	r.placements[placement.ArticleID] = placement
+	r.appendEvents(events)
+	return nil
+}
+
+func (r *InMemoryArticleRepository) GetPlacement(articleID string) (core.ArticlePlacement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	placement, ok := r.placements[articleID]
+	if !ok {
+		return core.ArticlePlacement{}, core.ErrPlacementNotFound
+	}
+	return placement, nil
+}
+
+func (r *InMemoryArticleRepository) ListIssuePlacements(issueID string) ([]core.ArticlePlacement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var placements []core.ArticlePlacement
+	for _, placement := range r.placements {
+		if placement.IssueID == issueID {
+			placements = append(placements, placement)
+		}
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i].Sequence < placements[j].Sequence })
+	return placements, nil
+}
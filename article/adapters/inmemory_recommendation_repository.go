@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type InMemoryRecommendationRepository struct {
+	mu sync.RWMutex
+	// recommendations maps article IDs to their lists in rank order
+	recommendations map[string][]core.ArticleRecommendation
+}
+
+func NewInMemoryRecommendationRepository() *InMemoryRecommendationRepository {
+	return &InMemoryRecommendationRepository{recommendations: make(map[string][]core.ArticleRecommendation)}
+}
+
+func (r *InMemoryRecommendationRepository) ReplaceRecommendations(recommendations []core.ArticleRecommendation) error {
+	lists := make(map[string][]core.ArticleRecommendation)
+	for _, recommendation := range recommendations {
+		lists[recommendation.ArticleID] = append(lists[recommendation.ArticleID], recommendation)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recommendations = lists
+	return nil
+}
+
+func (r *InMemoryRecommendationRepository) ListRecommendations(articleID string) ([]core.ArticleRecommendation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]core.ArticleRecommendation(nil), r.recommendations[articleID]...), nil
+}
@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// InMemoryReviewRepository stores status changes made by review steps in
+// the article repository it was created with
+type InMemoryReviewRepository struct {
+	mu          sync.RWMutex
+	articles    *InMemoryArticleRepository
+	reviewers   map[string]core.Reviewer
+	assignments map[string]core.ReviewAssignment
+	reports     []core.ReviewReport
+	decisions   []core.EditorDecision
+}
+
+func NewInMemoryReviewRepository(articles *InMemoryArticleRepository) *InMemoryReviewRepository {
+	return &InMemoryReviewRepository{
+		articles:    articles,
+		reviewers:   make(map[string]core.Reviewer),
+		assignments: make(map[string]core.ReviewAssignment),
+	}
+}
+
+func (r *InMemoryReviewRepository) CreateReviewer(reviewer core.Reviewer) (core.Reviewer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reviewers[reviewer.ID] = reviewer
+	return reviewer, nil
+}
+
+func (r *InMemoryReviewRepository) GetReviewer(id string) (core.Reviewer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reviewer, ok := r.reviewers[id]
+	if !ok {
+		return core.Reviewer{}, core.ErrReviewerNotFound
+	}
+	return reviewer, nil
+}
+
+func (r *InMemoryReviewRepository) ListReviewers() ([]core.Reviewer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reviewers := make([]core.Reviewer, 0, len(r.reviewers))
+	for _, reviewer := range r.reviewers {
+		reviewers = append(reviewers, reviewer)
+	}
+	sort.Slice(reviewers, func(i, j int) bool { return reviewers[i].ID < reviewers[j].ID })
+	return reviewers, nil
+}
+
+func (r *InMemoryReviewRepository) CreateAssignment(assignment core.ReviewAssignment, change *core.StatusChange) (core.ReviewAssignment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if change != nil {
+		if err := r.updateArticleStatus(*change); err != nil {
+			return core.ReviewAssignment{}, err
+		}
+	}
+	r.assignments[assignment.ID] = assignment
+	return assignment, nil
+}
+
+func (r *InMemoryReviewRepository) GetAssignment(id string) (core.ReviewAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	assignment, ok := r.assignments[id]
+	if !ok {
+		return core.ReviewAssignment{}, core.ErrAssignmentNotFound
+	}
+	return assignment, nil
+}
+
+func (r *InMemoryReviewRepository) ListAssignments(articleID string) ([]core.ReviewAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var assignments []core.ReviewAssignment
+	for _, assignment := range r.assignments {
+		if assignment.ArticleID == articleID {
+			assignments = append(assignments, assignment)
+		}
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		if !assignments[i].AssignedAt.Equal(assignments[j].AssignedAt) {
+			return assignments[i].AssignedAt.Before(assignments[j].AssignedAt)
+		}
+		return assignments[i].ID < assignments[j].ID
+	})
+	return assignments, nil
+}
+
+func (r *InMemoryReviewRepository) CreateReport(report core.ReviewReport, assignment core.ReviewAssignment) (core.ReviewReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.assignments[assignment.ID]; !ok {
+		return core.ReviewReport{}, core.ErrAssignmentNotFound
+	}
+	r.assignments[assignment.ID] = assignment
+	r.reports = append(r.reports, report)
+	return report, nil
+}
+
+func (r *InMemoryReviewRepository) ListReports(articleID string) ([]core.ReviewReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var reports []core.ReviewReport
+	for _, report := range r.reports {
+		if report.ArticleID == articleID {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+func (r *InMemoryReviewRepository) CreateDecision(decision core.EditorDecision, change core.StatusChange) (core.EditorDecision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.updateArticleStatus(change); err != nil {
+		return core.EditorDecision{}, err
+	}
+	r.decisions = append(r.decisions, decision)
+	return decision, nil
+}
+
+func (r *InMemoryReviewRepository) ListDecisions(articleID string) ([]core.EditorDecision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var decisions []core.EditorDecision
+	for _, decision := range r.decisions {
+		if decision.ArticleID == articleID {
+			decisions = append(decisions, decision)
+		}
+	}
+	return decisions, nil
+}
+
+// updateArticleStatus applies a status change in the article repository. It
+// must be called with the write lock held, and the review record is only
+// stored when it succeeds.
+func (r *InMemoryReviewRepository) updateArticleStatus(change core.StatusChange) error {
+	r.articles.mu.Lock()
+	defer r.articles.mu.Unlock()
+
+	_, err := r.articles.updateArticleStatus(change.Article, change.Transition, change.Events)
+	return err
+}
@@ -0,0 +1,283 @@
+package adapters
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// BM25 parameters: k1 sets how quickly repeated terms stop adding to the
+// score and b how much long fields are penalised
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// InMemorySearchIndex is an inverted index held in memory. It is rebuilt
+// from the article repository on startup.
+type InMemorySearchIndex struct {
+	mu        sync.RWMutex
+	documents map[string]*indexedDocument
+	// postings maps a term to the documents containing it
+	postings map[string]map[string]*posting
+	// totalLength is the number of terms of each field over all documents
+	totalLength map[core.SearchField]int
+}
+
+type indexedDocument struct {
+	document core.SearchDocument
+	// length is the number of terms in each field
+	length map[core.SearchField]int
+	terms  []string
+}
+
+// posting lists a term's occurrences in one document by field, in text order
+type posting struct {
+	occurrences map[core.SearchField][]core.SearchToken
+}
+
+func NewInMemorySearchIndex() *InMemorySearchIndex {
+	return &InMemorySearchIndex{
+		documents:   make(map[string]*indexedDocument),
+		postings:    make(map[string]map[string]*posting),
+		totalLength: make(map[core.SearchField]int),
+	}
+}
+
+func (i *InMemorySearchIndex) IndexDocument(document core.SearchDocument) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(document.ArticleID)
+	indexed := &indexedDocument{document: document, length: make(map[core.SearchField]int)}
+	for _, field := range core.SearchFields {
+		tokens := core.AnalyzeText(document.Text(field))
+		indexed.length[field] = len(tokens)
+		i.totalLength[field] += len(tokens)
+		for _, token := range tokens {
+			documents, ok := i.postings[token.Term]
+			if !ok {
+				documents = make(map[string]*posting)
+				i.postings[token.Term] = documents
+			}
+			entry, ok := documents[document.ArticleID]
+			if !ok {
+				entry = &posting{occurrences: make(map[core.SearchField][]core.SearchToken)}
+				documents[document.ArticleID] = entry
+				indexed.terms = append(indexed.terms, token.Term)
+			}
+			entry.occurrences[field] = append(entry.occurrences[field], token)
+		}
+	}
+	i.documents[document.ArticleID] = indexed
+	return nil
+}
+
+func (i *InMemorySearchIndex) RemoveDocument(articleID string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(articleID)
+	return nil
+}
+
+// remove must be called with the write lock held
+func (i *InMemorySearchIndex) remove(articleID string) {
+	indexed, ok := i.documents[articleID]
+	if !ok {
+		return
+	}
+	for _, term := range indexed.terms {
+		delete(i.postings[term], articleID)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+	for field, length := range indexed.length {
+		i.totalLength[field] -= length
+	}
+	delete(i.documents, articleID)
+}
+
+// clauseMatch is a clause's occurrences in one document: single tokens for
+// words and the tokens of every occurrence for phrases, by field
+type clauseMatch map[core.SearchField][][]core.SearchToken
+
+func (i *InMemorySearchIndex) Search(query core.SearchQuery) (core.SearchHits, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	clauses := core.ParseSearchText(query.Text)
+	hasPhrases := false
+	// matches[c] maps the documents that match clause c to the occurrences
+	matches := make([]map[string]clauseMatch, len(clauses))
+	for c, clause := range clauses {
+		matches[c] = i.matchClause(clause, query)
+		hasPhrases = hasPhrases || clause.Phrase
+	}
+
+	// Documents must match every phrase, or some word when there are none
+	candidates := make(map[string]bool)
+	for c, clause := range clauses {
+		if clause.Phrase == hasPhrases {
+			for articleID := range matches[c] {
+				candidates[articleID] = true
+			}
+		}
+	}
+	if hasPhrases {
+		for c, clause := range clauses {
+			if !clause.Phrase {
+				continue
+			}
+			for articleID := range candidates {
+				if _, ok := matches[c][articleID]; !ok {
+					delete(candidates, articleID)
+				}
+			}
+		}
+	}
+
+	averageLength := make(map[core.SearchField]float64)
+	for _, field := range core.SearchFields {
+		if len(i.documents) > 0 {
+			averageLength[field] = float64(i.totalLength[field]) / float64(len(i.documents))
+		}
+	}
+
+	hits := make([]core.SearchHit, 0, len(candidates))
+	for articleID := range candidates {
+		hit := core.SearchHit{ArticleID: articleID}
+		for c := range clauses {
+			occurrences, ok := matches[c][articleID]
+			if !ok {
+				continue
+			}
+			hit.Score += i.score(occurrences, i.documentFrequency(clauses[c], matches[c]), i.documents[articleID], averageLength)
+			for field, runs := range occurrences {
+				for _, run := range runs {
+					for _, token := range run {
+						hit.Matches = append(hit.Matches, core.SearchMatch{Field: field, Start: token.Start, End: token.End})
+					}
+				}
+			}
+		}
+		hit.Matches = sortedMatches(hit.Matches)
+		hits = append(hits, hit)
+	}
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].ArticleID < hits[b].ArticleID
+	})
+
+	result := core.SearchHits{Total: len(hits)}
+	if query.Offset < len(hits) {
+		hits = hits[query.Offset:]
+		if len(hits) > query.Limit {
+			hits = hits[:query.Limit]
+		}
+		result.Hits = hits
+	}
+	return result, nil
+}
+
+// matchClause finds the clause's occurrences in the documents that pass
+// the query's filters
+func (i *InMemorySearchIndex) matchClause(clause core.SearchClause, query core.SearchQuery) map[string]clauseMatch {
+	first := clause.Tokens[0]
+	matches := make(map[string]clauseMatch)
+	for articleID, entry := range i.postings[first.Term] {
+		if !query.Matches(i.documents[articleID].document) {
+			continue
+		}
+		match := make(clauseMatch)
+		for field, occurrences := range entry.occurrences {
+			for _, occurrence := range occurrences {
+				if run, ok := i.phraseAt(articleID, field, clause.Tokens, occurrence); ok {
+					match[field] = append(match[field], run)
+				}
+			}
+		}
+		if len(match) > 0 {
+			matches[articleID] = match
+		}
+	}
+	return matches
+}
+
+// phraseAt returns the tokens of the phrase when it occurs in the field
+// starting with the given occurrence of its first term. Words are phrases
+// of one token.
+func (i *InMemorySearchIndex) phraseAt(articleID string, field core.SearchField, tokens []core.SearchToken, start core.SearchToken) ([]core.SearchToken, bool) {
+	run := []core.SearchToken{start}
+	for _, token := range tokens[1:] {
+		entry, ok := i.postings[token.Term][articleID]
+		if !ok {
+			return nil, false
+		}
+		position := start.Position + token.Position - tokens[0].Position
+		occurrences := entry.occurrences[field]
+		k := sort.Search(len(occurrences), func(k int) bool { return occurrences[k].Position >= position })
+		if k == len(occurrences) || occurrences[k].Position != position {
+			return nil, false
+		}
+		run = append(run, occurrences[k])
+	}
+	return run, true
+}
+
+// documentFrequency is the number of documents containing a word, or, for
+// phrases, the number of documents that pass the filters and contain it,
+// which saves checking the phrase in every other document
+func (i *InMemorySearchIndex) documentFrequency(clause core.SearchClause, matches map[string]clauseMatch) int {
+	if clause.Phrase {
+		return len(matches)
+	}
+	return len(i.postings[clause.Tokens[0].Term])
+}
+
+// score is the BM25F score of a clause in a document: occurrences are
+// weighted by field boost and normalised by field length before they are
+// saturated, so a term in the title and the abstract counts once with the
+// weight of both
+func (i *InMemorySearchIndex) score(occurrences clauseMatch, documentFrequency int, indexed *indexedDocument, averageLength map[core.SearchField]float64) float64 {
+	n := float64(len(i.documents))
+	df := float64(documentFrequency)
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	tf := 0.0
+	for field, runs := range occurrences {
+		norm := 1.0
+		if averageLength[field] > 0 {
+			norm = 1 - bm25B + bm25B*float64(indexed.length[field])/averageLength[field]
+		}
+		tf += field.Boost() * float64(len(runs)) / norm
+	}
+	return idf * tf * (bm25K1 + 1) / (tf + bm25K1)
+}
+
+// sortedMatches orders matches by field and position and drops duplicates
+func sortedMatches(matches []core.SearchMatch) []core.SearchMatch {
+	order := make(map[core.SearchField]int)
+	for k, field := range core.SearchFields {
+		order[field] = k
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].Field != matches[b].Field {
+			return order[matches[a].Field] < order[matches[b].Field]
+		}
+		return matches[a].Start < matches[b].Start
+	})
+
+	unique := matches[:0]
+	for _, match := range matches {
+		if len(unique) == 0 || match != unique[len(unique)-1] {
+			unique = append(unique, match)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,38 @@
+package adapters
+
+import (
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) ListArticlesBySubjects(termIDs []string, offset, limit int) (core.ArticlePage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(termIDs))
+	for _, termID := range termIDs {
+		wanted[termID] = true
+	}
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		for _, subject := range article.Subjects {
+			if wanted[subject] {
+				articles = append(articles, article)
+				break
+			}
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+
+	page := core.ArticlePage{Total: len(articles)}
+	if offset < len(articles) {
+		articles = articles[offset:]
+		if len(articles) > limit {
+			articles = articles[:limit]
+		}
+		page.Articles = articles
+	}
+	return page, nil
+}
@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type InMemoryTaxonomyRepository struct {
+	mu    sync.RWMutex
+	terms map[string]core.SubjectTerm
+}
+
+func NewInMemoryTaxonomyRepository() *InMemoryTaxonomyRepository {
+	return &InMemoryTaxonomyRepository{terms: make(map[string]core.SubjectTerm)}
+}
+
+func (r *InMemoryTaxonomyRepository) CreateTerm(term core.SubjectTerm) (core.SubjectTerm, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.terms[term.ID] = term
+	return term, nil
+}
+
+func (r *InMemoryTaxonomyRepository) GetTerm(id string) (core.SubjectTerm, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	term, ok := r.terms[id]
+	if !ok {
+		return core.SubjectTerm{}, core.ErrSubjectTermNotFound
+	}
+	return term, nil
+}
+
+func (r *InMemoryTaxonomyRepository) ListTerms() ([]core.SubjectTerm, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	terms := make([]core.SubjectTerm, 0, len(r.terms))
+	for _, term := range r.terms {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].ID < terms[j].ID })
+	return terms, nil
+}
+
+func (r *InMemoryTaxonomyRepository) UpdateTerm(term core.SubjectTerm) (core.SubjectTerm, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.terms[term.ID]; !ok {
+		return core.SubjectTerm{}, core.ErrSubjectTermNotFound
+	}
+	r.terms[term.ID] = term
+	return term, nil
+}
+
+func (r *InMemoryTaxonomyRepository) DeleteTerm(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.terms[id]; !ok {
+		return core.ErrSubjectTermNotFound
+	}
+	delete(r.terms, id)
+	return nil
+}
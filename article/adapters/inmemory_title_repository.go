@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *InMemoryArticleRepository) ListTitleCandidates(key string, limit int) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	shared := make(map[string]int)
+	for _, trigram := range core.TitleTrigrams(key) {
+		for articleID := range r.titleTrigrams[trigram] {
+			shared[articleID]++
+		}
+	}
+
+	articles := make([]core.Article, 0, len(shared))
+	for articleID := range shared {
+		articles = append(articles, r.articles[articleID])
+	}
+	sort.Slice(articles, func(i, j int) bool {
+		if shared[articles[i].ID] != shared[articles[j].ID] {
+			return shared[articles[i].ID] > shared[articles[j].ID]
+		}
+		return articles[i].ID < articles[j].ID
+	})
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
+
+// checkUniqueTitle returns ErrDuplicateTitle when another article of the
+// journal has the article's title key. It must be called with the write
+// lock held.
+func (r *InMemoryArticleRepository) checkUniqueTitle(article core.Article) error {
+	key := core.NormalizeTitle(article.Title)
+	for articleID, other := range r.titleKeys {
+		if other == key && articleID != article.ID && r.articles[articleID].JournalID == article.JournalID {
+			return fmt.Errorf("%w: article %s of journal %s has the same title", core.ErrDuplicateTitle, articleID, article.JournalID)
+		}
+	}
+	return nil
+}
+
+// indexTitle stores the article's title key and trigrams in place of the
+// old ones. It must be called with the write lock held.
+func (r *InMemoryArticleRepository) indexTitle(article core.Article) {
+	for _, trigram := range core.TitleTrigrams(r.titleKeys[article.ID]) {
+		delete(r.titleTrigrams[trigram], article.ID)
+		if len(r.titleTrigrams[trigram]) == 0 {
+			delete(r.titleTrigrams, trigram)
+		}
+	}
+
+	key := core.NormalizeTitle(article.Title)
+	r.titleKeys[article.ID] = key
+	for _, trigram := range core.TitleTrigrams(key) {
+		articleIDs, ok := r.titleTrigrams[trigram]
+		if !ok {
+			articleIDs = make(map[string]bool)
+			r.titleTrigrams[trigram] = articleIDs
+		}
+		articleIDs[article.ID] = true
+	}
+}
@@ -1,23 +1,68 @@
 package adapters
 
 import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/realBagher/hexaservice-go/article/core"
 )
 
+type outboxEntry struct {
+	event     core.Event
+	published bool
+}
+
 type InMemoryArticleRepository struct {
+	mu       sync.RWMutex
 	articles map[string]core.Article
+	history  map[string][]core.StatusTransition
+	// placements maps article IDs to their issue placement
+	placements map[string]core.ArticlePlacement
+	// references maps article IDs to their reference lists
+	references map[string][]core.Reference
+	// titleKeys maps article IDs to their normalized title
+	titleKeys map[string]string
+	// titleTrigrams maps title trigrams to the IDs of the articles having them
+	titleTrigrams map[string]map[string]bool
+	outbox        []outboxEntry
 }
 
 func NewInMemoryArticleRepository() *InMemoryArticleRepository {
-	return &InMemoryArticleRepository{articles: make(map[string]core.Article)}
+	return &InMemoryArticleRepository{
+		articles:      make(map[string]core.Article),
+		history:       make(map[string][]core.StatusTransition),
+		placements:    make(map[string]core.ArticlePlacement),
+		references:    make(map[string][]core.Reference),
+		titleKeys:     make(map[string]string),
+		titleTrigrams: make(map[string]map[string]bool),
+	}
 }
 
-func (r *InMemoryArticleRepository) CreateArticle(article core.Article) (core.Article, error) {
+func (r *InMemoryArticleRepository) CreateArticle(article core.Article, uniqueTitle bool, events ...core.Event) (core.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.articles[article.ID]; ok {
+		return core.Article{}, fmt.Errorf("%w: %s", core.ErrArticleExists, article.ID)
+	}
+	if uniqueTitle {
+		if err := r.checkUniqueTitle(article); err != nil {
+			return core.Article{}, err
+		}
+	}
+	touch(&article)
 	r.articles[article.ID] = article
+	r.indexTitle(article)
+	r.appendEvents(events)
 	return article, nil
 }
 
 func (r *InMemoryArticleRepository) GetArticleByID(id string) (core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	article, ok := r.articles[id]
 	if !ok {
 		return core.Article{}, core.ErrArticleNotFound
@@ -25,11 +70,218 @@ func (r *InMemoryArticleRepository) GetArticleByID(id string) (core.Article, err
 	return article, nil
 }
 
-func (r *InMemoryArticleRepository) GetArticleByTitle(title string) (core.Article, error) {
+func (r *InMemoryArticleRepository) ListArticlesByTitle(key, journalID string, offset, limit int) (core.ArticlePage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
 	for _, article := range r.articles {
-		if article.Title == title {
+		if r.titleKeys[article.ID] == key && (journalID == "" || article.JournalID == journalID) {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+
+	page := core.ArticlePage{Total: len(articles)}
+	if offset < len(articles) {
+		articles = articles[offset:]
+		if len(articles) > limit {
+			articles = articles[:limit]
+		}
+		page.Articles = articles
+	}
+	return page, nil
+}
+
+func (r *InMemoryArticleRepository) GetArticleByDOI(doi string) (core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, article := range r.articles {
+		if article.DOI == doi {
 			return article, nil
 		}
 	}
 	return core.Article{}, core.ErrArticleNotFound
 }
+
+func (r *InMemoryArticleRepository) ListArticlesByAuthor(authorID string) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if hasAuthor(article, authorID) {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+	return articles, nil
+}
+
+func (r *InMemoryArticleRepository) ListArticlesByJournal(journalID string) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if article.JournalID == journalID {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+	return articles, nil
+}
+
+func (r *InMemoryArticleRepository) ListArticlesAfter(articleID string, limit int) ([]core.Article, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var articles []core.Article
+	for _, article := range r.articles {
+		if article.ID > articleID {
+			articles = append(articles, article)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+	if len(articles) > limit {
+		articles = articles[:limit]
+	}
+	return articles, nil
+}
+
+func (r *InMemoryArticleRepository) UpdateArticle(article core.Article, uniqueTitle bool, events ...core.Event) (core.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.articles[article.ID]
+	if !ok {
+		return core.Article{}, core.ErrArticleNotFound
+	}
+	if uniqueTitle {
+		if err := r.checkUniqueTitle(article); err != nil {
+			return core.Article{}, err
+		}
+	}
+	article.CitationCount = current.CitationCount
+	article.DOI, article.Deposit = current.DOI, current.Deposit
+	article.CreatedAt = current.CreatedAt
+	touch(&article)
+	r.articles[article.ID] = article
+	r.indexTitle(article)
+	r.appendEvents(events)
+	return article, nil
+}
+
+func (r *InMemoryArticleRepository) ReplaceArticleAuthors(changes []core.AuthorListChange, events ...core.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Check every change before applying any of them
+	for _, change := range changes {
+		article, ok := r.articles[change.ArticleID]
+		if !ok {
+			return core.ErrArticleNotFound
+		}
+		if !core.SameAuthorList(article.Authors, change.Before) {
+			return fmt.Errorf("%w: article %s", core.ErrAuthorListChanged, change.ArticleID)
+		}
+	}
+
+	for _, change := range changes {
+		article := r.articles[change.ArticleID]
+		article.Authors = change.After
+		touch(&article)
+		r.articles[change.ArticleID] = article
+	}
+	r.appendEvents(events)
+	return nil
+}
+
+func (r *InMemoryArticleRepository) UpdateArticleStatus(article core.Article, transition core.StatusTransition, events ...core.Event) (core.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.updateArticleStatus(article, transition, events)
+}
+
+// updateArticleStatus must be called with the write lock held
+func (r *InMemoryArticleRepository) updateArticleStatus(article core.Article, transition core.StatusTransition, events []core.Event) (core.Article, error) {
+	current, ok := r.articles[article.ID]
+	if !ok {
+		return core.Article{}, core.ErrArticleNotFound
+	}
+	// Another writer moved the article since the service read it
+	if current.Status != transition.From {
+		return core.Article{}, &core.InvalidTransitionError{ArticleID: article.ID, From: current.Status, To: transition.To}
+	}
+
+	current.Status = article.Status
+	current.PublishedAt = article.PublishedAt
+	touch(&current)
+	r.articles[article.ID] = current
+	r.history[article.ID] = append(r.history[article.ID], transition)
+	r.appendEvents(events)
+	return current, nil
+}
+
+func (r *InMemoryArticleRepository) GetStatusHistory(articleID string) ([]core.StatusTransition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]core.StatusTransition(nil), r.history[articleID]...), nil
+}
+
+func (r *InMemoryArticleRepository) PendingEvents(limit int) ([]core.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []core.Event
+	for _, entry := range r.outbox {
+		if len(events) >= limit {
+			break
+		}
+		if !entry.published {
+			events = append(events, entry.event)
+		}
+	}
+	return events, nil
+}
+
+func (r *InMemoryArticleRepository) MarkEventPublished(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.outbox {
+		if r.outbox[i].event.ID == id {
+			r.outbox[i].published = true
+			return nil
+		}
+	}
+	return nil
+}
+
+// touch stamps a write the way the MySQL table's timestamp columns do
+func touch(article *core.Article) {
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	if article.CreatedAt == "" {
+		article.CreatedAt = now
+	}
+	article.UpdatedAt = now
+}
+
+// appendEvents must be called with the write lock held
+func (r *InMemoryArticleRepository) appendEvents(events []core.Event) {
+	for _, event := range events {
+		r.outbox = append(r.outbox, outboxEntry{event: event})
+	}
+}
+
+func hasAuthor(article core.Article, authorID string) bool {
+	for _, author := range article.Authors {
+		if author.AuthorID == authorID {
+			return true
+		}
+	}
+	return false
+}
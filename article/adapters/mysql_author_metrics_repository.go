@@ -0,0 +1,215 @@
+package adapters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type MySQLAuthorMetricsRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAuthorMetricsRepository(db *sql.DB) *MySQLAuthorMetricsRepository {
+	return &MySQLAuthorMetricsRepository{db: db}
+}
+
+// InitializeSchema creates the author metrics tables if they don't exist.
+// author_metrics holds one row per author for all journals (journal_id ”)
+// and one per journal the author published in.
+func (r *MySQLAuthorMetricsRepository) InitializeSchema() error {
+	queries := map[string]string{
+		"author_articles": `
+		CREATE TABLE IF NOT EXISTS author_articles (
+			article_id VARCHAR(255) NOT NULL,
+			author_id VARCHAR(255) NOT NULL,
+			journal_id VARCHAR(255) NOT NULL,
+			year INT NOT NULL,
+			citations INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (article_id, author_id),
+			INDEX idx_author_articles_author (author_id)
+		)`,
+		"author_metrics": `
+		CREATE TABLE IF NOT EXISTS author_metrics (
+			author_id VARCHAR(255) NOT NULL,
+			journal_id VARCHAR(255) NOT NULL DEFAULT '',
+			publications INT NOT NULL,
+			publications_by_year JSON NOT NULL,
+			total_citations INT NOT NULL,
+			h_index INT NOT NULL,
+			i10_index INT NOT NULL,
+			updated_at TIMESTAMP(6) NOT NULL,
+			PRIMARY KEY (author_id, journal_id),
+			INDEX idx_author_metrics_h_index (journal_id, h_index, total_citations)
+		)`,
+	}
+
+	for _, table := range []string{"author_articles", "author_metrics"} {
+		if _, err := r.db.Exec(queries[table]); err != nil {
+			return fmt.Errorf("failed to create %s table: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *MySQLAuthorMetricsRepository) ListArticleContributions(articleID string) ([]core.AuthorArticle, error) {
+	articles, err := r.queryAuthorArticles(authorArticleSelect+" WHERE article_id = ? ORDER BY author_id", articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article contributions: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MySQLAuthorMetricsRepository) ListAuthorArticles(authorID string) ([]core.AuthorArticle, error) {
+	articles, err := r.queryAuthorArticles(authorArticleSelect+" WHERE author_id = ? ORDER BY article_id", authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list author articles: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MySQLAuthorMetricsRepository) ApplyArticleContributions(articleID string, contributions []core.AuthorArticle, metrics []core.AuthorMetrics) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to apply article contributions: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.Exec(`DELETE FROM author_articles WHERE article_id = ?`, articleID); err != nil {
+		return fmt.Errorf("failed to apply article contributions: %w", err)
+	}
+
+	query := `
+	INSERT INTO author_articles (article_id, author_id, journal_id, year, citations) 
+	VALUES (?, ?, ?, ?, ?)`
+
+	for _, contribution := range contributions {
+		_, err := tx.Exec(query, contribution.ArticleID, contribution.AuthorID, contribution.JournalID,
+			contribution.Year, contribution.Citations)
+		if err != nil {
+			return fmt.Errorf("failed to apply article contributions: %w", err)
+		}
+	}
+
+	query = `
+	REPLACE INTO author_metrics 
+		(author_id, journal_id, publications, publications_by_year, total_citations, h_index, i10_index, updated_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for _, m := range metrics {
+		if m.Publications == 0 {
+			_, err := tx.Exec(`DELETE FROM author_metrics WHERE author_id = ? AND journal_id = ?`, m.AuthorID, m.JournalID)
+			if err != nil {
+				return fmt.Errorf("failed to remove author metrics: %w", err)
+			}
+			continue
+		}
+
+		byYear, err := json.Marshal(m.PublicationsByYear)
+		if err != nil {
+			return fmt.Errorf("failed to encode publications by year: %w", err)
+		}
+		_, err = tx.Exec(query, m.AuthorID, m.JournalID, m.Publications, byYear, m.TotalCitations,
+			m.HIndex, m.I10Index, m.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to save author metrics: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to apply article contributions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLAuthorMetricsRepository) GetAuthorMetrics(authorID, journalID string) (core.AuthorMetrics, error) {
+	metrics, err := scanAuthorMetrics(r.db.QueryRow(authorMetricsSelect+" WHERE author_id = ? AND journal_id = ?", authorID, journalID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.AuthorMetrics{}, core.ErrAuthorMetricsNotFound
+		}
+		return core.AuthorMetrics{}, fmt.Errorf("failed to get author metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
+func (r *MySQLAuthorMetricsRepository) ListJournalAuthorMetrics(journalID string, order core.LeaderboardOrder, limit int) ([]core.AuthorMetrics, error) {
+	// The column comes from a fixed list, never from the caller
+	column := "h_index"
+	switch order {
+	case core.LeaderboardByCitations:
+		column = "total_citations"
+	case core.LeaderboardByI10Index:
+		column = "i10_index"
+	case core.LeaderboardByPublications:
+		column = "publications"
+	}
+
+	query := authorMetricsSelect + fmt.Sprintf(`
+	WHERE journal_id = ? 
+	ORDER BY %s DESC, total_citations DESC, author_id 
+	LIMIT ?`, column)
+
+	rows, err := r.db.Query(query, journalID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal author metrics: %w", err)
+	}
+	defer rows.Close()
+
+	var ranked []core.AuthorMetrics
+	for rows.Next() {
+		metrics, err := scanAuthorMetrics(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan author metrics: %w", err)
+		}
+		ranked = append(ranked, metrics)
+	}
+
+	return ranked, rows.Err()
+}
+
+const authorArticleSelect = `
+	SELECT author_id, article_id, journal_id, year, citations 
+	FROM author_articles`
+
+func (r *MySQLAuthorMetricsRepository) queryAuthorArticles(query string, args ...any) ([]core.AuthorArticle, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var articles []core.AuthorArticle
+	for rows.Next() {
+		var article core.AuthorArticle
+		if err := rows.Scan(&article.AuthorID, &article.ArticleID, &article.JournalID, &article.Year, &article.Citations); err != nil {
+			return nil, fmt.Errorf("failed to scan author article: %w", err)
+		}
+		articles = append(articles, article)
+	}
+
+	return articles, rows.Err()
+}
+
+const authorMetricsSelect = `
+	SELECT author_id, journal_id, publications, publications_by_year, total_citations, h_index, i10_index, updated_at 
+	FROM author_metrics`
+
+func scanAuthorMetrics(row rowScanner) (core.AuthorMetrics, error) {
+	var metrics core.AuthorMetrics
+	var byYear []byte
+	err := row.Scan(&metrics.AuthorID, &metrics.JournalID, &metrics.Publications, &byYear,
+		&metrics.TotalCitations, &metrics.HIndex, &metrics.I10Index, &metrics.UpdatedAt)
+	if err != nil {
+		return core.AuthorMetrics{}, err
+	}
+	if err := json.Unmarshal(byYear, &metrics.PublicationsByYear); err != nil {
+		return core.AuthorMetrics{}, fmt.Errorf("failed to decode publications by year: %w", err)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,314 @@
+package adapters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type MySQLAuthorRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLAuthorRepository(db *sql.DB) *MySQLAuthorRepository {
+	return &MySQLAuthorRepository{db: db}
+}
+
+// InitializeSchema creates the authors and author merge tables if they
+// don't exist
+func (r *MySQLAuthorRepository) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS authors (
+		id VARCHAR(255) PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		orcid CHAR(19) NULL UNIQUE,
+		affiliation VARCHAR(500),
+		email VARCHAR(255),
+		created_at TIMESTAMP(6) NOT NULL,
+		updated_at TIMESTAMP(6) NOT NULL
+	)`
+
+	_, err := r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create authors table: %w", err)
+	}
+
+	// The merge record is kept as one JSON document so an undo restores
+	// exactly what was removed
+	query = `
+	CREATE TABLE IF NOT EXISTS author_merges (
+		seq BIGINT AUTO_INCREMENT PRIMARY KEY,
+		id VARCHAR(64) NOT NULL UNIQUE,
+		source_id VARCHAR(255) NOT NULL,
+		target_id VARCHAR(255) NOT NULL,
+		record LONGTEXT NOT NULL,
+		merged_at TIMESTAMP(6) NOT NULL,
+		undone_at TIMESTAMP(6) NULL
+	)`
+
+	_, err = r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create author_merges table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLAuthorRepository) CreateAuthor(author core.Author) (core.Author, error) {
+	query := `
+	INSERT INTO authors (id, name, orcid, affiliation, email, created_at, updated_at) 
+	VALUES (?, ?, NULLIF(?, ''), ?, ?, ?, ?)`
+
+	_, err := r.db.Exec(query, author.ID, author.Name, author.ORCID, author.Affiliation, author.Email,
+		author.CreatedAt, author.UpdatedAt)
+	if err != nil {
+		return core.Author{}, fmt.Errorf("failed to create author: %w", err)
+	}
+
+	return author, nil
+}
+
+func (r *MySQLAuthorRepository) DeleteAuthor(id string) error {
+	if _, err := r.db.Exec(`DELETE FROM authors WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete author: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLAuthorRepository) GetAuthor(id string) (core.Author, error) {
+	return r.getAuthor(`WHERE id = ?`, id)
+}
+
+func (r *MySQLAuthorRepository) GetAuthorByORCID(orcid string) (core.Author, error) {
+	return r.getAuthor(`WHERE orcid = ?`, orcid)
+}
+
+func (r *MySQLAuthorRepository) getAuthor(where string, arg any) (core.Author, error) {
+	author, err := scanAuthor(r.db.QueryRow(authorSelect+" "+where, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.Author{}, core.ErrAuthorNotFound
+		}
+		return core.Author{}, fmt.Errorf("failed to get author: %w", err)
+	}
+
+	return author, nil
+}
+
+func (r *MySQLAuthorRepository) ListAuthors() ([]core.Author, error) {
+	rows, err := r.db.Query(authorSelect + ` ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors: %w", err)
+	}
+	defer rows.Close()
+
+	var authors []core.Author
+	for rows.Next() {
+		author, err := scanAuthor(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan author: %w", err)
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
+
+func (r *MySQLAuthorRepository) UpdateAuthor(author core.Author) (core.Author, error) {
+	query := `
+	UPDATE authors 
+	SET name = ?, orcid = NULLIF(?, ''), affiliation = ?, email = ?, updated_at = ? 
+	WHERE id = ?`
+
+	result, err := r.db.Exec(query, author.Name, author.ORCID, author.Affiliation, author.Email,
+		author.UpdatedAt, author.ID)
+	if err != nil {
+		return core.Author{}, fmt.Errorf("failed to update author: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return core.Author{}, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return core.Author{}, core.ErrAuthorNotFound
+	}
+
+	return author, nil
+}
+
+func (r *MySQLAuthorRepository) MergeAuthor(merge core.AuthorMerge) error {
+	record, err := json.Marshal(merge)
+	if err != nil {
+		return fmt.Errorf("failed to encode author merge: %w", err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to merge authors: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.Exec(`DELETE FROM authors WHERE id = ?`, merge.Source.ID)
+	if err != nil {
+		return fmt.Errorf("failed to remove merged author: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return core.ErrAuthorNotFound
+	}
+
+	if err := updateAuthor(tx, merge.Target); err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO author_merges (id, source_id, target_id, record, merged_at) 
+	VALUES (?, ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, merge.ID, merge.Source.ID, merge.Target.ID, string(record), merge.MergedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record author merge: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to merge authors: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLAuthorRepository) UndoMerge(merge core.AuthorMerge) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to undo author merge: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var undoneAt sql.NullTime
+	err = tx.QueryRow(`SELECT undone_at FROM author_merges WHERE id = ? FOR UPDATE`, merge.ID).Scan(&undoneAt)
+	if err == sql.ErrNoRows {
+		return core.ErrMergeNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to undo author merge: %w", err)
+	}
+	if undoneAt.Valid {
+		return fmt.Errorf("%w: merge %s was already undone", core.ErrInvalidMerge, merge.ID)
+	}
+
+	// The target gives back a borrowed ORCID iD before the source returns,
+	// since iDs are unique
+	if err := updateAuthor(tx, merge.TargetBefore); err != nil {
+		return err
+	}
+
+	source := merge.Source
+	query := `
+	INSERT INTO authors (id, name, orcid, affiliation, email, created_at, updated_at) 
+	VALUES (?, ?, NULLIF(?, ''), ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, source.ID, source.Name, source.ORCID, source.Affiliation, source.Email,
+		source.CreatedAt, source.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to restore merged author: %w", err)
+	}
+
+	if _, err := tx.Exec(`UPDATE author_merges SET undone_at = ? WHERE id = ?`, merge.UndoneAt, merge.ID); err != nil {
+		return fmt.Errorf("failed to mark author merge undone: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to undo author merge: %w", err)
+	}
+	return nil
+}
+
+func (r *MySQLAuthorRepository) GetMerge(id string) (core.AuthorMerge, error) {
+	merge, err := scanMerge(r.db.QueryRow(mergeSelect+` WHERE id = ?`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.AuthorMerge{}, core.ErrMergeNotFound
+		}
+		return core.AuthorMerge{}, fmt.Errorf("failed to get author merge: %w", err)
+	}
+
+	return merge, nil
+}
+
+func (r *MySQLAuthorRepository) ListMerges() ([]core.AuthorMerge, error) {
+	rows, err := r.db.Query(mergeSelect + ` ORDER BY seq`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list author merges: %w", err)
+	}
+	defer rows.Close()
+
+	var merges []core.AuthorMerge
+	for rows.Next() {
+		merge, err := scanMerge(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan author merge: %w", err)
+		}
+		merges = append(merges, merge)
+	}
+
+	return merges, rows.Err()
+}
+
+// updateAuthor overwrites an author inside a merge or undo transaction
+func updateAuthor(tx *sql.Tx, author core.Author) error {
+	query := `
+	UPDATE authors 
+	SET name = ?, orcid = NULLIF(?, ''), affiliation = ?, email = ?, updated_at = ? 
+	WHERE id = ?`
+
+	result, err := tx.Exec(query, author.Name, author.ORCID, author.Affiliation, author.Email,
+		author.UpdatedAt, author.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update author: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return core.ErrAuthorNotFound
+	}
+	return nil
+}
+
+const mergeSelect = `
+	SELECT record, undone_at 
+	FROM author_merges`
+
+func scanMerge(row rowScanner) (core.AuthorMerge, error) {
+	var record string
+	var undoneAt sql.NullTime
+	if err := row.Scan(&record, &undoneAt); err != nil {
+		return core.AuthorMerge{}, err
+	}
+
+	var merge core.AuthorMerge
+	if err := json.Unmarshal([]byte(record), &merge); err != nil {
+		return core.AuthorMerge{}, fmt.Errorf("failed to decode author merge: %w", err)
+	}
+	merge.UndoneAt = nil
+	if undoneAt.Valid {
+		merge.UndoneAt = &undoneAt.Time
+	}
+	return merge, nil
+}
+
+const authorSelect = `
+	SELECT id, name, orcid, affiliation, email, created_at, updated_at 
+	FROM authors`
+
+func scanAuthor(row rowScanner) (core.Author, error) {
+	var author core.Author
+	var orcid, affiliation, email sql.NullString
+	err := row.Scan(&author.ID, &author.Name, &orcid, &affiliation, &email, &author.CreatedAt, &author.UpdatedAt)
+	if err != nil {
+		return core.Author{}, err
+	}
+
+	author.ORCID = orcid.String
+	author.Affiliation = affiliation.String
+	author.Email = email.String
+	return author, nil
+}
@@ -0,0 +1,199 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *MySQLArticleRepository) initializeCitationSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS article_references (
+		citing_article_id VARCHAR(255) NOT NULL,
+		position INT NOT NULL,
+		target_article_id VARCHAR(255) NULL,
+		doi VARCHAR(255) NULL,
+		citation_text TEXT,
+		PRIMARY KEY (citing_article_id, position),
+		UNIQUE KEY uq_article_references_article (citing_article_id, target_article_id),
+		UNIQUE KEY uq_article_references_doi (citing_article_id, doi),
+		INDEX idx_article_references_target (target_article_id),
+		INDEX idx_article_references_doi (doi)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create article_references table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLArticleRepository) ReplaceReferences(articleID string, references []core.Reference, events ...core.Event) error {
+	query := `
+	INSERT INTO article_references (citing_article_id, position, target_article_id, doi, citation_text) 
+	VALUES (?, ?, NULLIF(?, ''), NULLIF(?, ''), ?)`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		if err := lockRow(tx, "SELECT id FROM articles WHERE id = ? FOR UPDATE", articleID); err != nil {
+			if err == sql.ErrNoRows {
+				return core.ErrArticleNotFound
+			}
+			return err
+		}
+
+		before, err := selectReferences(tx, referenceSelect+" WHERE citing_article_id = ? ORDER BY position", articleID)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM article_references WHERE citing_article_id = ?`, articleID); err != nil {
+			return err
+		}
+		for _, reference := range references {
+			_, err := tx.Exec(query, articleID, reference.Position, reference.TargetArticleID, reference.DOI, reference.Text)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := updateCitationCounts(tx, before, references); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrInvalidReference) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to replace article references: %w", err)
+	}
+
+	return nil
+}
+
+// updateCitationCounts applies the difference between two reference lists
+// to the cited articles' counts. Rows are updated in ID order so concurrent
+// writers lock them in the same order.
+func updateCitationCounts(tx *sql.Tx, before, after []core.Reference) error {
+	deltas := make(map[string]int)
+	for _, reference := range before {
+		if reference.Internal() {
+			deltas[reference.TargetArticleID]--
+		}
+	}
+	for _, reference := range after {
+		if reference.Internal() {
+			deltas[reference.TargetArticleID]++
+		}
+	}
+
+	targets := make([]string, 0, len(deltas))
+	for target, delta := range deltas {
+		if delta != 0 {
+			targets = append(targets, target)
+		}
+	}
+	sort.Strings(targets)
+
+	for _, target := range targets {
+		result, err := tx.Exec(`UPDATE articles SET citation_count = citation_count + ? WHERE id = ?`, deltas[target], target)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 && deltas[target] > 0 {
+			return fmt.Errorf("%w: unknown article %s", core.ErrInvalidReference, target)
+		}
+	}
+
+	return nil
+}
+
+func (r *MySQLArticleRepository) ListReferences(articleID string) ([]core.Reference, error) {
+	references, err := selectReferences(r.db, referenceSelect+" WHERE citing_article_id = ? ORDER BY position", articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article references: %w", err)
+	}
+	return references, nil
+}
+
+func (r *MySQLArticleRepository) ListReferencesOf(articleIDs []string) ([]core.Reference, error) {
+	if len(articleIDs) == 0 {
+		return nil, nil
+	}
+	placeholders := make([]string, len(articleIDs))
+	args := make([]any, len(articleIDs))
+	for i, articleID := range articleIDs {
+		placeholders[i] = "?"
+		args[i] = articleID
+	}
+	query := referenceSelect + " WHERE citing_article_id IN (" + strings.Join(placeholders, ", ") + ") ORDER BY citing_article_id, position"
+
+	references, err := selectReferences(r.db, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list article references: %w", err)
+	}
+	return references, nil
+}
+
+func (r *MySQLArticleRepository) ListCitedBy(articleID string) ([]core.Reference, error) {
+	references, err := selectReferences(r.db, referenceSelect+" WHERE target_article_id = ? ORDER BY citing_article_id", articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list citing references: %w", err)
+	}
+	return references, nil
+}
+
+func (r *MySQLArticleRepository) ListCitedByDOI(doi string) ([]core.Reference, error) {
+	references, err := selectReferences(r.db, referenceSelect+" WHERE doi = ? ORDER BY citing_article_id", doi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list citing references: %w", err)
+	}
+	return references, nil
+}
+
+const referenceSelect = `
+	SELECT citing_article_id, position, target_article_id, doi, citation_text 
+	FROM article_references`
+
+// referenceQuerier is implemented by both *sql.DB and *sql.Tx
+type referenceQuerier interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func selectReferences(db referenceQuerier, query string, args ...any) ([]core.Reference, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var references []core.Reference
+	for rows.Next() {
+		reference, err := scanReference(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article reference: %w", err)
+		}
+		references = append(references, reference)
+	}
+
+	return references, rows.Err()
+}
+
+func scanReference(row rowScanner) (core.Reference, error) {
+	var reference core.Reference
+	var target, doi, text sql.NullString
+	if err := row.Scan(&reference.CitingArticleID, &reference.Position, &target, &doi, &text); err != nil {
+		return core.Reference{}, err
+	}
+	reference.TargetArticleID = target.String
+	reference.DOI = doi.String
+	reference.Text = text.String
+	return reference, nil
+}
@@ -0,0 +1,63 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *MySQLArticleRepository) SaveDOIDeposit(articleID, doi string, deposit core.DOIDeposit, events ...core.Event) (core.Article, error) {
+	query := `
+	UPDATE articles 
+	SET doi = ?, doi_status = ?, doi_batch_id = ?, doi_message = NULLIF(?, ''), doi_submitted_at = ?, doi_updated_at = ? 
+	WHERE id = ?`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		var current sql.NullString
+		err := tx.QueryRow(`SELECT doi FROM articles WHERE id = ? FOR UPDATE`, articleID).Scan(&current)
+		if err == sql.ErrNoRows {
+			return core.ErrArticleNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if current.Valid && current.String != doi {
+			return fmt.Errorf("%w: article %s already has DOI %s", core.ErrInvalidDOI, articleID, current.String)
+		}
+
+		_, err = tx.Exec(query, doi, deposit.Status, deposit.BatchID, deposit.Message,
+			deposit.SubmittedAt, deposit.UpdatedAt, articleID)
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+			return fmt.Errorf("%w: DOI %s already belongs to another article", core.ErrInvalidDOI, doi)
+		}
+		if err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrInvalidDOI) {
+		return core.Article{}, err
+	}
+	if err != nil {
+		return core.Article{}, fmt.Errorf("failed to save DOI deposit: %w", err)
+	}
+
+	return r.GetArticleByID(articleID)
+}
+
+func (r *MySQLArticleRepository) ListArticlesByDepositStatus(status core.DepositStatus, limit int) ([]core.Article, error) {
+	query := articleSelect + `
+	WHERE doi_status = ? 
+	ORDER BY doi_submitted_at, id 
+	LIMIT ?`
+
+	articles, err := r.queryArticles(query, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles by deposit status: %w", err)
+	}
+	return articles, nil
+}
@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (r *MySQLArticleRepository) ListPublishedArticles(query core.HarvestQuery) ([]core.Article, error) {
+	conditions := []string{"status = ?"}
+	args := []any{core.StatusPublished}
+	if query.JournalID != "" {
+		conditions = append(conditions, "journal_id = ?")
+		args = append(args, query.JournalID)
+	}
+	if !query.From.IsZero() {
+		conditions = append(conditions, "updated_at >= ?")
+		args = append(args, query.From)
+	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "updated_at < ?")
+		args = append(args, query.Until)
+	}
+	if after := query.After; after != nil {
+		conditions = append(conditions, "(updated_at > ? OR (updated_at = ? AND id > ?))")
+		args = append(args, after.Datestamp, after.Datestamp, after.ArticleID)
+	}
+
+	sql := articleSelect + `
+	WHERE ` + strings.Join(conditions, " AND ") + `
+	ORDER BY updated_at, id`
+	if query.Limit > 0 {
+		sql += `
+	LIMIT ?`
+		args = append(args, query.Limit)
+	}
+
+	articles, err := r.queryArticles(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published articles: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MySQLArticleRepository) ListPublishedJournalIDs() ([]string, error) {
+	rows, err := r.db.Query(`
+	SELECT DISTINCT journal_id FROM articles 
+	WHERE status = ? 
+	ORDER BY journal_id`, core.StatusPublished)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published journals: %w", err)
+	}
+	defer rows.Close()
+
+	var journalIDs []string
+	for rows.Next() {
+		var journalID string
+		if err := rows.Scan(&journalID); err != nil {
+			return nil, fmt.Errorf("failed to scan journal ID: %w", err)
+		}
+		journalIDs = append(journalIDs, journalID)
+	}
+	return journalIDs, rows.Err()
+}
+
+func (r *MySQLArticleRepository) ListRecentlyPublishedArticles(journalID string, limit int) ([]core.Article, error) {
+	articles, err := r.queryArticles(articleSelect+`
+	WHERE journal_id = ? AND status = ? AND published_at IS NOT NULL 
+	ORDER BY published_at DESC, id DESC 
+	LIMIT ?`, journalID, core.StatusPublished, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recently published articles: %w", err)
+	}
+	return articles, nil
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// mysqlDuplicateEntry is the server error for a unique key violation
+const mysqlDuplicateEntry = 1062
+
+// isDuplicateKey reports whether err is a violation of the named unique key.
+// The server quotes the key as 'name' or, since MySQL 8.0.19, 'table.name'.
+func isDuplicateKey(err error, key string) bool {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlDuplicateEntry {
+		return false
+	}
+	return strings.HasSuffix(mysqlErr.Message, "'"+key+"'") || strings.HasSuffix(mysqlErr.Message, "."+key+"'")
+}
+
+func (r *MySQLArticleRepository) initializePlacementSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS article_placements (
+		article_id VARCHAR(255) PRIMARY KEY,
+		issue_id VARCHAR(64) NOT NULL,
+		sequence INT NOT NULL,
+		first_page INT NOT NULL DEFAULT 0,
+		last_page INT NOT NULL DEFAULT 0,
+		placed_at TIMESTAMP(6) NOT NULL,
+		UNIQUE KEY uq_article_placements_sequence (issue_id, sequence)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create article_placements table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLArticleRepository) SavePlacement(placement core.ArticlePlacement, events ...core.Event) error {
+	query := `
+	INSERT INTO article_placements (article_id, issue_id, sequence, first_page, last_page, placed_at) 
+	VALUES (?, ?, ?, ?, ?, ?) 
+	ON DUPLICATE KEY UPDATE issue_id = VALUES(issue_id), sequence = VALUES(sequence), 
+		first_page = VALUES(first_page), last_page = VALUES(last_page), placed_at = VALUES(placed_at)`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		if err := lockRow(tx, "SELECT id FROM articles WHERE id = ? FOR UPDATE", placement.ArticleID); err != nil {
+			if err == sql.ErrNoRows {
+				return core.ErrArticleNotFound
+			}
+			return err
+		}
+
+		_, err := tx.Exec(query, placement.ArticleID, placement.IssueID, placement.Sequence,
+			placement.FirstPage, placement.LastPage, placement.PlacedAt)
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+			return fmt.Errorf("%w: position %d is taken", core.ErrInvalidPlacement, placement.Sequence)
+		}
+		if err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrInvalidPlacement) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save article placement: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLArticleRepository) GetPlacement(articleID string) (core.ArticlePlacement, error) {
+	placement, err := scanPlacement(r.db.QueryRow(placementSelect+" WHERE article_id = ?", articleID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.ArticlePlacement{}, core.ErrPlacementNotFound
+		}
+		return core.ArticlePlacement{}, fmt.Errorf("failed to get article placement: %w", err)
+	}
+
+	return placement, nil
+}
+
+func (r *MySQLArticleRepository) ListIssuePlacements(issueID string) ([]core.ArticlePlacement, error) {
+	rows, err := r.db.Query(placementSelect+" WHERE issue_id = ? ORDER BY sequence", issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue placements: %w", err)
+	}
+	defer rows.Close()
+
+	var placements []core.ArticlePlacement
+	for rows.Next() {
+		placement, err := scanPlacement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article placement: %w", err)
+		}
+		placements = append(placements, placement)
+	}
+
+	return placements, rows.Err()
+}
+
+const placementSelect = `
+	SELECT article_id, issue_id, sequence, first_page, last_page, placed_at 
+	FROM article_placements`
+
+func scanPlacement(row rowScanner) (core.ArticlePlacement, error) {
+	var placement core.ArticlePlacement
+	err := row.Scan(&placement.ArticleID, &placement.IssueID, &placement.Sequence,
+		&placement.FirstPage, &placement.LastPage, &placement.PlacedAt)
+	return placement, err
+}
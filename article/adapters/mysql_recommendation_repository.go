@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type MySQLRecommendationRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLRecommendationRepository(db *sql.DB) *MySQLRecommendationRepository {
+	return &MySQLRecommendationRepository{db: db}
+}
+
+// InitializeSchema creates the article_recommendations table if it doesn't
+// exist. It holds one row per article and related article, with the score
+// of each signal so ranking can be explained.
+func (r *MySQLRecommendationRepository) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS article_recommendations (
+		article_id VARCHAR(255) NOT NULL,
+		position INT NOT NULL,
+		related_article_id VARCHAR(255) NOT NULL,
+		score DOUBLE NOT NULL,
+		content_score DOUBLE NOT NULL,
+		author_score DOUBLE NOT NULL,
+		co_citation_score DOUBLE NOT NULL,
+		journal_score DOUBLE NOT NULL,
+		computed_at TIMESTAMP(6) NOT NULL,
+		PRIMARY KEY (article_id, position)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create article_recommendations table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLRecommendationRepository) ReplaceRecommendations(recommendations []core.ArticleRecommendation) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to replace recommendations: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.Exec(`DELETE FROM article_recommendations`); err != nil {
+		return fmt.Errorf("failed to replace recommendations: %w", err)
+	}
+
+	query := `
+	INSERT INTO article_recommendations 
+		(article_id, position, related_article_id, score, content_score, author_score, co_citation_score, journal_score, computed_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for _, recommendation := range recommendations {
+		signals := recommendation.Signals
+		_, err := tx.Exec(query, recommendation.ArticleID, recommendation.Rank, recommendation.RelatedArticleID,
+			recommendation.Score, signals.Content, signals.Authors, signals.CoCitation, signals.Journal,
+			recommendation.ComputedAt)
+		if err != nil {
+			return fmt.Errorf("failed to save recommendation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to replace recommendations: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLRecommendationRepository) ListRecommendations(articleID string) ([]core.ArticleRecommendation, error) {
+	query := `
+	SELECT article_id, position, related_article_id, score, content_score, author_score, 
+		co_citation_score, journal_score, computed_at 
+	FROM article_recommendations 
+	WHERE article_id = ? 
+	ORDER BY position`
+
+	rows, err := r.db.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recommendations: %w", err)
+	}
+	defer rows.Close()
+
+	var recommendations []core.ArticleRecommendation
+	for rows.Next() {
+		var recommendation core.ArticleRecommendation
+		signals := &recommendation.Signals
+		err := rows.Scan(&recommendation.ArticleID, &recommendation.Rank, &recommendation.RelatedArticleID,
+			&recommendation.Score, &signals.Content, &signals.Authors, &signals.CoCitation, &signals.Journal,
+			&recommendation.ComputedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recommendation: %w", err)
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+
+	return recommendations, rows.Err()
+}
@@ -0,0 +1,105 @@
+package adapters_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+const recommendationInsert = `INSERT INTO article_recommendations (article_id, position, related_article_id, score, content_score, author_score, co_citation_score, journal_score, computed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+func newMockRecommendationRepository(t *testing.T) (*adapters.MySQLRecommendationRepository, sqlmock.Sqlmock) {
+	db, mock := newMockDB(t)
+	return adapters.NewMySQLRecommendationRepository(db), mock
+}
+
+func TestMySQLReplaceRecommendations(t *testing.T) {
+	repository, mock := newMockRecommendationRepository(t)
+	computedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	recommendations := []core.ArticleRecommendation{
+		{ArticleID: "r1", Rank: 1, RelatedArticleID: "r2", Score: 0.6, ComputedAt: computedAt,
+			Signals: core.RecommendationSignals{Content: 0.8, Authors: 0.5, CoCitation: 0.25, Journal: 1}},
+		{ArticleID: "r1", Rank: 2, RelatedArticleID: "r3", Score: 0.2, ComputedAt: computedAt,
+			Signals: core.RecommendationSignals{Authors: 1}},
+	}
+
+	// The old lists are replaced in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec(matchSQL(`DELETE FROM article_recommendations`)).WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(matchSQL(recommendationInsert)).
+		WithArgs("r1", 1, "r2", 0.6, 0.8, 0.5, 0.25, 1.0, computedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(matchSQL(recommendationInsert)).
+		WithArgs("r1", 2, "r3", 0.2, 0.0, 1.0, 0.0, 0.0, computedAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := repository.ReplaceRecommendations(recommendations); err != nil {
+		t.Fatal(err)
+	}
+
+	// A failing insert keeps the old lists
+	mock.ExpectBegin()
+	mock.ExpectExec(matchSQL(`DELETE FROM article_recommendations`)).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(matchSQL(recommendationInsert)).WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+	if err := repository.ReplaceRecommendations(recommendations); err == nil {
+		t.Error("ReplaceRecommendations() ignored a failing insert")
+	}
+}
+
+func TestMySQLListRecommendations(t *testing.T) {
+	repository, mock := newMockRecommendationRepository(t)
+	computedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(matchSQL(`FROM article_recommendations WHERE article_id = ? ORDER BY position`)).WithArgs("r1").
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "position", "related_article_id", "score", "content_score",
+			"author_score", "co_citation_score", "journal_score", "computed_at"}).
+			AddRow("r1", 1, "r2", 0.6, 0.8, 0.5, 0.25, 1.0, computedAt).
+			AddRow("r1", 2, "r3", 0.2, 0.0, 1.0, 0.0, 0.0, computedAt))
+
+	recommendations, err := repository.ListRecommendations("r1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.ArticleRecommendation{
+		{ArticleID: "r1", Rank: 1, RelatedArticleID: "r2", Score: 0.6, ComputedAt: computedAt,
+			Signals: core.RecommendationSignals{Content: 0.8, Authors: 0.5, CoCitation: 0.25, Journal: 1}},
+		{ArticleID: "r1", Rank: 2, RelatedArticleID: "r3", Score: 0.2, ComputedAt: computedAt,
+			Signals: core.RecommendationSignals{Authors: 1}},
+	}
+	if !reflect.DeepEqual(recommendations, want) {
+		t.Errorf("ListRecommendations() = %+v, want %+v", recommendations, want)
+	}
+}
+
+func TestMySQLListReferencesOf(t *testing.T) {
+	repository, mock := newMockRepository(t)
+
+	// The references of a batch of articles are read in one query
+	mock.ExpectQuery(matchSQL(`FROM article_references WHERE citing_article_id IN (?, ?) ORDER BY citing_article_id, position`)).
+		WithArgs("c1", "c2").
+		WillReturnRows(sqlmock.NewRows([]string{"citing_article_id", "position", "target_article_id", "doi", "citation_text"}).
+			AddRow("c1", 1, "r4", nil, nil).
+			AddRow("c1", 2, nil, "10.1000/xyz", nil).
+			AddRow("c2", 1, "r5", nil, "Difference Engines"))
+
+	references, err := repository.ListReferencesOf([]string{"c1", "c2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.Reference{
+		{CitingArticleID: "c1", Position: 1, TargetArticleID: "r4"},
+		{CitingArticleID: "c1", Position: 2, DOI: "10.1000/xyz"},
+		{CitingArticleID: "c2", Position: 1, TargetArticleID: "r5", Text: "Difference Engines"},
+	}
+	if !reflect.DeepEqual(references, want) {
+		t.Errorf("ListReferencesOf() = %+v, want %+v", references, want)
+	}
+	if references, err := repository.ListReferencesOf(nil); err != nil || references != nil {
+		t.Errorf("ListReferencesOf(nil) = %+v, %v", references, err)
+	}
+}
@@ -2,23 +2,38 @@ package adapters
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
 	"github.com/realBagher/hexaservice-go/article/core"
+	eventadapters "github.com/realBagher/hexaservice-go/eventing/adapters"
 )
 
+// TablePrefix names the outbox, webhook and audit tables of the article
+// service, which the eventing adapters manage
+const TablePrefix = "article"
+
 type MySQLArticleRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	outbox *eventadapters.MySQLOutbox
 }
 
 func NewMySQLArticleRepository(db *sql.DB) *MySQLArticleRepository {
-	return &MySQLArticleRepository{db: db}
+	return &MySQLArticleRepository{db: db, outbox: eventadapters.NewMySQLOutbox(db, TablePrefix)}
 }
 
 // NewMySQLConnection creates a new MySQL database connection
 func NewMySQLConnection(dsn string) (*sql.DB, error) {
-	db, err := sql.Open("mysql", dsn)
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database DSN: %w", err)
+	}
+	// Outbox timestamps are scanned into time.Time
+	cfg.ParseTime = true
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
@@ -30,17 +45,29 @@ func NewMySQLConnection(dsn string) (*sql.DB, error) {
 	return db, nil
 }
 
-// InitializeSchema creates the articles table if it doesn't exist
+// InitializeSchema creates the articles, article authors, status history and
+// outbox tables if they don't exist, adds columns introduced after the first
+// release and moves the old single author_id column into article_authors
 func (r *MySQLArticleRepository) InitializeSchema() error {
 	query := `
 	CREATE TABLE IF NOT EXISTS articles (
 		id VARCHAR(255) PRIMARY KEY,
 		title VARCHAR(500) NOT NULL,
 		abstract TEXT,
-		author_id VARCHAR(255) NOT NULL,
 		journal_id VARCHAR(255) NOT NULL,
+		status VARCHAR(32) NOT NULL DEFAULT 'draft',
+		published_at TIMESTAMP(6) NULL,
+		citation_count INT NOT NULL DEFAULT 0,
+		doi VARCHAR(255) NULL UNIQUE,
+		doi_status VARCHAR(32) NULL,
+		doi_batch_id VARCHAR(64) NULL,
+		doi_message TEXT NULL,
+		doi_submitted_at TIMESTAMP(6) NULL,
+		doi_updated_at TIMESTAMP(6) NULL,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		INDEX idx_articles_harvest (status, updated_at, id),
+		INDEX idx_articles_feed (journal_id, status, published_at)
 	)`
 
 	_, err := r.db.Exec(query)
@@ -48,16 +75,120 @@ func (r *MySQLArticleRepository) InitializeSchema() error {
 		return fmt.Errorf("failed to create articles table: %w", err)
 	}
 
-	return nil
+	for column, definition := range map[string]string{
+		"status":           "VARCHAR(32) NOT NULL DEFAULT 'draft'",
+		"published_at":     "TIMESTAMP(6) NULL",
+		"citation_count":   "INT NOT NULL DEFAULT 0",
+		"doi":              "VARCHAR(255) NULL UNIQUE",
+		"doi_status":       "VARCHAR(32) NULL",
+		"doi_batch_id":     "VARCHAR(64) NULL",
+		"doi_message":      "TEXT NULL",
+		"doi_submitted_at": "TIMESTAMP(6) NULL",
+		"doi_updated_at":   "TIMESTAMP(6) NULL",
+	} {
+		if err := ensureColumn(r.db, "articles", column, definition); err != nil {
+			return err
+		}
+	}
+	if err := ensureIndex(r.db, "articles", "idx_articles_harvest", "status, updated_at, id"); err != nil {
+		return err
+	}
+	if err := ensureIndex(r.db, "articles", "idx_articles_feed", "journal_id, status, published_at"); err != nil {
+		return err
+	}
+
+	query = `
+	CREATE TABLE IF NOT EXISTS article_authors (
+		article_id VARCHAR(255) NOT NULL,
+		position INT NOT NULL,
+		author_id VARCHAR(255) NOT NULL,
+		orcid CHAR(19) NULL,
+		affiliation VARCHAR(500),
+		corresponding BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (article_id, position),
+		UNIQUE KEY uq_article_author (article_id, author_id),
+		INDEX idx_article_authors_author (author_id)
+	)`
+
+	_, err = r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create article_authors table: %w", err)
+	}
+
+	if err := r.migrateAuthorColumn(); err != nil {
+		return err
+	}
+
+	query = `
+	CREATE TABLE IF NOT EXISTS article_status_history (
+		seq BIGINT AUTO_INCREMENT PRIMARY KEY,
+		article_id VARCHAR(255) NOT NULL,
+		from_status VARCHAR(32) NOT NULL,
+		to_status VARCHAR(32) NOT NULL,
+		actor VARCHAR(255) NOT NULL,
+		reason TEXT,
+		transitioned_at TIMESTAMP(6) NOT NULL,
+		INDEX idx_article_status_history_article (article_id, seq)
+	)`
+
+	_, err = r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create article_status_history table: %w", err)
+	}
+
+	if err := r.outbox.InitializeSchema(); err != nil {
+		return err
+	}
+	if err := r.initializePlacementSchema(); err != nil {
+		return err
+	}
+	if err := r.initializeCitationSchema(); err != nil {
+		return err
+	}
+	if err := r.initializeTitleSchema(); err != nil {
+		return err
+	}
+	return r.initializeSubjectSchema()
 }
 
-func (r *MySQLArticleRepository) CreateArticle(article core.Article) (core.Article, error) {
+func (r *MySQLArticleRepository) CreateArticle(article core.Article, uniqueTitle bool, events ...core.Event) (core.Article, error) {
 	query := `
-	INSERT INTO articles (id, title, abstract, author_id, journal_id, created_at, updated_at) 
-	VALUES (?, ?, ?, ?, ?, ?, ?)`
+	INSERT INTO articles (id, title, abstract, journal_id, status, published_at, doi, unique_title_hash, created_at, updated_at) 
+	VALUES (?, ?, ?, ?, ?, ?, NULLIF(?, ''), ?, 
+		COALESCE(NULLIF(?, ''), CURRENT_TIMESTAMP), COALESCE(NULLIF(?, ''), CURRENT_TIMESTAMP))`
 
-	_, err := r.db.Exec(query, article.ID, article.Title, article.Abstract,
-		article.AuthorID, article.JournalID, article.CreatedAt, article.UpdatedAt)
+	err := r.inTx(func(tx *sql.Tx) error {
+		if uniqueTitle {
+			if err := checkUniqueTitle(tx, article); err != nil {
+				return err
+			}
+		}
+		_, err := tx.Exec(query, article.ID, article.Title, article.Abstract, article.JournalID,
+			article.Status, article.PublishedAt, article.DOI, uniqueTitleHash(article.Title, uniqueTitle),
+			article.CreatedAt, article.UpdatedAt)
+		if isDuplicateKey(err, "PRIMARY") {
+			return fmt.Errorf("%w: %s", core.ErrArticleExists, article.ID)
+		}
+		if isDuplicateKey(err, uniqueTitleIndex) {
+			return fmt.Errorf("%w: another article of journal %s is being created with the same title", core.ErrDuplicateTitle, article.JournalID)
+		}
+		if err != nil {
+			return err
+		}
+		if err := replaceArticleAuthors(tx, article); err != nil {
+			return err
+		}
+		if err := saveTitleKey(tx, article.ID, article.Title); err != nil {
+			return err
+		}
+		if err := saveSubjects(tx, article); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if errors.Is(err, core.ErrArticleExists) || errors.Is(err, core.ErrDuplicateTitle) {
+		return core.Article{}, err
+	}
 	if err != nil {
 		return core.Article{}, fmt.Errorf("failed to create article: %w", err)
 	}
@@ -66,15 +197,10 @@ func (r *MySQLArticleRepository) CreateArticle(article core.Article) (core.Artic
 }
 
 func (r *MySQLArticleRepository) GetArticleByID(id string) (core.Article, error) {
-	var article core.Article
-	query := `
-	SELECT id, title, abstract, author_id, journal_id, created_at, updated_at 
-	FROM articles 
+	query := articleSelect + `
 	WHERE id = ?`
 
-	row := r.db.QueryRow(query, id)
-	err := row.Scan(&article.ID, &article.Title, &article.Abstract,
-		&article.AuthorID, &article.JournalID, &article.CreatedAt, &article.UpdatedAt)
+	article, err := scanArticle(r.db.QueryRow(query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return core.Article{}, core.ErrArticleNotFound
@@ -82,30 +208,544 @@ func (r *MySQLArticleRepository) GetArticleByID(id string) (core.Article, error)
 		return core.Article{}, fmt.Errorf("failed to get article by ID: %w", err)
 	}
 
+	if err := r.loadAuthors([]*core.Article{&article}); err != nil {
+		return core.Article{}, err
+	}
+
 	return article, nil
 }
 
-func (r *MySQLArticleRepository) GetArticleByTitle(title string) (core.Article, error) {
-	var article core.Article
-	query := `
-	SELECT id, title, abstract, author_id, journal_id, created_at, updated_at 
-	FROM articles 
-	WHERE title = ?`
+func (r *MySQLArticleRepository) GetArticleByDOI(doi string) (core.Article, error) {
+	query := articleSelect + `
+	WHERE doi = ?`
 
-	row := r.db.QueryRow(query, title)
-	err := row.Scan(&article.ID, &article.Title, &article.Abstract,
-		&article.AuthorID, &article.JournalID, &article.CreatedAt, &article.UpdatedAt)
+	article, err := scanArticle(r.db.QueryRow(query, doi))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return core.Article{}, core.ErrArticleNotFound
 		}
-		return core.Article{}, fmt.Errorf("failed to get article by title: %w", err)
+		return core.Article{}, fmt.Errorf("failed to get article by DOI: %w", err)
+	}
+
+	if err := r.loadAuthors([]*core.Article{&article}); err != nil {
+		return core.Article{}, err
+	}
+
+	return article, nil
+}
+
+func (r *MySQLArticleRepository) ListArticlesByAuthor(authorID string) ([]core.Article, error) {
+	query := articleSelect + `
+	WHERE id IN (SELECT article_id FROM article_authors WHERE author_id = ?) 
+	ORDER BY id`
+
+	articles, err := r.queryArticles(query, authorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles by author: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MySQLArticleRepository) ListArticlesByJournal(journalID string) ([]core.Article, error) {
+	query := articleSelect + `
+	WHERE journal_id = ? 
+	ORDER BY id`
+
+	articles, err := r.queryArticles(query, journalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles by journal: %w", err)
+	}
+	return articles, nil
+}
+
+func (r *MySQLArticleRepository) ListArticlesAfter(articleID string, limit int) ([]core.Article, error) {
+	query := articleSelect + `
+	WHERE id > ? 
+	ORDER BY id 
+	LIMIT ?`
+
+	articles, err := r.queryArticles(query, articleID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list articles: %w", err)
+	}
+	return articles, nil
+}
+
+// queryArticles runs an articleSelect query and loads the author lists of
+// the articles it returns
+func (r *MySQLArticleRepository) queryArticles(query string, args ...any) ([]core.Article, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var articles []core.Article
+	for rows.Next() {
+		article, err := scanArticle(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan article: %w", err)
+		}
+		articles = append(articles, article)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	refs := make([]*core.Article, len(articles))
+	for i := range articles {
+		refs[i] = &articles[i]
+	}
+	if err := r.loadAuthors(refs); err != nil {
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+func (r *MySQLArticleRepository) UpdateArticle(article core.Article, uniqueTitle bool, events ...core.Event) (core.Article, error) {
+	query := `
+	UPDATE articles 
+	SET title = ?, abstract = ?, journal_id = ?, unique_title_hash = ?, updated_at = CURRENT_TIMESTAMP 
+	WHERE id = ?`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		var key sql.NullString
+		var journalID string
+		var storedHash []byte
+		err := tx.QueryRow(`SELECT title_key, journal_id, unique_title_hash FROM articles WHERE id = ? FOR UPDATE`,
+			article.ID).Scan(&key, &journalID, &storedHash)
+		if err == sql.ErrNoRows {
+			return core.ErrArticleNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if uniqueTitle {
+			if err := checkUniqueTitle(tx, article); err != nil {
+				return err
+			}
+		}
+		// An article keeping its title and journal keeps its hash, so that
+		// the unique index still guards the title against racing writes
+		hash := uniqueTitleHash(article.Title, uniqueTitle)
+		if !uniqueTitle && key.String == core.NormalizeTitle(article.Title) && journalID == article.JournalID {
+			hash = storedHash
+		}
+		_, err = tx.Exec(query, article.Title, article.Abstract, article.JournalID, hash, article.ID)
+		if isDuplicateKey(err, uniqueTitleIndex) {
+			return fmt.Errorf("%w: another article of journal %s is being given the same title", core.ErrDuplicateTitle, article.JournalID)
+		}
+		if err != nil {
+			return err
+		}
+		if err := replaceArticleAuthors(tx, article); err != nil {
+			return err
+		}
+		if err := saveTitleKey(tx, article.ID, article.Title); err != nil {
+			return err
+		}
+		if err := saveSubjects(tx, article); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrDuplicateTitle) {
+		return core.Article{}, err
+	}
+	if err != nil {
+		return core.Article{}, fmt.Errorf("failed to update article: %w", err)
 	}
 
 	return article, nil
 }
 
+func (r *MySQLArticleRepository) ReplaceArticleAuthors(changes []core.AuthorListChange, events ...core.Event) error {
+	err := r.inTx(func(tx *sql.Tx) error {
+		for _, change := range changes {
+			if err := lockRow(tx, "SELECT id FROM articles WHERE id = ? FOR UPDATE", change.ArticleID); err != nil {
+				if err == sql.ErrNoRows {
+					return core.ErrArticleNotFound
+				}
+				return err
+			}
+
+			current, err := selectArticleAuthors(tx, change.ArticleID)
+			if err != nil {
+				return err
+			}
+			if !core.SameAuthorList(current, change.Before) {
+				return fmt.Errorf("%w: article %s", core.ErrAuthorListChanged, change.ArticleID)
+			}
+
+			if err := replaceArticleAuthors(tx, core.Article{ID: change.ArticleID, Authors: change.After}); err != nil {
+				return err
+			}
+			// The author list lives in its own table, so the article's
+			// datestamp is moved explicitly
+			if _, err := tx.Exec("UPDATE articles SET updated_at = CURRENT_TIMESTAMP WHERE id = ?", change.ArticleID); err != nil {
+				return err
+			}
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrAuthorListChanged) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to replace article authors: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLArticleRepository) UpdateArticleStatus(article core.Article, transition core.StatusTransition, events ...core.Event) (core.Article, error) {
+	err := r.inTx(func(tx *sql.Tx) error {
+		return updateArticleStatus(tx, article, transition, events)
+	})
+	if err != nil {
+		return core.Article{}, statusChangeError(err)
+	}
+
+	return article, nil
+}
+
+// updateArticleStatus stores a status change as part of the caller's
+// transaction. It locks the article row and fails with an
+// InvalidTransitionError when another writer moved the article first.
+func updateArticleStatus(tx *sql.Tx, article core.Article, transition core.StatusTransition, events []core.Event) error {
+	var current core.ArticleStatus
+	err := tx.QueryRow(`SELECT status FROM articles WHERE id = ? FOR UPDATE`, article.ID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return core.ErrArticleNotFound
+	}
+	if err != nil {
+		return err
+	}
+	// Another writer moved the article since the service read it
+	if current != transition.From {
+		return &core.InvalidTransitionError{ArticleID: article.ID, From: current, To: transition.To}
+	}
+
+	_, err = tx.Exec(`UPDATE articles SET status = ?, published_at = ? WHERE id = ?`,
+		article.Status, article.PublishedAt, article.ID)
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO article_status_history (article_id, from_status, to_status, actor, reason, transitioned_at) 
+	VALUES (?, ?, ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, transition.ArticleID, transition.From, transition.To,
+		transition.Actor, transition.Reason, transition.At)
+	if err != nil {
+		return err
+	}
+	return insertOutboxEvents(tx, events)
+}
+
+func (r *MySQLArticleRepository) GetStatusHistory(articleID string) ([]core.StatusTransition, error) {
+	query := `
+	SELECT article_id, from_status, to_status, actor, reason, transitioned_at 
+	FROM article_status_history 
+	WHERE article_id = ? 
+	ORDER BY seq`
+
+	rows, err := r.db.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query status history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []core.StatusTransition
+	for rows.Next() {
+		var transition core.StatusTransition
+		var reason sql.NullString
+		err := rows.Scan(&transition.ArticleID, &transition.From, &transition.To,
+			&transition.Actor, &reason, &transition.At)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan status transition: %w", err)
+		}
+		transition.Reason = reason.String
+		history = append(history, transition)
+	}
+
+	return history, rows.Err()
+}
+
+func (r *MySQLArticleRepository) PendingEvents(limit int) ([]core.Event, error) {
+	return r.outbox.PendingEvents(limit)
+}
+
+func (r *MySQLArticleRepository) MarkEventPublished(id string) error {
+	return r.outbox.MarkEventPublished(id)
+}
+
 // Close closes the database connection
 func (r *MySQLArticleRepository) Close() error {
 	return r.db.Close()
 }
+
+const articleSelect = `
+	SELECT id, title, abstract, journal_id, status, published_at, citation_count, 
+		doi, doi_status, doi_batch_id, doi_message, doi_submitted_at, doi_updated_at, keywords, subjects, 
+		created_at, updated_at 
+	FROM articles`
+
+func scanArticle(row rowScanner) (core.Article, error) {
+	var article core.Article
+	var abstract sql.NullString
+	var publishedAt sql.NullTime
+	var doi, depositStatus, batchID, depositMessage sql.NullString
+	var submittedAt, depositUpdatedAt sql.NullTime
+	var keywords, subjects []byte
+	err := row.Scan(&article.ID, &article.Title, &abstract, &article.JournalID,
+		&article.Status, &publishedAt, &article.CitationCount,
+		&doi, &depositStatus, &batchID, &depositMessage, &submittedAt, &depositUpdatedAt,
+		&keywords, &subjects, &article.CreatedAt, &article.UpdatedAt)
+	if err != nil {
+		return core.Article{}, err
+	}
+	if article.Keywords, err = decodeTerms(keywords); err != nil {
+		return core.Article{}, fmt.Errorf("failed to decode keywords: %w", err)
+	}
+	if article.Subjects, err = decodeTerms(subjects); err != nil {
+		return core.Article{}, fmt.Errorf("failed to decode subjects: %w", err)
+	}
+
+	article.Abstract = abstract.String
+	if publishedAt.Valid {
+		article.PublishedAt = &publishedAt.Time
+	}
+	article.DOI = doi.String
+	if depositStatus.Valid {
+		article.Deposit = &core.DOIDeposit{
+			Status:      core.DepositStatus(depositStatus.String),
+			BatchID:     batchID.String,
+			Message:     depositMessage.String,
+			SubmittedAt: submittedAt.Time,
+			UpdatedAt:   depositUpdatedAt.Time,
+		}
+	}
+	return article, nil
+}
+
+// loadAuthors fills in the author lists of the given articles
+func (r *MySQLArticleRepository) loadAuthors(articles []*core.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*core.Article, len(articles))
+	placeholders := make([]string, 0, len(articles))
+	args := make([]any, 0, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+		placeholders = append(placeholders, "?")
+		args = append(args, article.ID)
+	}
+
+	query := `
+	SELECT article_id, author_id, orcid, affiliation, corresponding 
+	FROM article_authors 
+	WHERE article_id IN (` + strings.Join(placeholders, ", ") + `) 
+	ORDER BY article_id, position`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to load article authors: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID string
+		var author core.ArticleAuthor
+		var orcid, affiliation sql.NullString
+		if err := rows.Scan(&articleID, &author.AuthorID, &orcid, &affiliation, &author.Corresponding); err != nil {
+			return fmt.Errorf("failed to scan article author: %w", err)
+		}
+		author.ORCID = orcid.String
+		author.Affiliation = affiliation.String
+		byID[articleID].Authors = append(byID[articleID].Authors, author)
+	}
+
+	return rows.Err()
+}
+
+// selectArticleAuthors reads one article's author list inside a transaction
+func selectArticleAuthors(tx *sql.Tx, articleID string) ([]core.ArticleAuthor, error) {
+	query := `
+	SELECT author_id, orcid, affiliation, corresponding 
+	FROM article_authors 
+	WHERE article_id = ? 
+	ORDER BY position`
+
+	rows, err := tx.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load article authors: %w", err)
+	}
+	defer rows.Close()
+
+	var authors []core.ArticleAuthor
+	for rows.Next() {
+		var author core.ArticleAuthor
+		var orcid, affiliation sql.NullString
+		if err := rows.Scan(&author.AuthorID, &orcid, &affiliation, &author.Corresponding); err != nil {
+			return nil, fmt.Errorf("failed to scan article author: %w", err)
+		}
+		author.ORCID = orcid.String
+		author.Affiliation = affiliation.String
+		authors = append(authors, author)
+	}
+
+	return authors, rows.Err()
+}
+
+// replaceArticleAuthors stores the article's author list in byline order
+func replaceArticleAuthors(tx *sql.Tx, article core.Article) error {
+	if _, err := tx.Exec(`DELETE FROM article_authors WHERE article_id = ?`, article.ID); err != nil {
+		return fmt.Errorf("failed to clear article authors: %w", err)
+	}
+
+	query := `
+	INSERT INTO article_authors (article_id, position, author_id, orcid, affiliation, corresponding) 
+	VALUES (?, ?, ?, NULLIF(?, ''), ?, ?)`
+
+	for position, author := range article.Authors {
+		_, err := tx.Exec(query, article.ID, position, author.AuthorID, author.ORCID, author.Affiliation, author.Corresponding)
+		if err != nil {
+			return fmt.Errorf("failed to store article author: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migrateAuthorColumn moves the single author_id column of databases created
+// before articles had author lists into article_authors. Authors that only
+// existed as IDs get a placeholder author record named after the ID, which
+// can be corrected through UpdateAuthor. The column is dropped once its
+// values are copied, so the migration runs once.
+func (r *MySQLArticleRepository) migrateAuthorColumn() error {
+	exists, err := columnExists(r.db, "articles", "author_id")
+	if err != nil || !exists {
+		return err
+	}
+
+	// Placeholder authors need the authors table
+	if err := NewMySQLAuthorRepository(r.db).InitializeSchema(); err != nil {
+		return err
+	}
+
+	queries := []string{`
+	INSERT IGNORE INTO authors (id, name, created_at, updated_at) 
+	SELECT DISTINCT author_id, author_id, CURRENT_TIMESTAMP(6), CURRENT_TIMESTAMP(6) 
+	FROM articles 
+	WHERE author_id <> ''`, `
+	INSERT IGNORE INTO article_authors (article_id, position, author_id, corresponding) 
+	SELECT id, 0, author_id, TRUE 
+	FROM articles 
+	WHERE author_id <> ''`, `
+	ALTER TABLE articles DROP COLUMN author_id`,
+	}
+
+	for _, query := range queries {
+		if _, err := r.db.Exec(query); err != nil {
+			return fmt.Errorf("failed to migrate articles.author_id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// columnExists reports whether the table in the current database has the
+// column
+func columnExists(db *sql.DB, table, column string) (bool, error) {
+	query := `
+	SELECT COUNT(*) 
+	FROM information_schema.COLUMNS 
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?`
+
+	var count int
+	if err := db.QueryRow(query, table, column).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+	}
+	return count > 0, nil
+}
+
+// ensureColumn adds a column to an existing table. MySQL has no
+// ADD COLUMN IF NOT EXISTS, so the column is looked up first.
+func ensureColumn(db *sql.DB, table, column, definition string) error {
+	exists, err := columnExists(db, table, column)
+	if err != nil || exists {
+		return err
+	}
+
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// ensureIndex adds an index to an existing table, looking it up first like
+// ensureColumn
+func ensureIndex(db *sql.DB, table, index, columns string) error {
+	return ensureIndexOfKind(db, table, "INDEX", index, columns)
+}
+
+// ensureUniqueIndex is ensureIndex for a UNIQUE index
+func ensureUniqueIndex(db *sql.DB, table, index, columns string) error {
+	return ensureIndexOfKind(db, table, "UNIQUE INDEX", index, columns)
+}
+
+func ensureIndexOfKind(db *sql.DB, table, kind, index, columns string) error {
+	query := `
+	SELECT COUNT(*) 
+	FROM information_schema.STATISTICS 
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME = ?`
+
+	var count int
+	if err := db.QueryRow(query, table, index).Scan(&count); err != nil {
+		return fmt.Errorf("failed to inspect index %s.%s: %w", table, index, err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD %s %s (%s)", table, kind, index, columns)); err != nil {
+		return fmt.Errorf("failed to add index %s.%s: %w", table, index, err)
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction that is committed only if fn succeeds
+func (r *MySQLArticleRepository) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// lockRow runs a single-row SELECT ... FOR UPDATE and reports sql.ErrNoRows
+// when the row does not exist
+func lockRow(tx *sql.Tx, query string, args ...any) error {
+	var id string
+	return tx.QueryRow(query, args...).Scan(&id)
+}
+
+func insertOutboxEvents(tx *sql.Tx, events []core.Event) error {
+	return eventadapters.InsertOutboxEvents(tx, TablePrefix, events)
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
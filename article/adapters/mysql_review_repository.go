@@ -0,0 +1,349 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type MySQLReviewRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLReviewRepository(db *sql.DB) *MySQLReviewRepository {
+	return &MySQLReviewRepository{db: db}
+}
+
+// InitializeSchema creates the peer review tables if they don't exist
+func (r *MySQLReviewRepository) InitializeSchema() error {
+	queries := map[string]string{
+		"reviewers": `
+		CREATE TABLE IF NOT EXISTS reviewers (
+			id VARCHAR(255) PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			affiliation VARCHAR(500),
+			author_id VARCHAR(255),
+			created_at TIMESTAMP(6) NOT NULL
+		)`,
+		"review_assignments": `
+		CREATE TABLE IF NOT EXISTS review_assignments (
+			id VARCHAR(64) PRIMARY KEY,
+			article_id VARCHAR(255) NOT NULL,
+			reviewer_id VARCHAR(255) NOT NULL,
+			status VARCHAR(32) NOT NULL,
+			assigned_at TIMESTAMP(6) NOT NULL,
+			due_date TIMESTAMP(6) NOT NULL,
+			completed_at TIMESTAMP(6) NULL,
+			UNIQUE KEY uq_review_assignment (article_id, reviewer_id)
+		)`,
+		"review_reports": `
+		CREATE TABLE IF NOT EXISTS review_reports (
+			id VARCHAR(64) PRIMARY KEY,
+			assignment_id VARCHAR(64) NOT NULL UNIQUE,
+			article_id VARCHAR(255) NOT NULL,
+			reviewer_id VARCHAR(255) NOT NULL,
+			recommendation VARCHAR(32) NOT NULL,
+			comments TEXT,
+			submitted_at TIMESTAMP(6) NOT NULL,
+			INDEX idx_review_reports_article (article_id, submitted_at)
+		)`,
+		"editor_decisions": `
+		CREATE TABLE IF NOT EXISTS editor_decisions (
+			id VARCHAR(64) PRIMARY KEY,
+			article_id VARCHAR(255) NOT NULL,
+			editor_id VARCHAR(255) NOT NULL,
+			outcome VARCHAR(32) NOT NULL,
+			comments TEXT,
+			decided_at TIMESTAMP(6) NOT NULL,
+			INDEX idx_editor_decisions_article (article_id, decided_at)
+		)`,
+	}
+
+	for _, table := range []string{"reviewers", "review_assignments", "review_reports", "editor_decisions"} {
+		if _, err := r.db.Exec(queries[table]); err != nil {
+			return fmt.Errorf("failed to create %s table: %w", table, err)
+		}
+	}
+
+	if err := ensureColumn(r.db, "reviewers", "author_id", "VARCHAR(255)"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *MySQLReviewRepository) CreateReviewer(reviewer core.Reviewer) (core.Reviewer, error) {
+	query := `
+	INSERT INTO reviewers (id, name, email, affiliation, author_id, created_at) 
+	VALUES (?, ?, ?, ?, ?, ?)`
+
+	_, err := r.db.Exec(query, reviewer.ID, reviewer.Name, reviewer.Email, reviewer.Affiliation,
+		reviewer.AuthorID, reviewer.CreatedAt)
+	if err != nil {
+		return core.Reviewer{}, fmt.Errorf("failed to create reviewer: %w", err)
+	}
+
+	return reviewer, nil
+}
+
+func (r *MySQLReviewRepository) GetReviewer(id string) (core.Reviewer, error) {
+	query := reviewerSelect + ` WHERE id = ?`
+
+	reviewer, err := scanReviewer(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.Reviewer{}, core.ErrReviewerNotFound
+		}
+		return core.Reviewer{}, fmt.Errorf("failed to get reviewer: %w", err)
+	}
+
+	return reviewer, nil
+}
+
+func (r *MySQLReviewRepository) ListReviewers() ([]core.Reviewer, error) {
+	rows, err := r.db.Query(reviewerSelect + ` ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	var reviewers []core.Reviewer
+	for rows.Next() {
+		reviewer, err := scanReviewer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	return reviewers, rows.Err()
+}
+
+func (r *MySQLReviewRepository) CreateAssignment(assignment core.ReviewAssignment, change *core.StatusChange) (core.ReviewAssignment, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return core.ReviewAssignment{}, fmt.Errorf("failed to create review assignment: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if change != nil {
+		if err := updateArticleStatus(tx, change.Article, change.Transition, change.Events); err != nil {
+			return core.ReviewAssignment{}, statusChangeError(err)
+		}
+	}
+
+	query := `
+	INSERT INTO review_assignments (id, article_id, reviewer_id, status, assigned_at, due_date, completed_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, assignment.ID, assignment.ArticleID, assignment.ReviewerID,
+		assignment.Status, assignment.AssignedAt, assignment.DueDate, assignment.CompletedAt)
+	if err != nil {
+		return core.ReviewAssignment{}, fmt.Errorf("failed to create review assignment: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return core.ReviewAssignment{}, fmt.Errorf("failed to create review assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+func (r *MySQLReviewRepository) GetAssignment(id string) (core.ReviewAssignment, error) {
+	query := assignmentSelect + ` WHERE id = ?`
+
+	assignment, err := scanAssignment(r.db.QueryRow(query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.ReviewAssignment{}, core.ErrAssignmentNotFound
+		}
+		return core.ReviewAssignment{}, fmt.Errorf("failed to get review assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+func (r *MySQLReviewRepository) ListAssignments(articleID string) ([]core.ReviewAssignment, error) {
+	query := assignmentSelect + ` WHERE article_id = ? ORDER BY assigned_at, id`
+
+	rows, err := r.db.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []core.ReviewAssignment
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review assignment: %w", err)
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (r *MySQLReviewRepository) CreateReport(report core.ReviewReport, assignment core.ReviewAssignment) (core.ReviewReport, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return core.ReviewReport{}, fmt.Errorf("failed to create review report: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.Exec(`UPDATE review_assignments SET status = ?, completed_at = ? WHERE id = ? AND status = ?`,
+		assignment.Status, assignment.CompletedAt, assignment.ID, core.AssignmentPending)
+	if err != nil {
+		return core.ReviewReport{}, fmt.Errorf("failed to complete review assignment: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return core.ReviewReport{}, fmt.Errorf("%w: assignment %s is no longer pending", core.ErrInvalidReview, assignment.ID)
+	}
+
+	query := `
+	INSERT INTO review_reports (id, assignment_id, article_id, reviewer_id, recommendation, comments, submitted_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, report.ID, report.AssignmentID, report.ArticleID, report.ReviewerID,
+		report.Recommendation, report.Comments, report.SubmittedAt)
+	if err != nil {
+		return core.ReviewReport{}, fmt.Errorf("failed to create review report: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return core.ReviewReport{}, fmt.Errorf("failed to create review report: %w", err)
+	}
+
+	return report, nil
+}
+
+func (r *MySQLReviewRepository) ListReports(articleID string) ([]core.ReviewReport, error) {
+	query := `
+	SELECT id, assignment_id, article_id, reviewer_id, recommendation, comments, submitted_at 
+	FROM review_reports 
+	WHERE article_id = ? 
+	ORDER BY submitted_at, id`
+
+	rows, err := r.db.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review reports: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []core.ReviewReport
+	for rows.Next() {
+		var report core.ReviewReport
+		var comments sql.NullString
+		err := rows.Scan(&report.ID, &report.AssignmentID, &report.ArticleID, &report.ReviewerID,
+			&report.Recommendation, &comments, &report.SubmittedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review report: %w", err)
+		}
+		report.Comments = comments.String
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+func (r *MySQLReviewRepository) CreateDecision(decision core.EditorDecision, change core.StatusChange) (core.EditorDecision, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return core.EditorDecision{}, fmt.Errorf("failed to create editor decision: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := updateArticleStatus(tx, change.Article, change.Transition, change.Events); err != nil {
+		return core.EditorDecision{}, statusChangeError(err)
+	}
+
+	query := `
+	INSERT INTO editor_decisions (id, article_id, editor_id, outcome, comments, decided_at) 
+	VALUES (?, ?, ?, ?, ?, ?)`
+
+	_, err = tx.Exec(query, decision.ID, decision.ArticleID, decision.EditorID,
+		decision.Outcome, decision.Comments, decision.DecidedAt)
+	if err != nil {
+		return core.EditorDecision{}, fmt.Errorf("failed to create editor decision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return core.EditorDecision{}, fmt.Errorf("failed to create editor decision: %w", err)
+	}
+
+	return decision, nil
+}
+
+func (r *MySQLReviewRepository) ListDecisions(articleID string) ([]core.EditorDecision, error) {
+	query := `
+	SELECT id, article_id, editor_id, outcome, comments, decided_at 
+	FROM editor_decisions 
+	WHERE article_id = ? 
+	ORDER BY decided_at, id`
+
+	rows, err := r.db.Query(query, articleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list editor decisions: %w", err)
+	}
+	defer rows.Close()
+
+	var decisions []core.EditorDecision
+	for rows.Next() {
+		var decision core.EditorDecision
+		var comments sql.NullString
+		err := rows.Scan(&decision.ID, &decision.ArticleID, &decision.EditorID,
+			&decision.Outcome, &comments, &decision.DecidedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan editor decision: %w", err)
+		}
+		decision.Comments = comments.String
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, rows.Err()
+}
+
+const reviewerSelect = `
+	SELECT id, name, email, affiliation, author_id, created_at 
+	FROM reviewers`
+
+func scanReviewer(row rowScanner) (core.Reviewer, error) {
+	var reviewer core.Reviewer
+	var affiliation, authorID sql.NullString
+	err := row.Scan(&reviewer.ID, &reviewer.Name, &reviewer.Email, &affiliation, &authorID, &reviewer.CreatedAt)
+	if err != nil {
+		return core.Reviewer{}, err
+	}
+	reviewer.Affiliation = affiliation.String
+	reviewer.AuthorID = authorID.String
+	return reviewer, nil
+}
+
+const assignmentSelect = `
+	SELECT id, article_id, reviewer_id, status, assigned_at, due_date, completed_at 
+	FROM review_assignments`
+
+func scanAssignment(row rowScanner) (core.ReviewAssignment, error) {
+	var assignment core.ReviewAssignment
+	var completedAt sql.NullTime
+	err := row.Scan(&assignment.ID, &assignment.ArticleID, &assignment.ReviewerID, &assignment.Status,
+		&assignment.AssignedAt, &assignment.DueDate, &completedAt)
+	if err != nil {
+		return core.ReviewAssignment{}, err
+	}
+	if completedAt.Valid {
+		assignment.CompletedAt = &completedAt.Time
+	}
+	return assignment, nil
+}
+
+// statusChangeError passes workflow errors through and wraps storage errors
+func statusChangeError(err error) error {
+	if err == core.ErrArticleNotFound || errors.Is(err, core.ErrInvalidTransition) {
+		return err
+	}
+	return fmt.Errorf("failed to update article status: %w", err)
+}
@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// initializeSubjectSchema adds the keyword and subject lists to articles
+// and creates the table subject queries use
+func (r *MySQLArticleRepository) initializeSubjectSchema() error {
+	for _, column := range []string{"keywords", "subjects"} {
+		if err := ensureColumn(r.db, "articles", column, "JSON NULL"); err != nil {
+			return err
+		}
+	}
+
+	query := `
+	CREATE TABLE IF NOT EXISTS article_subjects (
+		term_id VARCHAR(255) NOT NULL,
+		article_id VARCHAR(255) NOT NULL,
+		PRIMARY KEY (term_id, article_id),
+		INDEX idx_article_subjects_article (article_id)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create article_subjects table: %w", err)
+	}
+
+	return nil
+}
+
+// saveSubjects stores the article's keyword and subject lists and replaces
+// its rows in article_subjects
+func saveSubjects(tx *sql.Tx, article core.Article) error {
+	keywords, err := encodeTerms(article.Keywords)
+	if err != nil {
+		return err
+	}
+	subjects, err := encodeTerms(article.Subjects)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE articles SET keywords = ?, subjects = ?, updated_at = updated_at WHERE id = ?`
+	if _, err := tx.Exec(query, keywords, subjects, article.ID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM article_subjects WHERE article_id = ?`, article.ID); err != nil {
+		return err
+	}
+	for _, termID := range article.Subjects {
+		if _, err := tx.Exec(`INSERT INTO article_subjects (term_id, article_id) VALUES (?, ?)`, termID, article.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeTerms stores empty lists as NULL
+func encodeTerms(terms []string) (any, error) {
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(terms)
+}
+
+func decodeTerms(encoded []byte) ([]string, error) {
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	var terms []string
+	if err := json.Unmarshal(encoded, &terms); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+func (r *MySQLArticleRepository) ListArticlesBySubjects(termIDs []string, offset, limit int) (core.ArticlePage, error) {
+	if len(termIDs) == 0 {
+		return core.ArticlePage{}, nil
+	}
+	placeholders := make([]string, len(termIDs))
+	args := make([]any, 0, len(termIDs)+2)
+	for i, termID := range termIDs {
+		placeholders[i] = "?"
+		args = append(args, termID)
+	}
+	where := `
+	WHERE id IN (SELECT article_id FROM article_subjects WHERE term_id IN (` + strings.Join(placeholders, ", ") + `))`
+
+	var page core.ArticlePage
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM articles`+where, args...).Scan(&page.Total); err != nil {
+		return core.ArticlePage{}, fmt.Errorf("failed to count articles by subject: %w", err)
+	}
+	if page.Total <= offset {
+		return page, nil
+	}
+
+	articles, err := r.queryArticles(articleSelect+where+` 
+	ORDER BY id 
+	LIMIT ? OFFSET ?`, append(args, limit, offset)...)
+	if err != nil {
+		return core.ArticlePage{}, fmt.Errorf("failed to list articles by subject: %w", err)
+	}
+	page.Articles = articles
+	return page, nil
+}
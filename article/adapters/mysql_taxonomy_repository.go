@@ -0,0 +1,149 @@
+package adapters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+type MySQLTaxonomyRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLTaxonomyRepository(db *sql.DB) *MySQLTaxonomyRepository {
+	return &MySQLTaxonomyRepository{db: db}
+}
+
+// InitializeSchema creates the subject_terms table if it doesn't exist
+func (r *MySQLTaxonomyRepository) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS subject_terms (
+		id VARCHAR(255) PRIMARY KEY,
+		label VARCHAR(500) NOT NULL,
+		parent_id VARCHAR(255) NULL,
+		synonyms JSON NULL,
+		created_at TIMESTAMP(6) NOT NULL,
+		updated_at TIMESTAMP(6) NOT NULL,
+		INDEX idx_subject_terms_parent (parent_id)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create subject_terms table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLTaxonomyRepository) CreateTerm(term core.SubjectTerm) (core.SubjectTerm, error) {
+	query := `
+	INSERT INTO subject_terms (id, label, parent_id, synonyms, created_at, updated_at) 
+	VALUES (?, ?, NULLIF(?, ''), ?, ?, ?)`
+
+	synonyms, err := encodeTerms(term.Synonyms)
+	if err != nil {
+		return core.SubjectTerm{}, fmt.Errorf("failed to encode synonyms: %w", err)
+	}
+	_, err = r.db.Exec(query, term.ID, term.Label, term.ParentID, synonyms, term.CreatedAt, term.UpdatedAt)
+	if err != nil {
+		return core.SubjectTerm{}, fmt.Errorf("failed to create subject term: %w", err)
+	}
+
+	return term, nil
+}
+
+func (r *MySQLTaxonomyRepository) GetTerm(id string) (core.SubjectTerm, error) {
+	term, err := scanSubjectTerm(r.db.QueryRow(subjectTermSelect+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.SubjectTerm{}, core.ErrSubjectTermNotFound
+		}
+		return core.SubjectTerm{}, fmt.Errorf("failed to get subject term: %w", err)
+	}
+
+	return term, nil
+}
+
+func (r *MySQLTaxonomyRepository) ListTerms() ([]core.SubjectTerm, error) {
+	rows, err := r.db.Query(subjectTermSelect + " ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subject terms: %w", err)
+	}
+	defer rows.Close()
+
+	var terms []core.SubjectTerm
+	for rows.Next() {
+		term, err := scanSubjectTerm(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan subject term: %w", err)
+		}
+		terms = append(terms, term)
+	}
+
+	return terms, rows.Err()
+}
+
+func (r *MySQLTaxonomyRepository) UpdateTerm(term core.SubjectTerm) (core.SubjectTerm, error) {
+	query := `
+	UPDATE subject_terms 
+	SET label = ?, parent_id = NULLIF(?, ''), synonyms = ?, updated_at = ? 
+	WHERE id = ?`
+
+	synonyms, err := encodeTerms(term.Synonyms)
+	if err != nil {
+		return core.SubjectTerm{}, fmt.Errorf("failed to encode synonyms: %w", err)
+	}
+	result, err := r.db.Exec(query, term.Label, term.ParentID, synonyms, term.UpdatedAt, term.ID)
+	if err != nil {
+		return core.SubjectTerm{}, fmt.Errorf("failed to update subject term: %w", err)
+	}
+	if err := requireTerm(result); err != nil {
+		return core.SubjectTerm{}, err
+	}
+
+	return term, nil
+}
+
+func (r *MySQLTaxonomyRepository) DeleteTerm(id string) error {
+	result, err := r.db.Exec(`DELETE FROM subject_terms WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete subject term: %w", err)
+	}
+	return requireTerm(result)
+}
+
+// requireTerm returns ErrSubjectTermNotFound when a statement affected no
+// term. Updates always set updated_at, so they affect the term's row even
+// when nothing else changes.
+func requireTerm(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check affected subject terms: %w", err)
+	}
+	if affected == 0 {
+		return core.ErrSubjectTermNotFound
+	}
+	return nil
+}
+
+const subjectTermSelect = `
+	SELECT id, label, parent_id, synonyms, created_at, updated_at 
+	FROM subject_terms`
+
+func scanSubjectTerm(row rowScanner) (core.SubjectTerm, error) {
+	var term core.SubjectTerm
+	var parentID sql.NullString
+	var synonyms []byte
+	err := row.Scan(&term.ID, &term.Label, &parentID, &synonyms, &term.CreatedAt, &term.UpdatedAt)
+	if err != nil {
+		return core.SubjectTerm{}, err
+	}
+	term.ParentID = parentID.String
+	if len(synonyms) > 0 {
+		if err := json.Unmarshal(synonyms, &term.Synonyms); err != nil {
+			return core.SubjectTerm{}, fmt.Errorf("failed to decode synonyms: %w", err)
+		}
+	}
+	return term, nil
+}
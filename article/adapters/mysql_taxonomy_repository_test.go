@@ -0,0 +1,102 @@
+package adapters_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func newMockTaxonomyRepository(t *testing.T) (*adapters.MySQLTaxonomyRepository, sqlmock.Sqlmock) {
+	db, mock := newMockDB(t)
+	return adapters.NewMySQLTaxonomyRepository(db), mock
+}
+
+var subjectTermColumns = []string{"id", "label", "parent_id", "synonyms", "created_at", "updated_at"}
+
+func TestMySQLSubjectTerms(t *testing.T) {
+	repository, mock := newMockTaxonomyRepository(t)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	algebra := core.SubjectTerm{ID: "algebra", Label: "Algebra", ParentID: "math", Synonyms: []string{"Abstract Algebra"},
+		CreatedAt: now, UpdatedAt: now}
+
+	// Synonyms are stored as JSON and a missing parent as NULL
+	mock.ExpectExec(matchSQL(`INSERT INTO subject_terms (id, label, parent_id, synonyms, created_at, updated_at) VALUES (?, ?, NULLIF(?, ''), ?, ?, ?)`)).
+		WithArgs("algebra", "Algebra", "math", []byte(`["Abstract Algebra"]`), now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(matchSQL(`INSERT INTO subject_terms`)).
+		WithArgs("math", "Mathematics", "", nil, now, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if _, err := repository.CreateTerm(algebra); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repository.CreateTerm(core.SubjectTerm{ID: "math", Label: "Mathematics", CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectQuery(matchSQL(`FROM subject_terms ORDER BY id`)).
+		WillReturnRows(sqlmock.NewRows(subjectTermColumns).
+			AddRow("algebra", "Algebra", "math", []byte(`["Abstract Algebra"]`), now, now).
+			AddRow("math", "Mathematics", nil, nil, now, now))
+	terms, err := repository.ListTerms()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.SubjectTerm{algebra, {ID: "math", Label: "Mathematics", CreatedAt: now, UpdatedAt: now}}
+	if !reflect.DeepEqual(terms, want) {
+		t.Errorf("ListTerms() = %+v, want %+v", terms, want)
+	}
+
+	// Unknown terms are reported as not found
+	mock.ExpectQuery(matchSQL(`FROM subject_terms WHERE id = ?`)).WithArgs("topology").
+		WillReturnRows(sqlmock.NewRows(subjectTermColumns))
+	if _, err := repository.GetTerm("topology"); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("GetTerm() of an unknown term = %v, want ErrSubjectTermNotFound", err)
+	}
+	mock.ExpectExec(matchSQL(`UPDATE subject_terms SET label = ?, parent_id = NULLIF(?, ''), synonyms = ?, updated_at = ? WHERE id = ?`)).
+		WithArgs("Topology", "", nil, now, "topology").WillReturnResult(sqlmock.NewResult(0, 0))
+	if _, err := repository.UpdateTerm(core.SubjectTerm{ID: "topology", Label: "Topology", UpdatedAt: now}); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("UpdateTerm() of an unknown term = %v, want ErrSubjectTermNotFound", err)
+	}
+	mock.ExpectExec(matchSQL(`DELETE FROM subject_terms WHERE id = ?`)).WithArgs("topology").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := repository.DeleteTerm("topology"); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("DeleteTerm() of an unknown term = %v, want ErrSubjectTermNotFound", err)
+	}
+}
+
+func TestMySQLListArticlesBySubjects(t *testing.T) {
+	repository, mock := newMockRepository(t)
+	where := `WHERE id IN (SELECT article_id FROM article_subjects WHERE term_id IN (?, ?))`
+
+	mock.ExpectQuery(matchSQL(`SELECT COUNT(*) FROM articles `+where)).WithArgs("algebra", "groups").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(matchSQL(`FROM articles `+where+` ORDER BY id LIMIT ? OFFSET ?`)).
+		WithArgs("algebra", "groups", 2, 1).
+		WillReturnRows(articleRows("a2", "Sylow Theorems", "a3", "Free Groups"))
+	mock.ExpectQuery(matchSQL(`FROM article_authors WHERE article_id IN (?, ?)`)).WithArgs("a2", "a3").
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "author_id", "orcid", "affiliation", "corresponding"}))
+
+	page, err := repository.ListArticlesBySubjects([]string{"algebra", "groups"}, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 3 || len(page.Articles) != 2 || page.Articles[0].ID != "a2" || page.Articles[1].ID != "a3" {
+		t.Errorf("ListArticlesBySubjects() = %+v", page)
+	}
+
+	// Past the last article only the count is read
+	mock.ExpectQuery(matchSQL(`SELECT COUNT(*) FROM articles `+where)).WithArgs("algebra", "groups").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	if page, err := repository.ListArticlesBySubjects([]string{"algebra", "groups"}, 3, 2); err != nil || page.Total != 3 || page.Articles != nil {
+		t.Errorf("ListArticlesBySubjects() past the end = %+v, %v", page, err)
+	}
+	if page, err := repository.ListArticlesBySubjects(nil, 0, 2); err != nil || page.Total != 0 {
+		t.Errorf("ListArticlesBySubjects(nil) = %+v, %v", page, err)
+	}
+}
@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// uniqueTitleIndex is the unique index on journal_id and unique_title_hash
+const uniqueTitleIndex = "uq_articles_unique_title"
+
+// initializeTitleSchema adds the normalized title key to articles, creates
+// the trigram table fuzzy title lookups use and fills both for articles
+// stored before they existed
+func (r *MySQLArticleRepository) initializeTitleSchema() error {
+	if err := ensureColumn(r.db, "articles", "title_key", "VARCHAR(768) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL"); err != nil {
+		return err
+	}
+	if err := ensureIndex(r.db, "articles", "idx_articles_title_key", "title_key"); err != nil {
+		return err
+	}
+	if err := ensureColumn(r.db, "articles", "unique_title_hash", "BINARY(32) NULL"); err != nil {
+		return err
+	}
+	if err := ensureUniqueIndex(r.db, "articles", uniqueTitleIndex, "journal_id, unique_title_hash"); err != nil {
+		return err
+	}
+
+	query := `
+	CREATE TABLE IF NOT EXISTS article_title_trigrams (
+		trigram VARCHAR(3) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+		article_id VARCHAR(255) NOT NULL,
+		PRIMARY KEY (trigram, article_id),
+		INDEX idx_article_title_trigrams_article (article_id)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create article_title_trigrams table: %w", err)
+	}
+
+	return r.backfillTitleKeys()
+}
+
+// backfillTitleKeys stores the title keys and trigrams of articles that
+// have no key yet
+func (r *MySQLArticleRepository) backfillTitleKeys() error {
+	rows, err := r.db.Query(`SELECT id, title FROM articles WHERE title_key IS NULL ORDER BY id`)
+	if err != nil {
+		return fmt.Errorf("failed to list articles without title keys: %w", err)
+	}
+	titles := make(map[string]string)
+	var ids []string
+	for rows.Next() {
+		var id, title string
+		if err := rows.Scan(&id, &title); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan article title: %w", err)
+		}
+		ids = append(ids, id)
+		titles[id] = title
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := r.inTx(func(tx *sql.Tx) error { return saveTitleKey(tx, id, titles[id]) }); err != nil {
+			return fmt.Errorf("failed to backfill title key of article %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// checkUniqueTitle returns ErrDuplicateTitle when another article of the
+// journal has the article's title key
+func checkUniqueTitle(tx *sql.Tx, article core.Article) error {
+	query := `SELECT id FROM articles WHERE journal_id = ? AND title_key = ? AND id <> ? LIMIT 1`
+
+	var other string
+	err := tx.QueryRow(query, article.JournalID, core.NormalizeTitle(article.Title), article.ID).Scan(&other)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: article %s of journal %s has the same title", core.ErrDuplicateTitle, other, article.JournalID)
+}
+
+// uniqueTitleHash returns the unique_title_hash of an article: the SHA-256
+// of its title key when the journal requires unique titles, and NULL
+// otherwise. checkUniqueTitle only sees committed articles; the unique
+// index on the hash stops two transactions storing the same title at once.
+// The key itself is too long to index together with the journal.
+func uniqueTitleHash(title string, uniqueTitle bool) []byte {
+	if !uniqueTitle {
+		return nil
+	}
+	sum := sha256.Sum256([]byte(core.NormalizeTitle(title)))
+	return sum[:]
+}
+
+// saveTitleKey stores the article's title key and replaces its trigrams
+func saveTitleKey(tx *sql.Tx, articleID, title string) error {
+	key := core.NormalizeTitle(title)
+	if _, err := tx.Exec(`UPDATE articles SET title_key = ?, updated_at = updated_at WHERE id = ?`, key, articleID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM article_title_trigrams WHERE article_id = ?`, articleID); err != nil {
+		return err
+	}
+	for _, trigram := range core.TitleTrigrams(key) {
+		if _, err := tx.Exec(`INSERT INTO article_title_trigrams (trigram, article_id) VALUES (?, ?)`, trigram, articleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MySQLArticleRepository) ListArticlesByTitle(key, journalID string, offset, limit int) (core.ArticlePage, error) {
+	where := `
+	WHERE title_key = ? AND (? = '' OR journal_id = ?)`
+
+	var page core.ArticlePage
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM articles`+where, key, journalID, journalID).Scan(&page.Total); err != nil {
+		return core.ArticlePage{}, fmt.Errorf("failed to count articles by title: %w", err)
+	}
+	if page.Total <= offset {
+		return page, nil
+	}
+
+	articles, err := r.queryArticles(articleSelect+where+` 
+	ORDER BY id 
+	LIMIT ? OFFSET ?`, key, journalID, journalID, limit, offset)
+	if err != nil {
+		return core.ArticlePage{}, fmt.Errorf("failed to list articles by title: %w", err)
+	}
+	page.Articles = articles
+	return page, nil
+}
+
+func (r *MySQLArticleRepository) ListTitleCandidates(key string, limit int) ([]core.Article, error) {
+	trigrams := core.TitleTrigrams(key)
+	if len(trigrams) == 0 {
+		return nil, nil
+	}
+	placeholders := make([]string, len(trigrams))
+	args := make([]any, 0, len(trigrams)+1)
+	for i, trigram := range trigrams {
+		placeholders[i] = "?"
+		args = append(args, trigram)
+	}
+	args = append(args, limit)
+
+	query := `
+	SELECT article_id 
+	FROM article_title_trigrams 
+	WHERE trigram IN (` + strings.Join(placeholders, ", ") + `) 
+	GROUP BY article_id 
+	ORDER BY COUNT(*) DESC, article_id 
+	LIMIT ?`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list title candidates: %w", err)
+	}
+	var ids []any
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan title candidate: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list title candidates: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	articles, err := r.queryArticles(articleSelect+`
+	WHERE id IN (`+strings.Repeat("?, ", len(ids)-1)+`?)`, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load title candidates: %w", err)
+	}
+	// Return the articles in candidate order
+	byID := make(map[string]core.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+	candidates := make([]core.Article, 0, len(articles))
+	for _, id := range ids {
+		if article, ok := byID[id.(string)]; ok {
+			candidates = append(candidates, article)
+		}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,192 @@
+package adapters_test
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// newMockDB returns a mock database that fails the test when expectations
+// are left over
+func newMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		db.Close()
+	})
+	return db, mock
+}
+
+func newMockRepository(t *testing.T) (*adapters.MySQLArticleRepository, sqlmock.Sqlmock) {
+	db, mock := newMockDB(t)
+	return adapters.NewMySQLArticleRepository(db), mock
+}
+
+// matchSQL matches the statement exactly, apart from its whitespace
+func matchSQL(statement string) string {
+	return regexp.MustCompile(`\s+`).ReplaceAllString(regexp.QuoteMeta(statement), `\s+`)
+}
+
+func titleHash(title string) []byte {
+	sum := sha256.Sum256([]byte(core.NormalizeTitle(title)))
+	return sum[:]
+}
+
+// expectArticleRows expects the author, title key and subject writes that
+// follow storing the article's row
+func expectArticleRows(mock sqlmock.Sqlmock, article core.Article) {
+	mock.ExpectExec(matchSQL(`DELETE FROM article_authors WHERE article_id = ?`)).WithArgs(article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for position, author := range article.Authors {
+		mock.ExpectExec(matchSQL(`INSERT INTO article_authors`)).
+			WithArgs(article.ID, position, author.AuthorID, author.ORCID, author.Affiliation, author.Corresponding).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	key := core.NormalizeTitle(article.Title)
+	mock.ExpectExec(matchSQL(`UPDATE articles SET title_key = ?, updated_at = updated_at WHERE id = ?`)).
+		WithArgs(key, article.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(matchSQL(`DELETE FROM article_title_trigrams WHERE article_id = ?`)).WithArgs(article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for _, trigram := range core.TitleTrigrams(key) {
+		mock.ExpectExec(matchSQL(`INSERT INTO article_title_trigrams (trigram, article_id) VALUES (?, ?)`)).
+			WithArgs(trigram, article.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	mock.ExpectExec(matchSQL(`UPDATE articles SET keywords = ?, subjects = ?, updated_at = updated_at WHERE id = ?`)).
+		WithArgs(nil, nil, article.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(matchSQL(`DELETE FROM article_subjects WHERE article_id = ?`)).WithArgs(article.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func scienceArticle(id, title string) core.Article {
+	return core.Article{
+		ID:        id,
+		Title:     title,
+		Abstract:  "A revised abstract.",
+		Authors:   []core.ArticleAuthor{{AuthorID: "author_1", Corresponding: true}},
+		JournalID: "journal_2",
+	}
+}
+
+func TestMySQLUpdateArticleKeepsTheTitleHash(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		uniqueTitle bool
+		want        driver.Value
+	}{
+		{"title kept", "Graph colouring!", false, titleHash("Graph Colouring")},
+		{"title checked", "Planar Graphs", true, titleHash("Planar Graphs")},
+		{"title changed", "Planar Graphs", false, []byte(nil)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repository, mock := newMockRepository(t)
+			article := scienceArticle("s1", test.title)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(matchSQL(`SELECT title_key, journal_id, unique_title_hash FROM articles WHERE id = ? FOR UPDATE`)).
+				WithArgs("s1").
+				WillReturnRows(sqlmock.NewRows([]string{"title_key", "journal_id", "unique_title_hash"}).
+					AddRow("graph colouring", "journal_2", titleHash("Graph Colouring")))
+			if test.uniqueTitle {
+				mock.ExpectQuery(matchSQL(`SELECT id FROM articles WHERE journal_id = ? AND title_key = ? AND id <> ? LIMIT 1`)).
+					WithArgs("journal_2", "planar graphs", "s1").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			}
+			mock.ExpectExec(matchSQL(`UPDATE articles SET title = ?, abstract = ?, journal_id = ?, unique_title_hash = ?`)).
+				WithArgs(test.title, article.Abstract, "journal_2", test.want, "s1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			expectArticleRows(mock, article)
+			mock.ExpectCommit()
+
+			if _, err := repository.UpdateArticle(article, test.uniqueTitle); err != nil {
+				t.Errorf("UpdateArticle() = %v", err)
+			}
+		})
+	}
+}
+
+func TestMySQLCreateArticleRacingAnEditedTitle(t *testing.T) {
+	repository, mock := newMockRepository(t)
+
+	// The edited article still holds its hash, so the unique index rejects
+	// a duplicate its committed-rows check did not see yet
+	mock.ExpectBegin()
+	mock.ExpectQuery(matchSQL(`SELECT id FROM articles WHERE journal_id = ? AND title_key = ? AND id <> ? LIMIT 1`)).
+		WithArgs("journal_2", "graph colouring", "s5").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(matchSQL(`INSERT INTO articles`)).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'journal_2-...' for key 'articles.uq_articles_unique_title'"})
+	mock.ExpectRollback()
+
+	_, err := repository.CreateArticle(scienceArticle("s5", "Graph Colouring"), true)
+	if !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("CreateArticle() = %v, want ErrDuplicateTitle", err)
+	}
+}
+
+// articleColumns are the columns of articleSelect
+var articleColumns = []string{"id", "title", "abstract", "journal_id", "status", "published_at", "citation_count",
+	"doi", "doi_status", "doi_batch_id", "doi_message", "doi_submitted_at", "doi_updated_at", "keywords", "subjects",
+	"created_at", "updated_at"}
+
+// articleRows returns draft rows of journal_1 with the given IDs and titles
+func articleRows(idsAndTitles ...string) *sqlmock.Rows {
+	rows := sqlmock.NewRows(articleColumns)
+	for i := 0; i+1 < len(idsAndTitles); i += 2 {
+		rows.AddRow(idsAndTitles[i], idsAndTitles[i+1], "An abstract.", "journal_1", "draft", nil, 0,
+			nil, nil, nil, nil, nil, nil, nil, nil, "2024-01-01 00:00:00", "2024-01-01 00:00:00")
+	}
+	return rows
+}
+
+func TestMySQLListTitleCandidates(t *testing.T) {
+	repository, mock := newMockRepository(t)
+
+	trigrams := core.TitleTrigrams("graph")
+	args := make([]driver.Value, 0, len(trigrams)+1)
+	for _, trigram := range trigrams {
+		args = append(args, trigram)
+	}
+	placeholders := strings.Repeat("?, ", len(trigrams)-1) + "?"
+	mock.ExpectQuery(matchSQL(`SELECT article_id FROM article_title_trigrams WHERE trigram IN (` + placeholders + `) GROUP BY article_id ORDER BY COUNT(*) DESC, article_id LIMIT ?`)).
+		WithArgs(append(args, 2)...).
+		WillReturnRows(sqlmock.NewRows([]string{"article_id"}).AddRow("a2").AddRow("a1"))
+	mock.ExpectQuery(matchSQL(`FROM articles WHERE id IN (?, ?)`)).WithArgs("a2", "a1").
+		WillReturnRows(articleRows("a1", "Graph Colouring", "a2", "Graphs"))
+	mock.ExpectQuery(matchSQL(`FROM article_authors WHERE article_id IN (?, ?)`)).WithArgs("a1", "a2").
+		WillReturnRows(sqlmock.NewRows([]string{"article_id", "author_id", "orcid", "affiliation", "corresponding"}).
+			AddRow("a1", "author_1", nil, nil, true))
+
+	candidates, err := repository.ListTitleCandidates("graph", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The articles come back in the order of their shared trigrams
+	if len(candidates) != 2 || candidates[0].ID != "a2" || candidates[1].ID != "a1" || len(candidates[1].Authors) != 1 {
+		t.Errorf("ListTitleCandidates() = %+v", candidates)
+	}
+
+	// A key without trigrams matches nothing and queries nothing
+	if candidates, err := repository.ListTitleCandidates("", 2); err != nil || candidates != nil {
+		t.Errorf("ListTitleCandidates(\"\") = %+v, %v", candidates, err)
+	}
+}
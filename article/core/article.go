@@ -2,17 +2,36 @@ package core
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
 )
 
 type Article struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Abstract  string `json:"abstract"`
-	AuthorID  string `json:"author_id"`
-	JournalID string `json:"journal_id"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Abstract string `json:"abstract"`
+	// Authors lists the article's authors in byline order
+	Authors []ArticleAuthor `json:"authors"`
+	// Keywords are free-text tags chosen by the authors
+	Keywords []string `json:"keywords,omitempty"`
+	// Subjects are the IDs of the article's terms in the subject taxonomy.
+	// Writes may name a term by its label or a synonym instead.
+	Subjects    []string      `json:"subjects,omitempty"`
+	JournalID   string        `json:"journal_id"`
+	Status      ArticleStatus `json:"status"`
+	PublishedAt *time.Time    `json:"published_at,omitempty"`
+	// CitationCount is the number of articles of this service that cite the
+	// article. The repository maintains it as reference lists change.
+	CitationCount int `json:"citation_count"`
+	// DOI is minted when the article is first deposited and never changes
+	DOI string `json:"doi,omitempty"`
+	// Deposit tracks the latest DOI deposit with the registration agency
+	Deposit   *DOIDeposit `json:"doi_deposit,omitempty"`
+	CreatedAt string      `json:"created_at"`
+	UpdatedAt string      `json:"updated_at"`
 }
 
 // Validate checks if the article data is valid
@@ -25,36 +44,287 @@ func (a Article) Validate() error {
 		return fmt.Errorf("%w: title cannot be empty", ErrInvalidArticle)
 	}
 
-	if strings.TrimSpace(a.AuthorID) == "" {
-		return fmt.Errorf("%w: author ID cannot be empty", ErrInvalidArticle)
+	if len(a.Authors) == 0 {
+		return fmt.Errorf("%w: article must have at least one author", ErrInvalidArticle)
+	}
+
+	seen := make(map[string]bool, len(a.Authors))
+	corresponding := 0
+	for i, author := range a.Authors {
+		if strings.TrimSpace(author.AuthorID) == "" {
+			return fmt.Errorf("%w: author %d has no author ID", ErrInvalidArticle, i+1)
+		}
+		if seen[author.AuthorID] {
+			return fmt.Errorf("%w: author %s is listed more than once", ErrInvalidArticle, author.AuthorID)
+		}
+		seen[author.AuthorID] = true
+
+		if author.ORCID != "" {
+			if err := ValidateORCID(author.ORCID); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidArticle, err)
+			}
+		}
+		if author.Corresponding {
+			corresponding++
+		}
+	}
+	if corresponding > 1 {
+		return fmt.Errorf("%w: article can have only one corresponding author", ErrInvalidArticle)
+	}
+
+	keywords := make(map[string]bool, len(a.Keywords))
+	for i, keyword := range a.Keywords {
+		key := NormalizeTitle(keyword)
+		if key == "" {
+			return fmt.Errorf("%w: keyword %d has no words", ErrInvalidArticle, i+1)
+		}
+		if keywords[key] {
+			return fmt.Errorf("%w: keyword %q is listed more than once", ErrInvalidArticle, keyword)
+		}
+		keywords[key] = true
+	}
+
+	for i, subject := range a.Subjects {
+		if strings.TrimSpace(subject) == "" {
+			return fmt.Errorf("%w: subject %d is empty", ErrInvalidArticle, i+1)
+		}
 	}
 
 	if strings.TrimSpace(a.JournalID) == "" {
 		return fmt.Errorf("%w: journal ID cannot be empty", ErrInvalidArticle)
 	}
 
+	if !a.Status.Valid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidArticle, a.Status)
+	}
+
 	return nil
 }
 
+// AuthorIDs returns the IDs of the article's authors in byline order
+func (a Article) AuthorIDs() []string {
+	ids := make([]string, 0, len(a.Authors))
+	for _, author := range a.Authors {
+		ids = append(ids, author.AuthorID)
+	}
+	return ids
+}
+
 type ArticleService struct {
 	repository ArticleRepository
+	authors    AuthorRepository
+	journals   JournalDirectory
+	taxonomy   TaxonomyRepository
+	screen     DuplicateScreen
+	caller     eventing.Caller
+}
+
+func NewArticleService(repository ArticleRepository, authors AuthorRepository, journals JournalDirectory,
+	taxonomy TaxonomyRepository) *ArticleService {
+	return &ArticleService{repository: repository, authors: authors, journals: journals, taxonomy: taxonomy, caller: eventing.SystemCaller}
+}
+
+// WithActor returns a copy of the service that attributes audit entries to
+// the given actor
+func (s *ArticleService) WithActor(actor string) *ArticleService {
+	return s.WithCaller(eventing.Caller{Actor: actor})
 }
 
-func NewArticleService(repository ArticleRepository) *ArticleService {
-	return &ArticleService{repository: repository}
+// WithCaller returns a copy of the service that attributes audit entries to
+// the given caller
+func (s *ArticleService) WithCaller(caller eventing.Caller) *ArticleService {
+	scoped := *s
+	scoped.caller = caller
+	return &scoped
 }
 
+// WithDuplicateScreen returns a copy of the service that screens every
+// article it creates, imports included, for near duplicates
+func (s *ArticleService) WithDuplicateScreen(screen DuplicateScreen) *ArticleService {
+	scoped := *s
+	scoped.screen = screen
+	return &scoped
+}
+
+// CreateArticle stores a new article as a draft. Later statuses are only
+// reachable through the transition methods.
 func (s *ArticleService) CreateArticle(article Article) (Article, error) {
+	created, _, err := s.CreateArticleScreened(article)
+	return created, err
+}
+
+// CreateArticleScreened creates the article like CreateArticle and also
+// returns the stored articles it may duplicate, most similar first. Without
+// a duplicate screen there are none.
+func (s *ArticleService) CreateArticleScreened(article Article) (Article, []DuplicateCandidate, error) {
+	if article.Status == "" {
+		article.Status = StatusDraft
+	}
+	if article.Status != StatusDraft {
+		return Article{}, nil, fmt.Errorf("%w: new articles must start as %s", ErrInvalidArticle, StatusDraft)
+	}
+	article.PublishedAt = nil
+	article.CitationCount = 0
+	article.DOI = ""
+	article.Deposit = nil
+
+	return s.createArticle(article, AuditCreateArticle)
+}
+
+// createArticle checks and stores a new draft, recording the given audit
+// operation, and screens it for duplicates. Imports use it for articles
+// that keep the DOI of their record.
+func (s *ArticleService) createArticle(article Article, operation AuditOperation) (Article, []DuplicateCandidate, error) {
 	if err := article.Validate(); err != nil {
-		return Article{}, err
+		return Article{}, nil, err
+	}
+	if err := s.resolveAuthors(&article); err != nil {
+		return Article{}, nil, err
+	}
+	if err := s.resolveSubjects(&article); err != nil {
+		return Article{}, nil, err
+	}
+	uniqueTitle, err := s.checkUniqueTitle(article)
+	if err != nil {
+		return Article{}, nil, err
+	}
+
+	event, err := NewEvent(EventArticleCreated, article.ID, article)
+	if err != nil {
+		return Article{}, nil, err
+	}
+	audit, err := s.auditEvent(operation, article.ID, nil, article)
+	if err != nil {
+		return Article{}, nil, err
+	}
+
+	created, err := s.repository.CreateArticle(article, uniqueTitle, event, audit)
+	if err != nil {
+		return Article{}, nil, err
 	}
-	return s.repository.CreateArticle(article)
+	return created, s.screenArticle(created), nil
+}
+
+// screenArticle returns the stored articles a new article may duplicate.
+// The article is stored by then, so failing to screen it only loses the
+// warning.
+func (s *ArticleService) screenArticle(article Article) []DuplicateCandidate {
+	if s.screen == nil {
+		return nil
+	}
+	candidates, err := s.screen.ScreenArticle(article)
+	if err != nil {
+		log.Printf("Duplicates: failed to screen article %s: %v", article.ID, err)
+		return nil
+	}
+	return candidates
 }
 
 func (s *ArticleService) GetArticleByID(id string) (Article, error) {
 	return s.repository.GetArticleByID(id)
 }
 
-func (s *ArticleService) GetArticleByTitle(title string) (Article, error) {
-	return s.repository.GetArticleByTitle(title)
+func (s *ArticleService) ListArticlesByAuthor(authorID string) ([]Article, error) {
+	return s.repository.ListArticlesByAuthor(authorID)
+}
+
+// UpdateArticle changes the article's metadata. The status, citation count
+// and DOI are kept as stored; use the transition methods to move the article
+// through the workflow.
+func (s *ArticleService) UpdateArticle(article Article) (Article, error) {
+	before, err := s.repository.GetArticleByID(article.ID)
+	if err != nil {
+		return Article{}, err
+	}
+	article.Status = before.Status
+	article.PublishedAt = before.PublishedAt
+	article.CitationCount = before.CitationCount
+	article.DOI = before.DOI
+	article.Deposit = before.Deposit
+
+	if err := article.Validate(); err != nil {
+		return Article{}, err
+	}
+	if err := s.resolveAuthors(&article); err != nil {
+		return Article{}, err
+	}
+	if err := s.resolveSubjects(&article); err != nil {
+		return Article{}, err
+	}
+	// Titles stored before their journal required unique ones stay editable
+	uniqueTitle := false
+	if NormalizeTitle(article.Title) != NormalizeTitle(before.Title) || article.JournalID != before.JournalID {
+		if uniqueTitle, err = s.checkUniqueTitle(article); err != nil {
+			return Article{}, err
+		}
+	}
+
+	event, err := NewEvent(EventArticleUpdated, article.ID, article)
+	if err != nil {
+		return Article{}, err
+	}
+	audit, err := s.auditEvent(AuditUpdateArticle, article.ID, before, article)
+	if err != nil {
+		return Article{}, err
+	}
+
+	return s.repository.UpdateArticle(article, uniqueTitle, event, audit)
+}
+
+// resolveAuthors checks that every listed author exists and fills in the
+// ORCID iD and affiliation from the author record where the byline leaves
+// them out
+func (s *ArticleService) resolveAuthors(article *Article) error {
+	resolved := make([]ArticleAuthor, len(article.Authors))
+	for i, byline := range article.Authors {
+		author, err := s.authors.GetAuthor(byline.AuthorID)
+		if err == ErrAuthorNotFound {
+			return fmt.Errorf("%w: unknown author %s", ErrInvalidArticle, byline.AuthorID)
+		}
+		if err != nil {
+			return err
+		}
+
+		if byline.ORCID == "" {
+			byline.ORCID = author.ORCID
+		} else if author.ORCID != "" && byline.ORCID != author.ORCID {
+			return fmt.Errorf("%w: ORCID %s does not belong to author %s", ErrInvalidArticle, byline.ORCID, author.ID)
+		}
+		if byline.Affiliation == "" {
+			byline.Affiliation = author.Affiliation
+		}
+		resolved[i] = byline
+	}
+	article.Authors = resolved
+	return nil
+}
+
+// rewriteAuthorLists applies author list changes made outside the normal
+// update path, such as author merges, and raises an update event for every
+// article it touches. The extra events, such as the audit record of the
+// merge, are stored with the changes.
+func (s *ArticleService) rewriteAuthorLists(changes []AuthorListChange, extra ...Event) error {
+	events := make([]Event, 0, len(changes)+len(extra))
+	for _, change := range changes {
+		article, err := s.repository.GetArticleByID(change.ArticleID)
+		if err != nil {
+			return err
+		}
+		article.Authors = change.After
+
+		event, err := NewEvent(EventArticleUpdated, article.ID, article)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	return s.repository.ReplaceArticleAuthors(changes, append(events, extra...)...)
+}
+
+// auditEvent builds the audit record of a mutation. It is stored in the
+// outbox together with the mutation, so the change cannot be committed
+// without it, and the relay appends it to the audit log.
+func (s *ArticleService) auditEvent(operation AuditOperation, entityID string, before, after any) (Event, error) {
+	return eventing.NewAuditEvent(s.caller, operation, entityID, before, after)
 }
@@ -0,0 +1,114 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// auditEntries returns the audit records waiting in the outbox, in order
+func (f fixture) auditEntries(t *testing.T) []eventing.AuditEntry {
+	t.Helper()
+	events, err := f.articles.PendingEvents(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []eventing.AuditEntry
+	for _, event := range events {
+		if event.Type != eventing.EventAuditRecorded {
+			continue
+		}
+		var entry eventing.AuditEntry
+		if err := json.Unmarshal(event.Payload, &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func auditOperations(entries []eventing.AuditEntry) []eventing.AuditOperation {
+	operations := make([]eventing.AuditOperation, len(entries))
+	for i, entry := range entries {
+		operations[i] = entry.Operation
+	}
+	return operations
+}
+
+func equalOperations(got, want []eventing.AuditOperation) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestArticleChangesStoreTheirAuditRecord(t *testing.T) {
+	f := newFixture(t)
+	caller := eventing.Caller{Actor: "author", Peer: "198.51.100.7:50000"}
+	service := f.service.WithCaller(caller)
+
+	article, err := service.CreateArticle(newArticle("a1", "Graph Colouring"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	article.Abstract = "A revised abstract."
+	if _, err := service.UpdateArticle(article); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.SubmitArticle("a1"); err != nil {
+		t.Fatal(err)
+	}
+	// A rejected change leaves no audit record
+	if _, err := service.CreateArticle(newArticle("a2", "")); err == nil {
+		t.Fatal("CreateArticle() accepted an article without a title")
+	}
+
+	entries := f.auditEntries(t)
+	want := []eventing.AuditOperation{core.AuditCreateArticle, core.AuditUpdateArticle, core.AuditTransitionArticle}
+	if got := auditOperations(entries); !equalOperations(got, want) {
+		t.Fatalf("audit operations = %v, want %v", got, want)
+	}
+	for _, entry := range entries {
+		if entry.EntityID != "a1" || entry.Actor != "author" || entry.Peer != caller.Peer {
+			t.Errorf("audit record = %+v", entry)
+		}
+	}
+
+	history, err := service.GetStatusHistory("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Actor != "author" {
+		t.Errorf("status history = %+v", history)
+	}
+}
+
+func TestAuthorMergeStoresItsAuditRecord(t *testing.T) {
+	f := newFixture(t)
+	f.create(t, newArticle("a1", "Graph Colouring"))
+	merges := core.NewDisambiguationService(f.authors, f.service).WithActor("admin")
+
+	merge, err := merges.MergeAuthors("author_1", "author_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merge.Actor != "admin" {
+		t.Errorf("merge actor = %q, want admin", merge.Actor)
+	}
+	if _, err := merges.UndoMerge(merge.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []eventing.AuditOperation{core.AuditCreateArticle, core.AuditMergeAuthors, core.AuditUndoMergeAuthors}
+	if got := auditOperations(f.auditEntries(t)); !equalOperations(got, want) {
+		t.Errorf("audit operations = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,21 @@
+package core
+
+import "github.com/realBagher/hexaservice-go/eventing"
+
+// SystemActor is recorded for mutations that were not attributed to a user
+const SystemActor = eventing.SystemActor
+
+// AuditOperation names the mutating service call that produced an entry
+type AuditOperation = eventing.AuditOperation
+
+const (
+	AuditCreateArticle     AuditOperation = "create_article"
+	AuditUpdateArticle     AuditOperation = "update_article"
+	AuditTransitionArticle AuditOperation = "transition_article"
+	AuditMergeAuthors      AuditOperation = "merge_authors"
+	AuditUndoMergeAuthors  AuditOperation = "undo_merge_authors"
+	AuditPlaceArticle      AuditOperation = "place_article"
+	AuditSetReferences     AuditOperation = "set_references"
+	AuditDepositDOI        AuditOperation = "deposit_doi"
+	AuditImportArticle     AuditOperation = "import_article"
+)
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Author struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ORCID       string    `json:"orcid,omitempty"`
+	Affiliation string    `json:"affiliation"`
+	Email       string    `json:"email,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks if the author data is valid
+func (a Author) Validate() error {
+	if strings.TrimSpace(a.ID) == "" {
+		return fmt.Errorf("%w: ID cannot be empty", ErrInvalidAuthor)
+	}
+
+	if strings.TrimSpace(a.Name) == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidAuthor)
+	}
+
+	if a.ORCID != "" {
+		if err := ValidateORCID(a.ORCID); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidAuthor, err)
+		}
+	}
+
+	return nil
+}
+
+// ArticleAuthor is one entry in an article's ordered author list. The
+// affiliation is the one printed on the article, which may differ from the
+// author's current affiliation.
+type ArticleAuthor struct {
+	AuthorID      string `json:"author_id"`
+	ORCID         string `json:"orcid,omitempty"`
+	Affiliation   string `json:"affiliation,omitempty"`
+	Corresponding bool   `json:"corresponding"`
+}
+
+// ValidateORCID checks the format of an ORCID iD (0000-0002-1825-0097) and
+// its ISO 7064 mod 11-2 check character
+func ValidateORCID(orcid string) error {
+	if len(orcid) != 19 {
+		return fmt.Errorf("ORCID %q must have the form 0000-0000-0000-0000", orcid)
+	}
+
+	digits := make([]byte, 0, 16)
+	for i := 0; i < len(orcid); i++ {
+		c := orcid[i]
+		switch {
+		case i%5 == 4:
+			if c != '-' {
+				return fmt.Errorf("ORCID %q must have the form 0000-0000-0000-0000", orcid)
+			}
+			continue
+		case c >= '0' && c <= '9':
+		case c == 'X' && i == len(orcid)-1:
+		default:
+			return fmt.Errorf("ORCID %q contains an invalid character %q", orcid, c)
+		}
+		digits = append(digits, c)
+	}
+
+	if check := orcidCheckDigit(digits[:15]); check != digits[15] {
+		return fmt.Errorf("ORCID %q has an invalid check digit", orcid)
+	}
+	return nil
+}
+
+// orcidCheckDigit computes the ISO 7064 mod 11-2 check character for the
+// first 15 digits of an ORCID iD
+func orcidCheckDigit(digits []byte) byte {
+	total := 0
+	for _, d := range digits {
+		total = (total + int(d-'0')) * 2
+	}
+	result := (12 - total%11) % 11
+	if result == 10 {
+		return 'X'
+	}
+	return byte('0' + result)
+}
+
+// AuthorService manages authors independently of their articles
+type AuthorService struct {
+	repository AuthorRepository
+}
+
+func NewAuthorService(repository AuthorRepository) *AuthorService {
+	return &AuthorService{repository: repository}
+}
+
+func (s *AuthorService) CreateAuthor(author Author) (Author, error) {
+	if err := author.Validate(); err != nil {
+		return Author{}, err
+	}
+	if err := s.checkORCIDAvailable(author); err != nil {
+		return Author{}, err
+	}
+
+	now := time.Now().UTC()
+	author.CreatedAt = now
+	author.UpdatedAt = now
+	return s.repository.CreateAuthor(author)
+}
+
+func (s *AuthorService) GetAuthor(id string) (Author, error) {
+	return s.repository.GetAuthor(id)
+}
+
+func (s *AuthorService) ListAuthors() ([]Author, error) {
+	return s.repository.ListAuthors()
+}
+
+func (s *AuthorService) UpdateAuthor(author Author) (Author, error) {
+	if err := author.Validate(); err != nil {
+		return Author{}, err
+	}
+
+	existing, err := s.repository.GetAuthor(author.ID)
+	if err != nil {
+		return Author{}, err
+	}
+	if err := s.checkORCIDAvailable(author); err != nil {
+		return Author{}, err
+	}
+
+	author.CreatedAt = existing.CreatedAt
+	author.UpdatedAt = time.Now().UTC()
+	return s.repository.UpdateAuthor(author)
+}
+
+// checkORCIDAvailable rejects an ORCID iD that already belongs to another
+// author, since an iD identifies exactly one person
+func (s *AuthorService) checkORCIDAvailable(author Author) error {
+	if author.ORCID == "" {
+		return nil
+	}
+
+	owner, err := s.repository.GetAuthorByORCID(author.ORCID)
+	if err == ErrAuthorNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if owner.ID != author.ID {
+		return fmt.Errorf("%w: ORCID %s already belongs to author %s", ErrInvalidAuthor, author.ORCID, owner.ID)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestValidateORCID(t *testing.T) {
+	tests := []struct {
+		orcid string
+		valid bool
+	}{
+		{"0000-0002-1825-0097", true},
+		{"0000-0002-1694-233X", true},
+		{"0000-0002-1825-0098", false},
+		{"0000-0002-1694-2339", false},
+		{"0000-0002-1825-009", false},
+		{"0000000218250097", false},
+		{"0000-0002-1825_0097", false},
+		{"0000-000X-1825-0097", false},
+		{"0000-0002-1825-009x", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		err := core.ValidateORCID(test.orcid)
+		if (err == nil) != test.valid {
+			t.Errorf("ValidateORCID(%q) = %v, want valid %v", test.orcid, err, test.valid)
+		}
+	}
+}
+
+func TestAuthorValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		author core.Author
+		valid  bool
+	}{
+		{"complete", core.Author{ID: "a", Name: "Ada Lovelace", ORCID: "0000-0002-1825-0097"}, true},
+		{"without ORCID", core.Author{ID: "a", Name: "Ada Lovelace"}, true},
+		{"blank ID", core.Author{ID: " ", Name: "Ada Lovelace"}, false},
+		{"blank name", core.Author{ID: "a", Name: ""}, false},
+		{"bad ORCID", core.Author{ID: "a", Name: "Ada Lovelace", ORCID: "0000-0002-1825-0098"}, false},
+	}
+
+	for _, test := range tests {
+		err := test.author.Validate()
+		if test.valid && err != nil {
+			t.Errorf("%s: Validate() = %v", test.name, err)
+		}
+		if !test.valid && !errors.Is(err, core.ErrInvalidAuthor) {
+			t.Errorf("%s: Validate() = %v, want ErrInvalidAuthor", test.name, err)
+		}
+	}
+}
+
+func TestAuthorServiceKeepsORCIDUnique(t *testing.T) {
+	service := core.NewAuthorService(adapters.NewInMemoryAuthorRepository())
+	if _, err := service.CreateAuthor(core.Author{ID: "a1", Name: "Josiah Carberry", ORCID: "0000-0002-1825-0097"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.CreateAuthor(core.Author{ID: "a2", Name: "J. Carberry", ORCID: "0000-0002-1825-0097"}); !errors.Is(err, core.ErrInvalidAuthor) {
+		t.Errorf("CreateAuthor() with a taken ORCID = %v, want ErrInvalidAuthor", err)
+	}
+
+	second, err := service.CreateAuthor(core.Author{ID: "a2", Name: "Ada Lovelace"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second.ORCID = "0000-0002-1825-0097"
+	if _, err := service.UpdateAuthor(second); !errors.Is(err, core.ErrInvalidAuthor) {
+		t.Errorf("UpdateAuthor() with a taken ORCID = %v, want ErrInvalidAuthor", err)
+	}
+
+	// An author keeps their own iD when other fields change
+	first, err := service.GetAuthor("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.Affiliation = "Brown University"
+	updated, err := service.UpdateAuthor(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.CreatedAt.Equal(first.CreatedAt) || updated.Affiliation != "Brown University" {
+		t.Errorf("UpdateAuthor() = %+v", updated)
+	}
+
+	if _, err := service.UpdateAuthor(core.Author{ID: "missing", Name: "Nobody"}); !errors.Is(err, core.ErrAuthorNotFound) {
+		t.Errorf("UpdateAuthor() of an unknown author = %v, want ErrAuthorNotFound", err)
+	}
+}
+
+func TestArticleAuthorListIsResolved(t *testing.T) {
+	f := newFixture(t)
+	if _, err := f.authors.UpdateAuthor(core.Author{ID: "author_2", Name: "Ada Lovelace", Affiliation: "University of London"}); err != nil {
+		t.Fatal(err)
+	}
+
+	article := newArticle("a1", "Graph Colouring")
+	article.Authors = []core.ArticleAuthor{
+		{AuthorID: "author_2"},
+		{AuthorID: "author_1", Affiliation: "MIT", Corresponding: true},
+	}
+	created := f.create(t, article)
+
+	want := []core.ArticleAuthor{
+		{AuthorID: "author_2", Affiliation: "University of London"},
+		{AuthorID: "author_1", ORCID: "0000-0002-1825-0097", Affiliation: "MIT", Corresponding: true},
+	}
+	if len(created.Authors) != len(want) {
+		t.Fatalf("authors = %+v, want %+v", created.Authors, want)
+	}
+	for i := range want {
+		if created.Authors[i] != want[i] {
+			t.Errorf("author %d = %+v, want %+v", i+1, created.Authors[i], want[i])
+		}
+	}
+
+	byAuthor, err := f.service.ListArticlesByAuthor("author_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byAuthor) != 1 || byAuthor[0].ID != "a1" {
+		t.Errorf("ListArticlesByAuthor(author_2) = %+v", byAuthor)
+	}
+}
+
+func TestArticleAuthorListIsChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		authors []core.ArticleAuthor
+	}{
+		{"no authors", nil},
+		{"unknown author", []core.ArticleAuthor{{AuthorID: "author_9"}}},
+		{"listed twice", []core.ArticleAuthor{{AuthorID: "author_1"}, {AuthorID: "author_1"}}},
+		{"two corresponding", []core.ArticleAuthor{{AuthorID: "author_1", Corresponding: true}, {AuthorID: "author_2", Corresponding: true}}},
+		{"ORCID of someone else", []core.ArticleAuthor{{AuthorID: "author_1", ORCID: "0000-0002-1694-233X"}}},
+		{"bad ORCID", []core.ArticleAuthor{{AuthorID: "author_2", ORCID: "0000-0002-1825-0098"}}},
+	}
+
+	for _, test := range tests {
+		f := newFixture(t)
+		article := newArticle("a1", "Graph Colouring")
+		article.Authors = test.authors
+		if _, err := f.service.CreateArticle(article); !errors.Is(err, core.ErrInvalidArticle) {
+			t.Errorf("%s: CreateArticle() = %v, want ErrInvalidArticle", test.name, err)
+		}
+	}
+}
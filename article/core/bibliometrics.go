@@ -0,0 +1,257 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// i10Threshold is the number of citations an article needs to count towards
+// the i10-index
+const i10Threshold = 10
+
+// DefaultLeaderboardSize is used when a leaderboard query gives no limit
+const DefaultLeaderboardSize = 10
+
+// AuthorArticle is one published article counted towards the metrics of one
+// of its authors, with the article's citation count when it was last seen
+type AuthorArticle struct {
+	AuthorID  string `json:"author_id"`
+	ArticleID string `json:"article_id"`
+	JournalID string `json:"journal_id"`
+	Year      int    `json:"year"`
+	Citations int    `json:"citations"`
+}
+
+// AuthorMetrics summarizes an author's published articles, either across all
+// journals or, when JournalID is set, within one journal
+type AuthorMetrics struct {
+	AuthorID  string `json:"author_id"`
+	JournalID string `json:"journal_id,omitempty"`
+	// Publications counts published articles, PublicationsByYear splits them
+	// by year of publication
+	Publications       int         `json:"publications"`
+	PublicationsByYear map[int]int `json:"publications_by_year"`
+	TotalCitations     int         `json:"total_citations"`
+	// HIndex is the largest h such that h articles have at least h citations
+	HIndex int `json:"h_index"`
+	// I10Index counts articles with at least ten citations
+	I10Index  int       `json:"i10_index"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LeaderboardOrder selects the metric a journal leaderboard ranks by
+type LeaderboardOrder string
+
+const (
+	LeaderboardByHIndex       LeaderboardOrder = "h_index"
+	LeaderboardByCitations    LeaderboardOrder = "total_citations"
+	LeaderboardByI10Index     LeaderboardOrder = "i10_index"
+	LeaderboardByPublications LeaderboardOrder = "publications"
+)
+
+// Valid reports whether the order is known
+func (o LeaderboardOrder) Valid() bool {
+	switch o {
+	case LeaderboardByHIndex, LeaderboardByCitations, LeaderboardByI10Index, LeaderboardByPublications:
+		return true
+	}
+	return false
+}
+
+// Value returns the metric the order ranks by
+func (o LeaderboardOrder) Value(metrics AuthorMetrics) int {
+	switch o {
+	case LeaderboardByCitations:
+		return metrics.TotalCitations
+	case LeaderboardByI10Index:
+		return metrics.I10Index
+	case LeaderboardByPublications:
+		return metrics.Publications
+	default:
+		return metrics.HIndex
+	}
+}
+
+// ComputeAuthorMetrics summarizes the given articles of an author. Only the
+// articles of journalID count unless it is empty.
+func ComputeAuthorMetrics(authorID, journalID string, articles []AuthorArticle, now time.Time) AuthorMetrics {
+	metrics := AuthorMetrics{
+		AuthorID:           authorID,
+		JournalID:          journalID,
+		PublicationsByYear: make(map[int]int),
+		UpdatedAt:          now,
+	}
+
+	var citations []int
+	for _, article := range articles {
+		if journalID != "" && article.JournalID != journalID {
+			continue
+		}
+		metrics.Publications++
+		metrics.PublicationsByYear[article.Year]++
+		metrics.TotalCitations += article.Citations
+		if article.Citations >= i10Threshold {
+			metrics.I10Index++
+		}
+		citations = append(citations, article.Citations)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(citations)))
+	for i, count := range citations {
+		if count < i+1 {
+			break
+		}
+		metrics.HIndex = i + 1
+	}
+
+	return metrics
+}
+
+// BibliometricsService keeps per-author metrics up to date. It consumes
+// article events and refreshes only the authors of the article an event is
+// about, so a new citation costs one pass over each affected author's
+// articles rather than a recomputation for everyone.
+type BibliometricsService struct {
+	repository AuthorMetricsRepository
+	articles   *ArticleService
+	// mu serializes refreshes, which read and rewrite an article's
+	// contributions
+	mu sync.Mutex
+}
+
+func NewBibliometricsService(repository AuthorMetricsRepository, articles *ArticleService) *BibliometricsService {
+	return &BibliometricsService{repository: repository, articles: articles}
+}
+
+// HandleEvent refreshes the metrics affected by an article event. Refreshes
+// read the article's current state, so duplicate or late events are harmless.
+func (s *BibliometricsService) HandleEvent(event Event) error {
+	switch event.Type {
+	case EventArticleUpdated, EventArticlePublished, EventArticleCitationsChanged:
+		return s.RefreshArticle(event.AggregateID)
+	}
+	return nil
+}
+
+// RefreshArticle brings the article's contribution to its authors' metrics
+// up to date and recomputes the metrics of every author it is added to or
+// removed from: overall and for each journal involved
+func (s *BibliometricsService) RefreshArticle(articleID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before, err := s.repository.ListArticleContributions(articleID)
+	if err != nil {
+		return err
+	}
+
+	article, err := s.articles.GetArticleByID(articleID)
+	if err != nil && err != ErrArticleNotFound {
+		return err
+	}
+	var after []AuthorArticle
+	if err == nil && article.Status == StatusPublished && article.PublishedAt != nil {
+		for _, author := range article.Authors {
+			after = append(after, AuthorArticle{
+				AuthorID:  author.AuthorID,
+				ArticleID: article.ID,
+				JournalID: article.JournalID,
+				Year:      article.PublishedAt.Year(),
+				Citations: article.CitationCount,
+			})
+		}
+	}
+
+	if len(before) == 0 && len(after) == 0 {
+		return nil
+	}
+
+	authors := make(map[string]bool)
+	journals := map[string]bool{"": true}
+	for _, contribution := range append(append([]AuthorArticle(nil), before...), after...) {
+		authors[contribution.AuthorID] = true
+		journals[contribution.JournalID] = true
+	}
+
+	now := time.Now().UTC()
+	var metrics []AuthorMetrics
+	for _, authorID := range sortedKeys(authors) {
+		articles, err := s.repository.ListAuthorArticles(authorID)
+		if err != nil {
+			return err
+		}
+		articles = replaceContribution(authorID, articles, articleID, after)
+		for _, journalID := range sortedKeys(journals) {
+			metrics = append(metrics, ComputeAuthorMetrics(authorID, journalID, articles, now))
+		}
+	}
+
+	if err := s.repository.ApplyArticleContributions(articleID, after, metrics); err != nil {
+		return fmt.Errorf("failed to update metrics for article %s: %w", articleID, err)
+	}
+	return nil
+}
+
+// GetAuthorMetrics returns an author's metrics across all journals, or
+// within one journal when journalID is set
+func (s *BibliometricsService) GetAuthorMetrics(authorID, journalID string) (AuthorMetrics, error) {
+	if _, err := s.articles.authors.GetAuthor(authorID); err != nil {
+		return AuthorMetrics{}, err
+	}
+
+	metrics, err := s.repository.GetAuthorMetrics(authorID, journalID)
+	if err == ErrAuthorMetricsNotFound {
+		// Authors without published articles have empty metrics
+		return ComputeAuthorMetrics(authorID, journalID, nil, time.Now().UTC()), nil
+	}
+	return metrics, err
+}
+
+// Leaderboard ranks the authors of a journal by the given metric, breaking
+// ties by total citations and then author ID
+func (s *BibliometricsService) Leaderboard(journalID string, order LeaderboardOrder, limit int) ([]AuthorMetrics, error) {
+	if journalID == "" {
+		return nil, fmt.Errorf("%w: journal ID cannot be empty", ErrInvalidMetricsQuery)
+	}
+	if order == "" {
+		order = LeaderboardByHIndex
+	}
+	if !order.Valid() {
+		return nil, fmt.Errorf("%w: unknown order %q", ErrInvalidMetricsQuery, order)
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("%w: limit cannot be negative", ErrInvalidMetricsQuery)
+	}
+	if limit == 0 {
+		limit = DefaultLeaderboardSize
+	}
+	return s.repository.ListJournalAuthorMetrics(journalID, order, limit)
+}
+
+// replaceContribution swaps the article's entry in an author's article list
+// for the author's entry in contributions, if any
+func replaceContribution(authorID string, articles []AuthorArticle, articleID string, contributions []AuthorArticle) []AuthorArticle {
+	var replaced []AuthorArticle
+	for _, article := range articles {
+		if article.ArticleID != articleID {
+			replaced = append(replaced, article)
+		}
+	}
+	for _, contribution := range contributions {
+		if contribution.AuthorID == authorID {
+			replaced = append(replaced, contribution)
+		}
+	}
+	return replaced
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,159 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestComputeAuthorMetrics(t *testing.T) {
+	tests := []struct {
+		name      string
+		citations []int
+		h, i10    int
+	}{
+		{"no articles", nil, 0, 0},
+		{"uncited", []int{0, 0}, 0, 0},
+		{"one cited", []int{5}, 1, 0},
+		{"h equals count", []int{3, 3, 3}, 3, 0},
+		{"classic", []int{10, 8, 5, 4, 3}, 4, 1},
+		{"long tail", []int{25, 12, 1, 1, 1, 0}, 2, 2},
+	}
+
+	for _, test := range tests {
+		var articles []core.AuthorArticle
+		total := 0
+		for i, count := range test.citations {
+			articles = append(articles, core.AuthorArticle{AuthorID: "a", ArticleID: string(rune('a' + i)), JournalID: "j1", Year: 2020 + i%2, Citations: count})
+			total += count
+		}
+
+		metrics := core.ComputeAuthorMetrics("a", "", articles, time.Now())
+		if metrics.HIndex != test.h || metrics.I10Index != test.i10 || metrics.TotalCitations != total || metrics.Publications != len(articles) {
+			t.Errorf("%s: metrics = %+v, want h %d, i10 %d, %d citations", test.name, metrics, test.h, test.i10, total)
+		}
+	}
+}
+
+func TestComputeAuthorMetricsByJournal(t *testing.T) {
+	articles := []core.AuthorArticle{
+		{AuthorID: "a", ArticleID: "x", JournalID: "j1", Year: 2020, Citations: 12},
+		{AuthorID: "a", ArticleID: "y", JournalID: "j2", Year: 2021, Citations: 3},
+		{AuthorID: "a", ArticleID: "z", JournalID: "j1", Year: 2021, Citations: 2},
+	}
+
+	all := core.ComputeAuthorMetrics("a", "", articles, time.Now())
+	if all.Publications != 3 || all.PublicationsByYear[2021] != 2 || all.HIndex != 2 {
+		t.Errorf("overall metrics = %+v", all)
+	}
+	inJournal := core.ComputeAuthorMetrics("a", "j1", articles, time.Now())
+	if inJournal.Publications != 2 || inJournal.TotalCitations != 14 || inJournal.I10Index != 1 || inJournal.HIndex != 2 {
+		t.Errorf("metrics in j1 = %+v", inJournal)
+	}
+}
+
+// handlePending feeds the events waiting in the outbox to the service, as
+// the relay would
+func (f fixture) handlePending(t *testing.T, service *core.BibliometricsService) {
+	t.Helper()
+	events, err := f.articles.PendingEvents(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range events {
+		if err := service.HandleEvent(event); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.articles.MarkEventPublished(event.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBibliometricsFollowArticleEvents(t *testing.T) {
+	f := newFixture(t)
+	metrics := core.NewBibliometricsService(adapters.NewInMemoryAuthorMetricsRepository(), f.service)
+
+	// author_1 writes a1 and a2, author_2 co-writes a2, and a3 cites both
+	f.acceptedArticle(t, "a1", "Graph Colouring")
+	a2 := newArticle("a2", "Planar Graphs")
+	a2.Authors = append(a2.Authors, core.ArticleAuthor{AuthorID: "author_2"})
+	f.create(t, a2)
+	for _, step := range []func(string) (core.Article, error){f.service.SubmitArticle, f.service.StartReview, f.service.AcceptArticle} {
+		if _, err := step("a2"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range []string{"a1", "a2"} {
+		if _, err := f.service.PublishArticle(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.create(t, newArticle("a3", "Four Colours"))
+	if _, err := f.service.SetReferences("a3", []core.Reference{{TargetArticleID: "a1"}, {TargetArticleID: "a2"}}); err != nil {
+		t.Fatal(err)
+	}
+	f.handlePending(t, metrics)
+
+	first, err := metrics.GetAuthorMetrics("author_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Publications != 2 || first.TotalCitations != 2 || first.HIndex != 1 {
+		t.Errorf("author_1 metrics = %+v", first)
+	}
+
+	// Dropping a reference lowers the counts of the article's authors only
+	if _, err := f.service.SetReferences("a3", []core.Reference{{TargetArticleID: "a1"}}); err != nil {
+		t.Fatal(err)
+	}
+	f.handlePending(t, metrics)
+
+	second, err := metrics.GetAuthorMetrics("author_2", testJournalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Publications != 1 || second.TotalCitations != 0 {
+		t.Errorf("author_2 metrics = %+v", second)
+	}
+
+	board, err := metrics.Leaderboard(testJournalID, core.LeaderboardByPublications, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(board) != 2 || board[0].AuthorID != "author_1" || board[1].AuthorID != "author_2" {
+		t.Errorf("Leaderboard() = %+v", board)
+	}
+	if board, err := metrics.Leaderboard(testJournalID, core.LeaderboardByHIndex, 1); err != nil || len(board) != 1 {
+		t.Errorf("Leaderboard(limit 1) = %+v, %v", board, err)
+	}
+}
+
+func TestBibliometricsQueriesAreChecked(t *testing.T) {
+	f := newFixture(t)
+	metrics := core.NewBibliometricsService(adapters.NewInMemoryAuthorMetricsRepository(), f.service)
+
+	empty, err := metrics.GetAuthorMetrics("author_2", "")
+	if err != nil || empty.Publications != 0 {
+		t.Errorf("GetAuthorMetrics() without articles = %+v, %v", empty, err)
+	}
+	if _, err := metrics.GetAuthorMetrics("author_9", ""); !errors.Is(err, core.ErrAuthorNotFound) {
+		t.Errorf("GetAuthorMetrics() of an unknown author = %v, want ErrAuthorNotFound", err)
+	}
+	for _, query := range []struct {
+		journalID string
+		order     core.LeaderboardOrder
+		limit     int
+	}{
+		{"", core.LeaderboardByHIndex, 10},
+		{testJournalID, "g_index", 10},
+		{testJournalID, core.LeaderboardByHIndex, -1},
+	} {
+		if _, err := metrics.Leaderboard(query.journalID, query.order, query.limit); !errors.Is(err, core.ErrInvalidMetricsQuery) {
+			t.Errorf("Leaderboard(%q, %q, %d) = %v, want ErrInvalidMetricsQuery", query.journalID, query.order, query.limit, err)
+		}
+	}
+}
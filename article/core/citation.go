@@ -0,0 +1,398 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Reference is one entry of an article's reference list. It cites either
+// another article of this service by ID or a work published elsewhere by
+// DOI, never both. A work with neither, such as a book or a personal
+// communication, is kept by its printed text alone.
+type Reference struct {
+	CitingArticleID string `json:"citing_article_id"`
+	// Position is the 1-based place of the entry in the reference list
+	Position        int    `json:"position"`
+	TargetArticleID string `json:"target_article_id,omitempty"`
+	DOI             string `json:"doi,omitempty"`
+	// Text is the reference as printed, e.g. "Smith J. (2020) ..."
+	Text string `json:"text,omitempty"`
+}
+
+// Internal reports whether the reference cites an article of this service
+func (r Reference) Internal() bool {
+	return r.TargetArticleID != ""
+}
+
+// Linked reports whether the reference identifies the cited work by
+// article ID or DOI rather than by its text alone
+func (r Reference) Linked() bool {
+	return r.TargetArticleID != "" || r.DOI != ""
+}
+
+// Validate checks if the reference data is valid
+func (r Reference) Validate() error {
+	if strings.TrimSpace(r.CitingArticleID) == "" {
+		return fmt.Errorf("%w: citing article ID cannot be empty", ErrInvalidReference)
+	}
+
+	if r.Position < 1 {
+		return fmt.Errorf("%w: position must be positive", ErrInvalidReference)
+	}
+
+	if r.TargetArticleID != "" && r.DOI != "" {
+		return fmt.Errorf("%w: reference %d cannot cite both an article ID and a DOI", ErrInvalidReference, r.Position)
+	}
+
+	if !r.Linked() && strings.TrimSpace(r.Text) == "" {
+		return fmt.Errorf("%w: reference %d must cite an article ID, a DOI or give its text", ErrInvalidReference, r.Position)
+	}
+
+	if r.TargetArticleID == r.CitingArticleID {
+		return fmt.Errorf("%w: article %s cannot cite itself", ErrInvalidReference, r.CitingArticleID)
+	}
+
+	if r.DOI != "" {
+		if err := ValidateDOI(r.DOI); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidReference, err)
+		}
+	}
+
+	return nil
+}
+
+// ReferenceList is the payload of EventArticleReferencesUpdated
+type ReferenceList struct {
+	ArticleID  string      `json:"article_id"`
+	References []Reference `json:"references"`
+}
+
+// CitationChange is the payload of EventArticleCitationsChanged. Delta is
+// +1 when the citing article started citing the article and -1 when it
+// stopped.
+type CitationChange struct {
+	ArticleID       string `json:"article_id"`
+	CitingArticleID string `json:"citing_article_id"`
+	Delta           int    `json:"delta"`
+}
+
+// CitationDirection selects which edges a citation graph traversal follows
+type CitationDirection string
+
+const (
+	// CitationReferences follows an article's references to the works it cites
+	CitationReferences CitationDirection = "references"
+
+	// CitationCitedBy follows citations back to the articles citing a work
+	CitationCitedBy CitationDirection = "cited_by"
+)
+
+const (
+	// DefaultCitationDepth is used when a graph query gives no depth
+	DefaultCitationDepth = 1
+
+	// MaxCitationDepth bounds graph traversals, since the number of
+	// reachable articles grows quickly with every level
+	MaxCitationDepth = 5
+
+	// MaxCitationGraphNodes stops a traversal early on densely cited works
+	MaxCitationGraphNodes = 500
+)
+
+// CitationNode is a work reached by a citation graph traversal. External
+// works are identified by DOI only and are never expanded.
+type CitationNode struct {
+	ArticleID string `json:"article_id,omitempty"`
+	DOI       string `json:"doi,omitempty"`
+	// Depth is the number of citation edges from the traversal's root
+	Depth int `json:"depth"`
+}
+
+// CitationGraph is the part of the citation graph within a depth limit
+// around one article
+type CitationGraph struct {
+	Root      string            `json:"root"`
+	Direction CitationDirection `json:"direction"`
+	MaxDepth  int               `json:"max_depth"`
+	// Nodes are ordered by depth, starting with the root at depth 0
+	Nodes []CitationNode `json:"nodes"`
+	Edges []Reference    `json:"edges"`
+	// Truncated is set when the traversal stopped at MaxCitationGraphNodes
+	Truncated bool `json:"truncated"`
+}
+
+// SetReferences replaces the article's reference list. Entries are numbered
+// in the given order; references to articles of this service must resolve
+// and each linked work may be cited only once. A DOI minted by this service is
+// stored as a reference to its article. The citation counts of cited
+// articles are adjusted together with the list.
+func (s *ArticleService) SetReferences(articleID string, references []Reference) ([]Reference, error) {
+	if _, err := s.repository.GetArticleByID(articleID); err != nil {
+		return nil, err
+	}
+
+	cited := make(map[string]bool, len(references))
+	normalized := make([]Reference, len(references))
+	for i, reference := range references {
+		reference.CitingArticleID = articleID
+		reference.Position = i + 1
+		reference.TargetArticleID = strings.TrimSpace(reference.TargetArticleID)
+		reference.DOI = NormalizeDOI(reference.DOI)
+		reference.Text = strings.TrimSpace(reference.Text)
+		if err := s.resolveDOIReference(&reference); err != nil {
+			return nil, err
+		}
+		if err := reference.Validate(); err != nil {
+			return nil, err
+		}
+
+		key := "doi:" + reference.DOI
+		if reference.Internal() {
+			key = "article:" + reference.TargetArticleID
+			if _, err := s.repository.GetArticleByID(reference.TargetArticleID); err != nil {
+				if errors.Is(err, ErrArticleNotFound) {
+					return nil, fmt.Errorf("%w: reference %d cites unknown article %s",
+						ErrInvalidReference, reference.Position, reference.TargetArticleID)
+				}
+				return nil, err
+			}
+		}
+		if reference.Linked() && cited[key] {
+			return nil, fmt.Errorf("%w: reference %d cites the same work as an earlier entry", ErrInvalidReference, reference.Position)
+		}
+		cited[key] = true
+		normalized[i] = reference
+	}
+
+	before, err := s.repository.ListReferences(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := referenceEvents(articleID, before, normalized)
+	if err != nil {
+		return nil, err
+	}
+	audit, err := s.auditEvent(AuditSetReferences, articleID, before, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.ReplaceReferences(articleID, normalized, append(events, audit)...); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// resolveDOIReference turns a reference by DOI into a reference by article
+// ID when the DOI belongs to an article of this service
+func (s *ArticleService) resolveDOIReference(reference *Reference) error {
+	if reference.TargetArticleID != "" || reference.DOI == "" {
+		return nil
+	}
+
+	article, err := s.repository.GetArticleByDOI(reference.DOI)
+	if errors.Is(err, ErrArticleNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	reference.TargetArticleID, reference.DOI = article.ID, ""
+	return nil
+}
+
+// referenceEvents raises the list update for the citing article and a
+// citation change for every cited article that was added or dropped
+func referenceEvents(articleID string, before, after []Reference) ([]Event, error) {
+	event, err := NewEvent(EventArticleReferencesUpdated, articleID, ReferenceList{ArticleID: articleID, References: after})
+	if err != nil {
+		return nil, err
+	}
+	events := []Event{event}
+
+	deltas := make(map[string]int)
+	for _, reference := range before {
+		if reference.Internal() {
+			deltas[reference.TargetArticleID]--
+		}
+	}
+	for _, reference := range after {
+		if reference.Internal() {
+			deltas[reference.TargetArticleID]++
+		}
+	}
+
+	targets := make([]string, 0, len(deltas))
+	for target, delta := range deltas {
+		if delta != 0 {
+			targets = append(targets, target)
+		}
+	}
+	sort.Strings(targets)
+
+	for _, target := range targets {
+		change := CitationChange{ArticleID: target, CitingArticleID: articleID, Delta: deltas[target]}
+		event, err := NewEvent(EventArticleCitationsChanged, target, change)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// ListReferences returns the article's reference list in order
+func (s *ArticleService) ListReferences(articleID string) ([]Reference, error) {
+	if _, err := s.repository.GetArticleByID(articleID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListReferences(articleID)
+}
+
+// ListCitedBy returns the references that cite the article, ordered by
+// citing article
+func (s *ArticleService) ListCitedBy(articleID string) ([]Reference, error) {
+	if _, err := s.repository.GetArticleByID(articleID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListCitedBy(articleID)
+}
+
+// ListCitedByDOI returns the references that cite an external work by DOI
+func (s *ArticleService) ListCitedByDOI(doi string) ([]Reference, error) {
+	doi = NormalizeDOI(doi)
+	if err := ValidateDOI(doi); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCitationQuery, err)
+	}
+	return s.repository.ListCitedByDOI(doi)
+}
+
+// CitationGraph walks the citation graph breadth-first from an article,
+// following references or citations up to maxDepth edges away. A maxDepth
+// of zero selects DefaultCitationDepth.
+func (s *ArticleService) CitationGraph(articleID string, direction CitationDirection, maxDepth int) (CitationGraph, error) {
+	if direction == "" {
+		direction = CitationReferences
+	}
+	if direction != CitationReferences && direction != CitationCitedBy {
+		return CitationGraph{}, fmt.Errorf("%w: unknown direction %q", ErrInvalidCitationQuery, direction)
+	}
+	if maxDepth == 0 {
+		maxDepth = DefaultCitationDepth
+	}
+	if maxDepth < 0 || maxDepth > MaxCitationDepth {
+		return CitationGraph{}, fmt.Errorf("%w: depth must be between 1 and %d", ErrInvalidCitationQuery, MaxCitationDepth)
+	}
+
+	if _, err := s.repository.GetArticleByID(articleID); err != nil {
+		return CitationGraph{}, err
+	}
+
+	graph := CitationGraph{
+		Root:      articleID,
+		Direction: direction,
+		MaxDepth:  maxDepth,
+		Nodes:     []CitationNode{{ArticleID: articleID}},
+	}
+	seen := map[string]bool{"article:" + articleID: true}
+	frontier := []string{articleID}
+
+	for depth := 1; depth <= maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			var edges []Reference
+			var err error
+			if direction == CitationReferences {
+				edges, err = s.repository.ListReferences(id)
+			} else {
+				edges, err = s.repository.ListCitedBy(id)
+			}
+			if err != nil {
+				return CitationGraph{}, err
+			}
+
+			for _, edge := range edges {
+				if !edge.Linked() {
+					// The cited work is known only by its text
+					continue
+				}
+				node := CitationNode{Depth: depth}
+				switch {
+				case direction == CitationCitedBy:
+					node.ArticleID = edge.CitingArticleID
+				case edge.Internal():
+					node.ArticleID = edge.TargetArticleID
+				default:
+					node.DOI = edge.DOI
+				}
+
+				key := "article:" + node.ArticleID
+				if node.ArticleID == "" {
+					key = "doi:" + node.DOI
+				}
+				if !seen[key] {
+					if len(graph.Nodes) >= MaxCitationGraphNodes {
+						graph.Truncated = true
+						return graph, nil
+					}
+					seen[key] = true
+					graph.Nodes = append(graph.Nodes, node)
+					if node.ArticleID != "" {
+						next = append(next, node.ArticleID)
+					}
+				}
+				graph.Edges = append(graph.Edges, edge)
+			}
+		}
+		frontier = next
+	}
+
+	return graph, nil
+}
+
+// CitableItem is a published article as seen by the journal metrics: the
+// year it was published and the publication years of the articles citing it
+type CitableItem struct {
+	ArticleID     string
+	Year          int
+	CitationYears []int
+}
+
+// JournalCitableItems lists the published articles of a journal for the
+// journal service's metrics calculation. Only citations from published
+// articles count; each contributes the year its citing article appeared.
+func (s *ArticleService) JournalCitableItems(journalID string) ([]CitableItem, error) {
+	articles, err := s.repository.ListArticlesByJournal(journalID)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []CitableItem
+	for _, article := range articles {
+		if article.Status != StatusPublished || article.PublishedAt == nil {
+			continue
+		}
+
+		item := CitableItem{ArticleID: article.ID, Year: article.PublishedAt.Year()}
+		citations, err := s.repository.ListCitedBy(article.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, citation := range citations {
+			citing, err := s.repository.GetArticleByID(citation.CitingArticleID)
+			if err != nil {
+				return nil, err
+			}
+			if citing.Status == StatusPublished && citing.PublishedAt != nil {
+				item.CitationYears = append(item.CitationYears, citing.PublishedAt.Year())
+			}
+		}
+		sort.Ints(item.CitationYears)
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ArticleID < items[j].ArticleID })
+	return items, nil
+}
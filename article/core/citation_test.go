@@ -0,0 +1,194 @@
+package core_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func (f fixture) citationCount(t *testing.T, id string) int {
+	t.Helper()
+	article, err := f.service.GetArticleByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return article.CitationCount
+}
+
+func TestSetReferences(t *testing.T) {
+	f := newFixture(t).withDrafts(t)
+	if _, err := f.articles.SaveDOIDeposit("a4", "10.5555/a4", core.DOIDeposit{}); err != nil {
+		t.Fatal(err)
+	}
+
+	references, err := f.service.SetReferences("a1", []core.Reference{
+		{TargetArticleID: "a2", Text: " Article a2 "},
+		{DOI: "https://doi.org/10.1000/XYZ"},
+		{DOI: "doi:10.5555/A4"},
+		{Text: "Personal communication."},
+		{Text: "Personal communication."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.Reference{
+		{CitingArticleID: "a1", Position: 1, TargetArticleID: "a2", Text: "Article a2"},
+		{CitingArticleID: "a1", Position: 2, DOI: "10.1000/xyz"},
+		// The DOI of an article of this service becomes a reference to it
+		{CitingArticleID: "a1", Position: 3, TargetArticleID: "a4"},
+		// Works known only by their text are kept as printed
+		{CitingArticleID: "a1", Position: 4, Text: "Personal communication."},
+		{CitingArticleID: "a1", Position: 5, Text: "Personal communication."},
+	}
+	if !reflect.DeepEqual(references, want) {
+		t.Fatalf("SetReferences() = %+v, want %+v", references, want)
+	}
+	if stored, err := f.service.ListReferences("a1"); err != nil || !reflect.DeepEqual(stored, want) {
+		t.Errorf("ListReferences() = %+v, %v", stored, err)
+	}
+	if f.citationCount(t, "a2") != 1 || f.citationCount(t, "a4") != 1 {
+		t.Errorf("citation counts = %d and %d, want 1 and 1", f.citationCount(t, "a2"), f.citationCount(t, "a4"))
+	}
+	if citing, err := f.service.ListCitedByDOI("10.1000/XYZ"); err != nil || len(citing) != 1 || citing[0].CitingArticleID != "a1" {
+		t.Errorf("ListCitedByDOI() = %+v, %v", citing, err)
+	}
+
+	// Replacing the list moves the citation counts with it
+	if _, err := f.service.SetReferences("a1", []core.Reference{{TargetArticleID: "a3"}}); err != nil {
+		t.Fatal(err)
+	}
+	for id, count := range map[string]int{"a2": 0, "a3": 1, "a4": 0} {
+		if got := f.citationCount(t, id); got != count {
+			t.Errorf("citation count of %s = %d, want %d", id, got, count)
+		}
+	}
+	if _, err := f.service.SetReferences("a2", []core.Reference{{TargetArticleID: "a3"}}); err != nil {
+		t.Fatal(err)
+	}
+	citing, err := f.service.ListCitedBy("a3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(citing) != 2 || citing[0].CitingArticleID != "a1" || citing[1].CitingArticleID != "a2" {
+		t.Errorf("ListCitedBy(a3) = %+v", citing)
+	}
+}
+
+func TestSetReferencesIsChecked(t *testing.T) {
+	f := newFixture(t).withDrafts(t)
+	if _, err := f.service.SetReferences("a1", []core.Reference{{TargetArticleID: "a2"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		references []core.Reference
+	}{
+		{"self-citation", []core.Reference{{TargetArticleID: "a1"}}},
+		{"unknown article", []core.Reference{{TargetArticleID: "a9"}}},
+		{"both targets", []core.Reference{{TargetArticleID: "a2", DOI: "10.1000/xyz"}}},
+		{"empty", []core.Reference{{Text: " "}}},
+		{"bad DOI", []core.Reference{{DOI: "10.12/xyz"}}},
+		{"cited twice", []core.Reference{{TargetArticleID: "a3"}, {TargetArticleID: "a3"}}},
+		{"DOI cited twice", []core.Reference{{DOI: "10.1000/xyz"}, {DOI: "https://doi.org/10.1000/XYZ"}}},
+	}
+	for _, test := range tests {
+		if _, err := f.service.SetReferences("a1", test.references); !errors.Is(err, core.ErrInvalidReference) {
+			t.Errorf("%s: SetReferences() = %v, want ErrInvalidReference", test.name, err)
+		}
+	}
+
+	// A rejected list leaves the stored one alone
+	if references, err := f.service.ListReferences("a1"); err != nil || len(references) != 1 || references[0].TargetArticleID != "a2" {
+		t.Errorf("ListReferences() = %+v, %v", references, err)
+	}
+	if _, err := f.service.SetReferences("a9", nil); !errors.Is(err, core.ErrArticleNotFound) {
+		t.Errorf("SetReferences() of an unknown article = %v, want ErrArticleNotFound", err)
+	}
+	if _, err := f.service.ListCitedByDOI("not a doi"); !errors.Is(err, core.ErrInvalidCitationQuery) {
+		t.Errorf("ListCitedByDOI() of a bad DOI = %v, want ErrInvalidCitationQuery", err)
+	}
+}
+
+func TestCitationGraph(t *testing.T) {
+	f := newFixture(t).withDrafts(t)
+	// a1 -> a2 -> a3 -> a1, and a2 also cites an external work. a1 cites
+	// a book the graph cannot follow.
+	for citing, references := range map[string][]core.Reference{
+		"a1": {{TargetArticleID: "a2"}, {Text: "Knuth D. The Art of Computer Programming."}},
+		"a2": {{TargetArticleID: "a3"}, {DOI: "10.1000/xyz"}},
+		"a3": {{TargetArticleID: "a1"}},
+	} {
+		if _, err := f.service.SetReferences(citing, references); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	graph, err := f.service.CitationGraph("a1", core.CitationReferences, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantNodes := []core.CitationNode{
+		{ArticleID: "a1"},
+		{ArticleID: "a2", Depth: 1},
+		{ArticleID: "a3", Depth: 2},
+		{DOI: "10.1000/xyz", Depth: 2},
+	}
+	if !reflect.DeepEqual(graph.Nodes, wantNodes) {
+		t.Errorf("nodes = %+v, want %+v", graph.Nodes, wantNodes)
+	}
+	// The cycle back to a1 is kept as an edge but not visited again
+	if len(graph.Edges) != 4 || graph.Truncated {
+		t.Errorf("edges = %+v, truncated %v", graph.Edges, graph.Truncated)
+	}
+
+	citedBy, err := f.service.CitationGraph("a3", core.CitationCitedBy, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(citedBy.Nodes) != 2 || citedBy.Nodes[1].ArticleID != "a2" || citedBy.MaxDepth != core.DefaultCitationDepth {
+		t.Errorf("CitationGraph(cited_by) = %+v", citedBy)
+	}
+
+	for _, depth := range []int{-1, core.MaxCitationDepth + 1} {
+		if _, err := f.service.CitationGraph("a1", core.CitationReferences, depth); !errors.Is(err, core.ErrInvalidCitationQuery) {
+			t.Errorf("CitationGraph(depth %d) = %v, want ErrInvalidCitationQuery", depth, err)
+		}
+	}
+	if _, err := f.service.CitationGraph("a1", "sideways", 1); !errors.Is(err, core.ErrInvalidCitationQuery) {
+		t.Errorf("CitationGraph(sideways) = %v, want ErrInvalidCitationQuery", err)
+	}
+}
+
+func TestJournalCitableItems(t *testing.T) {
+	f := newFixture(t)
+	for _, id := range []string{"a1", "a2"} {
+		f.acceptedArticle(t, id, "Article "+id)
+		if _, err := f.service.PublishArticle(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.create(t, newArticle("draft", "Unpublished"))
+	for citing, target := range map[string]string{"a2": "a1", "draft": "a1"} {
+		if _, err := f.service.SetReferences(citing, []core.Reference{{TargetArticleID: target}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	items, err := f.service.JournalCitableItems(testJournalID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	year := time.Now().UTC().Year()
+	// Only the citation from the published article counts
+	want := []core.CitableItem{
+		{ArticleID: "a1", Year: year, CitationYears: []int{year}},
+		{ArticleID: "a2", Year: year},
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("JournalCitableItems() = %+v, want %+v", items, want)
+	}
+}
@@ -0,0 +1,347 @@
+package core
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Crossref deposit schema the generated XML follows
+const (
+	CrossrefSchemaVersion  = "5.3.1"
+	CrossrefNamespace      = "http://www.crossref.org/schema/5.3.1"
+	crossrefSchemaLocation = CrossrefNamespace + " https://www.crossref.org/schemas/crossref5.3.1.xsd"
+	xsiNamespace           = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// crossrefTimestampLayout formats the head timestamp, which Crossref
+// requires to increase with every deposit of the same DOI
+const crossrefTimestampLayout = "20060102150405"
+
+// CrossrefDeposit is a doi_batch document registering journal articles.
+// It models the subset of the Crossref 5.3.1 schema the service fills in.
+type CrossrefDeposit struct {
+	XMLName        xml.Name     `xml:"http://www.crossref.org/schema/5.3.1 doi_batch"`
+	Version        string       `xml:"version,attr"`
+	XSI            string       `xml:"xmlns:xsi,attr,omitempty"`
+	SchemaLocation string       `xml:"xsi:schemaLocation,attr,omitempty"`
+	Head           CrossrefHead `xml:"head"`
+	Journal        struct {
+		Metadata CrossrefJournalMetadata `xml:"journal_metadata"`
+		Issue    *CrossrefJournalIssue   `xml:"journal_issue"`
+		Articles []CrossrefArticle       `xml:"journal_article"`
+	} `xml:"body>journal"`
+}
+
+type CrossrefHead struct {
+	BatchID   string `xml:"doi_batch_id"`
+	Timestamp string `xml:"timestamp"`
+	Depositor struct {
+		Name  string `xml:"depositor_name"`
+		Email string `xml:"email_address"`
+	} `xml:"depositor"`
+	Registrant string `xml:"registrant"`
+}
+
+type CrossrefJournalMetadata struct {
+	Language  string         `xml:"language,attr,omitempty"`
+	FullTitle string         `xml:"full_title"`
+	ISSNs     []CrossrefISSN `xml:"issn"`
+}
+
+type CrossrefISSN struct {
+	MediaType string `xml:"media_type,attr"`
+	Value     string `xml:",chardata"`
+}
+
+type CrossrefJournalIssue struct {
+	PublicationDate CrossrefDate `xml:"publication_date"`
+	// Volume is nil for issues outside a numbered volume; the schema does
+	// not allow an empty journal_volume
+	Volume *CrossrefJournalVolume `xml:"journal_volume"`
+	Issue  string                 `xml:"issue"`
+}
+
+type CrossrefJournalVolume struct {
+	Volume string `xml:"volume"`
+}
+
+type CrossrefArticle struct {
+	PublicationType string            `xml:"publication_type,attr"`
+	Title           string            `xml:"titles>title"`
+	Contributors    []CrossrefName    `xml:"contributors>person_name"`
+	Abstract        *CrossrefAbstract `xml:"http://www.ncbi.nlm.nih.gov/JATS1 abstract"`
+	PublicationDate CrossrefDate      `xml:"publication_date"`
+	Pages           *CrossrefPages    `xml:"pages"`
+	DOIData         struct {
+		DOI      string `xml:"doi"`
+		Resource string `xml:"resource"`
+	} `xml:"doi_data"`
+}
+
+type CrossrefName struct {
+	Sequence     string                `xml:"sequence,attr"`
+	Role         string                `xml:"contributor_role,attr"`
+	GivenName    string                `xml:"given_name,omitempty"`
+	Surname      string                `xml:"surname"`
+	Affiliations *CrossrefAffiliations `xml:"affiliations"`
+	ORCID        *CrossrefORCID        `xml:"ORCID"`
+}
+
+type CrossrefAffiliations struct {
+	Institution string `xml:"institution>institution_name"`
+}
+
+type CrossrefORCID struct {
+	Authenticated bool   `xml:"authenticated,attr"`
+	Value         string `xml:",chardata"`
+}
+
+type CrossrefAbstract struct {
+	Paragraph string `xml:"http://www.ncbi.nlm.nih.gov/JATS1 p"`
+}
+
+type CrossrefDate struct {
+	MediaType string `xml:"media_type,attr"`
+	Month     int    `xml:"month,omitempty"`
+	Day       int    `xml:"day,omitempty"`
+	Year      int    `xml:"year"`
+}
+
+type CrossrefPages struct {
+	FirstPage int `xml:"first_page"`
+	LastPage  int `xml:"last_page,omitempty"`
+}
+
+// CrossrefContributor is an article author resolved for a deposit
+type CrossrefContributor struct {
+	Name        string
+	ORCID       string
+	Affiliation string
+}
+
+// CrossrefInput collects what a deposit describes. Placement and Issue are
+// nil for articles that are not in an issue yet.
+type CrossrefInput struct {
+	BatchID      string
+	Timestamp    time.Time
+	Config       DOIConfig
+	Article      Article
+	DOI          string
+	Journal      JournalInfo
+	Contributors []CrossrefContributor
+	Placement    *ArticlePlacement
+	Issue        *IssueInfo
+}
+
+// BuildCrossrefDeposit renders the deposit XML for one published article and
+// checks it with ParseCrossrefDeposit
+func BuildCrossrefDeposit(input CrossrefInput) ([]byte, error) {
+	if input.Article.PublishedAt == nil {
+		return nil, fmt.Errorf("%w: article %s has no publication date", ErrInvalidDeposit, input.Article.ID)
+	}
+	published := input.Article.PublishedAt.UTC()
+
+	deposit := CrossrefDeposit{
+		Version:        CrossrefSchemaVersion,
+		XSI:            xsiNamespace,
+		SchemaLocation: crossrefSchemaLocation,
+	}
+	deposit.Head.BatchID = input.BatchID
+	deposit.Head.Timestamp = input.Timestamp.UTC().Format(crossrefTimestampLayout)
+	deposit.Head.Depositor.Name = input.Config.DepositorName
+	deposit.Head.Depositor.Email = input.Config.DepositorEmail
+	deposit.Head.Registrant = input.Config.Registrant
+
+	deposit.Journal.Metadata = CrossrefJournalMetadata{Language: "en", FullTitle: input.Journal.Name}
+	if input.Journal.PrintISSN != "" {
+		deposit.Journal.Metadata.ISSNs = append(deposit.Journal.Metadata.ISSNs, CrossrefISSN{MediaType: "print", Value: input.Journal.PrintISSN})
+	}
+	if input.Journal.ElectronicISSN != "" {
+		deposit.Journal.Metadata.ISSNs = append(deposit.Journal.Metadata.ISSNs, CrossrefISSN{MediaType: "electronic", Value: input.Journal.ElectronicISSN})
+	}
+	if input.Issue != nil {
+		deposit.Journal.Issue = &CrossrefJournalIssue{
+			PublicationDate: CrossrefDate{MediaType: "online", Year: published.Year()},
+			Issue:           fmt.Sprint(input.Issue.Number),
+		}
+		if input.Issue.Volume > 0 {
+			deposit.Journal.Issue.Volume = &CrossrefJournalVolume{Volume: fmt.Sprint(input.Issue.Volume)}
+		}
+	}
+
+	article := CrossrefArticle{
+		PublicationType: "full_text",
+		Title:           input.Article.Title,
+		PublicationDate: CrossrefDate{
+			MediaType: "online",
+			Month:     int(published.Month()),
+			Day:       published.Day(),
+			Year:      published.Year(),
+		},
+	}
+	for i, contributor := range input.Contributors {
+		given, surname := splitDisplayName(contributor.Name)
+		name := CrossrefName{Sequence: "additional", Role: "author", GivenName: given, Surname: surname}
+		if i == 0 {
+			name.Sequence = "first"
+		}
+		if contributor.Affiliation != "" {
+			name.Affiliations = &CrossrefAffiliations{Institution: contributor.Affiliation}
+		}
+		if contributor.ORCID != "" {
+			name.ORCID = &CrossrefORCID{Value: "https://orcid.org/" + contributor.ORCID}
+		}
+		article.Contributors = append(article.Contributors, name)
+	}
+	if abstract := strings.TrimSpace(input.Article.Abstract); abstract != "" {
+		article.Abstract = &CrossrefAbstract{Paragraph: abstract}
+	}
+	if input.Placement != nil && input.Placement.HasPages() {
+		article.Pages = &CrossrefPages{FirstPage: input.Placement.FirstPage, LastPage: input.Placement.LastPage}
+	}
+	article.DOIData.DOI = input.DOI
+	article.DOIData.Resource = input.Config.ResourceURL(input.Article.ID)
+	deposit.Journal.Articles = []CrossrefArticle{article}
+
+	data, err := xml.MarshalIndent(deposit, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Crossref deposit: %w", err)
+	}
+	data = append([]byte(xml.Header), data...)
+
+	if _, err := ParseCrossrefDeposit(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ParseCrossrefDeposit decodes a deposit and validates it
+func ParseCrossrefDeposit(data []byte) (CrossrefDeposit, error) {
+	var deposit CrossrefDeposit
+	if err := xml.Unmarshal(data, &deposit); err != nil {
+		return CrossrefDeposit{}, fmt.Errorf("%w: %v", ErrInvalidDeposit, err)
+	}
+	if err := deposit.Validate(); err != nil {
+		return CrossrefDeposit{}, err
+	}
+	return deposit, nil
+}
+
+var (
+	crossrefTimestamp = regexp.MustCompile(`^[0-9]{14,20}$`)
+	crossrefORCID     = regexp.MustCompile(`^https?://orcid.org/[0-9]{4}-[0-9]{4}-[0-9]{4}-[0-9]{3}[X0-9]$`)
+)
+
+// Validate enforces the constraints of the Crossref schema on the elements
+// the service generates: required elements, value formats and lengths
+func (d CrossrefDeposit) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidDeposit, fmt.Sprintf(format, args...))
+	}
+
+	if d.Version != CrossrefSchemaVersion {
+		return invalid("unsupported schema version %q", d.Version)
+	}
+	if len(d.Head.BatchID) < 4 || len(d.Head.BatchID) > 100 {
+		return invalid("doi_batch_id must have 4 to 100 characters")
+	}
+	if !crossrefTimestamp.MatchString(d.Head.Timestamp) {
+		return invalid("timestamp %q must be a number such as yyyyMMddHHmmss", d.Head.Timestamp)
+	}
+	if d.Head.Depositor.Name == "" || !strings.Contains(d.Head.Depositor.Email, "@") {
+		return invalid("depositor needs a name and an email address")
+	}
+	if d.Head.Registrant == "" {
+		return invalid("registrant cannot be empty")
+	}
+
+	metadata := d.Journal.Metadata
+	if metadata.FullTitle == "" {
+		return invalid("journal full_title cannot be empty")
+	}
+	if len(metadata.ISSNs) == 0 {
+		return invalid("journal %q needs a print or electronic ISSN", metadata.FullTitle)
+	}
+	for _, issn := range metadata.ISSNs {
+		if issn.MediaType != "print" && issn.MediaType != "electronic" {
+			return invalid("unknown ISSN media type %q", issn.MediaType)
+		}
+		if !validISSNFormat(issn.Value) {
+			return invalid("ISSN %q must have the form 1234-567X", issn.Value)
+		}
+	}
+	if d.Journal.Issue != nil && d.Journal.Issue.PublicationDate.Year == 0 {
+		return invalid("journal_issue needs a publication year")
+	}
+
+	if len(d.Journal.Articles) == 0 {
+		return invalid("deposit has no journal_article")
+	}
+	for _, article := range d.Journal.Articles {
+		if strings.TrimSpace(article.Title) == "" {
+			return invalid("journal_article needs a title")
+		}
+		for _, name := range article.Contributors {
+			if name.Surname == "" {
+				return invalid("contributor needs a surname")
+			}
+			if name.Sequence != "first" && name.Sequence != "additional" {
+				return invalid("unknown contributor sequence %q", name.Sequence)
+			}
+			if name.ORCID != nil && !crossrefORCID.MatchString(name.ORCID.Value) {
+				return invalid("ORCID %q must be an https://orcid.org/ URI", name.ORCID.Value)
+			}
+		}
+		date := article.PublicationDate
+		if date.Year < 1400 || date.Year > 2200 || date.Month < 0 || date.Month > 12 || date.Day < 0 || date.Day > 31 {
+			return invalid("invalid publication date %d-%d-%d", date.Year, date.Month, date.Day)
+		}
+		if article.Pages != nil && article.Pages.FirstPage < 1 {
+			return invalid("first_page must be positive")
+		}
+		if err := ValidateDOI(article.DOIData.DOI); err != nil {
+			return invalid("%v", err)
+		}
+		resource, err := url.Parse(article.DOIData.Resource)
+		if err != nil || (resource.Scheme != "http" && resource.Scheme != "https") || resource.Host == "" {
+			return invalid("resource %q must be an http or https URL", article.DOIData.Resource)
+		}
+	}
+
+	return nil
+}
+
+// validISSNFormat checks the shape of an ISSN; the journal service owns the
+// check digit
+func validISSNFormat(issn string) bool {
+	if len(issn) != 9 || issn[4] != '-' {
+		return false
+	}
+	for i, c := range issn {
+		switch {
+		case i == 4:
+		case c >= '0' && c <= '9':
+		case c == 'X' && i == 8:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// splitDisplayName splits "Given Family" or "Family, Given" into given
+// names and surname
+func splitDisplayName(name string) (given, surname string) {
+	if family, rest, ok := strings.Cut(name, ","); ok {
+		return strings.TrimSpace(rest), strings.TrimSpace(family)
+	}
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	return strings.Join(fields[:len(fields)-1], " "), fields[len(fields)-1]
+}
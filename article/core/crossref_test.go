@@ -0,0 +1,209 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// crossrefSchema is the deposit schema the tests validate against; see the
+// comment at its top for what it covers
+const crossrefSchema = "testdata/crossref/crossref5.3.1.xsd"
+
+func crossrefInput() core.CrossrefInput {
+	published := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	return core.CrossrefInput{
+		BatchID:   "batch-0001",
+		Timestamp: published,
+		Config: core.DOIConfig{
+			Prefix:             "10.5555",
+			SuffixPattern:      core.DefaultDOISuffixPattern,
+			ResourceURLPattern: "https://journals.example.org/articles/{article}",
+			DepositorName:      "Example Press",
+			DepositorEmail:     "doi@example.org",
+			Registrant:         "Example Press",
+		},
+		Article: core.Article{
+			ID:          "a1",
+			Title:       "Graph Colouring & Planarity",
+			Abstract:    "We colour <planar> graphs.",
+			JournalID:   "journal_1",
+			PublishedAt: &published,
+		},
+		DOI:     "10.5555/journal_1.2024.a1",
+		Journal: core.JournalInfo{ID: "journal_1", Name: "Nature", PrintISSN: "0028-0836", ElectronicISSN: "1476-4687"},
+		Contributors: []core.CrossrefContributor{
+			{Name: "Josiah Carberry", ORCID: "0000-0002-1825-0097", Affiliation: "Brown University"},
+			{Name: "Lovelace, Ada"},
+		},
+		Placement: &core.ArticlePlacement{ArticleID: "a1", IssueID: "i1", Sequence: 1, FirstPage: 1, LastPage: 10},
+		Issue:     &core.IssueInfo{ID: "i1", JournalID: "journal_1", Volume: 3, Number: 2},
+	}
+}
+
+// validateAgainstSchema runs xmllint, which ships with libxml2, since Go
+// has no XML Schema validator
+func validateAgainstSchema(t *testing.T, data []byte) error {
+	t.Helper()
+	xmllint, err := exec.LookPath("xmllint")
+	if err != nil {
+		t.Skip("xmllint is not installed")
+	}
+
+	cmd := exec.Command(xmllint, "--noout", "--schema", crossrefSchema, "-")
+	cmd.Stdin = bytes.NewReader(data)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.New(strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func TestCrossrefDepositMatchesSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*core.CrossrefInput)
+	}{
+		{"complete", func(*core.CrossrefInput) {}},
+		{"not in an issue", func(input *core.CrossrefInput) { input.Issue, input.Placement = nil, nil }},
+		{"no pages", func(input *core.CrossrefInput) { input.Placement.FirstPage, input.Placement.LastPage = 0, 0 }},
+		{"no volume", func(input *core.CrossrefInput) { input.Issue.Volume = 0 }},
+		{"no abstract", func(input *core.CrossrefInput) { input.Article.Abstract = " " }},
+		{"online only", func(input *core.CrossrefInput) { input.Journal.PrintISSN = "" }},
+		{"single-word name", func(input *core.CrossrefInput) {
+			input.Contributors = []core.CrossrefContributor{{Name: "Plato"}}
+		}},
+	}
+
+	for _, test := range tests {
+		input := crossrefInput()
+		test.change(&input)
+		data, err := core.BuildCrossrefDeposit(input)
+		if err != nil {
+			t.Fatalf("%s: BuildCrossrefDeposit() = %v", test.name, err)
+		}
+		if err := validateAgainstSchema(t, data); err != nil {
+			t.Errorf("%s: deposit does not match the schema:\n%s\n%s", test.name, err, data)
+		}
+	}
+}
+
+func TestCrossrefSchemaRejectsBadDeposits(t *testing.T) {
+	data, err := core.BuildCrossrefDeposit(crossrefInput())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Make sure the schema check is not vacuous
+	for _, bad := range [][2]string{
+		{"<doi>10.5555/", "<doi>11.5555/"},
+		{"<registrant>Example Press</registrant>", ""},
+		{`sequence="first"`, `sequence="second"`},
+	} {
+		if !bytes.Contains(data, []byte(bad[0])) {
+			t.Fatalf("deposit does not contain %q", bad[0])
+		}
+		if err := validateAgainstSchema(t, bytes.Replace(data, []byte(bad[0]), []byte(bad[1]), 1)); err == nil {
+			t.Errorf("schema accepted %q in place of %q", bad[1], bad[0])
+		}
+	}
+}
+
+func TestBuildCrossrefDeposit(t *testing.T) {
+	data, err := core.BuildCrossrefDeposit(crossrefInput())
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit, err := core.ParseCrossrefDeposit(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	article := deposit.Journal.Articles[0]
+	if article.Title != "Graph Colouring & Planarity" || article.Abstract.Paragraph != "We colour <planar> graphs." {
+		t.Errorf("article = %+v", article)
+	}
+	if len(article.Contributors) != 2 {
+		t.Fatalf("contributors = %+v", article.Contributors)
+	}
+	first, second := article.Contributors[0], article.Contributors[1]
+	if first.Sequence != "first" || first.GivenName != "Josiah" || first.Surname != "Carberry" ||
+		first.ORCID.Value != "https://orcid.org/0000-0002-1825-0097" || first.Affiliations.Institution != "Brown University" {
+		t.Errorf("first contributor = %+v", first)
+	}
+	if second.Sequence != "additional" || second.GivenName != "Ada" || second.Surname != "Lovelace" || second.ORCID != nil {
+		t.Errorf("second contributor = %+v", second)
+	}
+	if deposit.Journal.Issue.Volume.Volume != "3" || deposit.Journal.Issue.Issue != "2" || article.Pages.LastPage != 10 {
+		t.Errorf("issue = %+v, pages = %+v", deposit.Journal.Issue, article.Pages)
+	}
+	if article.DOIData.Resource != "https://journals.example.org/articles/a1" || deposit.Head.Timestamp != "20240305100000" {
+		t.Errorf("resource = %q, timestamp = %q", article.DOIData.Resource, deposit.Head.Timestamp)
+	}
+
+	unpublished := crossrefInput()
+	unpublished.Article.PublishedAt = nil
+	if _, err := core.BuildCrossrefDeposit(unpublished); !errors.Is(err, core.ErrInvalidDeposit) {
+		t.Errorf("BuildCrossrefDeposit() of an unpublished article = %v, want ErrInvalidDeposit", err)
+	}
+	unregistered := crossrefInput()
+	unregistered.Config.Registrant = ""
+	if _, err := core.BuildCrossrefDeposit(unregistered); !errors.Is(err, core.ErrInvalidDeposit) {
+		t.Errorf("BuildCrossrefDeposit() without a registrant = %v, want ErrInvalidDeposit", err)
+	}
+}
+
+func TestCrossrefDepositValidate(t *testing.T) {
+	data, err := core.BuildCrossrefDeposit(crossrefInput())
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := core.ParseCrossrefDeposit(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*core.CrossrefDeposit)
+	}{
+		{"missing DOI", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].DOIData.DOI = "" }},
+		{"bad DOI prefix", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].DOIData.DOI = "11.5555/journal_1.2024.a1" }},
+		{"short registrant code", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].DOIData.DOI = "10.55/journal_1.2024.a1" }},
+		{"missing registrant", func(d *core.CrossrefDeposit) { d.Head.Registrant = "" }},
+		{"short batch ID", func(d *core.CrossrefDeposit) { d.Head.BatchID = "b1" }},
+		{"bad timestamp", func(d *core.CrossrefDeposit) { d.Head.Timestamp = "2024-03-05" }},
+		{"no depositor email", func(d *core.CrossrefDeposit) { d.Head.Depositor.Email = "" }},
+		{"unsupported version", func(d *core.CrossrefDeposit) { d.Version = "4.4.2" }},
+		{"no ISSN", func(d *core.CrossrefDeposit) { d.Journal.Metadata.ISSNs = nil }},
+		{"bad ISSN", func(d *core.CrossrefDeposit) { d.Journal.Metadata.ISSNs[0].Value = "0028-083" }},
+		{"no title", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].Title = " " }},
+		{"bare ORCID", func(d *core.CrossrefDeposit) {
+			d.Journal.Articles[0].Contributors[0].ORCID.Value = "0000-0002-1825-0097"
+		}},
+		{"no surname", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].Contributors[1].Surname = "" }},
+		{"bad month", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].PublicationDate.Month = 13 }},
+		{"relative resource", func(d *core.CrossrefDeposit) { d.Journal.Articles[0].DOIData.Resource = "/articles/a1" }},
+	}
+
+	for _, test := range tests {
+		// Round-trip through XML so every case starts from its own copy
+		var deposit core.CrossrefDeposit
+		if err := xml.Unmarshal(data, &deposit); err != nil {
+			t.Fatal(err)
+		}
+		test.change(&deposit)
+		if err := deposit.Validate(); !errors.Is(err, core.ErrInvalidDeposit) {
+			t.Errorf("%s: Validate() = %v, want ErrInvalidDeposit", test.name, err)
+		}
+	}
+
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() of the built deposit = %v", err)
+	}
+}
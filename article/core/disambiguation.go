@@ -0,0 +1,568 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// DefaultDuplicateScore is the lowest pair score that proposes two authors
+// as duplicates. A compatible name on its own reaches it; the other signals
+// raise the score to help an admin prioritise.
+const DefaultDuplicateScore = 0.5
+
+// Evidence for a proposed duplicate
+const (
+	EvidenceName            = "compatible_name"
+	EvidenceSameGivenName   = "same_given_name"
+	EvidenceSameAffiliation = "same_affiliation"
+	EvidenceSharedCoAuthors = "shared_co_authors"
+)
+
+// AuthorCluster is a group of author records that probably describe the
+// same person
+type AuthorCluster struct {
+	Authors  []Author `json:"authors"`
+	Score    float64  `json:"score"`
+	Evidence []string `json:"evidence"`
+}
+
+// AuthorListChange rewrites the author list of one article. Applying a
+// change fails with ErrAuthorListChanged unless the stored list still equals
+// Before.
+type AuthorListChange struct {
+	ArticleID string          `json:"article_id"`
+	Before    []ArticleAuthor `json:"before"`
+	After     []ArticleAuthor `json:"after"`
+}
+
+// Inverse returns the change that undoes c
+func (c AuthorListChange) Inverse() AuthorListChange {
+	return AuthorListChange{ArticleID: c.ArticleID, Before: c.After, After: c.Before}
+}
+
+// AuthorMerge records a merge of Source into Target with everything needed
+// to undo it
+type AuthorMerge struct {
+	ID           string             `json:"id"`
+	Source       Author             `json:"source"`
+	TargetBefore Author             `json:"target_before"`
+	Target       Author             `json:"target"`
+	Changes      []AuthorListChange `json:"changes"`
+	Actor        string             `json:"actor"`
+	MergedAt     time.Time          `json:"merged_at"`
+	UndoneAt     *time.Time         `json:"undone_at,omitempty"`
+}
+
+// FindDuplicateAuthors proposes clusters of duplicate authors. Pairs are only
+// compared when their names are compatible ("J. Smith" and "John Smith", but
+// not "Jane Smith"), and authors with different ORCID iDs are never the same
+// person. coAuthors maps an author ID to the IDs of everyone they have
+// published with.
+func FindDuplicateAuthors(authors []Author, coAuthors map[string][]string, minScore float64) []AuthorCluster {
+	sorted := append([]Author(nil), authors...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	names := make([]personName, len(sorted))
+	blocks := make(map[string][]int)
+	for i, author := range sorted {
+		names[i] = parsePersonName(author.Name)
+		if key := names[i].key(); key != "" {
+			blocks[key] = append(blocks[key], i)
+		}
+	}
+
+	parent := make([]int, len(sorted))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	scores := make(map[int]float64)
+	evidence := make(map[int]map[string]bool)
+	record := func(i, j int, score float64, reasons []string) {
+		ri, rj := find(i), find(j)
+		if ri != rj {
+			if rj < ri {
+				ri, rj = rj, ri
+			}
+			parent[rj] = ri
+			scores[ri] = maxFloat(maxFloat(scores[ri], scores[rj]), score)
+			if evidence[ri] == nil {
+				evidence[ri] = make(map[string]bool)
+			}
+			for reason := range evidence[rj] {
+				evidence[ri][reason] = true
+			}
+			delete(scores, rj)
+			delete(evidence, rj)
+		} else {
+			scores[ri] = maxFloat(scores[ri], score)
+		}
+		for _, reason := range reasons {
+			evidence[ri][reason] = true
+		}
+	}
+
+	keys := make([]string, 0, len(blocks))
+	for key := range blocks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		block := blocks[key]
+		for a := 0; a < len(block); a++ {
+			for b := a + 1; b < len(block); b++ {
+				i, j := block[a], block[b]
+				score, reasons, ok := scoreAuthorPair(sorted[i], sorted[j], names[i], names[j], coAuthors)
+				if ok && score >= minScore {
+					record(i, j, score, reasons)
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]Author)
+	for i, author := range sorted {
+		members[find(i)] = append(members[find(i)], author)
+	}
+
+	var clusters []AuthorCluster
+	for root, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		reasons := make([]string, 0, len(evidence[root]))
+		for reason := range evidence[root] {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		clusters = append(clusters, AuthorCluster{Authors: group, Score: scores[root], Evidence: reasons})
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Score != clusters[j].Score {
+			return clusters[i].Score > clusters[j].Score
+		}
+		return clusters[i].Authors[0].ID < clusters[j].Authors[0].ID
+	})
+	return clusters
+}
+
+// scoreAuthorPair weighs the evidence that two authors with the same name
+// key are one person. ok is false when the names or ORCID iDs rule it out.
+func scoreAuthorPair(a, b Author, nameA, nameB personName, coAuthors map[string][]string) (float64, []string, bool) {
+	if a.ORCID != "" && b.ORCID != "" && a.ORCID != b.ORCID {
+		return 0, nil, false
+	}
+	if !nameA.compatible(nameB) {
+		return 0, nil, false
+	}
+
+	score := 0.5
+	reasons := []string{EvidenceName}
+
+	if len(nameA.given) > 0 && strings.Join(nameA.given, " ") == strings.Join(nameB.given, " ") && len(nameA.given[0]) > 1 {
+		score += 0.1
+		reasons = append(reasons, EvidenceSameGivenName)
+	}
+
+	if affiliation := normalizeAffiliation(a.Affiliation); affiliation != "" && affiliation == normalizeAffiliation(b.Affiliation) {
+		score += 0.2
+		reasons = append(reasons, EvidenceSameAffiliation)
+	}
+
+	if overlap := jaccard(coAuthors[a.ID], coAuthors[b.ID], a.ID, b.ID); overlap > 0 {
+		score += 0.2 * overlap
+		reasons = append(reasons, EvidenceSharedCoAuthors)
+	}
+
+	return score, reasons, true
+}
+
+// jaccard returns the overlap of two co-author sets, ignoring the pair
+// themselves
+func jaccard(a, b []string, ignore ...string) float64 {
+	setA := make(map[string]bool)
+	for _, id := range a {
+		if !containsString(ignore, id) {
+			setA[id] = true
+		}
+	}
+	union := len(setA)
+	shared := 0
+	seen := make(map[string]bool)
+	for _, id := range b {
+		if containsString(ignore, id) || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if setA[id] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// personName is a name split into family name and given names, folded to
+// lower-case ASCII letters
+type personName struct {
+	family string
+	given  []string
+}
+
+// parsePersonName understands "Given Family" and "Family, Given"
+func parsePersonName(name string) personName {
+	var family, given string
+	if comma := strings.Index(name, ","); comma >= 0 {
+		family, given = name[:comma], name[comma+1:]
+	} else {
+		fields := strings.Fields(name)
+		if len(fields) == 0 {
+			return personName{}
+		}
+		family, given = fields[len(fields)-1], strings.Join(fields[:len(fields)-1], " ")
+	}
+
+	return personName{
+		family: strings.Join(nameTokens(family), ""),
+		given:  nameTokens(given),
+	}
+}
+
+// key blocks candidate pairs: family name and first initial
+func (n personName) key() string {
+	if n.family == "" {
+		return ""
+	}
+	if len(n.given) == 0 {
+		return n.family
+	}
+	return n.family + " " + n.given[0][:1]
+}
+
+// compatible reports whether the given names can belong to one person: each
+// given name matches or one is the initial of the other
+func (n personName) compatible(other personName) bool {
+	if n.family != other.family {
+		return false
+	}
+	for i := 0; i < len(n.given) && i < len(other.given); i++ {
+		a, b := n.given[i], other.given[i]
+		if a == b {
+			continue
+		}
+		if (len(a) == 1 || len(b) == 1) && a[0] == b[0] {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// nameTokens splits a name into lower-case ASCII words, so "J.-P." becomes
+// ["j", "p"] and "Müller" becomes ["muller"]
+func nameTokens(name string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(name) {
+		if folded, ok := latinFold[r]; ok {
+			current.WriteString(folded)
+			continue
+		}
+		if r < unicode.MaxASCII && unicode.IsLetter(r) {
+			current.WriteRune(r)
+			continue
+		}
+		if r == '\'' || r == '’' {
+			continue
+		}
+		flush()
+	}
+	flush()
+	return tokens
+}
+
+// latinFold maps the accented letters common in author names to ASCII
+var latinFold = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ą': "a", 'æ': "ae",
+	'ç': "c", 'ć': "c", 'č': "c", 'ď': "d", 'đ': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ę': "e", 'ě': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i",
+	'ł': "l", 'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'œ': "oe",
+	'ř': "r", 'ś': "s", 'š': "s", 'ş': "s", 'ß': "ss", 'ť': "t",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// mergeByline rewrites an author list so source is replaced by target. When
+// both are listed, the source entry is dropped and its corresponding flag
+// moves to the target.
+func mergeByline(authors []ArticleAuthor, source string, target Author) []ArticleAuthor {
+	targetListed := false
+	sourceCorresponding := false
+	for _, author := range authors {
+		if author.AuthorID == target.ID {
+			targetListed = true
+		}
+		if author.AuthorID == source && author.Corresponding {
+			sourceCorresponding = true
+		}
+	}
+
+	merged := make([]ArticleAuthor, 0, len(authors))
+	for _, author := range authors {
+		switch {
+		case author.AuthorID == source && targetListed:
+			continue
+		case author.AuthorID == source:
+			author.AuthorID = target.ID
+			author.ORCID = target.ORCID
+		case author.AuthorID == target.ID && sourceCorresponding:
+			author.Corresponding = true
+		}
+		merged = append(merged, author)
+	}
+	return merged
+}
+
+// DisambiguationService finds duplicate authors and merges them
+type DisambiguationService struct {
+	authors  AuthorRepository
+	articles *ArticleService
+}
+
+func NewDisambiguationService(authors AuthorRepository, articles *ArticleService) *DisambiguationService {
+	return &DisambiguationService{authors: authors, articles: articles}
+}
+
+// WithActor returns a copy of the service that records merges under the
+// given actor
+func (s *DisambiguationService) WithActor(actor string) *DisambiguationService {
+	scoped := *s
+	scoped.articles = s.articles.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that records merges under the
+// given caller
+func (s *DisambiguationService) WithCaller(caller eventing.Caller) *DisambiguationService {
+	scoped := *s
+	scoped.articles = s.articles.WithCaller(caller)
+	return &scoped
+}
+
+// FindDuplicates proposes duplicate author clusters. minScore <= 0 uses
+// DefaultDuplicateScore.
+func (s *DisambiguationService) FindDuplicates(minScore float64) ([]AuthorCluster, error) {
+	if minScore <= 0 {
+		minScore = DefaultDuplicateScore
+	}
+
+	authors, err := s.authors.ListAuthors()
+	if err != nil {
+		return nil, err
+	}
+
+	coAuthors := make(map[string][]string, len(authors))
+	for _, author := range authors {
+		articles, err := s.articles.ListArticlesByAuthor(author.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, article := range articles {
+			for _, id := range article.AuthorIDs() {
+				if id != author.ID {
+					coAuthors[author.ID] = append(coAuthors[author.ID], id)
+				}
+			}
+		}
+	}
+
+	return FindDuplicateAuthors(authors, coAuthors, minScore), nil
+}
+
+// MergeAuthors folds source into target: every article that lists source
+// lists target instead, target takes over source's ORCID iD and affiliation
+// where it has none, and source is removed. The merge is recorded and can be
+// undone with UndoMerge.
+func (s *DisambiguationService) MergeAuthors(sourceID, targetID string) (AuthorMerge, error) {
+	if sourceID == targetID {
+		return AuthorMerge{}, fmt.Errorf("%w: cannot merge author %s into itself", ErrInvalidMerge, sourceID)
+	}
+
+	source, err := s.authors.GetAuthor(sourceID)
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	targetBefore, err := s.authors.GetAuthor(targetID)
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	if source.ORCID != "" && targetBefore.ORCID != "" && source.ORCID != targetBefore.ORCID {
+		return AuthorMerge{}, fmt.Errorf("%w: authors %s and %s have different ORCID iDs", ErrInvalidMerge, sourceID, targetID)
+	}
+
+	target := targetBefore
+	if target.ORCID == "" {
+		target.ORCID = source.ORCID
+	}
+	if target.Affiliation == "" {
+		target.Affiliation = source.Affiliation
+	}
+	if target.Email == "" {
+		target.Email = source.Email
+	}
+
+	articles, err := s.articles.ListArticlesByAuthor(sourceID)
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	changes := make([]AuthorListChange, 0, len(articles))
+	for _, article := range articles {
+		changes = append(changes, AuthorListChange{
+			ArticleID: article.ID,
+			Before:    article.Authors,
+			After:     mergeByline(article.Authors, sourceID, target),
+		})
+	}
+
+	now := time.Now().UTC()
+	target.UpdatedAt = now
+	merge := AuthorMerge{
+		ID:           NewID(),
+		Source:       source,
+		TargetBefore: targetBefore,
+		Target:       target,
+		Changes:      changes,
+		Actor:        s.articles.caller.Actor,
+		MergedAt:     now,
+	}
+
+	audit, err := s.articles.auditEvent(AuditMergeAuthors, target.ID, []Author{source, targetBefore}, merge)
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	if err := s.articles.rewriteAuthorLists(changes, audit); err != nil {
+		return AuthorMerge{}, err
+	}
+	if err := s.authors.MergeAuthor(merge); err != nil {
+		// Put the bylines back so articles never point at a missing author,
+		// and record that the merge was rolled back
+		undoErr := s.compensate(inverseChanges(changes), AuditUndoMergeAuthors, target.ID, merge, []Author{source, targetBefore})
+		if undoErr != nil {
+			return AuthorMerge{}, fmt.Errorf("%w (restoring author lists also failed: %v)", err, undoErr)
+		}
+		return AuthorMerge{}, err
+	}
+
+	return merge, nil
+}
+
+// UndoMerge restores both authors and every rewritten author list. It fails
+// with ErrAuthorListChanged if one of the articles was edited after the
+// merge.
+func (s *DisambiguationService) UndoMerge(mergeID string) (AuthorMerge, error) {
+	merge, err := s.authors.GetMerge(mergeID)
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	if merge.UndoneAt != nil {
+		return AuthorMerge{}, fmt.Errorf("%w: merge %s was already undone", ErrInvalidMerge, mergeID)
+	}
+	if _, err := s.authors.GetAuthor(merge.Source.ID); err == nil {
+		return AuthorMerge{}, fmt.Errorf("%w: author %s exists again", ErrInvalidMerge, merge.Source.ID)
+	} else if err != ErrAuthorNotFound {
+		return AuthorMerge{}, err
+	}
+
+	audit, err := s.articles.auditEvent(AuditUndoMergeAuthors, merge.Target.ID, merge.Target, []Author{merge.Source, merge.TargetBefore})
+	if err != nil {
+		return AuthorMerge{}, err
+	}
+	if err := s.articles.rewriteAuthorLists(inverseChanges(merge.Changes), audit); err != nil {
+		return AuthorMerge{}, err
+	}
+
+	now := time.Now().UTC()
+	merge.UndoneAt = &now
+	if err := s.authors.UndoMerge(merge); err != nil {
+		redoErr := s.compensate(merge.Changes, AuditMergeAuthors, merge.Target.ID, []Author{merge.Source, merge.TargetBefore}, merge.Target)
+		if redoErr != nil {
+			return AuthorMerge{}, fmt.Errorf("%w (reapplying author lists also failed: %v)", err, redoErr)
+		}
+		return AuthorMerge{}, err
+	}
+
+	return merge, nil
+}
+
+// compensate reverts author list changes after the author repository
+// rejected a merge or undo, with an audit record of the reversal so the log
+// still matches the stored author lists
+func (s *DisambiguationService) compensate(changes []AuthorListChange, operation AuditOperation, entityID string, before, after any) error {
+	audit, err := s.articles.auditEvent(operation, entityID, before, after)
+	if err != nil {
+		return err
+	}
+	return s.articles.rewriteAuthorLists(changes, audit)
+}
+
+func (s *DisambiguationService) GetMerge(id string) (AuthorMerge, error) {
+	return s.authors.GetMerge(id)
+}
+
+func (s *DisambiguationService) ListMerges() ([]AuthorMerge, error) {
+	return s.authors.ListMerges()
+}
+
+func inverseChanges(changes []AuthorListChange) []AuthorListChange {
+	inverse := make([]AuthorListChange, len(changes))
+	for i, change := range changes {
+		inverse[i] = change.Inverse()
+	}
+	return inverse
+}
+
+// SameAuthorList reports whether two author lists are identical, including
+// order. Adapters use it to check AuthorListChange.Before.
+func SameAuthorList(a, b []ArticleAuthor) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
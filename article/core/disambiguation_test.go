@@ -0,0 +1,187 @@
+package core_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func clusterIDs(clusters []core.AuthorCluster) [][]string {
+	ids := make([][]string, len(clusters))
+	for i, cluster := range clusters {
+		for _, author := range cluster.Authors {
+			ids[i] = append(ids[i], author.ID)
+		}
+	}
+	return ids
+}
+
+func TestFindDuplicateAuthors(t *testing.T) {
+	authors := []core.Author{
+		{ID: "a1", Name: "John Smith", ORCID: "0000-0002-1825-0097", Affiliation: "Brown University"},
+		{ID: "a2", Name: "Smith, John", Affiliation: "brown university"},
+		{ID: "a3", Name: "Jane Smith"},
+		{ID: "a4", Name: "Müller, Anna", ORCID: "0000-0002-1825-0097"},
+		{ID: "a5", Name: "Anna Muller", ORCID: "0000-0002-1694-233X"},
+		{ID: "a6", Name: "José García"},
+		{ID: "a7", Name: "Jose Garcia"},
+	}
+
+	clusters := core.FindDuplicateAuthors(authors, nil, core.DefaultDuplicateScore)
+
+	want := [][]string{{"a1", "a2"}, {"a6", "a7"}}
+	if got := clusterIDs(clusters); !reflect.DeepEqual(got, want) {
+		t.Fatalf("clusters = %v, want %v", got, want)
+	}
+	if clusters[0].Score < 0.8-1e-9 || clusters[0].Score > 0.8+1e-9 {
+		t.Errorf("score = %v, want 0.8", clusters[0].Score)
+	}
+	wantEvidence := []string{core.EvidenceName, core.EvidenceSameAffiliation, core.EvidenceSameGivenName}
+	if !reflect.DeepEqual(clusters[0].Evidence, wantEvidence) {
+		t.Errorf("evidence = %v, want %v", clusters[0].Evidence, wantEvidence)
+	}
+
+	if got := core.FindDuplicateAuthors(authors, nil, 0.7); len(got) != 1 {
+		t.Errorf("clusters above 0.7 = %v, want only a1 and a2", clusterIDs(got))
+	}
+}
+
+func TestFindDuplicateAuthorsWeighsCoAuthors(t *testing.T) {
+	authors := []core.Author{
+		{ID: "a1", Name: "A. Turing"},
+		{ID: "a2", Name: "Alan Turing"},
+	}
+	coAuthors := map[string][]string{
+		"a1": {"c1", "c2", "a2"},
+		"a2": {"c1", "c2", "a1"},
+	}
+
+	clusters := core.FindDuplicateAuthors(authors, coAuthors, core.DefaultDuplicateScore)
+	if len(clusters) != 1 {
+		t.Fatalf("clusters = %v", clusterIDs(clusters))
+	}
+	if clusters[0].Score < 0.7-1e-9 || clusters[0].Score > 0.7+1e-9 {
+		t.Errorf("score = %v, want 0.7", clusters[0].Score)
+	}
+	wantEvidence := []string{core.EvidenceName, core.EvidenceSharedCoAuthors}
+	if !reflect.DeepEqual(clusters[0].Evidence, wantEvidence) {
+		t.Errorf("evidence = %v, want %v", clusters[0].Evidence, wantEvidence)
+	}
+}
+
+func (f fixture) authorList(t *testing.T, articleID string) []core.ArticleAuthor {
+	t.Helper()
+	article, err := f.service.GetArticleByID(articleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return article.Authors
+}
+
+func TestMergeAuthorsAndUndo(t *testing.T) {
+	f := newFixture(t).withAuthorDuplicates(t)
+	before := map[string][]core.ArticleAuthor{"a1": f.authorList(t, "a1"), "a2": f.authorList(t, "a2")}
+
+	clusters, err := f.merges.FindDuplicates(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := clusterIDs(clusters); !reflect.DeepEqual(got, [][]string{{"author_2", "author_3"}}) {
+		t.Errorf("FindDuplicates() = %v", got)
+	}
+
+	merge, err := f.merges.MergeAuthors("author_3", "author_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merge.Target.ORCID != "0000-0002-1694-233X" || merge.Target.Affiliation != "University of London" {
+		t.Errorf("merged target = %+v", merge.Target)
+	}
+	if _, err := f.authors.GetAuthor("author_3"); !errors.Is(err, core.ErrAuthorNotFound) {
+		t.Errorf("GetAuthor(author_3) after merge = %v, want ErrAuthorNotFound", err)
+	}
+
+	wantSolo := []core.ArticleAuthor{{AuthorID: "author_2", ORCID: "0000-0002-1694-233X", Affiliation: "University of London", Corresponding: true}}
+	if got := f.authorList(t, "a1"); !core.SameAuthorList(got, wantSolo) {
+		t.Errorf("a1 authors = %+v, want %+v", got, wantSolo)
+	}
+	// author_2 was already listed, so the duplicate entry is dropped and
+	// its corresponding flag moves over
+	wantBoth := []core.ArticleAuthor{{AuthorID: "author_2", Corresponding: true}, {AuthorID: "author_1", ORCID: "0000-0002-1825-0097"}}
+	if got := f.authorList(t, "a2"); !core.SameAuthorList(got, wantBoth) {
+		t.Errorf("a2 authors = %+v, want %+v", got, wantBoth)
+	}
+
+	if _, err := f.merges.UndoMerge(merge.ID); err != nil {
+		t.Fatal(err)
+	}
+	for id, authors := range before {
+		if got := f.authorList(t, id); !core.SameAuthorList(got, authors) {
+			t.Errorf("%s authors after undo = %+v, want %+v", id, got, authors)
+		}
+	}
+	restored, err := f.authors.GetAuthor("author_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.ORCID != "" {
+		t.Errorf("author_2 ORCID after undo = %q, want none", restored.ORCID)
+	}
+	if _, err := f.authors.GetAuthor("author_3"); err != nil {
+		t.Errorf("GetAuthor(author_3) after undo = %v", err)
+	}
+
+	if _, err := f.merges.UndoMerge(merge.ID); !errors.Is(err, core.ErrInvalidMerge) {
+		t.Errorf("second UndoMerge() = %v, want ErrInvalidMerge", err)
+	}
+}
+
+func TestMergeAuthorsRejectsConflicts(t *testing.T) {
+	f := newFixture(t).withAuthorDuplicates(t)
+
+	if _, err := f.merges.MergeAuthors("author_1", "author_1"); !errors.Is(err, core.ErrInvalidMerge) {
+		t.Errorf("MergeAuthors() into itself = %v, want ErrInvalidMerge", err)
+	}
+	if _, err := f.merges.MergeAuthors("author_3", "author_1"); !errors.Is(err, core.ErrInvalidMerge) {
+		t.Errorf("MergeAuthors() with different ORCID iDs = %v, want ErrInvalidMerge", err)
+	}
+	if _, err := f.merges.MergeAuthors("author_9", "author_1"); !errors.Is(err, core.ErrAuthorNotFound) {
+		t.Errorf("MergeAuthors() of an unknown author = %v, want ErrAuthorNotFound", err)
+	}
+	if merged, err := f.merges.ListMerges(); err != nil || len(merged) != 0 {
+		t.Errorf("ListMerges() = %v, %v, want none", merged, err)
+	}
+	if got := f.authorList(t, "a1"); got[0].AuthorID != "author_3" {
+		t.Errorf("a1 authors = %+v", got)
+	}
+}
+
+func TestUndoMergeRefusesEditedArticles(t *testing.T) {
+	f := newFixture(t).withAuthorDuplicates(t)
+	merge, err := f.merges.MergeAuthors("author_3", "author_2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edited, err := f.service.GetArticleByID("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited.Authors = append(edited.Authors, core.ArticleAuthor{AuthorID: "author_1"})
+	if _, err := f.service.UpdateArticle(edited); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.merges.UndoMerge(merge.ID); !errors.Is(err, core.ErrAuthorListChanged) {
+		t.Fatalf("UndoMerge() = %v, want ErrAuthorListChanged", err)
+	}
+	// Nothing was restored
+	if _, err := f.authors.GetAuthor("author_3"); !errors.Is(err, core.ErrAuthorNotFound) {
+		t.Errorf("GetAuthor(author_3) = %v, want ErrAuthorNotFound", err)
+	}
+	if got := f.authorList(t, "a2"); got[0].AuthorID != "author_2" || len(got) != 2 {
+		t.Errorf("a2 authors = %+v", got)
+	}
+}
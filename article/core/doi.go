@@ -0,0 +1,407 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// doiPrefixes are the resolver and scheme prefixes that NormalizeDOI strips
+var doiPrefixes = []string{
+	"https://doi.org/",
+	"http://doi.org/",
+	"https://dx.doi.org/",
+	"http://dx.doi.org/",
+	"doi:",
+}
+
+// NormalizeDOI brings a DOI into canonical form: without resolver URL or
+// "doi:" prefix and in lower case, since DOIs are case-insensitive
+func NormalizeDOI(doi string) string {
+	doi = strings.TrimSpace(doi)
+	lower := strings.ToLower(doi)
+	for _, prefix := range doiPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			lower = lower[len(prefix):]
+			break
+		}
+	}
+	return lower
+}
+
+// ValidateDOI checks that a normalized DOI has the form 10.<registrant>/<suffix>
+// with a registrant code of four to nine digits
+func ValidateDOI(doi string) error {
+	prefix, suffix, ok := strings.Cut(doi, "/")
+	if !ok || !strings.HasPrefix(prefix, "10.") || suffix == "" {
+		return fmt.Errorf("DOI %q must have the form 10.1234/suffix", doi)
+	}
+
+	registrant := prefix[len("10."):]
+	if len(registrant) < 4 || len(registrant) > 9 {
+		return fmt.Errorf("DOI %q has an invalid registrant code", doi)
+	}
+	for _, c := range registrant {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("DOI %q has an invalid registrant code", doi)
+		}
+	}
+
+	if strings.ContainsAny(suffix, " \t\r\n") {
+		return fmt.Errorf("DOI %q contains whitespace", doi)
+	}
+	return nil
+}
+
+// DefaultDOISuffixPattern names DOIs after the journal, the publication
+// year and the article, e.g. 10.5555/journal_1.2026.42
+const DefaultDOISuffixPattern = "{journal}.{year}.{article}"
+
+// DOIConfig describes how the service mints DOIs and who deposits them
+type DOIConfig struct {
+	// Prefix is the registrant prefix assigned by the agency, e.g. 10.5555
+	Prefix string
+	// SuffixPattern builds the suffix from the placeholders {journal},
+	// {year} and {article}
+	SuffixPattern string
+	// ResourceURLPattern is the landing page DOIs resolve to; {article} is
+	// replaced by the article ID
+	ResourceURLPattern string
+	DepositorName      string
+	DepositorEmail     string
+	Registrant         string
+}
+
+// Validate checks if the configuration can mint DOIs
+func (c DOIConfig) Validate() error {
+	if err := ValidateDOI(c.Prefix + "/x"); err != nil {
+		return fmt.Errorf("invalid DOI prefix %q: %v", c.Prefix, err)
+	}
+	if !strings.Contains(c.SuffixPattern, "{article}") {
+		return fmt.Errorf("DOI suffix pattern %q must contain {article}", c.SuffixPattern)
+	}
+	if !strings.Contains(c.ResourceURLPattern, "{article}") {
+		return fmt.Errorf("resource URL pattern %q must contain {article}", c.ResourceURLPattern)
+	}
+	return nil
+}
+
+// MintDOI derives the DOI of a published article from the suffix pattern.
+// Characters Crossref does not allow in suffixes become hyphens.
+func (c DOIConfig) MintDOI(article Article) string {
+	year := ""
+	if article.PublishedAt != nil {
+		year = fmt.Sprint(article.PublishedAt.Year())
+	}
+	suffix := strings.NewReplacer(
+		"{journal}", doiSuffixSafe(article.JournalID),
+		"{year}", year,
+		"{article}", doiSuffixSafe(article.ID),
+	).Replace(c.SuffixPattern)
+	return NormalizeDOI(c.Prefix + "/" + suffix)
+}
+
+// ResourceURL returns the landing page of an article
+func (c DOIConfig) ResourceURL(articleID string) string {
+	return strings.ReplaceAll(c.ResourceURLPattern, "{article}", url.PathEscape(articleID))
+}
+
+// doiSuffixSafe keeps the characters Crossref recommends for DOI suffixes
+func doiSuffixSafe(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("-._;()", r):
+			return r
+		}
+		return '-'
+	}, value)
+}
+
+// DepositStatus tracks a DOI deposit at the registration agency
+type DepositStatus string
+
+const (
+	// DepositSubmitted deposits wait for the agency to process them
+	DepositSubmitted DepositStatus = "submitted"
+	// DepositRegistered deposits were accepted and the DOI resolves
+	DepositRegistered DepositStatus = "registered"
+	// DepositFailed deposits were rejected or could not be submitted
+	DepositFailed DepositStatus = "failed"
+)
+
+// DOIDeposit is the latest deposit of an article's DOI
+type DOIDeposit struct {
+	Status      DepositStatus `json:"status"`
+	BatchID     string        `json:"batch_id"`
+	Message     string        `json:"message,omitempty"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// DepositResult is the agency's verdict on a deposit
+type DepositResult struct {
+	// Completed is false while the deposit is queued or processing
+	Completed bool
+	Success   bool
+	Message   string
+}
+
+// DOIService mints DOIs for published articles and registers them with a
+// registration agency
+type DOIService struct {
+	articles  *ArticleService
+	registrar DOIRegistrar
+	config    DOIConfig
+}
+
+func NewDOIService(articles *ArticleService, registrar DOIRegistrar, config DOIConfig) *DOIService {
+	return &DOIService{articles: articles, registrar: registrar, config: config}
+}
+
+// WithActor returns a copy of the service that attributes audit entries to
+// the given actor
+func (s *DOIService) WithActor(actor string) *DOIService {
+	scoped := *s
+	scoped.articles = s.articles.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that attributes audit entries to
+// the given caller
+func (s *DOIService) WithCaller(caller eventing.Caller) *DOIService {
+	scoped := *s
+	scoped.articles = s.articles.WithCaller(caller)
+	return &scoped
+}
+
+// RegisterDOI deposits the article's metadata and DOI, minting the DOI on
+// the first deposit. Registered articles can be deposited again to update
+// their metadata; the DOI stays the same.
+func (s *DOIService) RegisterDOI(articleID string) (Article, error) {
+	before, err := s.articles.repository.GetArticleByID(articleID)
+	if err != nil {
+		return Article{}, err
+	}
+	if before.Status != StatusPublished {
+		return Article{}, fmt.Errorf("%w: article %s is %s", ErrDOINotAllowed, before.ID, before.Status)
+	}
+	if before.Deposit != nil && before.Deposit.Status == DepositSubmitted {
+		return Article{}, fmt.Errorf("%w: deposit %s of article %s is still being processed",
+			ErrDOINotAllowed, before.Deposit.BatchID, before.ID)
+	}
+
+	doi, err := s.articleDOI(before)
+	if err != nil {
+		return Article{}, err
+	}
+	now := time.Now().UTC()
+	deposit := DOIDeposit{Status: DepositSubmitted, BatchID: NewID(), SubmittedAt: now, UpdatedAt: now}
+
+	data, err := s.depositXML(before, doi, deposit.BatchID, now)
+	if err != nil {
+		return Article{}, err
+	}
+	if err := s.registrar.Submit(deposit.BatchID, data); err != nil {
+		deposit.Status = DepositFailed
+		deposit.Message = err.Error()
+	}
+
+	return s.saveDeposit(before, doi, deposit)
+}
+
+// DepositXML returns the deposit RegisterDOI would submit for the article
+func (s *DOIService) DepositXML(articleID string) ([]byte, error) {
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.Status != StatusPublished {
+		return nil, fmt.Errorf("%w: article %s is %s", ErrDOINotAllowed, article.ID, article.Status)
+	}
+
+	doi, err := s.articleDOI(article)
+	if err != nil {
+		return nil, err
+	}
+	return s.depositXML(article, doi, "preview-"+article.ID, time.Now().UTC())
+}
+
+// GetArticleByDOI finds the article a DOI was minted for
+func (s *DOIService) GetArticleByDOI(doi string) (Article, error) {
+	doi = NormalizeDOI(doi)
+	if err := ValidateDOI(doi); err != nil {
+		return Article{}, fmt.Errorf("%w: %v", ErrInvalidDOI, err)
+	}
+	return s.articles.repository.GetArticleByDOI(doi)
+}
+
+// RefreshDeposit asks the agency for the outcome of the article's pending
+// deposit and records it. Other articles are returned unchanged.
+func (s *DOIService) RefreshDeposit(articleID string) (Article, error) {
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if err != nil {
+		return Article{}, err
+	}
+	if article.Deposit == nil || article.Deposit.Status != DepositSubmitted {
+		return article, nil
+	}
+
+	result, err := s.registrar.Result(article.Deposit.BatchID)
+	if err != nil {
+		return Article{}, fmt.Errorf("failed to get result of deposit %s: %w", article.Deposit.BatchID, err)
+	}
+	if !result.Completed {
+		return article, nil
+	}
+
+	deposit := *article.Deposit
+	deposit.Status = DepositRegistered
+	if !result.Success {
+		deposit.Status = DepositFailed
+	}
+	deposit.Message = result.Message
+	deposit.UpdatedAt = time.Now().UTC()
+	return s.saveDeposit(article, article.DOI, deposit)
+}
+
+// RefreshPendingDeposits refreshes up to limit submitted deposits and
+// returns how many of them were refreshed
+func (s *DOIService) RefreshPendingDeposits(limit int) (int, error) {
+	pending, err := s.articles.repository.ListArticlesByDepositStatus(DepositSubmitted, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, article := range pending {
+		if _, err := s.RefreshDeposit(article.ID); err != nil {
+			return i, err
+		}
+	}
+	return len(pending), nil
+}
+
+// articleDOI returns the article's DOI, minting one if it has none yet. A
+// freshly minted DOI must not belong to another article.
+func (s *DOIService) articleDOI(article Article) (string, error) {
+	if article.DOI != "" {
+		return article.DOI, nil
+	}
+
+	doi := s.config.MintDOI(article)
+	if err := ValidateDOI(doi); err != nil {
+		return "", fmt.Errorf("%w: minted %v", ErrInvalidDOI, err)
+	}
+	owner, err := s.articles.repository.GetArticleByDOI(doi)
+	if err == nil && owner.ID != article.ID {
+		return "", fmt.Errorf("%w: DOI %s already belongs to article %s", ErrInvalidDOI, doi, owner.ID)
+	}
+	if err != nil && err != ErrArticleNotFound {
+		return "", err
+	}
+	return doi, nil
+}
+
+// depositXML gathers the journal, authors and issue placement of the
+// article and renders its deposit
+func (s *DOIService) depositXML(article Article, doi, batchID string, now time.Time) ([]byte, error) {
+	journal, err := s.articles.journals.GetJournal(article.JournalID)
+	if err != nil {
+		if errors.Is(err, ErrJournalNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to look up journal %s: %w", article.JournalID, err)
+	}
+
+	input := CrossrefInput{
+		BatchID:   batchID,
+		Timestamp: now,
+		Config:    s.config,
+		Article:   article,
+		DOI:       doi,
+		Journal:   journal,
+	}
+	for _, byline := range article.Authors {
+		author, err := s.articles.authors.GetAuthor(byline.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		input.Contributors = append(input.Contributors, CrossrefContributor{
+			Name:        author.Name,
+			ORCID:       byline.ORCID,
+			Affiliation: byline.Affiliation,
+		})
+	}
+
+	placement, err := s.articles.repository.GetPlacement(article.ID)
+	switch {
+	case err == nil:
+		issue, err := s.articles.journals.GetIssue(placement.IssueID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up issue %s: %w", placement.IssueID, err)
+		}
+		input.Placement, input.Issue = &placement, &issue
+	case !errors.Is(err, ErrPlacementNotFound):
+		return nil, err
+	}
+
+	return BuildCrossrefDeposit(input)
+}
+
+// saveDeposit stores the DOI and deposit on the article and raises
+// EventArticleDOIRegistered once the agency accepted the deposit
+func (s *DOIService) saveDeposit(before Article, doi string, deposit DOIDeposit) (Article, error) {
+	after := before
+	after.DOI = doi
+	after.Deposit = &deposit
+
+	eventType := EventArticleDOIDeposited
+	if deposit.Status == DepositRegistered {
+		eventType = EventArticleDOIRegistered
+	}
+	event, err := NewEvent(eventType, after.ID, after)
+	if err != nil {
+		return Article{}, err
+	}
+	audit, err := s.articles.auditEvent(AuditDepositDOI, after.ID, before, after)
+	if err != nil {
+		return Article{}, err
+	}
+
+	return s.articles.repository.SaveDOIDeposit(after.ID, doi, deposit, event, audit)
+}
+
+// DOIStatusPoller periodically collects the outcome of submitted deposits
+type DOIStatusPoller struct {
+	dois      *DOIService
+	interval  time.Duration
+	batchSize int
+}
+
+func NewDOIStatusPoller(dois *DOIService, interval time.Duration) *DOIStatusPoller {
+	return &DOIStatusPoller{dois: dois, interval: interval, batchSize: 50}
+}
+
+// Run polls the registration agency until the context is cancelled
+func (p *DOIStatusPoller) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.dois.RefreshPendingDeposits(p.batchSize); err != nil {
+			log.Printf("DOI status poller: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
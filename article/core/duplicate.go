@@ -0,0 +1,327 @@
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+const (
+	// MinHashSize is the number of hash functions in a MinHash signature
+	MinHashSize = 128
+
+	// LSH splits signatures into bands of rows; articles sharing any band
+	// are compared. With 32 bands of 4 rows, pairs of about 0.42 similarity
+	// become candidates half of the time and pairs of 0.7 almost always.
+	lshBands = 32
+	lshRows  = MinHashSize / lshBands
+
+	// shingleSize is the number of consecutive words in a shingle
+	shingleSize = 3
+
+	// DefaultDuplicateThreshold is the similarity at which an article is
+	// flagged as a possible duplicate when the query sets none
+	DefaultDuplicateThreshold = 0.5
+
+	// DefaultDuplicateLimit is the number of candidates returned when the
+	// query sets no limit
+	DefaultDuplicateLimit = 10
+
+	// MaxDuplicateLimit bounds duplicate lookups
+	MaxDuplicateLimit = 100
+)
+
+// MinHashSignature estimates the shingle set of an article's title and
+// abstract: the share of equal positions in two signatures approximates the
+// Jaccard similarity of the sets
+type MinHashSignature []uint64
+
+// minHashSeeds turn one base hash into MinHashSize independent ones
+var minHashSeeds = func() []uint64 {
+	seeds := make([]uint64, MinHashSize)
+	for i := range seeds {
+		seeds[i] = splitMix64(uint64(i) + 1)
+	}
+	return seeds
+}()
+
+// NewMinHashSignature returns the signature of the article's title and
+// abstract, or nil when they have no words
+func NewMinHashSignature(title, abstract string) MinHashSignature {
+	shingles := articleShingles(title, abstract)
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	signature := make(MinHashSignature, MinHashSize)
+	for i := range signature {
+		signature[i] = ^uint64(0)
+	}
+	for _, shingle := range shingles {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		base := h.Sum64()
+		for i, seed := range minHashSeeds {
+			if value := splitMix64(base ^ seed); value < signature[i] {
+				signature[i] = value
+			}
+		}
+	}
+	return signature
+}
+
+// articleShingles returns the distinct runs of shingleSize words of the
+// normalized title and abstract. Shingles do not cross from the title into
+// the abstract; shorter texts are one shingle.
+func articleShingles(title, abstract string) []string {
+	seen := make(map[string]bool)
+	var shingles []string
+	for _, text := range []string{title, abstract} {
+		words := strings.Fields(NormalizeTitle(text))
+		for i := 0; i < len(words); i++ {
+			end := min(i+shingleSize, len(words))
+			shingle := strings.Join(words[i:end], " ")
+			if !seen[shingle] {
+				seen[shingle] = true
+				shingles = append(shingles, shingle)
+			}
+			if end == len(words) {
+				break
+			}
+		}
+	}
+	return shingles
+}
+
+// Similarity estimates the Jaccard similarity of the shingle sets two
+// signatures were made from
+func (s MinHashSignature) Similarity(other MinHashSignature) float64 {
+	if len(s) != MinHashSize || len(other) != MinHashSize {
+		return 0
+	}
+	equal := 0
+	for i := range s {
+		if s[i] == other[i] {
+			equal++
+		}
+	}
+	return float64(equal) / MinHashSize
+}
+
+// BandKeys returns the LSH bucket of each band of the signature. Buckets
+// are numbered by band, so equal rows in different bands do not collide.
+func (s MinHashSignature) BandKeys() []string {
+	if len(s) != MinHashSize {
+		return nil
+	}
+	keys := make([]string, 0, lshBands)
+	buf := make([]byte, 8)
+	for band := 0; band < lshBands; band++ {
+		h := fnv.New64a()
+		for _, value := range s[band*lshRows : (band+1)*lshRows] {
+			binary.BigEndian.PutUint64(buf, value)
+			h.Write(buf)
+		}
+		keys = append(keys, fmt.Sprintf("%02d:%016x", band, h.Sum64()))
+	}
+	return keys
+}
+
+// splitMix64 is the SplitMix64 finalizer, a fast hash of 64-bit values
+func splitMix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// IndexedSignature is an article's signature as stored in a DuplicateIndex
+type IndexedSignature struct {
+	ArticleID string
+	Signature MinHashSignature
+}
+
+// DuplicateQuery looks for articles similar to a stored article or to a
+// title and abstract that have not been submitted yet
+type DuplicateQuery struct {
+	ArticleID string
+	Title     string
+	Abstract  string
+	// Threshold is the similarity candidates need,
+	// DefaultDuplicateThreshold when zero
+	Threshold float64
+	Limit     int
+}
+
+// Validate checks if the query can be run
+func (q DuplicateQuery) Validate() error {
+	hasText := strings.TrimSpace(q.Title) != "" || strings.TrimSpace(q.Abstract) != ""
+	if (q.ArticleID == "") == !hasText {
+		return fmt.Errorf("%w: give either an article ID or a title and abstract", ErrInvalidDuplicateQuery)
+	}
+	if hasText && len(articleShingles(q.Title, q.Abstract)) == 0 {
+		return fmt.Errorf("%w: the title and abstract have no words", ErrInvalidDuplicateQuery)
+	}
+	if q.Threshold < 0 || q.Threshold > 1 {
+		return fmt.Errorf("%w: threshold must be between 0 and 1", ErrInvalidDuplicateQuery)
+	}
+	if q.Limit < 0 || q.Limit > MaxDuplicateLimit {
+		return fmt.Errorf("%w: limit cannot be negative or exceed %d", ErrInvalidDuplicateQuery, MaxDuplicateLimit)
+	}
+	return nil
+}
+
+// DuplicateCandidate is an article that may duplicate another
+type DuplicateCandidate struct {
+	Article Article
+	// Similarity is the estimated Jaccard similarity of the articles'
+	// title and abstract shingles
+	Similarity float64
+}
+
+// DuplicateService flags submissions that closely match stored articles,
+// whether they are resubmissions or plagiarised. The index is kept up to
+// date from article events and can be rebuilt from the repository.
+type DuplicateService struct {
+	index    DuplicateIndex
+	articles *ArticleService
+}
+
+func NewDuplicateService(index DuplicateIndex, articles *ArticleService) *DuplicateService {
+	return &DuplicateService{index: index, articles: articles}
+}
+
+// FindDuplicates returns the articles at least as similar to the query's
+// article or text as its threshold, most similar first, then by ID
+func (s *DuplicateService) FindDuplicates(query DuplicateQuery) ([]DuplicateCandidate, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	if query.Threshold == 0 {
+		query.Threshold = DefaultDuplicateThreshold
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultDuplicateLimit
+	}
+
+	if query.ArticleID != "" {
+		article, err := s.articles.repository.GetArticleByID(query.ArticleID)
+		if err != nil {
+			return nil, err
+		}
+		query.Title, query.Abstract = article.Title, article.Abstract
+	}
+	return s.candidates(query.ArticleID, NewMinHashSignature(query.Title, query.Abstract), query.Threshold, query.Limit)
+}
+
+// ScreenArticle flags the stored articles a newly created article may
+// duplicate, then adds it to the index so later submissions are compared
+// with it without waiting for its event
+func (s *DuplicateService) ScreenArticle(article Article) ([]DuplicateCandidate, error) {
+	signature := NewMinHashSignature(article.Title, article.Abstract)
+	candidates, err := s.candidates(article.ID, signature, DefaultDuplicateThreshold, DefaultDuplicateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if signature != nil {
+		if err := s.index.IndexSignature(article.ID, signature); err != nil {
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
+
+func (s *DuplicateService) candidates(articleID string, signature MinHashSignature, threshold float64, limit int) ([]DuplicateCandidate, error) {
+	if signature == nil {
+		return nil, nil
+	}
+	indexed, err := s.index.FindCandidates(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []DuplicateCandidate
+	for _, entry := range indexed {
+		if entry.ArticleID == articleID {
+			continue
+		}
+		similarity := signature.Similarity(entry.Signature)
+		if similarity < threshold {
+			continue
+		}
+		article, err := s.articles.repository.GetArticleByID(entry.ArticleID)
+		if errors.Is(err, ErrArticleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, DuplicateCandidate{Article: article, Similarity: similarity})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Similarity != candidates[j].Similarity {
+			return candidates[i].Similarity > candidates[j].Similarity
+		}
+		return candidates[i].Article.ID < candidates[j].Article.ID
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// HandleEvent refreshes the signature of an article whose text may have
+// changed. Refreshes read the article's current state, so duplicate or late
+// events are harmless.
+func (s *DuplicateService) HandleEvent(event Event) error {
+	switch event.Type {
+	case EventArticleCreated, EventArticleUpdated:
+		return s.RefreshArticle(event.AggregateID)
+	}
+	return nil
+}
+
+// RefreshArticle indexes the signature of the article's current state
+func (s *DuplicateService) RefreshArticle(articleID string) error {
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if errors.Is(err, ErrArticleNotFound) {
+		return s.index.RemoveSignature(articleID)
+	}
+	if err != nil {
+		return err
+	}
+	return s.indexArticle(article)
+}
+
+// Reindex indexes every stored article and returns how many there are. It
+// fills an empty index on startup; articles already indexed are replaced.
+func (s *DuplicateService) Reindex() (int, error) {
+	count, after := 0, ""
+	for {
+		articles, err := s.articles.repository.ListArticlesAfter(after, reindexBatchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, article := range articles {
+			if err := s.indexArticle(article); err != nil {
+				return count, err
+			}
+		}
+		count += len(articles)
+		if len(articles) < reindexBatchSize {
+			return count, nil
+		}
+		after = articles[len(articles)-1].ID
+	}
+}
+
+func (s *DuplicateService) indexArticle(article Article) error {
+	signature := NewMinHashSignature(article.Title, article.Abstract)
+	if signature == nil {
+		return s.index.RemoveSignature(article.ID)
+	}
+	return s.index.IndexSignature(article.ID, signature)
+}
@@ -0,0 +1,201 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// words returns count numbered words starting with prefix
+func words(prefix string, from, count int) []string {
+	var list []string
+	for i := from; i < from+count; i++ {
+		list = append(list, fmt.Sprintf("%s%d", prefix, i))
+	}
+	return list
+}
+
+func TestMinHashSignature(t *testing.T) {
+	abstract := strings.Join(words("w", 0, 60), " ")
+	signature := core.NewMinHashSignature("Graph Colouring", abstract)
+	if len(signature) != core.MinHashSize {
+		t.Fatalf("signature has %d values, want %d", len(signature), core.MinHashSize)
+	}
+	// Case and punctuation are normalized away before shingling
+	if same := core.NewMinHashSignature("graph colouring!", abstract); signature.Similarity(same) != 1 {
+		t.Errorf("Similarity() of the same text = %v, want 1", signature.Similarity(same))
+	}
+	if signature.Similarity(core.NewMinHashSignature("Planar Graphs", "w0 w1")) > 0.1 {
+		t.Errorf("Similarity() of unrelated texts = %v", signature.Similarity(core.NewMinHashSignature("Planar Graphs", "w0 w1")))
+	}
+
+	// The abstracts share 38 of their 78 distinct three-word shingles
+	shared := strings.Join(append(words("w", 0, 40), words("x", 40, 20)...), " ")
+	estimate := core.NewMinHashSignature("", abstract).Similarity(core.NewMinHashSignature("", shared))
+	if want := 38.0 / 78; math.Abs(estimate-want) > 0.15 {
+		t.Errorf("Similarity() = %.2f, want about %.2f", estimate, want)
+	}
+
+	if got := core.NewMinHashSignature(" ", "— !"); got != nil {
+		t.Errorf("NewMinHashSignature() without words = %v, want nil", got)
+	}
+	if got := core.NewMinHashSignature("Graphs", ""); len(got) != core.MinHashSize {
+		t.Errorf("NewMinHashSignature() of a single word has %d values", len(got))
+	}
+	if got := signature.Similarity(signature[:10]); got != 0 {
+		t.Errorf("Similarity() with a short signature = %v, want 0", got)
+	}
+}
+
+func TestMinHashBandKeys(t *testing.T) {
+	signature := core.NewMinHashSignature("Graph Colouring", "Every planar graph can be coloured with four colours.")
+	keys := signature.BandKeys()
+	if len(keys) != 32 {
+		t.Fatalf("BandKeys() = %d keys, want 32", len(keys))
+	}
+	seen := make(map[string]bool)
+	for band, key := range keys {
+		if !strings.HasPrefix(key, fmt.Sprintf("%02d:", band)) {
+			t.Errorf("key %q is not numbered by its band %d", key, band)
+		}
+		seen[key] = true
+	}
+	if len(seen) != len(keys) {
+		t.Errorf("BandKeys() repeats keys: %v", keys)
+	}
+
+	again := core.NewMinHashSignature("Graph Colouring", "Every planar graph can be coloured with four colours.").BandKeys()
+	if strings.Join(again, " ") != strings.Join(keys, " ") {
+		t.Errorf("BandKeys() differ for the same text")
+	}
+	if keys := core.MinHashSignature(nil).BandKeys(); keys != nil {
+		t.Errorf("BandKeys() of no signature = %v, want nil", keys)
+	}
+}
+
+func candidateIDs(candidates []core.DuplicateCandidate) string {
+	var ids []string
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.Article.ID)
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestFindDuplicates(t *testing.T) {
+	f := newFixture(t).withNearDuplicates(t)
+
+	candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if candidateIDs(candidates) != "a2" || candidates[0].Similarity < core.DefaultDuplicateThreshold || candidates[0].Similarity == 1 {
+		t.Errorf("FindDuplicates(a1) = %+v", candidates)
+	}
+	if candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a1", Threshold: 1}); err != nil || len(candidates) != 0 {
+		t.Errorf("FindDuplicates(a1) at threshold 1 = %v, %v", candidateIDs(candidates), err)
+	}
+
+	// Text that has not been submitted is compared the same way, and its
+	// exact copy comes first
+	candidates, err = f.duplicates.FindDuplicates(core.DuplicateQuery{Title: "Graph colouring", Abstract: strings.Join(words("w", 0, 60), " ")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if candidateIDs(candidates) != "a1 a2" || candidates[0].Similarity != 1 {
+		t.Errorf("FindDuplicates() of a1's text = %+v", candidates)
+	}
+	if candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{Title: "Graph colouring", Abstract: strings.Join(words("w", 0, 60), " "), Limit: 1}); err != nil || candidateIDs(candidates) != "a1" {
+		t.Errorf("FindDuplicates() with limit 1 = %v, %v", candidateIDs(candidates), err)
+	}
+	if _, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a9"}); !errors.Is(err, core.ErrArticleNotFound) {
+		t.Errorf("FindDuplicates() of an unknown article = %v, want ErrArticleNotFound", err)
+	}
+
+	// Creating an article screens it: the stored copy is flagged and the
+	// new article is indexed at once
+	copied := newArticle("a4", "Planar graphs")
+	copied.Abstract = strings.Join(words("y", 0, 60), " ")
+	if _, candidates, err := f.service.CreateArticleScreened(copied); err != nil || candidateIDs(candidates) != "a3" {
+		t.Errorf("CreateArticleScreened(a4) = %v, %v", candidateIDs(candidates), err)
+	}
+	if candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a3"}); err != nil || candidateIDs(candidates) != "a4" {
+		t.Errorf("FindDuplicates(a3) after creating a4 = %v, %v", candidateIDs(candidates), err)
+	}
+}
+
+func TestImportsAreScreenedForDuplicates(t *testing.T) {
+	f := newFixture(t).withNearDuplicates(t)
+
+	data := "title,authors,abstract\n" +
+		"Graph colouring,Josiah Carberry," + strings.Join(words("w", 0, 60), " ") + "\n" +
+		"Colouring Maps,Josiah Carberry," + strings.Join(words("z", 0, 60), " ") + "\n"
+	report, err := core.NewImportService(f.service, f.journals).Import([]byte(data), core.ImportOptions{Format: core.ImportCSV, JournalID: testJournalID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Succeeded != 2 {
+		t.Fatalf("Import() = %+v", report)
+	}
+	if got := strings.Join(report.Results[0].PossibleDuplicates, " "); got != "a1 a2" {
+		t.Errorf("possible duplicates of the copy of a1 = %q, want a1 a2", got)
+	}
+	if got := report.Results[1].PossibleDuplicates; len(got) != 0 {
+		t.Errorf("possible duplicates of a new text = %q, want none", got)
+	}
+
+	// Imported articles are indexed as they are screened
+	candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a1"})
+	if err != nil || candidateIDs(candidates) != report.Results[0].ArticleID+" a2" {
+		t.Errorf("FindDuplicates(a1) after the import = %v, %v", candidateIDs(candidates), err)
+	}
+}
+
+func TestDuplicatesFollowArticleEvents(t *testing.T) {
+	f := newFixture(t).withNearDuplicates(t)
+
+	rewritten := newArticle("a2", "Colouring Maps")
+	rewritten.Abstract = strings.Join(words("z", 0, 60), " ")
+	if _, err := f.service.UpdateArticle(rewritten); err != nil {
+		t.Fatal(err)
+	}
+	// Events are handled by article ID, so a repeated one changes nothing
+	for i := 0; i < 2; i++ {
+		if err := f.duplicates.HandleEvent(core.Event{Type: core.EventArticleUpdated, AggregateID: "a2"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{ArticleID: "a1"}); err != nil || len(candidates) != 0 {
+		t.Errorf("FindDuplicates(a1) after a2 was rewritten = %v, %v", candidateIDs(candidates), err)
+	}
+	candidates, err := f.duplicates.FindDuplicates(core.DuplicateQuery{Title: "Colouring maps", Abstract: rewritten.Abstract})
+	if err != nil || candidateIDs(candidates) != "a2" {
+		t.Errorf("FindDuplicates() of a2's new text = %v, %v", candidateIDs(candidates), err)
+	}
+
+	// An article that is gone leaves the index
+	if err := f.duplicates.RefreshArticle("a9"); err != nil {
+		t.Errorf("RefreshArticle() of an unknown article = %v", err)
+	}
+}
+
+func TestDuplicateQueryIsChecked(t *testing.T) {
+	f := newFixture(t).withNearDuplicates(t)
+
+	for _, query := range []core.DuplicateQuery{
+		{},
+		{ArticleID: "a1", Title: "Graph Colouring"},
+		{Title: " ", Abstract: "— !"},
+		{ArticleID: "a1", Threshold: -0.1},
+		{ArticleID: "a1", Threshold: 1.5},
+		{ArticleID: "a1", Limit: -1},
+		{ArticleID: "a1", Limit: core.MaxDuplicateLimit + 1},
+	} {
+		if _, err := f.duplicates.FindDuplicates(query); !errors.Is(err, core.ErrInvalidDuplicateQuery) {
+			t.Errorf("FindDuplicates(%+v) = %v, want ErrInvalidDuplicateQuery", query, err)
+		}
+	}
+}
@@ -6,6 +6,137 @@ var (
 	// ErrArticleNotFound is returned when an article is not found
 	ErrArticleNotFound = errors.New("article not found")
 
+	// ErrArticleExists is returned when creating an article with the ID of
+	// a stored one
+	ErrArticleExists = errors.New("article already exists")
+
 	// ErrInvalidArticle is returned when article data is invalid
 	ErrInvalidArticle = errors.New("invalid article data")
+
+	// ErrInvalidTransition is returned when the workflow does not allow a status change
+	ErrInvalidTransition = errors.New("invalid status transition")
+
+	// ErrTransitionBlocked is returned when a business rule blocks a status change
+	ErrTransitionBlocked = errors.New("status transition blocked")
+
+	// ErrJournalNotFound is returned when an article refers to an unknown journal
+	ErrJournalNotFound = errors.New("journal not found")
+
+	// ErrIssueNotFound is returned when a placement refers to an unknown issue
+	ErrIssueNotFound = errors.New("issue not found")
+
+	// ErrPlacementNotFound is returned when an article has not been placed in an issue
+	ErrPlacementNotFound = errors.New("article placement not found")
+
+	// ErrInvalidPlacement is returned when placement data is invalid or
+	// conflicts with other articles in the issue
+	ErrInvalidPlacement = errors.New("invalid article placement")
+
+	// ErrPlacementNotAllowed is returned when the article or issue is not in
+	// a state that allows placement
+	ErrPlacementNotAllowed = errors.New("article placement not allowed")
+
+	// ErrInvalidReference is returned when a reference list entry is invalid
+	// or cites an unknown article
+	ErrInvalidReference = errors.New("invalid article reference")
+
+	// ErrInvalidCitationQuery is returned when a citation query has an
+	// invalid DOI, direction or depth
+	ErrInvalidCitationQuery = errors.New("invalid citation query")
+
+	// ErrInvalidDOI is returned when a DOI is malformed or already taken
+	ErrInvalidDOI = errors.New("invalid DOI")
+
+	// ErrDOINotAllowed is returned when an article cannot be deposited in
+	// its current state
+	ErrDOINotAllowed = errors.New("DOI registration not allowed")
+
+	// ErrInvalidDeposit is returned when deposit XML violates the Crossref schema
+	ErrInvalidDeposit = errors.New("invalid Crossref deposit")
+
+	// ErrInvalidExportQuery is returned when an export has an unknown format
+	// or does not select articles in exactly one way
+	ErrInvalidExportQuery = errors.New("invalid export query")
+
+	// ErrInvalidImport is returned when an import has an unknown format or
+	// cannot be read, and reported for records that cannot be imported
+	ErrInvalidImport = errors.New("invalid import")
+
+	// ErrUnsupportedFeedFormat is returned for feeds in formats other than
+	// Atom and RSS
+	ErrUnsupportedFeedFormat = errors.New("unsupported feed format")
+
+	// ErrInvalidSearchQuery is returned when a search has no searchable
+	// words or invalid filters or paging
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+
+	// ErrInvalidTitleQuery is returned when a title lookup has no words or
+	// an invalid threshold or limit
+	ErrInvalidTitleQuery = errors.New("invalid title query")
+
+	// ErrDuplicateTitle is returned when an article would share its title
+	// with another article of a journal that requires unique titles
+	ErrDuplicateTitle = errors.New("duplicate article title")
+
+	// ErrInvalidDuplicateQuery is returned when a duplicate lookup gives
+	// neither or both of an article and text, or an invalid threshold or
+	// limit
+	ErrInvalidDuplicateQuery = errors.New("invalid duplicate query")
+
+	// ErrInvalidRecommendationQuery is returned when related articles are
+	// requested with an invalid limit
+	ErrInvalidRecommendationQuery = errors.New("invalid recommendation query")
+
+	// ErrSubjectTermNotFound is returned when a subject term is not found
+	ErrSubjectTermNotFound = errors.New("subject term not found")
+
+	// ErrInvalidSubjectTerm is returned when term data is invalid, its
+	// parent is unknown or would create a cycle, or its names are taken
+	ErrInvalidSubjectTerm = errors.New("invalid subject term")
+
+	// ErrSubjectTermInUse is returned when deleting a term that has
+	// subterms or is the subject of articles
+	ErrSubjectTermInUse = errors.New("subject term in use")
+
+	// ErrInvalidSubjectQuery is returned when a subject query has no term
+	// or invalid paging
+	ErrInvalidSubjectQuery = errors.New("invalid subject query")
+)
+
+var (
+	// ErrAuthorNotFound is returned when an author is not found
+	ErrAuthorNotFound = errors.New("author not found")
+
+	// ErrInvalidAuthor is returned when author data is invalid
+	ErrInvalidAuthor = errors.New("invalid author data")
+
+	// ErrMergeNotFound is returned when an author merge record is not found
+	ErrMergeNotFound = errors.New("author merge not found")
+
+	// ErrInvalidMerge is returned when two authors cannot be merged or a merge cannot be undone
+	ErrInvalidMerge = errors.New("invalid author merge")
+
+	// ErrAuthorListChanged is returned when an article's author list changed
+	// since it was read, e.g. when undoing a merge after the article was edited
+	ErrAuthorListChanged = errors.New("article author list changed")
+
+	// ErrAuthorMetricsNotFound is returned when no metrics are stored for an author
+	ErrAuthorMetricsNotFound = errors.New("author metrics not found")
+
+	// ErrInvalidMetricsQuery is returned when a leaderboard query is invalid
+	ErrInvalidMetricsQuery = errors.New("invalid author metrics query")
+)
+
+var (
+	// ErrReviewerNotFound is returned when a reviewer is not found
+	ErrReviewerNotFound = errors.New("reviewer not found")
+
+	// ErrAssignmentNotFound is returned when a review assignment is not found
+	ErrAssignmentNotFound = errors.New("review assignment not found")
+
+	// ErrInvalidReview is returned when reviewer, assignment or report data is invalid
+	ErrInvalidReview = errors.New("invalid review data")
+
+	// ErrReviewNotOpen is returned when an article is not in a state that accepts reviews
+	ErrReviewNotOpen = errors.New("article is not open for review")
 )
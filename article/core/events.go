@@ -0,0 +1,59 @@
+package core
+
+import "github.com/realBagher/hexaservice-go/eventing"
+
+// Event and EventType come from the eventing module, which the article and
+// journal services share
+type (
+	Event     = eventing.Event
+	EventType = eventing.EventType
+)
+
+const (
+	// EventArticleCreated is raised when a new article is stored
+	EventArticleCreated EventType = "article.created"
+
+	// EventArticleUpdated is raised when an existing article is changed
+	EventArticleUpdated EventType = "article.updated"
+
+	// EventArticleStatusChanged is raised for every workflow transition
+	EventArticleStatusChanged EventType = "article.status_changed"
+
+	// EventArticlePublished is raised when an article is published
+	EventArticlePublished EventType = "article.published"
+
+	// EventArticlePlaced is raised when an article is placed in an issue
+	EventArticlePlaced EventType = "article.placed"
+
+	// EventArticleReferencesUpdated is raised when an article's reference
+	// list is replaced
+	EventArticleReferencesUpdated EventType = "article.references_updated"
+
+	// EventArticleCitationsChanged is raised for each article that gained or
+	// lost a citation when another article's references were replaced
+	EventArticleCitationsChanged EventType = "article.citations_changed"
+
+	// EventArticleDOIDeposited is raised when an article's DOI deposit is
+	// submitted or fails
+	EventArticleDOIDeposited EventType = "article.doi_deposited"
+
+	// EventArticleDOIRegistered is raised when the registration agency
+	// accepted an article's DOI deposit
+	EventArticleDOIRegistered EventType = "article.doi_registered"
+)
+
+// EventTypes lists the events webhooks can subscribe to
+var EventTypes = []EventType{
+	EventArticleCreated, EventArticleUpdated, EventArticleStatusChanged, EventArticlePublished, EventArticlePlaced,
+	EventArticleReferencesUpdated, EventArticleCitationsChanged, EventArticleDOIDeposited, EventArticleDOIRegistered,
+}
+
+// NewEvent creates an event for the given aggregate with a JSON encoded payload
+func NewEvent(eventType EventType, aggregateID string, payload any) (Event, error) {
+	return eventing.NewEvent(eventType, aggregateID, payload)
+}
+
+// NewID returns a random 128-bit identifier encoded as hex
+func NewID() string {
+	return eventing.NewID()
+}
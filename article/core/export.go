@@ -0,0 +1,348 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxExportArticleIDs bounds the number of articles one export may name
+const MaxExportArticleIDs = 1000
+
+// ExportFormat is a bibliographic format articles can be exported in
+type ExportFormat string
+
+const (
+	ExportBibTeX  ExportFormat = "bibtex"
+	ExportRIS     ExportFormat = "ris"
+	ExportCSLJSON ExportFormat = "csl-json"
+	ExportJATS    ExportFormat = "jats"
+)
+
+// Valid reports whether the format is known
+func (f ExportFormat) Valid() bool {
+	switch f {
+	case ExportBibTeX, ExportRIS, ExportCSLJSON, ExportJATS:
+		return true
+	}
+	return false
+}
+
+// ContentType returns the media type of an exported entry
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportBibTeX:
+		return "application/x-bibtex"
+	case ExportRIS:
+		return "application/x-research-info-systems"
+	case ExportJATS:
+		return "application/jats+xml"
+	default:
+		return "application/vnd.citationstyles.csl+json"
+	}
+}
+
+// ExportQuery selects the articles of a bulk export: the listed articles,
+// the articles of a journal or the articles of an author, optionally only
+// those with a given status
+type ExportQuery struct {
+	ArticleIDs []string
+	JournalID  string
+	AuthorID   string
+	Status     ArticleStatus
+}
+
+// Validate checks that the query selects articles in exactly one way
+func (q ExportQuery) Validate() error {
+	selectors := 0
+	for _, set := range []bool{len(q.ArticleIDs) > 0, q.JournalID != "", q.AuthorID != ""} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return fmt.Errorf("%w: exactly one of article IDs, journal ID and author ID must be set", ErrInvalidExportQuery)
+	}
+
+	if len(q.ArticleIDs) > MaxExportArticleIDs {
+		return fmt.Errorf("%w: at most %d article IDs can be exported at once", ErrInvalidExportQuery, MaxExportArticleIDs)
+	}
+
+	if q.Status != "" && !q.Status.Valid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidExportQuery, q.Status)
+	}
+
+	return nil
+}
+
+// BibliographicRecord is an article joined with everything a citation of it
+// shows: its journal, the names of its authors and its issue placement
+type BibliographicRecord struct {
+	Article Article
+	Journal JournalInfo
+	// Authors are in byline order
+	Authors []Author
+	// Placement and Issue are nil for articles that are not in an issue yet
+	Placement *ArticlePlacement
+	Issue     *IssueInfo
+	// References are loaded only for formats that print them. References
+	// to articles of this service carry the cited article's DOI and, when
+	// they have no text, its title.
+	References []Reference
+}
+
+// ExportedArticle is one rendered entry of an export
+type ExportedArticle struct {
+	ArticleID string
+	// Key is the BibTeX citation key, also used as RIS and CSL-JSON ID.
+	// Keys are unique within one export.
+	Key         string
+	ContentType string
+	Content     []byte
+}
+
+// ExportService renders articles as citations in bibliographic formats
+type ExportService struct {
+	articles *ArticleService
+}
+
+func NewExportService(articles *ArticleService) *ExportService {
+	return &ExportService{articles: articles}
+}
+
+// ExportArticle renders one article
+func (s *ExportService) ExportArticle(articleID string, format ExportFormat) (ExportedArticle, error) {
+	if !format.Valid() {
+		return ExportedArticle{}, fmt.Errorf("%w: unknown format %q", ErrInvalidExportQuery, format)
+	}
+
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if err != nil {
+		return ExportedArticle{}, err
+	}
+	return newExportRun(s, format).render(article)
+}
+
+// Export renders the articles selected by the query and hands each entry to
+// emit as soon as it is rendered, stopping at the first error emit returns.
+// Listed articles are exported in the given order, others by ID.
+func (s *ExportService) Export(query ExportQuery, format ExportFormat, emit func(ExportedArticle) error) error {
+	if !format.Valid() {
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidExportQuery, format)
+	}
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	var articles []Article
+	var err error
+	switch {
+	case query.JournalID != "":
+		articles, err = s.articles.repository.ListArticlesByJournal(query.JournalID)
+	case query.AuthorID != "":
+		articles, err = s.articles.repository.ListArticlesByAuthor(query.AuthorID)
+	default:
+		for _, id := range query.ArticleIDs {
+			article, err := s.articles.repository.GetArticleByID(id)
+			if err != nil {
+				return err
+			}
+			articles = append(articles, article)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	run := newExportRun(s, format)
+	for _, article := range articles {
+		if query.Status != "" && article.Status != query.Status {
+			continue
+		}
+		entry, err := run.render(article)
+		if err != nil {
+			return err
+		}
+		if err := emit(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportRun renders the entries of one export. It caches journal and issue
+// lookups, which go to the journal service, and hands out unique keys.
+type exportRun struct {
+	service  *ExportService
+	format   ExportFormat
+	journals map[string]JournalInfo
+	issues   map[string]IssueInfo
+	keys     map[string]bool
+}
+
+func newExportRun(service *ExportService, format ExportFormat) *exportRun {
+	return &exportRun{
+		service:  service,
+		format:   format,
+		journals: make(map[string]JournalInfo),
+		issues:   make(map[string]IssueInfo),
+		keys:     make(map[string]bool),
+	}
+}
+
+func (r *exportRun) render(article Article) (ExportedArticle, error) {
+	record, err := r.record(article)
+	if err != nil {
+		return ExportedArticle{}, err
+	}
+
+	entry := ExportedArticle{ArticleID: article.ID, Key: r.uniqueKey(CitationKey(record)), ContentType: r.format.ContentType()}
+	switch r.format {
+	case ExportBibTeX:
+		entry.Content = RenderBibTeX(record, entry.Key)
+	case ExportRIS:
+		entry.Content = RenderRIS(record, entry.Key)
+	case ExportJATS:
+		entry.Content, err = RenderJATS(record)
+	default:
+		entry.Content, err = RenderCSLJSON(record, entry.Key)
+	}
+	return entry, err
+}
+
+// record joins the article with its journal, authors and placement
+func (r *exportRun) record(article Article) (BibliographicRecord, error) {
+	articles := r.service.articles
+	record := BibliographicRecord{Article: article}
+
+	journal, ok := r.journals[article.JournalID]
+	if !ok {
+		var err error
+		journal, err = articles.journals.GetJournal(article.JournalID)
+		if err != nil {
+			if errors.Is(err, ErrJournalNotFound) {
+				return BibliographicRecord{}, err
+			}
+			return BibliographicRecord{}, fmt.Errorf("failed to look up journal %s: %w", article.JournalID, err)
+		}
+		r.journals[article.JournalID] = journal
+	}
+	record.Journal = journal
+
+	for _, byline := range article.Authors {
+		author, err := articles.authors.GetAuthor(byline.AuthorID)
+		if err != nil {
+			return BibliographicRecord{}, err
+		}
+		record.Authors = append(record.Authors, author)
+	}
+
+	placement, err := articles.repository.GetPlacement(article.ID)
+	switch {
+	case err == nil:
+		issue, ok := r.issues[placement.IssueID]
+		if !ok {
+			issue, err = articles.journals.GetIssue(placement.IssueID)
+			if err != nil {
+				return BibliographicRecord{}, fmt.Errorf("failed to look up issue %s: %w", placement.IssueID, err)
+			}
+			r.issues[placement.IssueID] = issue
+		}
+		record.Placement, record.Issue = &placement, &issue
+	case !errors.Is(err, ErrPlacementNotFound):
+		return BibliographicRecord{}, err
+	}
+
+	if r.format == ExportJATS {
+		if record.References, err = r.references(article.ID); err != nil {
+			return BibliographicRecord{}, err
+		}
+	}
+
+	return record, nil
+}
+
+// references loads the article's reference list, filling in the DOI and
+// title of cited articles of this service
+func (r *exportRun) references(articleID string) ([]Reference, error) {
+	articles := r.service.articles
+	references, err := articles.repository.ListReferences(articleID)
+	if err != nil {
+		return nil, err
+	}
+	for i, reference := range references {
+		if !reference.Internal() {
+			continue
+		}
+		cited, err := articles.repository.GetArticleByID(reference.TargetArticleID)
+		if err != nil {
+			return nil, err
+		}
+		references[i].DOI = cited.DOI
+		if reference.Text == "" {
+			references[i].Text = cited.Title
+		}
+	}
+	return references, nil
+}
+
+// uniqueKey appends a, b, c, ... to keys already handed out in this export
+func (r *exportRun) uniqueKey(key string) string {
+	unique := key
+	for i := 0; r.keys[unique]; i++ {
+		unique = key + keySuffix(i)
+	}
+	r.keys[unique] = true
+	return unique
+}
+
+// keySuffix returns a, b, ..., z, aa, ab, ...
+func keySuffix(i int) string {
+	suffix := string(rune('a' + i%26))
+	if i >= 26 {
+		return keySuffix(i/26-1) + suffix
+	}
+	return suffix
+}
+
+// keyStopWords are skipped when picking the title word of a citation key
+var keyStopWords = map[string]bool{"a": true, "an": true, "the": true, "on": true, "of": true, "in": true, "and": true, "for": true}
+
+// CitationKey derives a BibTeX key from the first author's surname, the
+// publication year and the first significant title word, e.g.
+// carberry2026advanced. Only ASCII letters and digits are kept.
+func CitationKey(record BibliographicRecord) string {
+	surname := "anon"
+	if len(record.Authors) > 0 {
+		_, family := splitDisplayName(record.Authors[0].Name)
+		if family = keyPart(family); family != "" {
+			surname = family
+		}
+	}
+
+	year := ""
+	if record.Article.PublishedAt != nil {
+		year = fmt.Sprint(record.Article.PublishedAt.Year())
+	}
+
+	word := ""
+	for _, field := range strings.Fields(record.Article.Title) {
+		if part := keyPart(field); part != "" && !keyStopWords[part] {
+			word = part
+			break
+		}
+	}
+	return surname + year + word
+}
+
+func keyPart(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return -1
+	}, value)
+}
@@ -0,0 +1,273 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ISSN returns the journal's print ISSN, or its electronic ISSN for online
+// only journals
+func (r BibliographicRecord) ISSN() string {
+	if r.Journal.PrintISSN != "" {
+		return r.Journal.PrintISSN
+	}
+	return r.Journal.ElectronicISSN
+}
+
+// published reports whether the record cites a published article rather
+// than a manuscript
+func (r BibliographicRecord) published() bool {
+	return r.Article.Status == StatusPublished && r.Article.PublishedAt != nil
+}
+
+// collapseSpace joins the lines of a value, since none of the formats allow
+// line breaks inside a field
+func collapseSpace(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// latexEscaper escapes the characters LaTeX treats specially. Replacements
+// are applied in one pass, so the braces it inserts are not escaped again.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+)
+
+func latexEscape(value string) string {
+	return latexEscaper.Replace(collapseSpace(value))
+}
+
+// citationName splits an author's name into given names and surname.
+// Names with the word "and" in them, such as "Johnson and Johnson", are
+// returned whole as literal names: BibTeX would read them as two people and
+// inverting them gives "Johnson, Johnson and".
+func citationName(name string) (given, surname string, literal bool) {
+	for _, word := range strings.Fields(name) {
+		if strings.EqualFold(word, "and") {
+			return "", collapseSpace(name), true
+		}
+	}
+	given, surname = splitDisplayName(name)
+	return given, surname, false
+}
+
+// bibtexName writes an author for a BibTeX author list. Braces keep a
+// literal name or a name part with a comma, e.g. "Jr., John", from being
+// split by BibTeX.
+func bibtexName(name string) string {
+	given, surname, literal := citationName(name)
+	if literal {
+		return "{" + latexEscape(surname) + "}"
+	}
+
+	part := func(value string) string {
+		if strings.Contains(value, ",") {
+			return "{" + latexEscape(value) + "}"
+		}
+		return latexEscape(value)
+	}
+	if given == "" {
+		return part(surname)
+	}
+	return part(surname) + ", " + part(given)
+}
+
+// bibtexDOIEscaper escapes the characters that break a DOI field. DOIs are
+// printed verbatim with \url-like commands, so nothing else is escaped, but
+// a % would still start a LaTeX comment.
+var bibtexDOIEscaper = strings.NewReplacer("{", `\{`, "}", `\}`, "%", `\%`)
+
+var bibtexMonths = [...]string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+
+// RenderBibTeX renders the record as a BibTeX entry. Published articles are
+// @article entries; others are @unpublished manuscripts.
+func RenderBibTeX(record BibliographicRecord, key string) []byte {
+	var fields [][2]string
+	field := func(name, value string) {
+		if value != "" {
+			fields = append(fields, [2]string{name, "{" + value + "}"})
+		}
+	}
+
+	var authors []string
+	for _, author := range record.Authors {
+		authors = append(authors, bibtexName(author.Name))
+	}
+	field("author", strings.Join(authors, " and "))
+	field("title", latexEscape(record.Article.Title))
+
+	entryType := "unpublished"
+	if record.published() {
+		entryType = "article"
+		published := record.Article.PublishedAt.UTC()
+		field("journal", latexEscape(record.Journal.Name))
+		field("year", fmt.Sprint(published.Year()))
+		// Month macros are written without braces
+		fields = append(fields, [2]string{"month", bibtexMonths[published.Month()-1]})
+		if record.Issue != nil {
+			if record.Issue.Volume > 0 {
+				field("volume", fmt.Sprint(record.Issue.Volume))
+			}
+			field("number", fmt.Sprint(record.Issue.Number))
+		}
+		if record.Placement != nil && record.Placement.HasPages() {
+			pages := fmt.Sprint(record.Placement.FirstPage)
+			if record.Placement.LastPage > record.Placement.FirstPage {
+				pages += fmt.Sprintf("--%d", record.Placement.LastPage)
+			}
+			field("pages", pages)
+		}
+		field("issn", record.ISSN())
+	} else {
+		field("note", "Manuscript submitted to "+latexEscape(record.Journal.Name))
+	}
+	field("doi", bibtexDOIEscaper.Replace(record.Article.DOI))
+	field("abstract", latexEscape(record.Article.Abstract))
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "@%s{%s,\n", entryType, key)
+	for i, f := range fields {
+		separator := ","
+		if i == len(fields)-1 {
+			separator = ""
+		}
+		fmt.Fprintf(&out, "  %s = %s%s\n", f[0], f[1], separator)
+	}
+	out.WriteString("}\n")
+	return out.Bytes()
+}
+
+// RenderRIS renders the record as a RIS reference. Lines end in CRLF as the
+// format requires.
+func RenderRIS(record BibliographicRecord, key string) []byte {
+	var out bytes.Buffer
+	tag := func(name, value string) {
+		if value = collapseSpace(value); value != "" {
+			fmt.Fprintf(&out, "%s  - %s\r\n", name, value)
+		}
+	}
+
+	if record.published() {
+		tag("TY", "JOUR")
+	} else {
+		tag("TY", "UNPB")
+	}
+	tag("ID", key)
+	for _, author := range record.Authors {
+		given, surname, _ := citationName(author.Name)
+		if given != "" {
+			surname += ", " + given
+		}
+		tag("AU", surname)
+	}
+	tag("TI", record.Article.Title)
+	tag("T2", record.Journal.Name)
+	if record.published() {
+		published := record.Article.PublishedAt.UTC()
+		tag("PY", fmt.Sprint(published.Year()))
+		tag("DA", published.Format("2006/01/02"))
+		if record.Issue != nil {
+			if record.Issue.Volume > 0 {
+				tag("VL", fmt.Sprint(record.Issue.Volume))
+			}
+			tag("IS", fmt.Sprint(record.Issue.Number))
+		}
+		if record.Placement != nil && record.Placement.HasPages() {
+			tag("SP", fmt.Sprint(record.Placement.FirstPage))
+			if record.Placement.LastPage > 0 {
+				tag("EP", fmt.Sprint(record.Placement.LastPage))
+			}
+		}
+		tag("SN", record.ISSN())
+	}
+	tag("DO", record.Article.DOI)
+	tag("AB", record.Article.Abstract)
+	out.WriteString("ER  - \r\n")
+	return out.Bytes()
+}
+
+// cslItem is a CSL-JSON item as read by citeproc processors
+type cslItem struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	Author         []cslName `json:"author,omitempty"`
+	ContainerTitle string    `json:"container-title,omitempty"`
+	ISSN           string    `json:"ISSN,omitempty"`
+	Issued         *cslDate  `json:"issued,omitempty"`
+	Volume         string    `json:"volume,omitempty"`
+	Issue          string    `json:"issue,omitempty"`
+	Page           string    `json:"page,omitempty"`
+	DOI            string    `json:"DOI,omitempty"`
+	Abstract       string    `json:"abstract,omitempty"`
+}
+
+type cslName struct {
+	Family  string `json:"family,omitempty"`
+	Given   string `json:"given,omitempty"`
+	Literal string `json:"literal,omitempty"`
+}
+
+type cslDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+// RenderCSLJSON renders the record as one CSL-JSON item followed by a
+// newline. A bibliography is a JSON array of such items.
+func RenderCSLJSON(record BibliographicRecord, key string) ([]byte, error) {
+	item := cslItem{
+		ID:             key,
+		Type:           "manuscript",
+		Title:          collapseSpace(record.Article.Title),
+		ContainerTitle: record.Journal.Name,
+		DOI:            record.Article.DOI,
+		Abstract:       collapseSpace(record.Article.Abstract),
+	}
+	for _, author := range record.Authors {
+		given, surname, literal := citationName(author.Name)
+		if literal {
+			item.Author = append(item.Author, cslName{Literal: surname})
+			continue
+		}
+		item.Author = append(item.Author, cslName{Family: surname, Given: given})
+	}
+	if record.published() {
+		published := record.Article.PublishedAt.UTC()
+		item.Type = "article-journal"
+		item.ISSN = record.ISSN()
+		item.Issued = &cslDate{DateParts: [][]int{{published.Year(), int(published.Month()), published.Day()}}}
+		if record.Issue != nil {
+			if record.Issue.Volume > 0 {
+				item.Volume = fmt.Sprint(record.Issue.Volume)
+			}
+			item.Issue = fmt.Sprint(record.Issue.Number)
+		}
+		if record.Placement != nil && record.Placement.HasPages() {
+			item.Page = fmt.Sprint(record.Placement.FirstPage)
+			if record.Placement.LastPage > record.Placement.FirstPage {
+				item.Page += fmt.Sprintf("-%d", record.Placement.LastPage)
+			}
+		}
+	}
+
+	// Titles routinely contain <, > and &; JSON does not need them escaped
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(item); err != nil {
+		return nil, fmt.Errorf("failed to encode CSL-JSON item: %w", err)
+	}
+	return out.Bytes(), nil
+}
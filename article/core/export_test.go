@@ -0,0 +1,184 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func exportRecord() core.BibliographicRecord {
+	published := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	return core.BibliographicRecord{
+		Article: core.Article{
+			ID:          "a1",
+			Title:       "Graph Colouring: 100% of\n  Planar Graphs",
+			Abstract:    "Costs $5 & more.",
+			Status:      core.StatusPublished,
+			PublishedAt: &published,
+			DOI:         "10.5555/j1.2024.a1%7B",
+		},
+		Journal: core.JournalInfo{ID: "j1", Name: "Nature", PrintISSN: "0028-0836"},
+		Authors: []core.Author{
+			{ID: "author_1", Name: "Josiah Carberry"},
+			{ID: "author_2", Name: "Johnson and Johnson"},
+			{ID: "author_3", Name: "Smith, Jr., John"},
+			{ID: "author_4", Name: "Plato"},
+		},
+		Placement: &core.ArticlePlacement{ArticleID: "a1", IssueID: "i1", Sequence: 1, FirstPage: 5, LastPage: 9},
+		Issue:     &core.IssueInfo{ID: "i1", JournalID: "j1", Volume: 3, Number: 2},
+	}
+}
+
+func TestRenderBibTeX(t *testing.T) {
+	got := string(core.RenderBibTeX(exportRecord(), "carberry2024graph"))
+	want := `@article{carberry2024graph,
+  author = {Carberry, Josiah and {Johnson and Johnson} and Smith, {Jr., John} and Plato},
+  title = {Graph Colouring: 100\% of Planar Graphs},
+  journal = {Nature},
+  year = {2024},
+  month = mar,
+  volume = {3},
+  number = {2},
+  pages = {5--9},
+  issn = {0028-0836},
+  doi = {10.5555/j1.2024.a1\%7B},
+  abstract = {Costs \$5 \& more.}
+}
+`
+	if got != want {
+		t.Errorf("RenderBibTeX() =\n%s\nwant\n%s", got, want)
+	}
+
+	manuscript := exportRecord()
+	manuscript.Article.Status, manuscript.Article.PublishedAt, manuscript.Article.DOI = core.StatusSubmitted, nil, ""
+	got = string(core.RenderBibTeX(manuscript, "carberrygraph"))
+	if !strings.HasPrefix(got, "@unpublished{carberrygraph,\n") || !strings.Contains(got, "note = {Manuscript submitted to Nature}") ||
+		strings.Contains(got, "year =") || strings.Contains(got, "doi =") {
+		t.Errorf("RenderBibTeX() of a manuscript =\n%s", got)
+	}
+}
+
+func TestRenderRIS(t *testing.T) {
+	got := string(core.RenderRIS(exportRecord(), "carberry2024graph"))
+	want := strings.Join([]string{
+		"TY  - JOUR",
+		"ID  - carberry2024graph",
+		"AU  - Carberry, Josiah",
+		"AU  - Johnson and Johnson",
+		"AU  - Smith, Jr., John",
+		"AU  - Plato",
+		"TI  - Graph Colouring: 100% of Planar Graphs",
+		"T2  - Nature",
+		"PY  - 2024",
+		"DA  - 2024/03/05",
+		"VL  - 3",
+		"IS  - 2",
+		"SP  - 5",
+		"EP  - 9",
+		"SN  - 0028-0836",
+		"DO  - 10.5555/j1.2024.a1%7B",
+		"AB  - Costs $5 & more.",
+		"ER  - ",
+		"",
+	}, "\r\n")
+	if got != want {
+		t.Errorf("RenderRIS() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderCSLJSON(t *testing.T) {
+	data, err := core.RenderCSLJSON(exportRecord(), "carberry2024graph")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var item struct {
+		Type   string `json:"type"`
+		Title  string `json:"title"`
+		Page   string `json:"page"`
+		Author []struct {
+			Family  string `json:"family"`
+			Given   string `json:"given"`
+			Literal string `json:"literal"`
+		} `json:"author"`
+		Issued struct {
+			DateParts [][]int `json:"date-parts"`
+		} `json:"issued"`
+	}
+	if err := json.Unmarshal(data, &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Type != "article-journal" || item.Title != "Graph Colouring: 100% of Planar Graphs" || item.Page != "5-9" {
+		t.Errorf("item = %+v", item)
+	}
+	if len(item.Author) != 4 || item.Author[0].Family != "Carberry" || item.Author[1].Literal != "Johnson and Johnson" || item.Author[1].Family != "" {
+		t.Errorf("authors = %+v", item.Author)
+	}
+	if len(item.Issued.DateParts) != 1 || len(item.Issued.DateParts[0]) != 3 || item.Issued.DateParts[0][2] != 5 {
+		t.Errorf("issued = %+v", item.Issued)
+	}
+	if !strings.Contains(string(data), `"Costs $5 & more."`) {
+		t.Errorf("RenderCSLJSON() escaped HTML characters: %s", data)
+	}
+}
+
+func TestExportGivesUniqueKeys(t *testing.T) {
+	f := newFixture(t)
+	for _, id := range []string{"a1", "a2", "a3"} {
+		f.create(t, newArticle(id, "The Graph Colouring Problem"))
+	}
+	f.create(t, newArticle("other", "Planar Graphs"))
+	exports := core.NewExportService(f.service)
+
+	var keys []string
+	err := exports.Export(core.ExportQuery{ArticleIDs: []string{"a3", "a1", "a2"}}, core.ExportBibTeX, func(entry core.ExportedArticle) error {
+		keys = append(keys, entry.ArticleID+":"+entry.Key)
+		if entry.ContentType != "application/x-bibtex" {
+			t.Errorf("content type = %q", entry.ContentType)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a3:carberrygraph", "a1:carberrygrapha", "a2:carberrygraphb"}
+	if strings.Join(keys, " ") != strings.Join(want, " ") {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+
+	stop := errors.New("stop")
+	emitted := 0
+	err = exports.Export(core.ExportQuery{JournalID: testJournalID}, core.ExportRIS, func(core.ExportedArticle) error {
+		emitted++
+		return stop
+	})
+	if !errors.Is(err, stop) || emitted != 1 {
+		t.Errorf("Export() = %v after %d entries, want to stop after the first", err, emitted)
+	}
+}
+
+func TestExportQueryIsChecked(t *testing.T) {
+	exports := core.NewExportService(newFixture(t).service)
+	emit := func(core.ExportedArticle) error { return nil }
+
+	for _, query := range []core.ExportQuery{
+		{},
+		{JournalID: testJournalID, AuthorID: "author_1"},
+		{JournalID: testJournalID, Status: "lost"},
+		{ArticleIDs: make([]string, core.MaxExportArticleIDs+1)},
+	} {
+		if err := exports.Export(query, core.ExportBibTeX, emit); !errors.Is(err, core.ErrInvalidExportQuery) {
+			t.Errorf("Export(%+v) = %v, want ErrInvalidExportQuery", query, err)
+		}
+	}
+	if _, err := exports.ExportArticle("a1", "endnote"); !errors.Is(err, core.ErrInvalidExportQuery) {
+		t.Errorf("ExportArticle(endnote) = %v, want ErrInvalidExportQuery", err)
+	}
+	if _, err := exports.ExportArticle("a9", core.ExportRIS); !errors.Is(err, core.ErrArticleNotFound) {
+		t.Errorf("ExportArticle() of an unknown article = %v, want ErrArticleNotFound", err)
+	}
+}
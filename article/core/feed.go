@@ -0,0 +1,321 @@
+package core
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultFeedSize is the number of articles in a feed when the
+	// configuration sets none
+	DefaultFeedSize = 20
+
+	// MaxFeedSize bounds feeds, which are fetched often
+	MaxFeedSize = 100
+)
+
+// FeedFormat is a syndication format journal feeds are served in
+type FeedFormat string
+
+const (
+	FeedAtom FeedFormat = "atom"
+	FeedRSS  FeedFormat = "rss"
+)
+
+// ParseFeedFormat returns the feed format with the name
+func ParseFeedFormat(name string) (FeedFormat, error) {
+	switch format := FeedFormat(strings.ToLower(strings.TrimSpace(name))); format {
+	case FeedAtom, FeedRSS:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedFeedFormat, name)
+}
+
+// ContentType returns the media type of documents in the format
+func (f FeedFormat) ContentType() string {
+	if f == FeedRSS {
+		return "application/rss+xml; charset=utf-8"
+	}
+	return "application/atom+xml; charset=utf-8"
+}
+
+// FeedConfig describes where feeds and the articles they list are found
+type FeedConfig struct {
+	// FeedURLPattern is a feed's own address; {journal} is replaced by the
+	// journal ID and {format} by the feed format
+	FeedURLPattern string
+	// ArticleURLPattern is an article's landing page; {article} is replaced
+	// by the article ID
+	ArticleURLPattern string
+	Size              int
+}
+
+// Validate checks if the configuration can serve feeds
+func (c FeedConfig) Validate() error {
+	if !strings.Contains(c.FeedURLPattern, "{journal}") || !strings.Contains(c.FeedURLPattern, "{format}") {
+		return fmt.Errorf("feed URL pattern %q must contain {journal} and {format}", c.FeedURLPattern)
+	}
+	if !strings.Contains(c.ArticleURLPattern, "{article}") {
+		return fmt.Errorf("article URL pattern %q must contain {article}", c.ArticleURLPattern)
+	}
+	if c.Size < 0 || c.Size > MaxFeedSize {
+		return fmt.Errorf("feed size cannot be negative or exceed %d", MaxFeedSize)
+	}
+	return nil
+}
+
+// Feed lists a journal's latest published articles, newest first. Entries
+// are identified by the article's landing page, which unlike its DOI exists
+// from publication on.
+type Feed struct {
+	Journal JournalInfo
+	// URL is the feed's own address
+	URL string
+	// Updated is the publication time of the newest article. It is zero for
+	// journals that have published nothing yet.
+	Updated time.Time
+	Entries []FeedEntry
+}
+
+// FeedEntry is one article of a feed
+type FeedEntry struct {
+	Record BibliographicRecord
+	// Link is the article's landing page
+	Link string
+}
+
+// FeedService builds the feeds readers subscribe to for new articles in a
+// journal
+type FeedService struct {
+	articles *ArticleService
+	exports  *ExportService
+	config   FeedConfig
+}
+
+func NewFeedService(articles *ArticleService, config FeedConfig) *FeedService {
+	if config.Size == 0 {
+		config.Size = DefaultFeedSize
+	}
+	return &FeedService{articles: articles, exports: NewExportService(articles), config: config}
+}
+
+// JournalFeed returns the feed of the journal's latest published articles.
+// It returns ErrJournalNotFound for journals the journal service does not
+// know.
+func (s *FeedService) JournalFeed(journalID string, format FeedFormat) (Feed, error) {
+	journal, err := s.articles.journals.GetJournal(journalID)
+	if err != nil {
+		return Feed{}, err
+	}
+	articles, err := s.articles.repository.ListRecentlyPublishedArticles(journalID, s.config.Size)
+	if err != nil {
+		return Feed{}, err
+	}
+
+	feed := Feed{Journal: journal, URL: s.feedURL(journalID, format)}
+	run := newExportRun(s.exports, "")
+	// The journal was just looked up; entries reuse it
+	run.journals[journalID] = journal
+	for _, article := range articles {
+		record, err := run.record(article)
+		if err != nil {
+			return Feed{}, err
+		}
+		link := strings.ReplaceAll(s.config.ArticleURLPattern, "{article}", url.PathEscape(article.ID))
+		feed.Entries = append(feed.Entries, FeedEntry{Record: record, Link: link})
+		if published := article.PublishedAt; published != nil && published.After(feed.Updated) {
+			feed.Updated = published.UTC()
+		}
+	}
+	return feed, nil
+}
+
+// LastModified returns when an entry of the feed last changed. Editing a
+// published article moves it past Updated. It is zero for feeds without
+// entries.
+func (f Feed) LastModified() time.Time {
+	var modified time.Time
+	for _, entry := range f.Entries {
+		if datestamp := entry.Record.Article.Datestamp(); datestamp.After(modified) {
+			modified = datestamp
+		}
+	}
+	return modified
+}
+
+func (s *FeedService) feedURL(journalID string, format FeedFormat) string {
+	return strings.NewReplacer("{journal}", url.PathEscape(journalID), "{format}", string(format)).Replace(s.config.FeedURLPattern)
+}
+
+// RenderFeed writes the feed in the format
+func RenderFeed(feed Feed, format FeedFormat) ([]byte, error) {
+	var document any
+	if format == FeedRSS {
+		document = newRSSFeed(feed)
+	} else {
+		document = newAtomFeed(feed)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("failed to render %s feed: %w", format, err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// Atom (RFC 4287) documents
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string       `xml:"id"`
+	Title     string       `xml:"title"`
+	Updated   string       `xml:"updated"`
+	Published string       `xml:"published"`
+	Authors   []atomPerson `xml:"author"`
+	Links     []atomLink   `xml:"link"`
+	Summary   string       `xml:"summary,omitempty"`
+}
+
+func newAtomFeed(feed Feed) atomFeed {
+	updated := feed.Updated
+	if updated.IsZero() {
+		// Atom requires a date; an empty feed has not changed since the
+		// epoch as far as its entries are concerned
+		updated = time.Unix(0, 0).UTC()
+	}
+	document := atomFeed{
+		ID:      feed.URL,
+		Title:   feed.Journal.Name,
+		Updated: updated.Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Type: FeedAtom.mediaType(), Href: feed.URL}},
+	}
+	for _, entry := range feed.Entries {
+		article := entry.Record.Article
+		published := article.PublishedAt.UTC().Format(time.RFC3339)
+		converted := atomEntry{
+			ID:        entry.Link,
+			Title:     collapseSpace(article.Title),
+			Updated:   published,
+			Published: published,
+			Links:     []atomLink{{Rel: "alternate", Type: "text/html", Href: entry.Link}},
+			Summary:   strings.TrimSpace(article.Abstract),
+		}
+		if article.DOI != "" {
+			converted.Links = append(converted.Links, atomLink{Rel: "related", Href: "https://doi.org/" + article.DOI})
+		}
+		for _, author := range entry.Record.Authors {
+			person := atomPerson{Name: author.Name}
+			if author.ORCID != "" {
+				person.URI = "https://orcid.org/" + author.ORCID
+			}
+			converted.Authors = append(converted.Authors, person)
+		}
+		document.Entries = append(document.Entries, converted)
+	}
+	return document
+}
+
+// RSS 2.0 documents. Authors are given with the Dublin Core creator
+// element, since RSS authors must be email addresses.
+
+type rssFeed struct {
+	XMLName       xml.Name   `xml:"rss"`
+	Version       string     `xml:"version,attr"`
+	AtomNamespace string     `xml:"xmlns:atom,attr"`
+	DCNamespace   string     `xml:"xmlns:dc,attr"`
+	Channel       rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	SelfLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description,omitempty"`
+	Creators    []string `xml:"dc:creator"`
+	PubDate     string   `xml:"pubDate"`
+	GUID        rssGUID  `xml:"guid"`
+}
+
+func newRSSFeed(feed Feed) rssFeed {
+	document := rssFeed{
+		Version:       "2.0",
+		AtomNamespace: "http://www.w3.org/2005/Atom",
+		DCNamespace:   "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       feed.Journal.Name,
+			Link:        feed.URL,
+			Description: "Newly published articles in " + feed.Journal.Name,
+			SelfLink:    rssLink{Rel: "self", Type: FeedRSS.mediaType(), Href: feed.URL},
+		},
+	}
+	if !feed.Updated.IsZero() {
+		document.Channel.LastBuildDate = feed.Updated.Format(time.RFC1123Z)
+	}
+	for _, entry := range feed.Entries {
+		article := entry.Record.Article
+		item := rssItem{
+			Title:       collapseSpace(article.Title),
+			Link:        entry.Link,
+			Description: strings.TrimSpace(article.Abstract),
+			PubDate:     article.PublishedAt.UTC().Format(time.RFC1123Z),
+			GUID:        rssGUID{IsPermaLink: true, Value: entry.Link},
+		}
+		for _, author := range entry.Record.Authors {
+			item.Creators = append(item.Creators, author.Name)
+		}
+		document.Channel.Items = append(document.Channel.Items, item)
+	}
+	return document
+}
+
+// mediaType returns the content type without parameters
+func (f FeedFormat) mediaType() string {
+	mediaType, _, _ := strings.Cut(f.ContentType(), ";")
+	return mediaType
+}
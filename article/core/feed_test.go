@@ -0,0 +1,90 @@
+package core_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func feedConfig() core.FeedConfig {
+	return core.FeedConfig{
+		FeedURLPattern:    "https://articles.example.org/feeds/{journal}/{format}",
+		ArticleURLPattern: "https://articles.example.org/{article}",
+		Size:              3,
+	}
+}
+
+func TestJournalFeed(t *testing.T) {
+	f := newFixture(t).withPublishedArticles(t)
+	feeds := core.NewFeedService(f.service, feedConfig())
+
+	feed, err := feeds.JournalFeed(testJournalID, core.FeedAtom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if feed.URL != "https://articles.example.org/feeds/journal_1/atom" || feed.Journal.Name != "Nature" || len(feed.Entries) != 3 {
+		t.Fatalf("feed = %+v", feed)
+	}
+	// Newest first; p6 belongs to the other journal
+	newest := feed.Entries[0]
+	if newest.Record.Article.ID != "p5" || newest.Link != "https://articles.example.org/p5" || !feed.Updated.Equal(*newest.Record.Article.PublishedAt) {
+		t.Errorf("newest entry = %+v, updated %v", newest, feed.Updated)
+	}
+	if !feed.LastModified().Equal(newest.Record.Article.Datestamp()) {
+		t.Errorf("LastModified() = %v, want the datestamp of p5", feed.LastModified())
+	}
+
+	// Editing an older article changes the feed after its publication
+	edited, err := f.service.GetArticleByID("p4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited.Title = "Article p4, corrected"
+	edited, err = f.service.UpdateArticle(edited)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed, err = feeds.JournalFeed(testJournalID, core.FeedRSS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !feed.LastModified().Equal(edited.Datestamp()) || !feed.LastModified().After(feed.Updated) {
+		t.Errorf("LastModified() = %v after the edit at %v, feed updated %v", feed.LastModified(), edited.Datestamp(), feed.Updated)
+	}
+	content, err := core.RenderFeed(feed, core.FeedRSS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(content, []byte("<title>Article p4, corrected</title>")) {
+		t.Errorf("RenderFeed() =\n%s", content)
+	}
+
+	empty, err := core.NewFeedService(newFixture(t).service, feedConfig()).JournalFeed(testJournalID, core.FeedAtom)
+	if err != nil || len(empty.Entries) != 0 || !empty.Updated.IsZero() || !empty.LastModified().IsZero() {
+		t.Errorf("feed without articles = %+v, %v", empty, err)
+	}
+	if _, err := feeds.JournalFeed("journal_9", core.FeedAtom); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("JournalFeed() of an unknown journal = %v, want ErrJournalNotFound", err)
+	}
+	if _, err := core.ParseFeedFormat("json"); !errors.Is(err, core.ErrUnsupportedFeedFormat) {
+		t.Errorf("ParseFeedFormat(json) = %v, want ErrUnsupportedFeedFormat", err)
+	}
+}
+
+func TestFeedConfigValidate(t *testing.T) {
+	for _, config := range []core.FeedConfig{
+		{FeedURLPattern: "https://articles.example.org/feeds/{journal}", ArticleURLPattern: "https://articles.example.org/{article}"},
+		{FeedURLPattern: "https://articles.example.org/feeds/{journal}/{format}", ArticleURLPattern: "https://articles.example.org/"},
+		{FeedURLPattern: "https://articles.example.org/feeds/{journal}/{format}", ArticleURLPattern: "https://articles.example.org/{article}", Size: -1},
+		{FeedURLPattern: "https://articles.example.org/feeds/{journal}/{format}", ArticleURLPattern: "https://articles.example.org/{article}", Size: core.MaxFeedSize + 1},
+	} {
+		if err := config.Validate(); err == nil {
+			t.Errorf("Validate() accepted %+v", config)
+		}
+	}
+	if err := feedConfig().Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+}
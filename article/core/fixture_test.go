@@ -0,0 +1,267 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+const testJournalID = "journal_1"
+
+// fixture wires the article service to in-memory adapters with one journal
+// and two registered authors. Its with methods add the data the tests of a
+// feature share, and the service working on it.
+type fixture struct {
+	articles *adapters.InMemoryArticleRepository
+	authors  *adapters.InMemoryAuthorRepository
+	journals *adapters.InMemoryJournalDirectory
+	taxonomy *adapters.InMemoryTaxonomyRepository
+	service  *core.ArticleService
+
+	merges     *core.DisambiguationService
+	search     *core.SearchService
+	duplicates *core.DuplicateService
+	subjects   *core.TaxonomyService
+}
+
+func newFixture(t *testing.T, journals ...core.JournalInfo) fixture {
+	t.Helper()
+	f := fixture{
+		articles: adapters.NewInMemoryArticleRepository(),
+		authors:  adapters.NewInMemoryAuthorRepository(),
+		journals: adapters.NewInMemoryJournalDirectory(append([]core.JournalInfo{{ID: testJournalID, Name: "Nature"}}, journals...)...),
+		taxonomy: adapters.NewInMemoryTaxonomyRepository(),
+	}
+	f.service = core.NewArticleService(f.articles, f.authors, f.journals, f.taxonomy)
+
+	for _, author := range []core.Author{
+		{ID: "author_1", Name: "Josiah Carberry", ORCID: "0000-0002-1825-0097"},
+		{ID: "author_2", Name: "Ada Lovelace"},
+	} {
+		if _, err := f.authors.CreateAuthor(author); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f
+}
+
+// newArticle returns a valid draft by author_1
+func newArticle(id, title string) core.Article {
+	return core.Article{
+		ID:        id,
+		Title:     title,
+		Abstract:  "An abstract.",
+		Authors:   []core.ArticleAuthor{{AuthorID: "author_1", Corresponding: true}},
+		JournalID: testJournalID,
+	}
+}
+
+// create stores the article or fails the test
+func (f fixture) create(t *testing.T, article core.Article) core.Article {
+	t.Helper()
+	created, err := f.service.CreateArticle(article)
+	if err != nil {
+		t.Fatalf("CreateArticle(%s): %v", article.ID, err)
+	}
+	return created
+}
+
+// addJournal registers the journal with the directory
+func (f fixture) addJournal(t *testing.T, journal core.JournalInfo) {
+	t.Helper()
+	if _, err := f.journals.CreateJournal(journal); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// acceptedArticle creates an article and moves it to accepted
+func (f fixture) acceptedArticle(t *testing.T, id, title string) {
+	t.Helper()
+	f.create(t, newArticle(id, title))
+	for _, step := range []func(string) (core.Article, error){f.service.SubmitArticle, f.service.StartReview, f.service.AcceptArticle} {
+		if _, err := step(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// publish moves a stored draft through review to published
+func (f fixture) publish(t *testing.T, id string) {
+	t.Helper()
+	for _, step := range []func(string) (core.Article, error){
+		f.service.SubmitArticle, f.service.StartReview, f.service.AcceptArticle, f.service.PublishArticle,
+	} {
+		if _, err := step(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// withAuthorDuplicates adds author_3, a duplicate of author_2 with an
+// ORCID iD, and two articles that list it
+func (f fixture) withAuthorDuplicates(t *testing.T) fixture {
+	t.Helper()
+	if _, err := f.authors.CreateAuthor(core.Author{ID: "author_3", Name: "A. Lovelace", ORCID: "0000-0002-1694-233X", Affiliation: "University of London"}); err != nil {
+		t.Fatal(err)
+	}
+
+	solo := newArticle("a1", "Notes on the Analytical Engine")
+	solo.Authors = []core.ArticleAuthor{{AuthorID: "author_3", Corresponding: true}}
+	f.create(t, solo)
+
+	both := newArticle("a2", "Sketch of the Analytical Engine")
+	both.Authors = []core.ArticleAuthor{{AuthorID: "author_2"}, {AuthorID: "author_1"}, {AuthorID: "author_3", Corresponding: true}}
+	f.create(t, both)
+
+	f.merges = core.NewDisambiguationService(f.authors, f.service)
+	return f
+}
+
+// withIssues adds journal_2, open issues i1 and i2 and published issue old
+// of journal_1, issue other of journal_2, and accepted articles a1 to a3
+func (f fixture) withIssues(t *testing.T) fixture {
+	t.Helper()
+	f.addJournal(t, core.JournalInfo{ID: "journal_2", Name: "Science"})
+	f.journals.WithIssues(
+		core.IssueInfo{ID: "i1", JournalID: testJournalID, Volume: 1, Number: 1},
+		core.IssueInfo{ID: "i2", JournalID: testJournalID, Volume: 1, Number: 2},
+		core.IssueInfo{ID: "old", JournalID: testJournalID, Volume: 0, Number: 1, Published: true},
+		core.IssueInfo{ID: "other", JournalID: "journal_2", Volume: 1, Number: 1},
+	)
+	f.acceptedArticle(t, "a1", "Graph Colouring")
+	f.acceptedArticle(t, "a2", "Planar Graphs")
+	f.acceptedArticle(t, "a3", "Four Colours Suffice")
+	return f
+}
+
+// withDrafts adds drafts a1 to a4, titled by their ID
+func (f fixture) withDrafts(t *testing.T) fixture {
+	t.Helper()
+	for _, id := range []string{"a1", "a2", "a3", "a4"} {
+		f.create(t, newArticle(id, "Article "+id))
+	}
+	return f
+}
+
+// withPublishedArticles adds journal_2, publishes articles p1 to p5 in
+// journal_1 and p6 in journal_2, and leaves draft d1 unpublished
+func (f fixture) withPublishedArticles(t *testing.T) fixture {
+	t.Helper()
+	f.addJournal(t, core.JournalInfo{ID: "journal_2", Name: "Science", PrintISSN: "0036-8075"})
+	for _, id := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+		article := newArticle(id, "Article "+id)
+		if id == "p6" {
+			article.JournalID = "journal_2"
+		}
+		f.create(t, article)
+		f.publish(t, id)
+	}
+	f.create(t, newArticle("d1", "Draft"))
+	return f
+}
+
+// withSearchArticles indexes four articles of journal_1 by author_1, one
+// of them also by author_2
+func (f fixture) withSearchArticles(t *testing.T) fixture {
+	t.Helper()
+	for _, article := range []core.Article{
+		{ID: "s1", Title: "Graph Colouring", Abstract: "We colour planar graphs with four colours."},
+		{ID: "s2", Title: "Planar Maps", Abstract: "Every planar graph can be coloured; colouring planar maps is classic."},
+		{ID: "s3", Title: "Neural Networks", Abstract: "Networking of neurons in deep learning."},
+		{ID: "s4", Title: "Random Graphs", Abstract: "Graph theory meets probability.", Authors: []core.ArticleAuthor{{AuthorID: "author_2"}}},
+	} {
+		stored := newArticle(article.ID, article.Title)
+		stored.Abstract = article.Abstract
+		stored.Authors = append(stored.Authors, article.Authors...)
+		f.create(t, stored)
+	}
+
+	f.search = core.NewSearchService(adapters.NewInMemorySearchIndex(), f.service)
+	if count, err := f.search.Reindex(); err != nil || count != 4 {
+		t.Fatalf("Reindex() = %d, %v", count, err)
+	}
+	return f
+}
+
+// withNearDuplicates stores a1 and its near copy a2, which differ in the
+// last words of the abstract, and the unrelated a3, and screens new
+// articles against them
+func (f fixture) withNearDuplicates(t *testing.T) fixture {
+	t.Helper()
+	text := words("w", 0, 60)
+	for _, article := range []core.Article{
+		{ID: "a1", Title: "Graph Colouring", Abstract: strings.Join(text, " ")},
+		{ID: "a2", Title: "Graph Colouring", Abstract: strings.Join(append(text[:55:55], words("x", 0, 5)...), " ")},
+		{ID: "a3", Title: "Planar Graphs", Abstract: strings.Join(words("y", 0, 60), " ")},
+	} {
+		stored := newArticle(article.ID, article.Title)
+		stored.Abstract = article.Abstract
+		f.create(t, stored)
+	}
+
+	f.duplicates = core.NewDuplicateService(adapters.NewInMemoryDuplicateIndex(), f.service)
+	if count, err := f.duplicates.Reindex(); err != nil || count != 3 {
+		t.Fatalf("Reindex() = %d, %v", count, err)
+	}
+	f.service = f.service.WithDuplicateScreen(f.duplicates)
+	return f
+}
+
+// withRelatedArticles adds journal_2 and publishes
+//   - r1 and r2, which share title words and the journal,
+//   - r3, which shares author_1 with r1,
+//   - r4 and r5, which the draft c1 cites together,
+//   - r6, which shares nothing but the journal with r1 and r2.
+func (f fixture) withRelatedArticles(t *testing.T) fixture {
+	t.Helper()
+	f.addJournal(t, core.JournalInfo{ID: "journal_2", Name: "Science"})
+	for _, author := range []core.Author{
+		{ID: "author_3", Name: "Charles Babbage"},
+		{ID: "author_4", Name: "Luigi Menabrea"},
+		{ID: "author_5", Name: "William Shakespeare"},
+	} {
+		if _, err := f.authors.CreateAuthor(author); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, article := range []struct {
+		id, title, abstract, author, journal string
+	}{
+		{"r1", "Colouring Planar Graphs", "Four colours suffice.", "author_1", testJournalID},
+		{"r2", "Planar Graphs and Their Colourings", "Maps need few crayons.", "author_2", testJournalID},
+		{"r3", "Analytical Engines", "Machines weave algebra.", "author_1", "journal_2"},
+		{"r4", "Bernoulli Numbers", "A table computed mechanically.", "author_4", "journal_2"},
+		{"r5", "Difference Engines", "Cogs tabulate polynomials.", "author_3", "journal_2"},
+		{"r6", "Sonnets", "Verses about love.", "author_5", testJournalID},
+	} {
+		stored := newArticle(article.id, article.title)
+		stored.Abstract = article.abstract
+		stored.Authors = []core.ArticleAuthor{{AuthorID: article.author, Corresponding: true}}
+		stored.JournalID = article.journal
+		f.create(t, stored)
+		f.publish(t, article.id)
+	}
+
+	citing := newArticle("c1", "Sketch of the Engine")
+	citing.Abstract = "Notes by the translator."
+	f.create(t, citing)
+	if _, err := f.service.SetReferences("c1", []core.Reference{{TargetArticleID: "r4"}, {TargetArticleID: "r5"}}); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// withSubjectTerms stores the subjectTerms vocabulary
+func (f fixture) withSubjectTerms(t *testing.T) fixture {
+	t.Helper()
+	f.subjects = core.NewTaxonomyService(f.taxonomy, f.service)
+	for _, term := range subjectTerms() {
+		if _, err := f.subjects.CreateTerm(term); err != nil {
+			t.Fatalf("CreateTerm(%s) = %v", term.ID, err)
+		}
+	}
+	return f
+}
@@ -0,0 +1,347 @@
+package core
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultHarvestPageSize is the number of records in one list response
+	// when the configuration sets none
+	DefaultHarvestPageSize = 100
+
+	// MaxHarvestPageSize bounds list responses, which carry full records
+	MaxHarvestPageSize = 1000
+)
+
+// HarvestConfig describes the repository harvesters see
+type HarvestConfig struct {
+	RepositoryName string
+	// RepositoryIdentifier is the domain name that scopes record
+	// identifiers, as in oai:articles.example.org:42
+	RepositoryIdentifier string
+	AdminEmail           string
+	// ArticleURLPattern is an article's landing page; {article} is replaced
+	// by the article ID
+	ArticleURLPattern string
+	PageSize          int
+}
+
+// Validate checks if the configuration can serve harvesters
+func (c HarvestConfig) Validate() error {
+	if strings.TrimSpace(c.RepositoryName) == "" {
+		return fmt.Errorf("repository name cannot be empty")
+	}
+	if !validRepositoryIdentifier(c.RepositoryIdentifier) {
+		return fmt.Errorf("repository identifier %q must be a domain name such as articles.example.org", c.RepositoryIdentifier)
+	}
+	if !strings.Contains(c.AdminEmail, "@") {
+		return fmt.Errorf("admin email %q is not an email address", c.AdminEmail)
+	}
+	if !strings.Contains(c.ArticleURLPattern, "{article}") {
+		return fmt.Errorf("article URL pattern %q must contain {article}", c.ArticleURLPattern)
+	}
+	if c.PageSize < 0 || c.PageSize > MaxHarvestPageSize {
+		return fmt.Errorf("page size cannot be negative or exceed %d", MaxHarvestPageSize)
+	}
+	return nil
+}
+
+// validRepositoryIdentifier checks the domain name syntax the OAI
+// identifier scheme requires: labels of letters, digits and hyphens
+// separated by dots, with at least two labels
+func validRepositoryIdentifier(identifier string) bool {
+	labels := strings.Split(identifier, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// HarvestQuery selects published articles by journal and by the time they
+// last changed
+type HarvestQuery struct {
+	JournalID string
+	// From is inclusive and Until exclusive; zero times leave the window
+	// open
+	From  time.Time
+	Until time.Time
+	// After resumes a listing after the last article of an earlier page
+	After *HarvestCursor
+	Limit int
+}
+
+// HarvestCursor is a position in a harvest listing, which is ordered by
+// datestamp and then by article ID
+type HarvestCursor struct {
+	Datestamp time.Time
+	ArticleID string
+}
+
+// HarvestRecord is a published article as harvesters see it
+type HarvestRecord struct {
+	Identifier string
+	Datestamp  time.Time
+	// SetSpecs name the sets the record belongs to: its journal
+	SetSpecs []string
+	// Metadata is nil in identifier listings
+	Metadata *DublinCore
+}
+
+// HarvestPage is one page of a listing. Next is nil on the last page.
+type HarvestPage struct {
+	Records []HarvestRecord
+	Next    *HarvestCursor
+}
+
+// HarvestSet is a set of records; every journal with published articles
+// is one
+type HarvestSet struct {
+	Spec string
+	Name string
+}
+
+// Datestamp returns when the article last changed, as recorded by the
+// repository, or its publication date when the repository recorded none
+func (a Article) Datestamp() time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		if updated, err := time.Parse(layout, a.UpdatedAt); err == nil {
+			return updated.UTC()
+		}
+	}
+	if a.PublishedAt != nil {
+		return a.PublishedAt.UTC()
+	}
+	return time.Time{}
+}
+
+// DublinCore is an unqualified Dublin Core record in the oai_dc format.
+// Element names carry their prefixes, which the root declares.
+type DublinCore struct {
+	XMLName        xml.Name `xml:"oai_dc:dc"`
+	OAIDCNamespace string   `xml:"xmlns:oai_dc,attr"`
+	DCNamespace    string   `xml:"xmlns:dc,attr"`
+	XSINamespace   string   `xml:"xmlns:xsi,attr"`
+	SchemaLocation string   `xml:"xsi:schemaLocation,attr"`
+	Titles         []string `xml:"dc:title"`
+	Creators       []string `xml:"dc:creator"`
+	Subjects       []string `xml:"dc:subject"`
+	Descriptions   []string `xml:"dc:description"`
+	Dates          []string `xml:"dc:date"`
+	Types          []string `xml:"dc:type"`
+	Identifiers    []string `xml:"dc:identifier"`
+	Sources        []string `xml:"dc:source"`
+}
+
+// NewDublinCore describes the article of the record. Creators are written
+// "Surname, Given" as Dublin Core recommends; the DOI and landing page are
+// identifiers and the journal citation and ISSNs are sources.
+func NewDublinCore(record BibliographicRecord, landingPage string) DublinCore {
+	dc := DublinCore{
+		OAIDCNamespace: "http://www.openarchives.org/OAI/2.0/oai_dc/",
+		DCNamespace:    "http://purl.org/dc/elements/1.1/",
+		XSINamespace:   "http://www.w3.org/2001/XMLSchema-instance",
+		SchemaLocation: "http://www.openarchives.org/OAI/2.0/oai_dc/ http://www.openarchives.org/OAI/2.0/oai_dc.xsd",
+		Titles:         []string{collapseSpace(record.Article.Title)},
+		Types:          []string{"Text", "info:eu-repo/semantics/article"},
+	}
+	for _, author := range record.Authors {
+		given, surname := splitDisplayName(author.Name)
+		if given != "" {
+			surname += ", " + given
+		}
+		dc.Creators = append(dc.Creators, surname)
+	}
+	if abstract := strings.TrimSpace(record.Article.Abstract); abstract != "" {
+		dc.Descriptions = append(dc.Descriptions, abstract)
+	}
+	if record.Article.PublishedAt != nil {
+		dc.Dates = append(dc.Dates, record.Article.PublishedAt.UTC().Format("2006-01-02"))
+	}
+	if record.Article.DOI != "" {
+		dc.Identifiers = append(dc.Identifiers, "https://doi.org/"+record.Article.DOI)
+	}
+	dc.Identifiers = append(dc.Identifiers, landingPage)
+
+	source := record.Journal.Name
+	if record.Issue != nil {
+		if record.Issue.Volume > 0 {
+			source += fmt.Sprintf("; Vol. %d", record.Issue.Volume)
+		}
+		source += fmt.Sprintf("; No. %d", record.Issue.Number)
+	}
+	if record.Placement != nil && record.Placement.HasPages() {
+		pages := fmt.Sprint(record.Placement.FirstPage)
+		if record.Placement.LastPage > record.Placement.FirstPage {
+			pages += fmt.Sprintf("-%d", record.Placement.LastPage)
+		}
+		source += "; " + pages
+	}
+	dc.Sources = append(dc.Sources, source)
+	for _, issn := range []string{record.Journal.PrintISSN, record.Journal.ElectronicISSN} {
+		if issn != "" {
+			dc.Sources = append(dc.Sources, "ISSN "+issn)
+		}
+	}
+	return dc
+}
+
+// HarvestService exposes published articles to metadata harvesters. Each
+// article is a record identified as oai:<repository identifier>:<article
+// ID>; each journal is a set. Drafts and articles in review are never
+// exposed, and published articles are never withdrawn, so no record is
+// ever deleted.
+type HarvestService struct {
+	articles *ArticleService
+	exports  *ExportService
+	config   HarvestConfig
+}
+
+func NewHarvestService(articles *ArticleService, config HarvestConfig) *HarvestService {
+	if config.PageSize == 0 {
+		config.PageSize = DefaultHarvestPageSize
+	}
+	return &HarvestService{articles: articles, exports: NewExportService(articles), config: config}
+}
+
+// Config returns the configuration with defaults applied
+func (s *HarvestService) Config() HarvestConfig {
+	return s.config
+}
+
+// Identifier returns the record identifier of an article
+func (s *HarvestService) Identifier(articleID string) string {
+	return "oai:" + s.config.RepositoryIdentifier + ":" + articleID
+}
+
+// articleID returns the article a record identifier names. Identifiers of
+// other repositories name no article.
+func (s *HarvestService) articleID(identifier string) (string, error) {
+	articleID, ok := strings.CutPrefix(identifier, "oai:"+s.config.RepositoryIdentifier+":")
+	if !ok || articleID == "" {
+		return "", ErrArticleNotFound
+	}
+	return articleID, nil
+}
+
+// EarliestDatestamp returns the datestamp of the oldest record, or the
+// current time when there are no records yet
+func (s *HarvestService) EarliestDatestamp() (time.Time, error) {
+	articles, err := s.articles.repository.ListPublishedArticles(HarvestQuery{Limit: 1})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(articles) == 0 {
+		return time.Now().UTC(), nil
+	}
+	return articles[0].Datestamp(), nil
+}
+
+// ListSets returns the journals with published articles, named after the
+// journal service's titles
+func (s *HarvestService) ListSets() ([]HarvestSet, error) {
+	journalIDs, err := s.articles.repository.ListPublishedJournalIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]HarvestSet, 0, len(journalIDs))
+	for _, journalID := range journalIDs {
+		set := HarvestSet{Spec: journalID, Name: journalID}
+		journal, err := s.articles.journals.GetJournal(journalID)
+		switch {
+		case err == nil:
+			set.Name = journal.Name
+		case !errors.Is(err, ErrJournalNotFound):
+			return nil, fmt.Errorf("failed to look up journal %s: %w", journalID, err)
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// GetRecord returns the record with the identifier. It returns
+// ErrArticleNotFound for identifiers of other repositories and of articles
+// that are not published.
+func (s *HarvestService) GetRecord(identifier string) (HarvestRecord, error) {
+	articleID, err := s.articleID(identifier)
+	if err != nil {
+		return HarvestRecord{}, err
+	}
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if err != nil {
+		return HarvestRecord{}, err
+	}
+	if article.Status != StatusPublished {
+		return HarvestRecord{}, ErrArticleNotFound
+	}
+	return s.record(newExportRun(s.exports, ""), article, true)
+}
+
+// List returns a page of the records the query selects, with their
+// metadata or, for identifier listings, only their headers. A zero limit
+// selects the configured page size.
+func (s *HarvestService) List(query HarvestQuery, withMetadata bool) (HarvestPage, error) {
+	if query.Limit <= 0 || query.Limit > s.config.PageSize {
+		query.Limit = s.config.PageSize
+	}
+	limit := query.Limit
+	// One more article tells whether another page follows
+	query.Limit++
+	articles, err := s.articles.repository.ListPublishedArticles(query)
+	if err != nil {
+		return HarvestPage{}, err
+	}
+
+	var page HarvestPage
+	if len(articles) > limit {
+		articles = articles[:limit]
+		last := articles[limit-1]
+		page.Next = &HarvestCursor{Datestamp: last.Datestamp(), ArticleID: last.ID}
+	}
+
+	// Records of one page share journal and issue lookups
+	run := newExportRun(s.exports, "")
+	for _, article := range articles {
+		record, err := s.record(run, article, withMetadata)
+		if err != nil {
+			return HarvestPage{}, err
+		}
+		page.Records = append(page.Records, record)
+	}
+	return page, nil
+}
+
+func (s *HarvestService) record(run *exportRun, article Article, withMetadata bool) (HarvestRecord, error) {
+	record := HarvestRecord{
+		Identifier: s.Identifier(article.ID),
+		Datestamp:  article.Datestamp(),
+		SetSpecs:   []string{article.JournalID},
+	}
+	if !withMetadata {
+		return record, nil
+	}
+
+	bibliographic, err := run.record(article)
+	if err != nil {
+		return HarvestRecord{}, err
+	}
+	landingPage := strings.ReplaceAll(s.config.ArticleURLPattern, "{article}", url.PathEscape(article.ID))
+	dc := NewDublinCore(bibliographic, landingPage)
+	record.Metadata = &dc
+	return record, nil
+}
@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func harvestConfig() core.HarvestConfig {
+	return core.HarvestConfig{
+		RepositoryName:       "Example Articles",
+		RepositoryIdentifier: "articles.example.org",
+		AdminEmail:           "oai@example.org",
+		ArticleURLPattern:    "https://articles.example.org/{article}",
+		PageSize:             2,
+	}
+}
+
+func TestHarvestConfigValidate(t *testing.T) {
+	if err := harvestConfig().Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*core.HarvestConfig)
+	}{
+		{"no name", func(c *core.HarvestConfig) { c.RepositoryName = " " }},
+		{"single label", func(c *core.HarvestConfig) { c.RepositoryIdentifier = "localhost" }},
+		{"hyphenated label", func(c *core.HarvestConfig) { c.RepositoryIdentifier = "articles.-example.org" }},
+		{"underscore", func(c *core.HarvestConfig) { c.RepositoryIdentifier = "articles_example.org" }},
+		{"bad email", func(c *core.HarvestConfig) { c.AdminEmail = "oai" }},
+		{"no placeholder", func(c *core.HarvestConfig) { c.ArticleURLPattern = "https://articles.example.org/" }},
+		{"negative page size", func(c *core.HarvestConfig) { c.PageSize = -1 }},
+		{"page too large", func(c *core.HarvestConfig) { c.PageSize = core.MaxHarvestPageSize + 1 }},
+	}
+	for _, test := range tests {
+		config := harvestConfig()
+		test.change(&config)
+		if err := config.Validate(); err == nil {
+			t.Errorf("%s: Validate() accepted %+v", test.name, config)
+		}
+	}
+
+	if got := core.NewHarvestService(newFixture(t).service, core.HarvestConfig{}).Config().PageSize; got != core.DefaultHarvestPageSize {
+		t.Errorf("default page size = %d, want %d", got, core.DefaultHarvestPageSize)
+	}
+}
+
+func TestHarvestListFollowsCursors(t *testing.T) {
+	harvest := core.NewHarvestService(newFixture(t).withPublishedArticles(t).service, harvestConfig())
+
+	var identifiers []string
+	query := core.HarvestQuery{}
+	for pages := 1; ; pages++ {
+		page, err := harvest.List(query, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Records) > 2 {
+			t.Fatalf("page %d has %d records, want at most 2", pages, len(page.Records))
+		}
+		for _, record := range page.Records {
+			if record.Metadata != nil {
+				t.Errorf("identifier listing has metadata for %s", record.Identifier)
+			}
+			identifiers = append(identifiers, record.Identifier)
+		}
+		if page.Next == nil {
+			if pages != 3 {
+				t.Errorf("listing took %d pages, want 3", pages)
+			}
+			break
+		}
+		query.After = page.Next
+	}
+
+	// Every published article is listed once; the draft is not
+	seen := make(map[string]bool)
+	for _, identifier := range identifiers {
+		if seen[identifier] {
+			t.Errorf("%s was listed twice", identifier)
+		}
+		seen[identifier] = true
+	}
+	for _, id := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+		if !seen["oai:articles.example.org:"+id] {
+			t.Errorf("%s was not listed", id)
+		}
+	}
+	if len(identifiers) != 6 {
+		t.Errorf("listed %v", identifiers)
+	}
+
+	inJournal, err := harvest.List(core.HarvestQuery{JournalID: "journal_2"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inJournal.Records) != 1 || inJournal.Next != nil || !reflect.DeepEqual(inJournal.Records[0].SetSpecs, []string{"journal_2"}) {
+		t.Errorf("List(journal_2) = %+v", inJournal)
+	}
+	future, err := harvest.List(core.HarvestQuery{From: time.Now().Add(time.Hour)}, false)
+	if err != nil || len(future.Records) != 0 {
+		t.Errorf("List(from an hour ahead) = %+v, %v", future, err)
+	}
+}
+
+func TestHarvestRecords(t *testing.T) {
+	harvest := core.NewHarvestService(newFixture(t).withPublishedArticles(t).service, harvestConfig())
+
+	record, err := harvest.GetRecord("oai:articles.example.org:p6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dc := record.Metadata
+	if dc == nil || !reflect.DeepEqual(dc.Titles, []string{"Article p6"}) || !reflect.DeepEqual(dc.Creators, []string{"Carberry, Josiah"}) {
+		t.Fatalf("metadata = %+v", dc)
+	}
+	if !reflect.DeepEqual(dc.Sources, []string{"Science", "ISSN 0036-8075"}) || dc.Identifiers[len(dc.Identifiers)-1] != "https://articles.example.org/p6" {
+		t.Errorf("sources = %q, identifiers = %q", dc.Sources, dc.Identifiers)
+	}
+	if len(dc.Dates) != 1 {
+		t.Errorf("dates = %q", dc.Dates)
+	} else if _, err := time.Parse("2006-01-02", dc.Dates[0]); err != nil {
+		t.Errorf("date %q is not a calendar date", dc.Dates[0])
+	}
+
+	for _, identifier := range []string{"oai:articles.example.org:d1", "oai:other.example.org:p1", "oai:articles.example.org:", "p1"} {
+		if _, err := harvest.GetRecord(identifier); !errors.Is(err, core.ErrArticleNotFound) {
+			t.Errorf("GetRecord(%q) = %v, want ErrArticleNotFound", identifier, err)
+		}
+	}
+
+	sets, err := harvest.ListSets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.HarvestSet{{Spec: testJournalID, Name: "Nature"}, {Spec: "journal_2", Name: "Science"}}
+	if !reflect.DeepEqual(sets, want) {
+		t.Errorf("ListSets() = %+v, want %+v", sets, want)
+	}
+
+	earliest, err := harvest.EarliestDatestamp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if earliest.IsZero() || earliest.After(time.Now()) {
+		t.Errorf("EarliestDatestamp() = %v", earliest)
+	}
+}
@@ -0,0 +1,550 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// MaxImportRecords bounds the number of records one import may contain
+const MaxImportRecords = 10000
+
+// ImportFormat is a file format articles can be imported from
+type ImportFormat string
+
+const (
+	ImportBibTeX ImportFormat = "bibtex"
+	ImportRIS    ImportFormat = "ris"
+	ImportCSV    ImportFormat = "csv"
+	// ImportJATS files hold a single JATS article
+	ImportJATS ImportFormat = "jats"
+)
+
+// Valid reports whether the format is known
+func (f ImportFormat) Valid() bool {
+	switch f {
+	case ImportBibTeX, ImportRIS, ImportCSV, ImportJATS:
+		return true
+	}
+	return false
+}
+
+// ImportRecord is one bibliographic record read from an import file
+type ImportRecord struct {
+	// Index is the 1-based position of the record in the file and Line the
+	// line it starts on
+	Index int
+	Line  int
+	// Key is the record's identifier in the file, e.g. its BibTeX key
+	Key      string
+	Title    string
+	Abstract string
+	Authors  []ImportAuthor
+	Journal  string
+	// ISSN is the journal's print ISSN, or its only ISSN when the record
+	// does not say which edition it belongs to
+	ISSN           string
+	ElectronicISSN string
+	DOI            string
+	// Published is nil when the record gives no publication year
+	Published *time.Time
+	// References are the record's cited works in order; only formats with
+	// reference lists set them
+	References []Reference
+	// Err is set when the record could not be read
+	Err error
+}
+
+// ImportAuthor is an author as named by an import record
+type ImportAuthor struct {
+	Name          string
+	ORCID         string
+	Affiliation   string
+	Corresponding bool
+}
+
+// ImportOptions control an import
+type ImportOptions struct {
+	Format ImportFormat
+	// DryRun validates the records and resolves their journals and authors
+	// without storing anything
+	DryRun bool
+	// JournalID is used for records that name no journal
+	JournalID string
+}
+
+// ImportOutcome is what happened to one record of an import
+type ImportOutcome string
+
+const (
+	ImportImported ImportOutcome = "imported"
+	// ImportValid records passed a dry run
+	ImportValid  ImportOutcome = "valid"
+	ImportFailed ImportOutcome = "failed"
+)
+
+// ImportResult reports on one record of an import
+type ImportResult struct {
+	Index     int           `json:"index"`
+	Line      int           `json:"line"`
+	Key       string        `json:"key,omitempty"`
+	Outcome   ImportOutcome `json:"outcome"`
+	ArticleID string        `json:"article_id,omitempty"`
+	JournalID string        `json:"journal_id,omitempty"`
+	// JournalCreated is set when the record's journal was, or in a dry run
+	// would be, registered with the journal service
+	JournalCreated bool `json:"journal_created,omitempty"`
+	AuthorsCreated int  `json:"authors_created,omitempty"`
+	// References is the number of references stored, or in a dry run
+	// that would be stored, with the article
+	References int    `json:"references,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Warnings report data of a successful record that was left out
+	Warnings []string `json:"warnings,omitempty"`
+	// PossibleDuplicates are the IDs of the stored articles the imported
+	// article may duplicate, most similar first
+	PossibleDuplicates []string `json:"possible_duplicates,omitempty"`
+}
+
+// ImportReport summarizes an import record by record
+type ImportReport struct {
+	Format          ImportFormat   `json:"format"`
+	DryRun          bool           `json:"dry_run"`
+	Total           int            `json:"total"`
+	Succeeded       int            `json:"succeeded"`
+	Failed          int            `json:"failed"`
+	JournalsCreated int            `json:"journals_created"`
+	AuthorsCreated  int            `json:"authors_created"`
+	Results         []ImportResult `json:"results"`
+}
+
+// ImportService loads articles in bulk from bibliographic files. Articles
+// are created as drafts through the ArticleService; records with a
+// publication year are then recorded as published on that date. Journals are matched by ISSN or name and registered with the
+// journal service when unknown; authors are matched by ORCID iD or name
+// and created when unknown.
+type ImportService struct {
+	articles *ArticleService
+	journals JournalRegistry
+}
+
+func NewImportService(articles *ArticleService, journals JournalRegistry) *ImportService {
+	return &ImportService{articles: articles, journals: journals}
+}
+
+// WithActor returns a copy of the service that attributes audit entries to
+// the given actor
+func (s *ImportService) WithActor(actor string) *ImportService {
+	scoped := *s
+	scoped.articles = s.articles.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that attributes audit entries to
+// the given caller
+func (s *ImportService) WithCaller(caller eventing.Caller) *ImportService {
+	scoped := *s
+	scoped.articles = s.articles.WithCaller(caller)
+	return &scoped
+}
+
+// Import reads the file and imports its records one by one. A record that
+// fails is reported and does not stop the import; only an unreadable file
+// or invalid options fail the import as a whole.
+func (s *ImportService) Import(data []byte, options ImportOptions) (ImportReport, error) {
+	if !options.Format.Valid() {
+		return ImportReport{}, fmt.Errorf("%w: unknown format %q", ErrInvalidImport, options.Format)
+	}
+	if options.JournalID != "" {
+		if _, err := s.articles.journals.GetJournal(options.JournalID); err != nil {
+			return ImportReport{}, err
+		}
+	}
+
+	records, err := ParseImport(options.Format, data)
+	if err != nil {
+		return ImportReport{}, err
+	}
+	if len(records) > MaxImportRecords {
+		return ImportReport{}, fmt.Errorf("%w: at most %d records can be imported at once", ErrInvalidImport, MaxImportRecords)
+	}
+
+	run := &importRun{
+		service:  s,
+		options:  options,
+		journals: make(map[string]JournalInfo),
+		dois:     make(map[string]string),
+		titles:   make(map[string]string),
+	}
+	report := ImportReport{Format: options.Format, DryRun: options.DryRun, Total: len(records)}
+	for _, record := range records {
+		result := run.importRecord(record)
+		if result.Outcome == ImportFailed {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+		if result.JournalCreated {
+			report.JournalsCreated++
+		}
+		report.AuthorsCreated += result.AuthorsCreated
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+// importRun carries the lookups of one import, so a journal or author
+// registered for one record is reused by the following ones
+type importRun struct {
+	service *ImportService
+	options ImportOptions
+	// journals maps "issn:<issn>" and "name:<name>" keys to journals found
+	// or registered earlier in the run
+	journals map[string]JournalInfo
+	// authors indexes existing authors by normalized name; loaded on first use
+	authors map[string]Author
+	// dois maps the DOIs of earlier records to their index
+	dois map[string]string
+	// titles maps the journal ID and title key of earlier records of
+	// journals requiring unique titles to their index
+	titles map[string]string
+}
+
+func (r *importRun) importRecord(record ImportRecord) ImportResult {
+	result := ImportResult{Index: record.Index, Line: record.Line, Key: record.Key, Outcome: ImportFailed}
+	fail := func(err error) ImportResult {
+		result.Error = err.Error()
+		return result
+	}
+
+	if record.Err != nil {
+		return fail(record.Err)
+	}
+	if err := r.checkDOI(&record); err != nil {
+		return fail(err)
+	}
+
+	journal, newJournal, err := r.resolveJournal(record)
+	if err != nil {
+		return fail(err)
+	}
+	authors, newAuthors, err := r.resolveAuthors(record)
+	if err != nil {
+		return fail(err)
+	}
+
+	article := Article{
+		ID:        NewID(),
+		Title:     strings.TrimSpace(record.Title),
+		Abstract:  strings.TrimSpace(record.Abstract),
+		JournalID: journal.ID,
+		Status:    StatusDraft,
+		DOI:       record.DOI,
+	}
+	for i, author := range authors {
+		// The record's affiliation is the one the article was written at
+		named := record.Authors[i]
+		byline := ArticleAuthor{AuthorID: author.ID, ORCID: author.ORCID, Affiliation: author.Affiliation, Corresponding: named.Corresponding}
+		if named.Affiliation != "" {
+			byline.Affiliation = strings.Join(strings.Fields(named.Affiliation), " ")
+		}
+		article.Authors = append(article.Authors, byline)
+	}
+	if article.JournalID == "" {
+		// Journals a dry run would register have no ID yet
+		article.JournalID = "pending"
+	}
+	if err := article.Validate(); err != nil {
+		return fail(err)
+	}
+	if journal.UniqueArticleTitles {
+		if err := r.checkTitle(record, article); err != nil {
+			return fail(err)
+		}
+	}
+
+	references, warnings := r.references(record)
+	result.JournalID, result.JournalCreated = journal.ID, newJournal
+	result.AuthorsCreated = len(newAuthors)
+	result.References, result.Warnings = len(references), warnings
+	if r.options.DryRun {
+		if newJournal {
+			result.JournalID = ""
+		}
+		result.Outcome = ImportValid
+		r.remember(record, journal, newJournal, newAuthors)
+		return result
+	}
+
+	// Everything the record needs is valid; register what is missing
+	if newJournal {
+		journal, err = r.service.journals.CreateJournal(journal)
+		if err != nil {
+			return fail(err)
+		}
+		article.JournalID, result.JournalID = journal.ID, journal.ID
+	}
+	r.remember(record, journal, false, nil)
+	now := time.Now().UTC()
+	var registered []Author
+	for _, author := range newAuthors {
+		author.CreatedAt, author.UpdatedAt = now, now
+		created, err := r.service.articles.authors.CreateAuthor(author)
+		if err != nil {
+			result.AuthorsCreated = 0
+			return fail(r.forget(registered, err))
+		}
+		registered = append(registered, created)
+	}
+
+	created, duplicates, err := r.service.articles.createArticle(article, AuditImportArticle)
+	if err != nil {
+		result.AuthorsCreated = 0
+		return fail(r.forget(registered, err))
+	}
+	for _, author := range registered {
+		r.authors[authorNameKey(author.Name)] = author
+	}
+	result.Outcome = ImportImported
+	result.ArticleID = created.ID
+	for _, duplicate := range duplicates {
+		result.PossibleDuplicates = append(result.PossibleDuplicates, duplicate.Article.ID)
+	}
+
+	// The article is stored as a draft; a publication or reference list that
+	// cannot be stored is reported rather than failing the record
+	if record.Published != nil {
+		if _, err := r.service.articles.importPublication(created.ID, *record.Published); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("the article was stored as a draft because its publication could not be: %v", err))
+		}
+	}
+	if len(references) > 0 {
+		if _, err := r.service.articles.SetReferences(created.ID, references); err != nil {
+			result.References = 0
+			result.Warnings = append(result.Warnings, fmt.Sprintf("references were not stored: %v", err))
+		}
+	}
+	return result
+}
+
+// forget removes the authors registered for a record that could not be
+// stored and returns the error that failed the record. The record's journal
+// stays registered, since the journal service cannot remove journals; later
+// records of the journal use it.
+func (r *importRun) forget(authors []Author, err error) error {
+	for _, author := range authors {
+		if deleteErr := r.service.articles.authors.DeleteAuthor(author.ID); deleteErr != nil {
+			return fmt.Errorf("%w (removing author %s also failed: %v)", err, author.ID, deleteErr)
+		}
+	}
+	return err
+}
+
+// references returns the record's references that can be stored: those
+// citing a work other than the record itself, each DOI once. References
+// without a valid DOI keep their text. Skipped references and dropped DOIs
+// are reported as warnings.
+func (r *importRun) references(record ImportRecord) (references []Reference, warnings []string) {
+	cited := make(map[string]bool, len(record.References))
+	for i, reference := range record.References {
+		doi := NormalizeDOI(reference.DOI)
+		text := strings.TrimSpace(reference.Text)
+		switch {
+		case doi == "" && text == "":
+			warnings = append(warnings, fmt.Sprintf("reference %d is empty and was skipped", i+1))
+		case doi == "":
+			references = append(references, Reference{Text: text})
+		case ValidateDOI(doi) != nil && text == "":
+			warnings = append(warnings, fmt.Sprintf("reference %d has an invalid DOI %q and was skipped", i+1, reference.DOI))
+		case ValidateDOI(doi) != nil:
+			warnings = append(warnings, fmt.Sprintf("reference %d has an invalid DOI %q, which was dropped", i+1, reference.DOI))
+			references = append(references, Reference{Text: text})
+		case doi == record.DOI:
+			warnings = append(warnings, fmt.Sprintf("reference %d cites the article itself and was skipped", i+1))
+		case cited[doi]:
+			warnings = append(warnings, fmt.Sprintf("reference %d repeats DOI %s and was skipped", i+1, doi))
+		default:
+			cited[doi] = true
+			references = append(references, Reference{DOI: doi, Text: text})
+		}
+	}
+	return references, warnings
+}
+
+// checkDOI normalizes the record's DOI and rejects DOIs that are invalid,
+// already stored or repeated in the file
+func (r *importRun) checkDOI(record *ImportRecord) error {
+	if record.DOI == "" {
+		return nil
+	}
+
+	record.DOI = NormalizeDOI(record.DOI)
+	if err := ValidateDOI(record.DOI); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if earlier, ok := r.dois[record.DOI]; ok {
+		return fmt.Errorf("%w: DOI %s was already used by record %s", ErrInvalidImport, record.DOI, earlier)
+	}
+	existing, err := r.service.articles.repository.GetArticleByDOI(record.DOI)
+	if err == nil {
+		return fmt.Errorf("%w: DOI %s already belongs to article %s", ErrInvalidImport, record.DOI, existing.ID)
+	}
+	if !errors.Is(err, ErrArticleNotFound) {
+		return err
+	}
+	r.dois[record.DOI] = fmt.Sprint(record.Index)
+	return nil
+}
+
+// checkTitle rejects a title that another article of the journal has, or
+// that an earlier record gave for the same journal
+func (r *importRun) checkTitle(record ImportRecord, article Article) error {
+	key := article.JournalID + " " + NormalizeTitle(article.Title)
+	if earlier, ok := r.titles[key]; ok {
+		return fmt.Errorf("%w: record %s has the same title in journal %s", ErrDuplicateTitle, earlier, article.JournalID)
+	}
+	if _, err := r.service.articles.checkUniqueTitle(article); err != nil {
+		return err
+	}
+	r.titles[key] = fmt.Sprint(record.Index)
+	return nil
+}
+
+// resolveJournal finds the record's journal by ISSN, then by name, and
+// falls back to the import's default journal. Unknown journals are
+// returned unsaved with created set.
+func (r *importRun) resolveJournal(record ImportRecord) (journal JournalInfo, created bool, err error) {
+	issns := recordISSNs(record)
+	name := strings.Join(strings.Fields(record.Journal), " ")
+	for _, issn := range issns {
+		if issn != "" && !validISSNFormat(issn) {
+			return JournalInfo{}, false, fmt.Errorf("%w: invalid ISSN %q", ErrInvalidImport, issn)
+		}
+	}
+
+	for _, issn := range issns {
+		if issn == "" {
+			continue
+		}
+		if journal, ok := r.journals["issn:"+issn]; ok {
+			return journal, false, nil
+		}
+		journal, err := r.service.journals.FindJournalByISSN(issn)
+		if err == nil {
+			return journal, false, nil
+		}
+		if !errors.Is(err, ErrJournalNotFound) {
+			return JournalInfo{}, false, err
+		}
+	}
+
+	if name != "" {
+		if journal, ok := r.journals["name:"+strings.ToLower(name)]; ok {
+			return journal, false, nil
+		}
+		matches, err := r.service.journals.FindJournalsByName(name)
+		if err != nil {
+			return JournalInfo{}, false, err
+		}
+		switch {
+		case len(matches) == 1:
+			return matches[0], false, nil
+		case len(matches) > 1:
+			return JournalInfo{}, false, fmt.Errorf("%w: %d journals are named %q; give an ISSN", ErrInvalidImport, len(matches), name)
+		}
+		return JournalInfo{ID: NewID(), Name: name, PrintISSN: issns[0], ElectronicISSN: issns[1]}, true, nil
+	}
+
+	if issns[0] != "" || issns[1] != "" {
+		return JournalInfo{}, false, fmt.Errorf("%w: no journal has ISSN %s and the record names no journal", ErrInvalidImport, firstNonEmpty(issns[:]...))
+	}
+	if r.options.JournalID == "" {
+		return JournalInfo{}, false, fmt.Errorf("%w: record names no journal and the import has no default journal", ErrInvalidImport)
+	}
+	journal, err = r.service.articles.journals.GetJournal(r.options.JournalID)
+	return journal, false, err
+}
+
+// recordISSNs returns the record's normalized print and electronic ISSNs
+func recordISSNs(record ImportRecord) [2]string {
+	return [2]string{NormalizeISSN(record.ISSN), NormalizeISSN(record.ElectronicISSN)}
+}
+
+// resolveAuthors finds the record's authors by ORCID iD, then by name.
+// Unknown authors are returned unsaved in created as well.
+func (r *importRun) resolveAuthors(record ImportRecord) (authors, created []Author, err error) {
+	if r.authors == nil {
+		existing, err := r.service.articles.authors.ListAuthors()
+		if err != nil {
+			return nil, nil, err
+		}
+		r.authors = make(map[string]Author, len(existing))
+		for _, author := range existing {
+			if key := authorNameKey(author.Name); r.authors[key].ID == "" {
+				r.authors[key] = author
+			}
+		}
+	}
+
+	for _, named := range record.Authors {
+		if named.ORCID != "" {
+			author, err := r.service.articles.authors.GetAuthorByORCID(named.ORCID)
+			if err == nil {
+				authors = append(authors, author)
+				continue
+			}
+			if !errors.Is(err, ErrAuthorNotFound) {
+				return nil, nil, err
+			}
+		}
+		if author, ok := r.authors[authorNameKey(named.Name)]; ok && (named.ORCID == "" || author.ORCID == "") {
+			authors = append(authors, author)
+			continue
+		}
+
+		author := Author{ID: NewID(), Name: strings.Join(strings.Fields(named.Name), " "), ORCID: named.ORCID, Affiliation: named.Affiliation}
+		if err := author.Validate(); err != nil {
+			return nil, nil, err
+		}
+		authors = append(authors, author)
+		created = append(created, author)
+	}
+	return authors, created, nil
+}
+
+// remember makes the journal and authors of a record available to the
+// records after it
+func (r *importRun) remember(record ImportRecord, journal JournalInfo, pending bool, authors []Author) {
+	if pending {
+		// Later records of a dry run share the journal it would register,
+		// which has no ID yet
+		journal.ID = ""
+	}
+	for _, issn := range recordISSNs(record) {
+		if issn != "" {
+			r.journals["issn:"+issn] = journal
+		}
+	}
+	if name := strings.Join(strings.Fields(record.Journal), " "); name != "" {
+		r.journals["name:"+strings.ToLower(name)] = journal
+	}
+	for _, author := range authors {
+		r.authors[authorNameKey(author.Name)] = author
+	}
+}
+
+func authorNameKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// NormalizeISSN upper-cases an ISSN and inserts the hyphen if it is missing
+func NormalizeISSN(issn string) string {
+	issn = strings.ToUpper(strings.TrimSpace(issn))
+	if len(issn) == 8 && !strings.Contains(issn, "-") {
+		issn = issn[:4] + "-" + issn[4:]
+	}
+	return issn
+}
@@ -0,0 +1,686 @@
+package core
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParseImport reads the records of an import file. Records that cannot be
+// read are returned with Err set; an error is returned only when the file
+// as a whole cannot be read.
+func ParseImport(format ImportFormat, data []byte) ([]ImportRecord, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var records []ImportRecord
+	var err error
+	switch format {
+	case ImportBibTeX:
+		records = parseBibTeX(string(data))
+	case ImportRIS:
+		records = parseRIS(string(data))
+	case ImportCSV:
+		records, err = parseCSV(data)
+	case ImportJATS:
+		records, err = parseJATS(data)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidImport, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range records {
+		records[i].Index = i + 1
+		if records[i].Err == nil {
+			records[i].Err = records[i].check()
+		}
+	}
+	return records, nil
+}
+
+// check rejects records that cannot become an article
+func (r ImportRecord) check() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return fmt.Errorf("%w: record has no title", ErrInvalidImport)
+	}
+	if len(r.Authors) == 0 {
+		return fmt.Errorf("%w: record has no authors", ErrInvalidImport)
+	}
+	for _, author := range r.Authors {
+		if strings.TrimSpace(author.Name) == "" {
+			return fmt.Errorf("%w: record has an author without a name", ErrInvalidImport)
+		}
+	}
+	return nil
+}
+
+// publicationDate builds a date from the year, month and day a record
+// gives. Month and day default to 1 when missing.
+func publicationDate(year, month, day string) (*time.Time, error) {
+	if year == "" {
+		return nil, nil
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil || y < 1000 || y > 9999 {
+		return nil, fmt.Errorf("%w: invalid year %q", ErrInvalidImport, year)
+	}
+
+	m := 1
+	if month != "" {
+		if m, err = parseMonth(month); err != nil {
+			return nil, err
+		}
+	}
+
+	d := 1
+	if day != "" {
+		if d, err = strconv.Atoi(day); err != nil || d < 1 || d > 31 {
+			return nil, fmt.Errorf("%w: invalid day %q", ErrInvalidImport, day)
+		}
+	}
+
+	date := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if date.Day() != d {
+		return nil, fmt.Errorf("%w: invalid date %s-%02d-%02d", ErrInvalidImport, year, m, d)
+	}
+	return &date, nil
+}
+
+// parseMonth accepts month numbers and English month names or abbreviations
+func parseMonth(month string) (int, error) {
+	month = strings.ToLower(strings.TrimSpace(month))
+	if m, err := strconv.Atoi(month); err == nil && m >= 1 && m <= 12 {
+		return m, nil
+	}
+	if len(month) >= 3 {
+		for i := time.January; i <= time.December; i++ {
+			if strings.HasPrefix(strings.ToLower(i.String()), month[:3]) {
+				return int(i), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: invalid month %q", ErrInvalidImport, month)
+}
+
+// parseDate reads dates of the form YYYY, YYYY-MM or YYYY-MM-DD, with "-"
+// or "/" as separator. RIS dates may end in a slash and free text.
+func parseDate(value string) (*time.Time, error) {
+	parts := strings.FieldsFunc(strings.TrimSpace(value), func(r rune) bool { return r == '-' || r == '/' })
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+	return publicationDate(parts[0], parts[1], parts[2])
+}
+
+// displayName turns "Surname, Given" into "Given Surname"
+func displayName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	if family, given, ok := strings.Cut(name, ","); ok && strings.TrimSpace(given) != "" {
+		return strings.TrimSpace(given) + " " + strings.TrimSpace(family)
+	}
+	return strings.TrimSuffix(name, ",")
+}
+
+// parseBibTeX reads @article-style entries. @string definitions are
+// expanded, @comment and @preamble blocks and text between entries are
+// skipped.
+func parseBibTeX(data string) []ImportRecord {
+	p := &bibtexParser{data: data, line: 1, macros: make(map[string]string)}
+	for i, month := range bibtexMonths {
+		p.macros[month] = strconv.Itoa(i + 1)
+	}
+
+	var records []ImportRecord
+	for p.skipTo('@') {
+		line := p.line
+		p.pos++
+		entryType := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if p.pos >= len(p.data) || (p.data[p.pos] != '{' && p.data[p.pos] != '(') {
+			records = append(records, ImportRecord{Line: line, Err: fmt.Errorf("%w: line %d: expected { after @%s", ErrInvalidImport, line, entryType)})
+			continue
+		}
+		end := byte('}')
+		if p.data[p.pos] == '(' {
+			end = ')'
+		}
+		p.pos++
+
+		switch entryType {
+		case "comment", "preamble":
+			p.skipBalanced(end)
+			continue
+		case "string":
+			if fields, err := p.fields(end); err == nil {
+				for name, value := range fields {
+					p.macros[name] = value
+				}
+			}
+			continue
+		}
+
+		record := ImportRecord{Line: line}
+		p.skipSpace()
+		record.Key = strings.TrimSpace(p.until(",", end))
+		if p.pos < len(p.data) && p.data[p.pos] == ',' {
+			p.pos++
+		}
+		fields, err := p.fields(end)
+		if err != nil {
+			record.Err = fmt.Errorf("%w: line %d: %v", ErrInvalidImport, line, err)
+			records = append(records, record)
+			continue
+		}
+
+		record.Title = latexUnescape(fields["title"])
+		record.Abstract = latexUnescape(fields["abstract"])
+		record.Journal = latexUnescape(firstNonEmpty(fields["journal"], fields["journaltitle"]))
+		record.ISSN = fields["issn"]
+		record.DOI = fields["doi"]
+		for _, name := range splitBibTeXAuthors(fields["author"]) {
+			record.Authors = append(record.Authors, ImportAuthor{Name: displayName(latexUnescape(name))})
+		}
+		if date := fields["date"]; date != "" {
+			record.Published, record.Err = parseDate(date)
+		} else {
+			record.Published, record.Err = publicationDate(strings.TrimSpace(fields["year"]), fields["month"], fields["day"])
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+type bibtexParser struct {
+	data   string
+	pos    int
+	line   int
+	macros map[string]string
+}
+
+func (p *bibtexParser) advance() {
+	if p.data[p.pos] == '\n' {
+		p.line++
+	}
+	p.pos++
+}
+
+func (p *bibtexParser) skipTo(c byte) bool {
+	for p.pos < len(p.data) && p.data[p.pos] != c {
+		p.advance()
+	}
+	return p.pos < len(p.data)
+}
+
+func (p *bibtexParser) skipSpace() {
+	for p.pos < len(p.data) && unicode.IsSpace(rune(p.data[p.pos])) {
+		p.advance()
+	}
+}
+
+func (p *bibtexParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.data) && !strings.ContainsRune(" \t\r\n{}(),=#\"@", rune(p.data[p.pos])) {
+		p.pos++
+	}
+	return p.data[start:p.pos]
+}
+
+// until reads up to, not including, the first of the stop characters
+func (p *bibtexParser) until(stops string, end byte) string {
+	start := p.pos
+	for p.pos < len(p.data) && !strings.ContainsRune(stops, rune(p.data[p.pos])) && p.data[p.pos] != end {
+		p.advance()
+	}
+	return p.data[start:p.pos]
+}
+
+// skipBalanced skips to after the end character closing the current block
+func (p *bibtexParser) skipBalanced(end byte) {
+	depth := 0
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.advance()
+		switch {
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case c == end && depth == 0:
+			return
+		}
+	}
+}
+
+// fields reads name = value pairs up to the end character of the entry.
+// Names are lower-cased; values keep their LaTeX markup.
+func (p *bibtexParser) fields(end byte) (map[string]string, error) {
+	fields := make(map[string]string)
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, errors.New("entry is not closed")
+		}
+		if p.data[p.pos] == end {
+			p.pos++
+			return fields, nil
+		}
+
+		name := strings.ToLower(p.identifier())
+		if name == "" {
+			return nil, fmt.Errorf("unexpected %q at line %d", p.data[p.pos], p.line)
+		}
+		p.skipSpace()
+		if p.pos >= len(p.data) || p.data[p.pos] != '=' {
+			return nil, fmt.Errorf("expected = after %s at line %d", name, p.line)
+		}
+		p.pos++
+
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = value
+
+		p.skipSpace()
+		if p.pos < len(p.data) && p.data[p.pos] == ',' {
+			p.pos++
+		}
+	}
+}
+
+// value reads a field value: braced or quoted text, numbers and macros,
+// joined with #
+func (p *bibtexParser) value() (string, error) {
+	var value strings.Builder
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return "", errors.New("entry is not closed")
+		}
+
+		switch c := p.data[p.pos]; c {
+		case '{', '"':
+			text, err := p.delimited()
+			if err != nil {
+				return "", err
+			}
+			value.WriteString(text)
+		default:
+			word := p.identifier()
+			if word == "" {
+				return "", fmt.Errorf("unexpected %q at line %d", c, p.line)
+			}
+			if expanded, ok := p.macros[strings.ToLower(word)]; ok {
+				word = expanded
+			}
+			value.WriteString(word)
+		}
+
+		p.skipSpace()
+		if p.pos >= len(p.data) || p.data[p.pos] != '#' {
+			return value.String(), nil
+		}
+		p.pos++
+	}
+}
+
+// delimited reads {...} or "..." text, keeping nested braces
+func (p *bibtexParser) delimited() (string, error) {
+	open := p.data[p.pos]
+	line := p.line
+	p.pos++
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.data):
+			p.pos++
+		case c == '{':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case depth == 0 && ((open == '{' && c == '}') || (open == '"' && c == '"')):
+			text := p.data[start:p.pos]
+			p.pos++
+			return text, nil
+		}
+		p.advance()
+	}
+	return "", fmt.Errorf("value starting at line %d is not closed", line)
+}
+
+// splitBibTeXAuthors splits an author field at " and " outside braces
+func splitBibTeXAuthors(field string) []string {
+	var names []string
+	depth, start := 0, 0
+	lower := strings.ToLower(field)
+	for i := 0; i < len(field); i++ {
+		switch field[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+		if depth == 0 && i > 0 && unicode.IsSpace(rune(field[i-1])) && strings.HasPrefix(lower[i:], "and") &&
+			i+3 < len(field) && unicode.IsSpace(rune(field[i+3])) {
+			names = append(names, field[start:i])
+			start = i + 3
+		}
+	}
+	names = append(names, field[start:])
+
+	var authors []string
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+// latexAccents maps accent commands and base letters to precomposed letters
+var latexAccents = map[byte]map[byte]rune{
+	'"':  {'a': 'ä', 'e': 'ë', 'i': 'ï', 'o': 'ö', 'u': 'ü', 'y': 'ÿ', 'A': 'Ä', 'E': 'Ë', 'I': 'Ï', 'O': 'Ö', 'U': 'Ü'},
+	'\'': {'a': 'á', 'e': 'é', 'i': 'í', 'o': 'ó', 'u': 'ú', 'y': 'ý', 'c': 'ć', 'n': 'ń', 's': 'ś', 'z': 'ź', 'A': 'Á', 'E': 'É', 'I': 'Í', 'O': 'Ó', 'U': 'Ú', 'Y': 'Ý'},
+	'`':  {'a': 'à', 'e': 'è', 'i': 'ì', 'o': 'ò', 'u': 'ù', 'A': 'À', 'E': 'È', 'I': 'Ì', 'O': 'Ò', 'U': 'Ù'},
+	'^':  {'a': 'â', 'e': 'ê', 'i': 'î', 'o': 'ô', 'u': 'û', 'A': 'Â', 'E': 'Ê', 'I': 'Î', 'O': 'Ô', 'U': 'Û'},
+	'~':  {'a': 'ã', 'n': 'ñ', 'o': 'õ', 'A': 'Ã', 'N': 'Ñ', 'O': 'Õ'},
+	'c':  {'c': 'ç', 'C': 'Ç', 's': 'ş', 'S': 'Ş'},
+	'v':  {'c': 'č', 's': 'š', 'z': 'ž', 'r': 'ř', 'e': 'ě', 'n': 'ň', 'C': 'Č', 'S': 'Š', 'Z': 'Ž', 'R': 'Ř'},
+	'H':  {'o': 'ő', 'u': 'ű', 'O': 'Ő', 'U': 'Ű'},
+	'k':  {'a': 'ą', 'e': 'ę', 'A': 'Ą', 'E': 'Ę'},
+	'.':  {'z': 'ż', 'Z': 'Ż'},
+}
+
+// latexSymbols maps the commands latexEscaper writes back to their text
+var latexSymbols = map[string]string{
+	"textbackslash": `\`, "textasciitilde": "~", "textasciicircum": "^",
+	"textless": "<", "textgreater": ">", "ss": "ß", "o": "ø", "O": "Ø",
+	"ae": "æ", "AE": "Æ", "aa": "å", "AA": "Å", "l": "ł", "L": "Ł", "i": "ı",
+}
+
+// latexUnescape turns BibTeX field text into plain text: accents and escaped
+// characters are decoded, grouping braces dropped, ~ and line breaks become
+// spaces. Unknown commands keep their name.
+func latexUnescape(value string) string {
+	var out strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '{' || c == '}':
+		case c == '~':
+			out.WriteByte(' ')
+		case c == '\\' && i+1 < len(value):
+			i++
+			if accented, end, ok := latexAccent(value, i); ok {
+				out.WriteRune(accented)
+				i = end
+				continue
+			}
+			if next := value[i]; !isLetter(next) {
+				// Escaped character such as \& or \%
+				out.WriteByte(next)
+				continue
+			}
+			j := i
+			for j < len(value) && isLetter(value[j]) {
+				j++
+			}
+			command := value[i:j]
+			if symbol, ok := latexSymbols[command]; ok {
+				out.WriteString(symbol)
+			} else {
+				out.WriteString(command)
+			}
+			i = j - 1
+			// Commands swallow a following {} or space
+			if strings.HasPrefix(value[j:], "{}") {
+				i += 2
+			} else if strings.HasPrefix(value[j:], " ") {
+				i++
+			}
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return collapseSpace(out.String())
+}
+
+// latexAccent decodes an accent command starting at value[i], just after
+// the backslash: \"o, \"{o}, \c{c} or \c c. It returns the accented letter
+// and the index of the last byte of the command.
+func latexAccent(value string, i int) (rune, int, bool) {
+	accents, ok := latexAccents[value[i]]
+	if !ok {
+		return 0, 0, false
+	}
+
+	j := i + 1
+	braced := j < len(value) && value[j] == '{'
+	if isLetter(value[i]) && !braced && !strings.HasPrefix(value[j:], " ") {
+		// A command such as \vspace rather than the \v accent
+		return 0, 0, false
+	}
+	if braced || (j < len(value) && value[j] == ' ') {
+		j++
+	}
+	if j >= len(value) {
+		return 0, 0, false
+	}
+	accented, ok := accents[value[j]]
+	if !ok {
+		return 0, 0, false
+	}
+	if braced {
+		if j+1 >= len(value) || value[j+1] != '}' {
+			return 0, 0, false
+		}
+		j++
+	}
+	return accented, j, true
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseRIS reads TY ... ER references. Lines without a tag continue the
+// previous value.
+func parseRIS(data string) []ImportRecord {
+	var records []ImportRecord
+	var record *ImportRecord
+	var lastTag string
+	tags := make(map[string][]string)
+
+	finish := func() {
+		if record == nil {
+			return
+		}
+		first := func(names ...string) string {
+			for _, name := range names {
+				if values := tags[name]; len(values) > 0 {
+					return values[0]
+				}
+			}
+			return ""
+		}
+		record.Title = first("TI", "T1")
+		record.Abstract = first("AB", "N2")
+		record.Journal = first("T2", "JF", "JO", "JA")
+		record.DOI = first("DO")
+		for _, value := range tags["SN"] {
+			// SN may hold ISBNs and several ISSNs; keep the first ISSN
+			for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ';' || r == ',' || unicode.IsSpace(r) }) {
+				if validISSNFormat(NormalizeISSN(field)) {
+					record.ISSN = NormalizeISSN(field)
+					break
+				}
+			}
+			if record.ISSN != "" {
+				break
+			}
+		}
+		for _, name := range append(tags["AU"], tags["A1"]...) {
+			record.Authors = append(record.Authors, ImportAuthor{Name: displayName(name)})
+		}
+		date := first("DA")
+		if date == "" {
+			date = first("PY", "Y1")
+		}
+		if record.Err == nil && date != "" {
+			record.Published, record.Err = parseDate(date)
+		}
+		records = append(records, *record)
+		record, tags, lastTag = nil, make(map[string][]string), ""
+	}
+
+	for number, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		tag, value, tagged := risTag(line)
+		switch {
+		case !tagged:
+			// Continuation of a wrapped value
+			if record != nil && lastTag != "" && strings.TrimSpace(line) != "" {
+				values := tags[lastTag]
+				values[len(values)-1] += " " + strings.TrimSpace(line)
+			}
+		case tag == "TY":
+			finish()
+			record = &ImportRecord{Line: number + 1}
+		case tag == "ER":
+			finish()
+		case record == nil:
+			records = append(records, ImportRecord{Line: number + 1, Err: fmt.Errorf("%w: line %d: %s tag outside of a reference", ErrInvalidImport, number+1, tag)})
+		default:
+			if tag == "ID" {
+				record.Key = value
+			}
+			tags[tag] = append(tags[tag], value)
+			lastTag = tag
+		}
+	}
+	if record != nil {
+		record.Err = fmt.Errorf("%w: reference starting at line %d has no ER tag", ErrInvalidImport, record.Line)
+		finish()
+	}
+	return records
+}
+
+// risTag splits "XX  - value" lines
+func risTag(line string) (tag, value string, ok bool) {
+	line = strings.TrimRight(line, " \t")
+	if len(line) < 5 || line[2:5] != "  -" || !isRISTagChar(line[0]) || !isRISTagChar(line[1]) {
+		return "", "", false
+	}
+	return line[:2], strings.TrimSpace(line[5:]), true
+}
+
+func isRISTagChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// csvColumns are the columns a CSV import understands. Authors and their
+// ORCID iDs are separated by semicolons; empty iDs keep the positions.
+var csvColumns = map[string]bool{
+	"key": true, "title": true, "authors": true, "orcids": true, "abstract": true,
+	"journal": true, "issn": true, "doi": true, "year": true, "date": true,
+}
+
+// parseCSV reads a CSV file with a header row naming its columns. Unknown
+// columns are rejected so misspelt headers do not silently drop data.
+func parseCSV(data []byte) ([]ImportRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read CSV header: %v", ErrInvalidImport, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !csvColumns[name] {
+			return nil, fmt.Errorf("%w: unknown CSV column %q", ErrInvalidImport, name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"title", "authors"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: CSV header has no %s column", ErrInvalidImport, required)
+		}
+	}
+
+	var records []ImportRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("%w: failed to read CSV: %v", ErrInvalidImport, err)
+			}
+			records = append(records, ImportRecord{Line: parseErr.StartLine, Err: fmt.Errorf("%w: %v", ErrInvalidImport, err)})
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		record := ImportRecord{Line: line}
+		if len(row) != len(header) {
+			record.Err = fmt.Errorf("%w: line %d has %d fields, the header has %d", ErrInvalidImport, line, len(row), len(header))
+			records = append(records, record)
+			continue
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		record.Key = field("key")
+		record.Title = field("title")
+		record.Abstract = field("abstract")
+		record.Journal = field("journal")
+		record.ISSN = field("issn")
+		record.DOI = field("doi")
+		orcids := strings.Split(field("orcids"), ";")
+		for i, name := range strings.Split(field("authors"), ";") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			author := ImportAuthor{Name: displayName(name)}
+			if i < len(orcids) {
+				author.ORCID = strings.TrimSpace(orcids[i])
+			}
+			record.Authors = append(record.Authors, author)
+		}
+		if date := field("date"); date != "" {
+			record.Published, record.Err = parseDate(date)
+		} else {
+			record.Published, record.Err = publicationDate(field("year"), "", "")
+		}
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,313 @@
+package core_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+func TestParseBibTeX(t *testing.T) {
+	data := `% A comment between entries
+@string{nat = "Nature"}
+@comment{ignored}
+@article{carberry2024,
+  author = {Carberry, Josiah and Ada Lovelace and {Johnson and Johnson}},
+  title = {Graph Colouring: 100\% of {Planar} Graphs},
+  journal = nat,
+  issn = {0028-0836},
+  year = 2024,
+  month = mar,
+  doi = {10.5555/j1.2024.a1},
+  abstract = {G\"odel \& Co.}
+}
+@article{broken, title = {Unclosed
+`
+	records, err := core.ParseImport(core.ImportBibTeX, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("ParseImport() = %d records, want 2", len(records))
+	}
+
+	record := records[0]
+	if record.Index != 1 || record.Line != 4 || record.Key != "carberry2024" || record.Err != nil {
+		t.Errorf("record = %+v", record)
+	}
+	if record.Title != "Graph Colouring: 100% of Planar Graphs" || record.Abstract != "Gödel & Co." || record.Journal != "Nature" {
+		t.Errorf("title = %q, abstract = %q, journal = %q", record.Title, record.Abstract, record.Journal)
+	}
+	var names []string
+	for _, author := range record.Authors {
+		names = append(names, author.Name)
+	}
+	if strings.Join(names, "|") != "Josiah Carberry|Ada Lovelace|Johnson and Johnson" {
+		t.Errorf("authors = %q", names)
+	}
+	if record.Published == nil || record.Published.Format("2006-01-02") != "2024-03-01" {
+		t.Errorf("published = %v", record.Published)
+	}
+	if !errors.Is(records[1].Err, core.ErrInvalidImport) {
+		t.Errorf("unclosed entry: Err = %v, want ErrInvalidImport", records[1].Err)
+	}
+}
+
+func TestParseRIS(t *testing.T) {
+	data := strings.Join([]string{
+		"TY  - JOUR",
+		"ID  - r1",
+		"AU  - Carberry, Josiah",
+		"TI  - Graph Colouring and",
+		"  Planar Graphs",
+		"JO  - Nature",
+		"SN  - 978-3-16-148410-0; 00280836",
+		"DA  - 2024/03/05/Spring",
+		"ER  - ",
+		"TY  - JOUR",
+		"TI  - No authors",
+		"PY  - 2024",
+		"ER  - ",
+		"AU  - Stray, Tag",
+		"TY  - JOUR",
+		"AU  - Lovelace, Ada",
+		"TI  - Unfinished",
+	}, "\r\n")
+	records, err := core.ParseImport(core.ImportRIS, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("ParseImport() = %d records, want 4", len(records))
+	}
+
+	record := records[0]
+	if record.Err != nil || record.Key != "r1" || record.Title != "Graph Colouring and Planar Graphs" || record.ISSN != "0028-0836" {
+		t.Errorf("record = %+v", record)
+	}
+	if len(record.Authors) != 1 || record.Authors[0].Name != "Josiah Carberry" {
+		t.Errorf("authors = %+v", record.Authors)
+	}
+	if record.Published == nil || record.Published.Format("2006-01-02") != "2024-03-05" {
+		t.Errorf("published = %v", record.Published)
+	}
+	for i, want := range []string{"no authors", "outside of a reference", "no ER tag"} {
+		if err := records[i+1].Err; !errors.Is(err, core.ErrInvalidImport) || !strings.Contains(err.Error(), want) {
+			t.Errorf("record %d: Err = %v, want one about %q", i+2, err, want)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	data := "Title,Authors,ORCIDs,Year,DOI\n" +
+		"Graph Colouring,\"Carberry, Josiah; Ada Lovelace\",0000-0002-1825-0097;,2024,10.5555/X1\n" +
+		"Too short,Plato\n" +
+		"Bad year,Plato,,MMXXIV,\n"
+	records, err := core.ParseImport(core.ImportCSV, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("ParseImport() = %d records, want 3", len(records))
+	}
+
+	record := records[0]
+	if record.Err != nil || record.Line != 2 || record.Title != "Graph Colouring" || record.DOI != "10.5555/X1" {
+		t.Errorf("record = %+v", record)
+	}
+	if len(record.Authors) != 2 || record.Authors[0].Name != "Josiah Carberry" || record.Authors[0].ORCID != "0000-0002-1825-0097" ||
+		record.Authors[1].Name != "Ada Lovelace" || record.Authors[1].ORCID != "" {
+		t.Errorf("authors = %+v", record.Authors)
+	}
+	for _, bad := range records[1:] {
+		if !errors.Is(bad.Err, core.ErrInvalidImport) {
+			t.Errorf("line %d: Err = %v, want ErrInvalidImport", bad.Line, bad.Err)
+		}
+	}
+
+	for _, header := range []string{"title,authers\n", "title,year\n"} {
+		if _, err := core.ParseImport(core.ImportCSV, []byte(header)); !errors.Is(err, core.ErrInvalidImport) {
+			t.Errorf("ParseImport(%q) = %v, want ErrInvalidImport", header, err)
+		}
+	}
+}
+
+func TestImport(t *testing.T) {
+	f := newFixture(t, core.JournalInfo{ID: "journal_2", Name: "Science", PrintISSN: "0036-8075"})
+	imports := core.NewImportService(f.service, f.journals)
+	data := `@article{a, author = {Carberry, Josiah}, title = {Graph Colouring}, journal = {Nature}, year = 2024, doi = {10.5555/A}}
+@article{b, author = {Lovelace, Ada and Babbage, Charles}, title = {Analytical Engines}, issn = {00368075}}
+@article{c, author = {Babbage, Charles}, title = {Difference Engines}, journal = {Philosophical Transactions}, issn = {0261-0523}}
+@article{d, author = {Plato}, title = {Republic}, journal = {Philosophical Transactions}, doi = {10.5555/a}}
+@article{e, author = {Plato}, title = {Laws}, issn = {1234-567X}}
+`
+
+	dryRun, err := imports.Import([]byte(data), core.ImportOptions{Format: core.ImportBibTeX, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := imports.Import([]byte(data), core.ImportOptions{Format: core.ImportBibTeX})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A dry run reports what the import does without storing anything
+	if report.Total != 5 || report.Succeeded != 3 || report.Failed != 2 || report.JournalsCreated != 1 || report.AuthorsCreated != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	if dryRun.Succeeded != report.Succeeded || dryRun.JournalsCreated != report.JournalsCreated || dryRun.AuthorsCreated != report.AuthorsCreated {
+		t.Errorf("dry run = %+v, import = %+v", dryRun, report)
+	}
+	for _, result := range dryRun.Results[:3] {
+		if result.Outcome != core.ImportValid || result.ArticleID != "" {
+			t.Errorf("dry run result = %+v", result)
+		}
+	}
+
+	a, b, c := report.Results[0], report.Results[1], report.Results[2]
+	if a.Outcome != core.ImportImported || a.JournalID != testJournalID || a.AuthorsCreated != 0 {
+		t.Errorf("record a = %+v", a)
+	}
+	if b.JournalID != "journal_2" || b.AuthorsCreated != 1 {
+		t.Errorf("record b = %+v", b)
+	}
+	if !c.JournalCreated || c.AuthorsCreated != 0 {
+		t.Errorf("record c = %+v, want a new journal and the author created for b", c)
+	}
+	for i, want := range []string{"already used by record 1", "no journal has ISSN"} {
+		if result := report.Results[3+i]; result.Outcome != core.ImportFailed || !strings.Contains(result.Error, want) {
+			t.Errorf("record %s = %+v, want an error about %q", result.Key, result, want)
+		}
+	}
+
+	article, err := f.service.GetArticleByID(a.ArticleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if article.Status != core.StatusPublished || article.DOI != "10.5555/a" || article.Authors[0].AuthorID != "author_1" {
+		t.Errorf("article a = %+v", article)
+	}
+	if draft, err := f.service.GetArticleByID(b.ArticleID); err != nil || draft.Status != core.StatusDraft {
+		t.Errorf("article b = %+v, %v", draft, err)
+	}
+	if journals, err := f.journals.FindJournalsByName("philosophical  transactions"); err != nil || len(journals) != 1 || journals[0].ID != c.JournalID {
+		t.Errorf("registered journals = %+v, %v", journals, err)
+	}
+	// Published records go through the workflow as a recorded transition
+	if article.PublishedAt == nil || article.PublishedAt.Year() != 2024 {
+		t.Errorf("article a was published at %v, want in 2024", article.PublishedAt)
+	}
+	history, err := f.service.GetStatusHistory(a.ArticleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].From != core.StatusDraft || history[0].To != core.StatusPublished || history[0].Reason == "" {
+		t.Errorf("history of article a = %+v", history)
+	}
+	want := []eventing.AuditOperation{core.AuditImportArticle, core.AuditTransitionArticle, core.AuditImportArticle, core.AuditImportArticle}
+	if operations := auditOperations(f.auditEntries(t)); !equalOperations(operations, want) {
+		t.Errorf("audit operations = %v, want %v", operations, want)
+	}
+
+	// Running the file again finds everything that was registered
+	again, err := imports.Import([]byte(data), core.ImportOptions{Format: core.ImportBibTeX, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.JournalsCreated != 0 || again.AuthorsCreated != 0 || !strings.Contains(again.Results[0].Error, "already belongs to article") {
+		t.Errorf("second dry run = %+v", again)
+	}
+}
+
+func TestImportKeepsTitlesUnique(t *testing.T) {
+	f := newFixture(t, core.JournalInfo{ID: "journal_2", Name: "Science", PrintISSN: "0036-8075", UniqueArticleTitles: true})
+	stored := newArticle("s1", "Graph Colouring")
+	stored.JournalID = "journal_2"
+	f.create(t, stored)
+	imports := core.NewImportService(f.service, f.journals)
+
+	data := "title,authors,issn\n" +
+		"Graph colouring,Plato,0036-8075\n" +
+		"Planar Graphs,Plato,0036-8075\n" +
+		"Planar  graphs,Plato,00368075\n" +
+		"Graph Colouring,Plato,\n"
+	for _, dryRun := range []bool{true, false} {
+		report, err := imports.Import([]byte(data), core.ImportOptions{Format: core.ImportCSV, JournalID: testJournalID, DryRun: dryRun})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Succeeded != 2 || report.Failed != 2 {
+			t.Errorf("dry run %v: report = %+v", dryRun, report)
+		}
+		for i, want := range []string{"article s1", "record 2"} {
+			if result := report.Results[2*i]; !strings.Contains(result.Error, core.ErrDuplicateTitle.Error()) || !strings.Contains(result.Error, want) {
+				t.Errorf("dry run %v: line %d = %+v, want a duplicate title of %s", dryRun, result.Line, result, want)
+			}
+		}
+		if report.Results[3].JournalID != testJournalID {
+			t.Errorf("dry run %v: title in a journal without the rule = %+v", dryRun, report.Results[3])
+		}
+	}
+}
+
+func TestImportOptionsAreChecked(t *testing.T) {
+	f := newFixture(t)
+	imports := core.NewImportService(f.service, f.journals)
+	record := []byte("title,authors\nGraph Colouring,Plato\n")
+
+	if _, err := imports.Import(record, core.ImportOptions{Format: "endnote"}); !errors.Is(err, core.ErrInvalidImport) {
+		t.Errorf("Import(endnote) = %v, want ErrInvalidImport", err)
+	}
+	if _, err := imports.Import(record, core.ImportOptions{Format: core.ImportCSV, JournalID: "journal_9"}); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("Import() into an unknown journal = %v, want ErrJournalNotFound", err)
+	}
+
+	report, err := imports.Import(record, core.ImportOptions{Format: core.ImportCSV})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Failed != 1 || !strings.Contains(report.Results[0].Error, "no default journal") {
+		t.Errorf("Import() without a journal = %+v", report)
+	}
+	report, err = imports.Import(record, core.ImportOptions{Format: core.ImportCSV, JournalID: testJournalID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Succeeded != 1 || report.Results[0].JournalID != testJournalID {
+		t.Errorf("Import() into the default journal = %+v", report)
+	}
+}
+
+// unavailableArticleRepository fails to store articles
+type unavailableArticleRepository struct {
+	*adapters.InMemoryArticleRepository
+}
+
+func (r unavailableArticleRepository) CreateArticle(core.Article, bool, ...core.Event) (core.Article, error) {
+	return core.Article{}, errors.New("article store unavailable")
+}
+
+func TestImportRemovesAuthorsOfRecordsThatFail(t *testing.T) {
+	f := newFixture(t)
+	service := core.NewArticleService(unavailableArticleRepository{f.articles}, f.authors, f.journals, f.taxonomy)
+	data := `@article{a, author = {Babbage, Charles and Lovelace, Ada}, title = {Analytical Engines}, journal = {Nature}}
+`
+	report, err := core.NewImportService(service, f.journals).Import([]byte(data), core.ImportOptions{Format: core.ImportBibTeX})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := report.Results[0]; result.Outcome != core.ImportFailed || result.AuthorsCreated != 0 || !strings.Contains(result.Error, "unavailable") {
+		t.Errorf("result = %+v", result)
+	}
+
+	authors, err := f.authors.ListAuthors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(authors) != 2 {
+		t.Errorf("authors = %+v, want only the two of the fixture", authors)
+	}
+}
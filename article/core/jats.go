@@ -0,0 +1,711 @@
+package core
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// JATS (ANSI/NISO Z39.96) is the XML vocabulary publishers exchange
+// full-text articles in. Ingestion reads the front matter and reference
+// list of any JATS or older NLM document; rendering writes front matter
+// and references valid against the Journal Publishing DTD 1.3.
+
+const (
+	jatsDTDVersion = "1.3"
+	jatsDoctype    = `<!DOCTYPE article PUBLIC "-//NLM//DTD JATS (Z39.96) Journal Publishing DTD v1.3 20210610//EN" "JATS-journalpublishing1-3.dtd">`
+	orcidURL       = "https://orcid.org/"
+)
+
+// jatsDocument is the part of a JATS article that ingestion reads
+type jatsDocument struct {
+	XMLName xml.Name `xml:"article"`
+	Front   struct {
+		JournalMeta struct {
+			Titles []string `xml:"journal-title-group>journal-title"`
+			// NLM 2.x documents have no title group
+			Title string          `xml:"journal-title"`
+			ISSNs []jatsISSNInput `xml:"issn"`
+		} `xml:"journal-meta"`
+		ArticleMeta struct {
+			ArticleIDs    []jatsPubID         `xml:"article-id"`
+			Title         jatsMixed           `xml:"title-group>article-title"`
+			ContribGroups []jatsContribGroup  `xml:"contrib-group"`
+			Affs          []jatsAffInput      `xml:"aff"`
+			PubDates      []jatsDateInput     `xml:"pub-date"`
+			Abstracts     []jatsAbstractInput `xml:"abstract"`
+		} `xml:"article-meta"`
+	} `xml:"front"`
+	Back struct {
+		RefLists []jatsRefList `xml:"ref-list"`
+	} `xml:"back"`
+}
+
+// jatsMixed holds mixed content such as a title with <italic> runs
+type jatsMixed struct {
+	Inner string `xml:",innerxml"`
+}
+
+// text returns the character data of the content with markup removed and
+// white space collapsed. Elements named in skip are left out entirely.
+func (m jatsMixed) text(skip ...string) string {
+	decoder := newJATSDecoder(strings.NewReader(m.Inner))
+	var out strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		switch t := token.(type) {
+		case xml.CharData:
+			out.Write(t)
+		case xml.StartElement:
+			for _, name := range skip {
+				if t.Name.Local == name {
+					decoder.Skip()
+					out.WriteByte(' ')
+					break
+				}
+			}
+		}
+	}
+	return collapseSpace(out.String())
+}
+
+type jatsPubID struct {
+	Type  string `xml:"pub-id-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type jatsISSNInput struct {
+	PubType string `xml:"pub-type,attr"`
+	Format  string `xml:"publication-format,attr"`
+	Value   string `xml:",chardata"`
+}
+
+type jatsContribGroup struct {
+	Contribs []jatsContribInput `xml:"contrib"`
+	Affs     []jatsAffInput     `xml:"aff"`
+}
+
+type jatsContribInput struct {
+	Type    string `xml:"contrib-type,attr"`
+	Corresp string `xml:"corresp,attr"`
+	IDs     []struct {
+		Type  string `xml:"contrib-id-type,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"contrib-id"`
+	Names        []jatsNameInput `xml:"name"`
+	Alternatives []jatsNameInput `xml:"name-alternatives>name"`
+	StringNames  []jatsMixed     `xml:"string-name"`
+	Collabs      []jatsMixed     `xml:"collab"`
+	Xrefs        []struct {
+		Type string `xml:"ref-type,attr"`
+		RID  string `xml:"rid,attr"`
+	} `xml:"xref"`
+	Affs []jatsAffInput `xml:"aff"`
+}
+
+type jatsNameInput struct {
+	Style      string `xml:"name-style,attr"`
+	Surname    string `xml:"surname"`
+	GivenNames string `xml:"given-names"`
+}
+
+// display returns the name in the "Given Surname" order the service
+// stores, keeping the family name first for eastern name styles
+func (n jatsNameInput) display() string {
+	surname, given := strings.TrimSpace(n.Surname), strings.TrimSpace(n.GivenNames)
+	if given == "" {
+		return collapseSpace(surname)
+	}
+	if n.Style == "eastern" {
+		return collapseSpace(surname + " " + given)
+	}
+	return collapseSpace(given + " " + surname)
+}
+
+type jatsAffInput struct {
+	ID           string      `xml:"id,attr"`
+	Institutions []jatsMixed `xml:"institution"`
+	Wrapped      []jatsMixed `xml:"institution-wrap>institution"`
+	jatsMixed
+}
+
+// name returns the affiliation's first institution, or its text without
+// the label when it names none
+func (a jatsAffInput) name() string {
+	for _, institutions := range [][]jatsMixed{a.Institutions, a.Wrapped} {
+		for _, institution := range institutions {
+			if name := institution.text(); name != "" {
+				return name
+			}
+		}
+	}
+	return strings.Trim(a.text("label", "sup"), " ,;.")
+}
+
+type jatsDateInput struct {
+	PubType  string `xml:"pub-type,attr"`
+	Format   string `xml:"publication-format,attr"`
+	DateType string `xml:"date-type,attr"`
+	Day      string `xml:"day"`
+	Month    string `xml:"month"`
+	Year     string `xml:"year"`
+}
+
+// rank orders publication dates by preference: the electronic publication
+// date first, then the print date, then any other
+func (d jatsDateInput) rank() int {
+	switch {
+	case d.PubType == "epub" || (d.Format == "electronic" && (d.DateType == "" || d.DateType == "pub")):
+		return 0
+	case d.PubType == "ppub" || d.DateType == "pub":
+		return 1
+	}
+	return 2
+}
+
+type jatsAbstractInput struct {
+	Type       string              `xml:"abstract-type,attr"`
+	Paragraphs []jatsMixed         `xml:"p"`
+	Sections   []jatsAbstractInput `xml:"sec"`
+}
+
+// paragraphs returns the text of the abstract's paragraphs, including
+// those of structured abstracts' sections, in document order
+func (a jatsAbstractInput) paragraphs() []string {
+	var paragraphs []string
+	for _, paragraph := range a.Paragraphs {
+		if text := paragraph.text(); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	for _, section := range a.Sections {
+		paragraphs = append(paragraphs, section.paragraphs()...)
+	}
+	return paragraphs
+}
+
+type jatsRefList struct {
+	Refs     []jatsRefInput `xml:"ref"`
+	RefLists []jatsRefList  `xml:"ref-list"`
+}
+
+// refs flattens nested reference lists
+func (l jatsRefList) refs() []jatsRefInput {
+	refs := l.Refs
+	for _, nested := range l.RefLists {
+		refs = append(refs, nested.refs()...)
+	}
+	return refs
+}
+
+type jatsRefInput struct {
+	Mixed        []jatsCitationInput `xml:"mixed-citation"`
+	Elements     []jatsCitationInput `xml:"element-citation"`
+	Alternatives struct {
+		Mixed    []jatsCitationInput `xml:"mixed-citation"`
+		Elements []jatsCitationInput `xml:"element-citation"`
+	} `xml:"citation-alternatives"`
+}
+
+type jatsCitationInput struct {
+	PubIDs   []jatsPubID `xml:"pub-id"`
+	ExtLinks []struct {
+		Type  string `xml:"ext-link-type,attr"`
+		Href  string `xml:"href,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"ext-link"`
+	PersonGroups []struct {
+		Names       []jatsNameInput `xml:"name"`
+		StringNames []jatsMixed     `xml:"string-name"`
+		Collabs     []jatsMixed     `xml:"collab"`
+	} `xml:"person-group"`
+	ArticleTitle jatsMixed `xml:"article-title"`
+	Source       jatsMixed `xml:"source"`
+	Year         string    `xml:"year"`
+	Volume       string    `xml:"volume"`
+	Issue        string    `xml:"issue"`
+	FirstPage    string    `xml:"fpage"`
+	LastPage     string    `xml:"lpage"`
+	jatsMixed
+}
+
+// doi returns the cited work's DOI from a DOI pub-id or a DOI link
+func (c jatsCitationInput) doi() string {
+	for _, id := range c.PubIDs {
+		if id.Type == "doi" {
+			return NormalizeDOI(id.Value)
+		}
+	}
+	for _, link := range c.ExtLinks {
+		href := NormalizeDOI(link.Href)
+		switch {
+		case link.Type == "doi":
+			return NormalizeDOI(firstNonEmpty(link.Value, link.Href))
+		case strings.HasPrefix(href, "10.") && href != strings.ToLower(strings.TrimSpace(link.Href)):
+			// A resolver link such as https://doi.org/10.1234/x
+			return href
+		}
+	}
+	return ""
+}
+
+// composed prints an element citation as "Surname GN, Surname GN. Title.
+// Source. Year;Volume(Issue):First-Last."
+func (c jatsCitationInput) composed() string {
+	var names []string
+	for _, group := range c.PersonGroups {
+		for _, name := range group.Names {
+			entry := strings.TrimSpace(name.Surname)
+			if given := strings.TrimSpace(name.GivenNames); given != "" {
+				entry += " " + given
+			}
+			names = append(names, entry)
+		}
+		for _, name := range append(group.StringNames, group.Collabs...) {
+			names = append(names, name.text())
+		}
+	}
+
+	var parts []string
+	for _, part := range []string{strings.Join(names, ", "), c.ArticleTitle.text(), c.Source.text()} {
+		if part = strings.TrimRight(part, ". "); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	location := strings.TrimSpace(c.Year)
+	if volume := strings.TrimSpace(c.Volume); volume != "" {
+		location += ";" + volume
+		if issue := strings.TrimSpace(c.Issue); issue != "" {
+			location += "(" + issue + ")"
+		}
+	}
+	if first := strings.TrimSpace(c.FirstPage); first != "" {
+		location += ":" + first
+		if last := strings.TrimSpace(c.LastPage); last != "" {
+			location += "-" + last
+		}
+	}
+	if location = strings.TrimLeft(location, ";:"); location != "" {
+		parts = append(parts, location)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return collapseSpace(strings.Join(parts, ". ") + ".")
+}
+
+// reference turns a reference list entry into a reference by DOI, keeping
+// the citation as printed. The printed DOI is left out of the text.
+func (r jatsRefInput) reference() Reference {
+	mixed := append(r.Mixed, r.Alternatives.Mixed...)
+	elements := append(r.Elements, r.Alternatives.Elements...)
+
+	var reference Reference
+	for _, citation := range mixed {
+		if reference.DOI == "" {
+			reference.DOI = citation.doi()
+		}
+		if reference.Text == "" {
+			reference.Text = citation.text("pub-id")
+			// Citations often print "doi:" before the identifier
+			if strings.HasSuffix(strings.ToLower(reference.Text), "doi:") {
+				reference.Text = strings.TrimSpace(reference.Text[:len(reference.Text)-len("doi:")])
+			}
+		}
+	}
+	for _, citation := range elements {
+		if reference.DOI == "" {
+			reference.DOI = citation.doi()
+		}
+		if reference.Text == "" {
+			reference.Text = citation.composed()
+		}
+	}
+	return reference
+}
+
+func newJATSDecoder(r io.Reader) *xml.Decoder {
+	decoder := xml.NewDecoder(r)
+	// Documents often use HTML entities such as &nbsp; declared by the DTD
+	decoder.Entity = xml.HTMLEntity
+	decoder.Strict = false
+	return decoder
+}
+
+// parseJATS reads a JATS article into one import record: its title,
+// abstract, authors with affiliations and ORCID iDs, journal, DOI,
+// publication date and reference list
+func parseJATS(data []byte) ([]ImportRecord, error) {
+	decoder := newJATSDecoder(bytes.NewReader(data))
+	line := 1
+	var document jatsDocument
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: document has no <article> element", ErrInvalidImport)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid JATS document: %v", ErrInvalidImport, err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Local != "article" {
+				return nil, fmt.Errorf("%w: root element is <%s>, not <article>", ErrInvalidImport, start.Name.Local)
+			}
+			line, _ = decoder.InputPos()
+			if err := decoder.DecodeElement(&document, &start); err != nil {
+				return nil, fmt.Errorf("%w: invalid JATS document: %v", ErrInvalidImport, err)
+			}
+			break
+		}
+	}
+
+	meta := document.Front.ArticleMeta
+	record := ImportRecord{Line: line, Title: meta.Title.text()}
+	for _, id := range meta.ArticleIDs {
+		switch id.Type {
+		case "doi":
+			record.DOI = strings.TrimSpace(id.Value)
+		case "publisher-id":
+			record.Key = strings.TrimSpace(id.Value)
+		}
+	}
+
+	journal := document.Front.JournalMeta
+	record.Journal = journal.Title
+	if len(journal.Titles) > 0 {
+		record.Journal = journal.Titles[0]
+	}
+	for _, issn := range journal.ISSNs {
+		value := strings.TrimSpace(issn.Value)
+		switch {
+		case issn.Format == "electronic" || issn.PubType == "epub":
+			record.ElectronicISSN = value
+		case record.ISSN == "":
+			record.ISSN = value
+		}
+	}
+
+	record.Authors = jatsAuthors(meta.ContribGroups, meta.Affs)
+
+	var date *jatsDateInput
+	for i, candidate := range meta.PubDates {
+		if candidate.Year != "" && (date == nil || candidate.rank() < date.rank()) {
+			date = &meta.PubDates[i]
+		}
+	}
+	if date != nil {
+		var err error
+		if record.Published, err = publicationDate(strings.TrimSpace(date.Year), strings.TrimSpace(date.Month), strings.TrimSpace(date.Day)); err != nil {
+			record.Err = err
+		}
+	}
+
+	// Graphical abstracts, teasers and the like have an abstract-type
+	for _, abstract := range meta.Abstracts {
+		if abstract.Type == "" {
+			record.Abstract = strings.Join(abstract.paragraphs(), "\n\n")
+			break
+		}
+	}
+
+	for _, list := range document.Back.RefLists {
+		for _, ref := range list.refs() {
+			record.References = append(record.References, ref.reference())
+		}
+	}
+	return []ImportRecord{record}, nil
+}
+
+// jatsAuthors reads the authors of the contributor groups. Affiliations
+// are linked by xref or nested in the contributor.
+func jatsAuthors(groups []jatsContribGroup, affs []jatsAffInput) []ImportAuthor {
+	affiliations := make(map[string]string)
+	for _, group := range groups {
+		affs = append(affs, group.Affs...)
+	}
+	for _, aff := range affs {
+		if aff.ID != "" {
+			affiliations[aff.ID] = aff.name()
+		}
+	}
+
+	var authors []ImportAuthor
+	for _, group := range groups {
+		for _, contrib := range group.Contribs {
+			if contrib.Type != "" && contrib.Type != "author" {
+				continue
+			}
+
+			var author ImportAuthor
+			switch names := append(contrib.Names, contrib.Alternatives...); {
+			case len(names) > 0:
+				author.Name = names[0].display()
+			case len(contrib.StringNames) > 0:
+				author.Name = displayName(contrib.StringNames[0].text())
+			case len(contrib.Collabs) > 0:
+				author.Name = contrib.Collabs[0].text()
+			}
+			for _, id := range contrib.IDs {
+				if id.Type == "orcid" {
+					author.ORCID = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(id.Value), "http://orcid.org/"), orcidURL)
+				}
+			}
+			author.Corresponding = contrib.Corresp == "yes"
+			for _, xref := range contrib.Xrefs {
+				switch xref.Type {
+				case "corresp":
+					author.Corresponding = true
+				case "aff":
+					// rid may list several affiliations; the first is kept
+					for _, rid := range strings.Fields(xref.RID) {
+						if author.Affiliation == "" {
+							author.Affiliation = affiliations[rid]
+						}
+					}
+				}
+			}
+			if author.Affiliation == "" && len(contrib.Affs) > 0 {
+				author.Affiliation = contrib.Affs[0].name()
+			}
+			authors = append(authors, author)
+		}
+	}
+	return authors
+}
+
+// jatsOutput is the document RenderJATS writes. Field order follows the
+// DTD's content models.
+type jatsOutput struct {
+	XMLName     xml.Name `xml:"article"`
+	ArticleType string   `xml:"article-type,attr"`
+	DTDVersion  string   `xml:"dtd-version,attr"`
+	Front       struct {
+		JournalMeta jatsJournalMeta `xml:"journal-meta"`
+		ArticleMeta jatsArticleMeta `xml:"article-meta"`
+	} `xml:"front"`
+	Back *jatsBack `xml:"back"`
+}
+
+type jatsJournalMeta struct {
+	JournalID struct {
+		Type  string `xml:"journal-id-type,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"journal-id"`
+	Title string     `xml:"journal-title-group>journal-title"`
+	ISSNs []jatsISSN `xml:"issn"`
+	ISSNL string     `xml:"issn-l,omitempty"`
+}
+
+type jatsISSN struct {
+	Format string `xml:"publication-format,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type jatsArticleMeta struct {
+	ArticleIDs []jatsPubIDOutput `xml:"article-id"`
+	Title      string            `xml:"title-group>article-title"`
+	Contribs   []jatsContrib     `xml:"contrib-group>contrib"`
+	Affs       []jatsAff         `xml:"aff"`
+	PubDate    *jatsDate         `xml:"pub-date"`
+	NoPubDate  *struct{}         `xml:"pub-date-not-available"`
+	Volume     string            `xml:"volume,omitempty"`
+	Issue      string            `xml:"issue,omitempty"`
+	FirstPage  string            `xml:"fpage,omitempty"`
+	LastPage   string            `xml:"lpage,omitempty"`
+	Abstract   *jatsAbstract     `xml:"abstract"`
+}
+
+type jatsAbstract struct {
+	Paragraphs []string `xml:"p"`
+}
+
+type jatsPubIDOutput struct {
+	Type  string `xml:"pub-id-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type jatsContrib struct {
+	Type      string         `xml:"contrib-type,attr"`
+	Corresp   string         `xml:"corresp,attr,omitempty"`
+	ContribID *jatsContribID `xml:"contrib-id"`
+	Name      struct {
+		Surname    string `xml:"surname"`
+		GivenNames string `xml:"given-names,omitempty"`
+	} `xml:"name"`
+	Xref *jatsXref `xml:"xref"`
+}
+
+type jatsContribID struct {
+	Type  string `xml:"contrib-id-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type jatsXref struct {
+	Type string `xml:"ref-type,attr"`
+	RID  string `xml:"rid,attr"`
+}
+
+type jatsAff struct {
+	ID          string `xml:"id,attr"`
+	Institution string `xml:"institution"`
+}
+
+type jatsDate struct {
+	Format   string `xml:"publication-format,attr"`
+	DateType string `xml:"date-type,attr"`
+	ISO      string `xml:"iso-8601-date,attr"`
+	Day      string `xml:"day"`
+	Month    string `xml:"month"`
+	Year     string `xml:"year"`
+}
+
+type jatsBack struct {
+	Title string    `xml:"ref-list>title"`
+	Refs  []jatsRef `xml:"ref-list>ref"`
+}
+
+type jatsRef struct {
+	ID string `xml:"id,attr"`
+	// Citation is written as escaped inner XML, since the encoder would
+	// indent the pub-id inside the mixed content
+	Citation struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"mixed-citation"`
+}
+
+// paragraphBreak separates the paragraphs of an abstract
+var paragraphBreak = regexp.MustCompile(`\n\s*\n`)
+
+// RenderJATS renders the record as a JATS article with front matter and,
+// when the record has references, a reference list. Published articles
+// carry their publication date and issue placement; others say that no
+// publication date is available.
+func RenderJATS(record BibliographicRecord) ([]byte, error) {
+	var document jatsOutput
+	document.ArticleType = "research-article"
+	document.DTDVersion = jatsDTDVersion
+
+	journal := &document.Front.JournalMeta
+	journal.JournalID.Type = "publisher-id"
+	journal.JournalID.Value = record.Journal.ID
+	journal.Title = record.Journal.Name
+	if record.Journal.PrintISSN != "" {
+		journal.ISSNs = append(journal.ISSNs, jatsISSN{Format: "print", Value: record.Journal.PrintISSN})
+	}
+	if record.Journal.ElectronicISSN != "" {
+		journal.ISSNs = append(journal.ISSNs, jatsISSN{Format: "electronic", Value: record.Journal.ElectronicISSN})
+	}
+	if len(journal.ISSNs) > 1 {
+		journal.ISSNL = record.Journal.ISSNL
+	}
+
+	meta := &document.Front.ArticleMeta
+	meta.ArticleIDs = append(meta.ArticleIDs, jatsPubIDOutput{Type: "publisher-id", Value: record.Article.ID})
+	if record.Article.DOI != "" {
+		meta.ArticleIDs = append(meta.ArticleIDs, jatsPubIDOutput{Type: "doi", Value: record.Article.DOI})
+	}
+	meta.Title = collapseSpace(record.Article.Title)
+
+	affiliations := make(map[string]string)
+	for i, author := range record.Authors {
+		var contrib jatsContrib
+		contrib.Type = "author"
+		given, surname := splitDisplayName(author.Name)
+		contrib.Name.Surname, contrib.Name.GivenNames = surname, given
+
+		// The byline carries the ORCID iD and affiliation the article was
+		// submitted with, which may differ from the author's current ones
+		byline := ArticleAuthor{ORCID: author.ORCID, Affiliation: author.Affiliation}
+		if i < len(record.Article.Authors) {
+			byline = record.Article.Authors[i]
+		}
+		if byline.ORCID != "" {
+			contrib.ContribID = &jatsContribID{Type: "orcid", Value: orcidURL + byline.ORCID}
+		}
+		if byline.Corresponding {
+			contrib.Corresp = "yes"
+		}
+		if affiliation := collapseSpace(byline.Affiliation); affiliation != "" {
+			id, ok := affiliations[affiliation]
+			if !ok {
+				id = fmt.Sprintf("aff%d", len(affiliations)+1)
+				affiliations[affiliation] = id
+				meta.Affs = append(meta.Affs, jatsAff{ID: id, Institution: affiliation})
+			}
+			contrib.Xref = &jatsXref{Type: "aff", RID: id}
+		}
+		meta.Contribs = append(meta.Contribs, contrib)
+	}
+
+	if record.published() {
+		published := record.Article.PublishedAt.UTC()
+		meta.PubDate = &jatsDate{
+			Format:   "electronic",
+			DateType: "pub",
+			ISO:      published.Format("2006-01-02"),
+			Day:      fmt.Sprintf("%02d", published.Day()),
+			Month:    fmt.Sprintf("%02d", published.Month()),
+			Year:     fmt.Sprint(published.Year()),
+		}
+		if record.Issue != nil {
+			if record.Issue.Volume > 0 {
+				meta.Volume = fmt.Sprint(record.Issue.Volume)
+			}
+			meta.Issue = fmt.Sprint(record.Issue.Number)
+		}
+		if record.Placement != nil && record.Placement.HasPages() {
+			meta.FirstPage = fmt.Sprint(record.Placement.FirstPage)
+			if record.Placement.LastPage > 0 {
+				meta.LastPage = fmt.Sprint(record.Placement.LastPage)
+			}
+		}
+	} else {
+		meta.NoPubDate = &struct{}{}
+	}
+
+	var paragraphs []string
+	for _, paragraph := range paragraphBreak.Split(strings.TrimSpace(record.Article.Abstract), -1) {
+		if paragraph = collapseSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+	if len(paragraphs) > 0 {
+		meta.Abstract = &jatsAbstract{Paragraphs: paragraphs}
+	}
+
+	if len(record.References) > 0 {
+		document.Back = &jatsBack{Title: "References"}
+		for _, reference := range record.References {
+			var citation bytes.Buffer
+			xml.EscapeText(&citation, []byte(collapseSpace(reference.Text)))
+			if reference.DOI != "" {
+				if citation.Len() > 0 {
+					citation.WriteByte(' ')
+				}
+				citation.WriteString(`<pub-id pub-id-type="doi">`)
+				xml.EscapeText(&citation, []byte(reference.DOI))
+				citation.WriteString(`</pub-id>`)
+			}
+			ref := jatsRef{ID: fmt.Sprintf("ref%d", reference.Position)}
+			ref.Citation.Inner = citation.String()
+			document.Back.Refs = append(document.Back.Refs, ref)
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(xml.Header)
+	out.WriteString(jatsDoctype + "\n")
+	encoder := xml.NewEncoder(&out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("failed to encode JATS document: %w", err)
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
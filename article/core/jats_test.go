@@ -0,0 +1,137 @@
+package core_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// jatsRoundTripDiff lists the fields a rendered document reads differently
+func jatsRoundTripDiff(original, rendered core.ImportRecord) []string {
+	var diff []string
+	compare := func(field string, a, b any) {
+		if !reflect.DeepEqual(a, b) {
+			diff = append(diff, fmt.Sprintf("%s %v became %v", field, a, b))
+		}
+	}
+	compare("title", original.Title, rendered.Title)
+	compare("abstract", original.Abstract, rendered.Abstract)
+	compare("DOI", original.DOI, rendered.DOI)
+	compare("journal", original.Journal, rendered.Journal)
+	compare("ISSNs", [2]string{original.ISSN, original.ElectronicISSN}, [2]string{rendered.ISSN, rendered.ElectronicISSN})
+	compare("published", original.Published, rendered.Published)
+	compare("authors", original.Authors, rendered.Authors)
+	compare("references", original.References, rendered.References)
+	return diff
+}
+
+func TestJATSRoundTrip(t *testing.T) {
+	tests := []struct {
+		file string
+		// journal is registered before the import, so the rendered
+		// document has the journal metadata of the original
+		journal    core.JournalInfo
+		title      string
+		published  string
+		authors    []core.ImportAuthor
+		references []core.Reference
+		// warnings are the reference warnings of the import report
+		warnings int
+	}{
+		{
+			file:      "publisher.xml",
+			journal:   core.JournalInfo{ID: "journal_2", Name: "Nature", PrintISSN: "0028-0836", ElectronicISSN: "1476-4687"},
+			title:     "Sparse Codes in Deep Belief Nets",
+			published: "2024-02-14",
+			authors: []core.ImportAuthor{
+				{Name: "Josiah Carberry", ORCID: "0000-0002-1825-0097", Affiliation: "Brown University, Providence, RI, USA", Corresponding: true},
+				{Name: "Simon Osindero", Affiliation: "University of Toronto"},
+			},
+			references: []core.Reference{
+				{DOI: "10.5555/demo.2006.1", Text: "Hinton GE, Osindero S. A fast learning algorithm for deep belief nets. Nature. 2006."},
+				{DOI: "10.5555/demo.1959.6", Text: "Erdős P, Rényi A. On random graphs I. Publ Math. 1959;6:290-297."},
+				{Text: "Personal communication, 2023."},
+			},
+		},
+		{
+			file:      "nlm.xml",
+			journal:   core.JournalInfo{ID: "journal_2", Name: "Philosophical Transactions", PrintISSN: "0261-0523"},
+			title:     "Notes on the Analytical Engine",
+			published: "1843-01-01",
+			authors: []core.ImportAuthor{
+				{Name: "Ada Lovelace", Affiliation: "Royal Society, London"},
+				{Name: "Seki Takakazu", Affiliation: "Edo Academy", Corresponding: true},
+			},
+			references: []core.Reference{
+				{Text: "Menabrea LF, Royal Society. Bibliothèque universelle de Genève. 1842;41:352."},
+				{DOI: "10.5555/economy.1832", Text: "Babbage C. On the economy of machinery. https://doi.org/10.5555/Economy.1832"},
+			},
+			warnings: 1,
+		},
+	}
+
+	for _, test := range tests {
+		data, err := os.ReadFile(filepath.Join("testdata", "jats", test.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := core.ParseImport(core.ImportJATS, data)
+		if err != nil {
+			t.Fatalf("%s: ParseImport() = %v", test.file, err)
+		}
+		original := records[0]
+		if original.Err != nil || original.Title != test.title || original.Published.Format("2006-01-02") != test.published {
+			t.Errorf("%s: record = %+v", test.file, original)
+		}
+		if !reflect.DeepEqual(original.Authors, test.authors) {
+			t.Errorf("%s: authors = %+v, want %+v", test.file, original.Authors, test.authors)
+		}
+
+		f := newFixture(t, test.journal)
+		report, err := core.NewImportService(f.service, f.journals).Import(data, core.ImportOptions{Format: core.ImportJATS})
+		if err != nil {
+			t.Fatalf("%s: Import() = %v", test.file, err)
+		}
+		result := report.Results[0]
+		if result.Outcome != core.ImportImported || result.References != len(test.references) || len(result.Warnings) != test.warnings {
+			t.Fatalf("%s: import result = %+v", test.file, result)
+		}
+
+		stored, err := f.service.ListReferences(result.ArticleID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range stored {
+			stored[i].CitingArticleID, stored[i].Position = "", 0
+		}
+		if !reflect.DeepEqual(stored, test.references) {
+			t.Errorf("%s: stored references = %+v, want %+v", test.file, stored, test.references)
+		}
+
+		entry, err := core.NewExportService(f.service).ExportArticle(result.ArticleID, core.ExportJATS)
+		if err != nil {
+			t.Fatalf("%s: ExportArticle() = %v", test.file, err)
+		}
+		rendered, err := core.ParseImport(core.ImportJATS, entry.Content)
+		if err != nil {
+			t.Fatalf("%s: ParseImport() of the rendered document = %v", test.file, err)
+		}
+
+		// The DOI is stored normalized and the empty reference not at all
+		want := original
+		want.DOI, want.References = core.NormalizeDOI(original.DOI), nil
+		for _, reference := range original.References {
+			if reference.DOI != "" || reference.Text != "" {
+				want.References = append(want.References, reference)
+			}
+		}
+		if diff := jatsRoundTripDiff(want, rendered[0]); len(diff) > 0 {
+			t.Errorf("%s: round trip changed the article: %s", test.file, strings.Join(diff, "; "))
+		}
+	}
+}
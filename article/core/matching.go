@@ -0,0 +1,225 @@
+package core
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ConflictReason explains why a reviewer may not review an article
+type ConflictReason string
+
+const (
+	ConflictSubmittingAuthor ConflictReason = "submitting_author"
+	ConflictCoAuthor         ConflictReason = "co_author"
+	ConflictSameAffiliation  ConflictReason = "same_affiliation"
+)
+
+// ReviewerCandidate is a reviewer together with the articles they authored
+type ReviewerCandidate struct {
+	Reviewer Reviewer
+	Articles []Article
+}
+
+// ReviewerMatch is a conflict-free reviewer ranked by topical similarity
+type ReviewerMatch struct {
+	Reviewer Reviewer `json:"reviewer"`
+	// Score is the cosine similarity between the submission and the
+	// reviewer's past articles, from 0 to 1
+	Score float64 `json:"score"`
+	// SharedTerms are the terms that contributed most to the score
+	SharedTerms []string `json:"shared_terms"`
+}
+
+// ReviewerConflict is a reviewer excluded from matching
+type ReviewerConflict struct {
+	Reviewer Reviewer         `json:"reviewer"`
+	Reasons  []ConflictReason `json:"reasons"`
+}
+
+type ReviewerSuggestions struct {
+	Matches   []ReviewerMatch    `json:"matches"`
+	Conflicts []ReviewerConflict `json:"conflicts"`
+}
+
+// Submission describes the article being matched and the people behind it
+type Submission struct {
+	Article      Article
+	Affiliations []string
+}
+
+const maxSharedTerms = 5
+
+// MatchReviewers ranks candidates by the similarity of their past articles to
+// the submission's title and abstract. Conflicted candidates are reported
+// separately. The result depends only on the input: ties are broken by
+// reviewer ID, and limit <= 0 returns every match.
+func MatchReviewers(submission Submission, candidates []ReviewerCandidate, limit int) ReviewerSuggestions {
+	submissionVector := termVector(submission.Article.Title + " " + submission.Article.Abstract)
+	authors := submission.Article.AuthorIDs()
+
+	var suggestions ReviewerSuggestions
+	for _, candidate := range candidates {
+		if reasons := conflictsOf(candidate, authors, submission.Affiliations, submission.Article.ID); len(reasons) > 0 {
+			suggestions.Conflicts = append(suggestions.Conflicts, ReviewerConflict{Reviewer: candidate.Reviewer, Reasons: reasons})
+			continue
+		}
+
+		var profile strings.Builder
+		for _, article := range candidate.Articles {
+			if article.ID == submission.Article.ID {
+				continue
+			}
+			profile.WriteString(article.Title + " " + article.Abstract + " ")
+		}
+		score, shared := cosineSimilarity(submissionVector, termVector(profile.String()))
+		suggestions.Matches = append(suggestions.Matches, ReviewerMatch{Reviewer: candidate.Reviewer, Score: score, SharedTerms: shared})
+	}
+
+	sort.SliceStable(suggestions.Matches, func(i, j int) bool {
+		a, b := suggestions.Matches[i], suggestions.Matches[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Reviewer.ID < b.Reviewer.ID
+	})
+	sort.SliceStable(suggestions.Conflicts, func(i, j int) bool {
+		return suggestions.Conflicts[i].Reviewer.ID < suggestions.Conflicts[j].Reviewer.ID
+	})
+
+	if limit > 0 && len(suggestions.Matches) > limit {
+		suggestions.Matches = suggestions.Matches[:limit]
+	}
+	return suggestions
+}
+
+func conflictsOf(candidate ReviewerCandidate, submissionAuthors, submissionAffiliations []string, submissionID string) []ConflictReason {
+	var reasons []ConflictReason
+	reviewer := candidate.Reviewer
+
+	if reviewer.AuthorID != "" && containsString(submissionAuthors, reviewer.AuthorID) {
+		reasons = append(reasons, ConflictSubmittingAuthor)
+	}
+
+	for _, article := range candidate.Articles {
+		if article.ID == submissionID {
+			continue
+		}
+		coAuthored := false
+		for _, author := range article.AuthorIDs() {
+			if author != reviewer.AuthorID && containsString(submissionAuthors, author) {
+				coAuthored = true
+				break
+			}
+		}
+		if coAuthored {
+			reasons = append(reasons, ConflictCoAuthor)
+			break
+		}
+	}
+
+	if affiliation := normalizeAffiliation(reviewer.Affiliation); affiliation != "" {
+		for _, other := range submissionAffiliations {
+			if normalizeAffiliation(other) == affiliation {
+				reasons = append(reasons, ConflictSameAffiliation)
+				break
+			}
+		}
+	}
+
+	return reasons
+}
+
+func normalizeAffiliation(affiliation string) string {
+	return strings.Join(strings.Fields(strings.ToLower(affiliation)), " ")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// stopWords are dropped before scoring because they carry no topic
+var stopWords = map[string]bool{
+	"about": true, "all": true, "also": true, "and": true, "are": true, "based": true,
+	"between": true, "but": true, "can": true, "for": true, "from": true, "has": true,
+	"have": true, "its": true, "into": true, "new": true, "not": true, "our": true,
+	"over": true, "paper": true, "such": true, "than": true, "that": true, "the": true,
+	"their": true, "these": true, "this": true, "using": true, "was": true, "were": true,
+	"which": true, "while": true, "with": true, "within": true,
+}
+
+// termVector counts the topical terms in text
+func termVector(text string) map[string]float64 {
+	vector := make(map[string]float64)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len([]rune(word)) < 3 || stopWords[word] {
+			continue
+		}
+		vector[word]++
+	}
+	return vector
+}
+
+// cosineSimilarity returns the cosine of the angle between two term vectors
+// and the shared terms ordered by their contribution. Terms are visited in
+// sorted order so floating point sums are reproducible.
+func cosineSimilarity(a, b map[string]float64) (float64, []string) {
+	if len(a) == 0 || len(b) == 0 {
+		return 0, nil
+	}
+
+	type contribution struct {
+		term  string
+		value float64
+	}
+	var shared []contribution
+	var dot float64
+	for _, term := range sortedTerms(a) {
+		if weight, ok := b[term]; ok {
+			value := a[term] * weight
+			dot += value
+			shared = append(shared, contribution{term: term, value: value})
+		}
+	}
+	if dot == 0 {
+		return 0, nil
+	}
+
+	score := dot / (vectorNorm(a) * vectorNorm(b))
+
+	sort.SliceStable(shared, func(i, j int) bool { return shared[i].value > shared[j].value })
+	if len(shared) > maxSharedTerms {
+		shared = shared[:maxSharedTerms]
+	}
+	terms := make([]string, len(shared))
+	for i, c := range shared {
+		terms[i] = c.term
+	}
+	return score, terms
+}
+
+func vectorNorm(vector map[string]float64) float64 {
+	var sum float64
+	for _, term := range sortedTerms(vector) {
+		sum += vector[term] * vector[term]
+	}
+	return math.Sqrt(sum)
+}
+
+func sortedTerms(vector map[string]float64) []string {
+	terms := make([]string, 0, len(vector))
+	for term := range vector {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	return terms
+}
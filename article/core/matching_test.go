@@ -0,0 +1,174 @@
+package core_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func authoredBy(id, title, abstract string, authorIDs ...string) core.Article {
+	article := core.Article{ID: id, Title: title, Abstract: abstract}
+	for _, authorID := range authorIDs {
+		article.Authors = append(article.Authors, core.ArticleAuthor{AuthorID: authorID})
+	}
+	return article
+}
+
+func matchingSubmission() core.Submission {
+	return core.Submission{
+		// Terms: graph 2, colouring 1, algorithms 1; "the" and "for" are stop
+		// words and "of" is too short
+		Article:      authoredBy("s1", "Graph colouring", "The algorithms for graph of", "author_1"),
+		Affiliations: []string{"Brown University"},
+	}
+}
+
+func TestMatchReviewersScores(t *testing.T) {
+	candidates := []core.ReviewerCandidate{
+		{Reviewer: core.Reviewer{ID: "r-protein"}, Articles: []core.Article{authoredBy("p1", "Protein folding", "", "author_9")}},
+		{Reviewer: core.Reviewer{ID: "r-graph"}, Articles: []core.Article{authoredBy("g1", "Graph", "", "author_8")}},
+		{Reviewer: core.Reviewer{ID: "r-algorithms"}, Articles: []core.Article{authoredBy("a1", "Graph algorithms", "", "author_7")}},
+		{Reviewer: core.Reviewer{ID: "r-new"}},
+	}
+
+	got := core.MatchReviewers(matchingSubmission(), candidates, 0)
+
+	want := []struct {
+		id     string
+		score  float64
+		shared []string
+	}{
+		// (2*1 + 1*1) / (sqrt(6) * sqrt(2))
+		{"r-algorithms", 3 / math.Sqrt(12), []string{"graph", "algorithms"}},
+		// 2*1 / (sqrt(6) * 1)
+		{"r-graph", 2 / math.Sqrt(6), []string{"graph"}},
+		// Unrelated and empty profiles score 0 and are ordered by ID
+		{"r-new", 0, nil},
+		{"r-protein", 0, nil},
+	}
+	if len(got.Matches) != len(want) {
+		t.Fatalf("got %d matches, want %d", len(got.Matches), len(want))
+	}
+	for i, w := range want {
+		match := got.Matches[i]
+		if match.Reviewer.ID != w.id || math.Abs(match.Score-w.score) > 1e-12 || !reflect.DeepEqual(match.SharedTerms, w.shared) {
+			t.Errorf("match %d = %s %.6f %v, want %s %.6f %v", i, match.Reviewer.ID, match.Score, match.SharedTerms, w.id, w.score, w.shared)
+		}
+	}
+	if len(got.Conflicts) != 0 {
+		t.Errorf("conflicts = %+v, want none", got.Conflicts)
+	}
+}
+
+func TestMatchReviewersIsDeterministic(t *testing.T) {
+	candidates := []core.ReviewerCandidate{
+		{Reviewer: core.Reviewer{ID: "r3"}, Articles: []core.Article{authoredBy("x3", "Graph colouring bounds", "", "author_3")}},
+		{Reviewer: core.Reviewer{ID: "r1"}, Articles: []core.Article{authoredBy("x1", "Graph colouring bounds", "", "author_4")}},
+		{Reviewer: core.Reviewer{ID: "r2"}, Articles: []core.Article{authoredBy("x2", "Colouring algorithms on graph minors", "", "author_5")}},
+	}
+	reversed := []core.ReviewerCandidate{candidates[2], candidates[1], candidates[0]}
+
+	first := core.MatchReviewers(matchingSubmission(), candidates, 0)
+	second := core.MatchReviewers(matchingSubmission(), reversed, 0)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("results depend on the candidate order:\n%+v\n%+v", first, second)
+	}
+	// r2 shares the most terms; r1 and r3 have identical profiles, so their
+	// tie goes by ID
+	var order []string
+	for _, match := range first.Matches {
+		order = append(order, match.Reviewer.ID)
+	}
+	if want := []string{"r2", "r1", "r3"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestMatchReviewersConflicts(t *testing.T) {
+	candidates := []core.ReviewerCandidate{
+		// The reviewer wrote the submission
+		{Reviewer: core.Reviewer{ID: "r-author", AuthorID: "author_1"}},
+		// The reviewer published with an author of the submission, and works
+		// at the same institution written differently
+		{
+			Reviewer: core.Reviewer{ID: "r-colleague", AuthorID: "author_2", Affiliation: "  brown   UNIVERSITY "},
+			Articles: []core.Article{authoredBy("c1", "Graph minors", "", "author_2", "author_1")},
+		},
+		{Reviewer: core.Reviewer{ID: "r-neighbour", Affiliation: "Brown University"}},
+		{Reviewer: core.Reviewer{ID: "r-free", AuthorID: "author_3", Affiliation: "MIT"}},
+	}
+
+	got := core.MatchReviewers(matchingSubmission(), candidates, 0)
+
+	if len(got.Matches) != 1 || got.Matches[0].Reviewer.ID != "r-free" {
+		t.Errorf("matches = %+v, want only r-free", got.Matches)
+	}
+	want := []core.ReviewerConflict{
+		{Reviewer: candidates[0].Reviewer, Reasons: []core.ConflictReason{core.ConflictSubmittingAuthor}},
+		{Reviewer: candidates[1].Reviewer, Reasons: []core.ConflictReason{core.ConflictCoAuthor, core.ConflictSameAffiliation}},
+		{Reviewer: candidates[2].Reviewer, Reasons: []core.ConflictReason{core.ConflictSameAffiliation}},
+	}
+	if !reflect.DeepEqual(got.Conflicts, want) {
+		t.Errorf("conflicts = %+v, want %+v", got.Conflicts, want)
+	}
+}
+
+func TestMatchReviewersIgnoresTheSubmissionInProfiles(t *testing.T) {
+	submission := matchingSubmission()
+	// A reviewer listed as co-author of the submission itself is caught as
+	// its author, not through their profile
+	candidate := core.ReviewerCandidate{
+		Reviewer: core.Reviewer{ID: "r1", AuthorID: "author_5"},
+		Articles: []core.Article{submission.Article, authoredBy("p1", "Protein folding", "", "author_5")},
+	}
+
+	got := core.MatchReviewers(submission, []core.ReviewerCandidate{candidate}, 0)
+	if len(got.Conflicts) != 0 {
+		t.Fatalf("conflicts = %+v, want none", got.Conflicts)
+	}
+	if len(got.Matches) != 1 || got.Matches[0].Score != 0 {
+		t.Errorf("matches = %+v, want r1 scored 0", got.Matches)
+	}
+}
+
+func TestMatchReviewersLimit(t *testing.T) {
+	var candidates []core.ReviewerCandidate
+	for _, id := range []string{"r1", "r2", "r3"} {
+		candidates = append(candidates, core.ReviewerCandidate{Reviewer: core.Reviewer{ID: id}})
+	}
+
+	if got := core.MatchReviewers(matchingSubmission(), candidates, 2); len(got.Matches) != 2 {
+		t.Errorf("limit 2 returned %d matches", len(got.Matches))
+	}
+	if got := core.MatchReviewers(matchingSubmission(), candidates, 0); len(got.Matches) != 3 {
+		t.Errorf("limit 0 returned %d matches, want all 3", len(got.Matches))
+	}
+}
+
+func TestSuggestReviewersLeavesOutAssignedReviewers(t *testing.T) {
+	f := newReviewFixture(t)
+	for _, reviewer := range []core.Reviewer{
+		{ID: "r2", Name: "Alan Turing", Email: "alan@example.com"},
+		{ID: "r3", Name: "Josiah Carberry", Email: "josiah@example.com", AuthorID: "author_1"},
+	} {
+		if _, err := f.reviews.RegisterReviewer(reviewer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.reviews.AssignReviewer("a1", "r1", timeInAWeek()); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := f.reviews.SuggestReviewers("a1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Matches) != 1 || got.Matches[0].Reviewer.ID != "r2" {
+		t.Errorf("matches = %+v, want only r2", got.Matches)
+	}
+	if len(got.Conflicts) != 1 || got.Conflicts[0].Reviewer.ID != "r3" {
+		t.Errorf("conflicts = %+v, want r3", got.Conflicts)
+	}
+}
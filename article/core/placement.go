@@ -0,0 +1,143 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ArticlePlacement puts an article into an issue of its journal. Sequence
+// orders the articles within the issue; the page range is optional until the
+// issue is paginated.
+type ArticlePlacement struct {
+	ArticleID string    `json:"article_id"`
+	IssueID   string    `json:"issue_id"`
+	Sequence  int       `json:"sequence"`
+	FirstPage int       `json:"first_page,omitempty"`
+	LastPage  int       `json:"last_page,omitempty"`
+	PlacedAt  time.Time `json:"placed_at"`
+}
+
+// Validate checks if the placement data is valid
+func (p ArticlePlacement) Validate() error {
+	if strings.TrimSpace(p.ArticleID) == "" {
+		return fmt.Errorf("%w: article ID cannot be empty", ErrInvalidPlacement)
+	}
+
+	if strings.TrimSpace(p.IssueID) == "" {
+		return fmt.Errorf("%w: issue ID cannot be empty", ErrInvalidPlacement)
+	}
+
+	if p.Sequence < 1 {
+		return fmt.Errorf("%w: sequence must be positive", ErrInvalidPlacement)
+	}
+
+	if p.FirstPage == 0 && p.LastPage == 0 {
+		return nil
+	}
+	if p.FirstPage < 1 || p.LastPage < p.FirstPage {
+		return fmt.Errorf("%w: invalid page range %d-%d", ErrInvalidPlacement, p.FirstPage, p.LastPage)
+	}
+
+	return nil
+}
+
+// HasPages reports whether the placement has a page range
+func (p ArticlePlacement) HasPages() bool {
+	return p.FirstPage > 0
+}
+
+// overlaps reports whether both placements have page ranges that share a page
+func (p ArticlePlacement) overlaps(other ArticlePlacement) bool {
+	if !p.HasPages() || !other.HasPages() {
+		return false
+	}
+	return p.FirstPage <= other.LastPage && other.FirstPage <= p.LastPage
+}
+
+// PlaceArticle assigns an accepted or published article to an unpublished
+// issue of its journal, replacing any earlier placement. The sequence must
+// be free in the issue and the page range must not overlap other articles.
+func (s *ArticleService) PlaceArticle(placement ArticlePlacement) (ArticlePlacement, error) {
+	if err := placement.Validate(); err != nil {
+		return ArticlePlacement{}, err
+	}
+
+	article, err := s.repository.GetArticleByID(placement.ArticleID)
+	if err != nil {
+		return ArticlePlacement{}, err
+	}
+	if article.Status != StatusAccepted && article.Status != StatusPublished {
+		return ArticlePlacement{}, fmt.Errorf("%w: article %s is %s", ErrPlacementNotAllowed, article.ID, article.Status)
+	}
+
+	issue, err := s.journals.GetIssue(placement.IssueID)
+	if err != nil {
+		if errors.Is(err, ErrIssueNotFound) {
+			return ArticlePlacement{}, err
+		}
+		return ArticlePlacement{}, fmt.Errorf("failed to look up issue %s: %w", placement.IssueID, err)
+	}
+	if issue.JournalID != article.JournalID {
+		return ArticlePlacement{}, fmt.Errorf("%w: issue %s belongs to journal %s, not %s",
+			ErrInvalidPlacement, issue.ID, issue.JournalID, article.JournalID)
+	}
+	if issue.Published {
+		return ArticlePlacement{}, fmt.Errorf("%w: issue %s is already published", ErrPlacementNotAllowed, issue.ID)
+	}
+
+	placed, err := s.repository.ListIssuePlacements(placement.IssueID)
+	if err != nil {
+		return ArticlePlacement{}, err
+	}
+	for _, other := range placed {
+		if other.ArticleID == placement.ArticleID {
+			continue
+		}
+		if other.Sequence == placement.Sequence {
+			return ArticlePlacement{}, fmt.Errorf("%w: position %d is taken by article %s",
+				ErrInvalidPlacement, placement.Sequence, other.ArticleID)
+		}
+		if placement.overlaps(other) {
+			return ArticlePlacement{}, fmt.Errorf("%w: pages %d-%d overlap article %s",
+				ErrInvalidPlacement, placement.FirstPage, placement.LastPage, other.ArticleID)
+		}
+	}
+
+	before, err := s.repository.GetPlacement(placement.ArticleID)
+	if err != nil && !errors.Is(err, ErrPlacementNotFound) {
+		return ArticlePlacement{}, err
+	}
+
+	placement.PlacedAt = time.Now().UTC()
+	event, err := NewEvent(EventArticlePlaced, placement.ArticleID, placement)
+	if err != nil {
+		return ArticlePlacement{}, err
+	}
+
+	var previous any
+	if before.IssueID != "" {
+		previous = before
+	}
+	audit, err := s.auditEvent(AuditPlaceArticle, placement.ArticleID, previous, placement)
+	if err != nil {
+		return ArticlePlacement{}, err
+	}
+
+	if err := s.repository.SavePlacement(placement, event, audit); err != nil {
+		return ArticlePlacement{}, err
+	}
+	return placement, nil
+}
+
+// GetPlacement returns the issue placement of an article
+func (s *ArticleService) GetPlacement(articleID string) (ArticlePlacement, error) {
+	return s.repository.GetPlacement(articleID)
+}
+
+// ListIssueArticles returns the placements of an issue in table of contents
+// order
+func (s *ArticleService) ListIssueArticles(issueID string) ([]ArticlePlacement, error) {
+	return s.repository.ListIssuePlacements(issueID)
+}
@@ -0,0 +1,93 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestPlaceArticlesInIssue(t *testing.T) {
+	f := newFixture(t).withIssues(t)
+
+	for _, placement := range []core.ArticlePlacement{
+		{ArticleID: "a2", IssueID: "i1", Sequence: 2, FirstPage: 11, LastPage: 20},
+		{ArticleID: "a1", IssueID: "i1", Sequence: 1, FirstPage: 1, LastPage: 10},
+		{ArticleID: "a3", IssueID: "i1", Sequence: 3},
+	} {
+		if _, err := f.service.PlaceArticle(placement); err != nil {
+			t.Fatalf("PlaceArticle(%s): %v", placement.ArticleID, err)
+		}
+	}
+
+	contents, err := f.service.ListIssueArticles("i1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contents) != 3 || contents[0].ArticleID != "a1" || contents[1].ArticleID != "a2" || contents[2].ArticleID != "a3" {
+		t.Errorf("ListIssueArticles() = %+v, want a1, a2, a3", contents)
+	}
+
+	// Placing an article again moves it
+	if _, err := f.service.PlaceArticle(core.ArticlePlacement{ArticleID: "a3", IssueID: "i2", Sequence: 1}); err != nil {
+		t.Fatal(err)
+	}
+	placement, err := f.service.GetPlacement("a3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placement.IssueID != "i2" {
+		t.Errorf("GetPlacement(a3) = %+v, want issue i2", placement)
+	}
+	if contents, err := f.service.ListIssueArticles("i1"); err != nil || len(contents) != 2 {
+		t.Errorf("ListIssueArticles(i1) after the move = %+v, %v", contents, err)
+	}
+
+	placements := 0
+	for _, operation := range auditOperations(f.auditEntries(t)) {
+		if operation == core.AuditPlaceArticle {
+			placements++
+		}
+	}
+	if placements != 4 {
+		t.Errorf("got %d placement audit records, want 4", placements)
+	}
+}
+
+func TestPlaceArticleIsChecked(t *testing.T) {
+	f := newFixture(t).withIssues(t)
+	if _, err := f.service.PlaceArticle(core.ArticlePlacement{ArticleID: "a1", IssueID: "i1", Sequence: 1, FirstPage: 1, LastPage: 10}); err != nil {
+		t.Fatal(err)
+	}
+	f.create(t, newArticle("draft", "Unfinished"))
+
+	tests := []struct {
+		name      string
+		placement core.ArticlePlacement
+		want      error
+	}{
+		{"taken position", core.ArticlePlacement{ArticleID: "a2", IssueID: "i1", Sequence: 1}, core.ErrInvalidPlacement},
+		{"overlapping pages", core.ArticlePlacement{ArticleID: "a2", IssueID: "i1", Sequence: 2, FirstPage: 10, LastPage: 12}, core.ErrInvalidPlacement},
+		{"backwards pages", core.ArticlePlacement{ArticleID: "a2", IssueID: "i1", Sequence: 2, FirstPage: 12, LastPage: 11}, core.ErrInvalidPlacement},
+		{"no sequence", core.ArticlePlacement{ArticleID: "a2", IssueID: "i1"}, core.ErrInvalidPlacement},
+		{"other journal", core.ArticlePlacement{ArticleID: "a2", IssueID: "other", Sequence: 1}, core.ErrInvalidPlacement},
+		{"published issue", core.ArticlePlacement{ArticleID: "a2", IssueID: "old", Sequence: 1}, core.ErrPlacementNotAllowed},
+		{"draft article", core.ArticlePlacement{ArticleID: "draft", IssueID: "i1", Sequence: 2}, core.ErrPlacementNotAllowed},
+		{"unknown issue", core.ArticlePlacement{ArticleID: "a2", IssueID: "i9", Sequence: 1}, core.ErrIssueNotFound},
+		{"unknown article", core.ArticlePlacement{ArticleID: "a9", IssueID: "i1", Sequence: 2}, core.ErrArticleNotFound},
+	}
+
+	for _, test := range tests {
+		if _, err := f.service.PlaceArticle(test.placement); !errors.Is(err, test.want) {
+			t.Errorf("%s: PlaceArticle() = %v, want %v", test.name, err, test.want)
+		}
+	}
+
+	// The article keeps its own position and pages when placed again
+	if _, err := f.service.PlaceArticle(core.ArticlePlacement{ArticleID: "a1", IssueID: "i1", Sequence: 1, FirstPage: 1, LastPage: 12}); err != nil {
+		t.Errorf("PlaceArticle() over its own placement: %v", err)
+	}
+	if _, err := f.service.GetPlacement("a2"); !errors.Is(err, core.ErrPlacementNotFound) {
+		t.Errorf("GetPlacement(a2) = %v, want ErrPlacementNotFound", err)
+	}
+}
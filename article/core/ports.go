@@ -1,7 +1,246 @@
 package core
 
 type ArticleRepository interface {
-	CreateArticle(article Article) (Article, error)
+	// CreateArticle stores the article together with the given events. With
+	// uniqueTitle set it returns ErrDuplicateTitle when another article of
+	// the journal has the same NormalizeTitle key.
+	CreateArticle(article Article, uniqueTitle bool, events ...Event) (Article, error)
 	GetArticleByID(id string) (Article, error)
-	GetArticleByTitle(title string) (Article, error)
+	// ListArticlesByTitle returns up to limit articles whose NormalizeTitle
+	// key is the given one, of one journal when journalID is set, ordered by
+	// ID and starting at offset. The page's total counts all of them.
+	ListArticlesByTitle(key, journalID string, offset, limit int) (ArticlePage, error)
+	// ListTitleCandidates returns up to limit articles sharing the most
+	// TitleTrigrams with the normalized title key, most shared first
+	ListTitleCandidates(key string, limit int) ([]Article, error)
+	// GetArticleByDOI returns ErrArticleNotFound when no article has the DOI
+	GetArticleByDOI(doi string) (Article, error)
+	ListArticlesByAuthor(authorID string) ([]Article, error)
+	ListArticlesByJournal(journalID string) ([]Article, error)
+	// UpdateArticle replaces the stored article together with the given
+	// events. uniqueTitle is checked as in CreateArticle; without it, an
+	// article keeping its title key and journal stays as unique as it was.
+	UpdateArticle(article Article, uniqueTitle bool, events ...Event) (Article, error)
+	// ReplaceArticleAuthors applies all changes together with the given
+	// events, or none of them. It returns ErrAuthorListChanged when a stored
+	// list no longer equals the change's Before list.
+	ReplaceArticleAuthors(changes []AuthorListChange, events ...Event) error
+	// UpdateArticleStatus stores the new status and appends the transition
+	// to the article's history together with the given events
+	UpdateArticleStatus(article Article, transition StatusTransition, events ...Event) (Article, error)
+	GetStatusHistory(articleID string) ([]StatusTransition, error)
+	// SavePlacement stores the article's placement, replacing an earlier
+	// one, together with the given events
+	SavePlacement(placement ArticlePlacement, events ...Event) error
+	GetPlacement(articleID string) (ArticlePlacement, error)
+	// ListIssuePlacements returns the issue's placements ordered by sequence
+	ListIssuePlacements(issueID string) ([]ArticlePlacement, error)
+	// ReplaceReferences stores the article's new reference list and adjusts
+	// the citation counts of the articles cited before and after, together
+	// with the given events
+	ReplaceReferences(articleID string, references []Reference, events ...Event) error
+	// ListReferences returns the article's references ordered by position
+	ListReferences(articleID string) ([]Reference, error)
+	// ListReferencesOf returns the references of all the given articles,
+	// ordered by citing article ID and position
+	ListReferencesOf(articleIDs []string) ([]Reference, error)
+	// ListCitedBy returns the references citing the article, ordered by
+	// citing article ID
+	ListCitedBy(articleID string) ([]Reference, error)
+	// ListCitedByDOI returns the references citing the DOI, ordered by
+	// citing article ID
+	ListCitedByDOI(doi string) ([]Reference, error)
+	// SaveDOIDeposit stores the article's DOI and latest deposit together
+	// with the given events. The DOI of an article never changes and
+	// belongs to one article only; both are reported as ErrInvalidDOI.
+	SaveDOIDeposit(articleID, doi string, deposit DOIDeposit, events ...Event) (Article, error)
+	// ListArticlesByDepositStatus returns up to limit articles whose latest
+	// deposit has the status, oldest deposit first
+	ListArticlesByDepositStatus(status DepositStatus, limit int) ([]Article, error)
+	// ListPublishedArticles returns up to query.Limit published articles of
+	// the query's journal and time window, ordered by the time they last
+	// changed and by ID, starting after query.After
+	ListPublishedArticles(query HarvestQuery) ([]Article, error)
+	// ListPublishedJournalIDs returns the IDs of the journals with published
+	// articles in order
+	ListPublishedJournalIDs() ([]string, error)
+	// ListRecentlyPublishedArticles returns the journal's up to limit most
+	// recently published articles, newest first
+	ListRecentlyPublishedArticles(journalID string, limit int) ([]Article, error)
+	// ListArticlesAfter returns up to limit articles with IDs after the
+	// given one, in ID order; an empty ID starts from the first article
+	ListArticlesAfter(articleID string, limit int) ([]Article, error)
+	// ListArticlesBySubjects returns up to limit articles having any of the
+	// subject terms, ordered by ID and starting at offset. The page's total
+	// counts all of them.
+	ListArticlesBySubjects(termIDs []string, offset, limit int) (ArticlePage, error)
+}
+
+// SearchIndex is a full-text index of article titles and abstracts.
+// Implementations analyse text with AnalyzeText and ParseSearchText, so
+// that all indexes agree on what a word is.
+type SearchIndex interface {
+	// IndexDocument adds the document, replacing the one with the same
+	// article ID
+	IndexDocument(document SearchDocument) error
+	// RemoveDocument removes the article's document if there is one
+	RemoveDocument(articleID string) error
+	// Search returns the page of hits the query selects, ranked by BM25
+	// with field boosts, and the total number of matching documents. The
+	// query is valid and its limit is set.
+	Search(query SearchQuery) (SearchHits, error)
+}
+
+// DuplicateIndex stores the MinHash signatures of articles and finds
+// candidates for near-duplicate detection by locality-sensitive hashing
+type DuplicateIndex interface {
+	// IndexSignature stores the article's signature, replacing an earlier one
+	IndexSignature(articleID string, signature MinHashSignature) error
+	// RemoveSignature removes the article's signature if there is one
+	RemoveSignature(articleID string) error
+	// FindCandidates returns the signatures sharing at least one of the
+	// signature's BandKeys, ordered by article ID
+	FindCandidates(signature MinHashSignature) ([]IndexedSignature, error)
+}
+
+// DuplicateScreen flags the stored articles a newly created article may
+// duplicate. DuplicateService implements it.
+type DuplicateScreen interface {
+	ScreenArticle(article Article) ([]DuplicateCandidate, error)
+}
+
+// TaxonomyRepository stores the subject vocabulary
+type TaxonomyRepository interface {
+	CreateTerm(term SubjectTerm) (SubjectTerm, error)
+	// GetTerm returns ErrSubjectTermNotFound for unknown terms
+	GetTerm(id string) (SubjectTerm, error)
+	// ListTerms returns all terms ordered by ID
+	ListTerms() ([]SubjectTerm, error)
+	UpdateTerm(term SubjectTerm) (SubjectTerm, error)
+	DeleteTerm(id string) error
+}
+
+// RecommendationRepository stores the precomputed related articles
+type RecommendationRepository interface {
+	// ReplaceRecommendations replaces all stored lists with the given ones
+	// in one step, so readers never see a half-computed set
+	ReplaceRecommendations(recommendations []ArticleRecommendation) error
+	// ListRecommendations returns the article's list ordered by rank
+	ListRecommendations(articleID string) ([]ArticleRecommendation, error)
+}
+
+// AuthorRepository stores authors. Article author lists refer to authors by
+// ID and are stored with the articles.
+type AuthorRepository interface {
+	CreateAuthor(author Author) (Author, error)
+	// DeleteAuthor removes an author that no article names yet, such as
+	// one registered for an import record that could not be stored
+	DeleteAuthor(id string) error
+	GetAuthor(id string) (Author, error)
+	// GetAuthorByORCID returns ErrAuthorNotFound when no author has the iD
+	GetAuthorByORCID(orcid string) (Author, error)
+	ListAuthors() ([]Author, error)
+	UpdateAuthor(author Author) (Author, error)
+	// MergeAuthor removes merge.Source, stores merge.Target and records the
+	// merge in one step
+	MergeAuthor(merge AuthorMerge) error
+	// UndoMerge restores merge.Source and merge.TargetBefore and stores the
+	// merge's UndoneAt in one step
+	UndoMerge(merge AuthorMerge) error
+	GetMerge(id string) (AuthorMerge, error)
+	ListMerges() ([]AuthorMerge, error)
+}
+
+// AuthorMetricsRepository stores the published articles counted for each
+// author and the metrics derived from them
+type AuthorMetricsRepository interface {
+	// ListArticleContributions returns the entries of the article for each
+	// of its authors
+	ListArticleContributions(articleID string) ([]AuthorArticle, error)
+	ListAuthorArticles(authorID string) ([]AuthorArticle, error)
+	// ApplyArticleContributions replaces the article's entries and stores
+	// the given metrics in one step. Metrics without publications are
+	// removed rather than stored.
+	ApplyArticleContributions(articleID string, contributions []AuthorArticle, metrics []AuthorMetrics) error
+	// GetAuthorMetrics returns ErrAuthorMetricsNotFound when nothing is
+	// stored for the author and journal
+	GetAuthorMetrics(authorID, journalID string) (AuthorMetrics, error)
+	// ListJournalAuthorMetrics returns up to limit metrics of the journal
+	// ordered by the given metric, total citations and author ID
+	ListJournalAuthorMetrics(journalID string, order LeaderboardOrder, limit int) ([]AuthorMetrics, error)
+}
+
+// JournalInfo is the article service's view of a journal owned by the
+// journal service
+type JournalInfo struct {
+	ID             string
+	Name           string
+	PrintISSN      string
+	ElectronicISSN string
+	// ISSNL is the linking ISSN of journals with print and electronic
+	// editions
+	ISSNL string
+	// UniqueArticleTitles forbids two articles of the journal with the same
+	// NormalizeTitle key
+	UniqueArticleTitles bool
+	// SubjectScope lists the subject terms the journal publishes in; the
+	// terms below them are in scope too and an empty scope accepts all
+	SubjectScope []string
+}
+
+// IssueInfo is the article service's view of a journal issue
+type IssueInfo struct {
+	ID        string
+	JournalID string
+	// Volume is the number of the volume the issue belongs to
+	Volume    int
+	Number    int
+	Published bool
+}
+
+// JournalDirectory looks up journals and their issues in the journal service
+type JournalDirectory interface {
+	// GetJournal returns ErrJournalNotFound for unknown journals
+	GetJournal(id string) (JournalInfo, error)
+	// GetIssue returns ErrIssueNotFound for unknown issues
+	GetIssue(id string) (IssueInfo, error)
+}
+
+// JournalRegistry finds the journals of imported records in the journal
+// service and registers journals it does not know yet
+type JournalRegistry interface {
+	// FindJournalByISSN matches any of the journal's ISSNs and returns
+	// ErrJournalNotFound when none does
+	FindJournalByISSN(issn string) (JournalInfo, error)
+	// FindJournalsByName matches names ignoring case and whitespace
+	FindJournalsByName(name string) ([]JournalInfo, error)
+	CreateJournal(journal JournalInfo) (JournalInfo, error)
+}
+
+// DOIRegistrar deposits DOI metadata with a registration agency such as
+// Crossref. Deposits are processed asynchronously and identified by their
+// batch ID.
+type DOIRegistrar interface {
+	Submit(batchID string, deposit []byte) error
+	// Result reports the outcome of a submitted deposit
+	Result(batchID string) (DepositResult, error)
+}
+
+// ReviewRepository stores reviewers and the peer review record of articles
+type ReviewRepository interface {
+	CreateReviewer(reviewer Reviewer) (Reviewer, error)
+	GetReviewer(id string) (Reviewer, error)
+	ListReviewers() ([]Reviewer, error)
+	// CreateAssignment stores the assignment and, unless it is nil, the
+	// status change that starts the review, atomically
+	CreateAssignment(assignment ReviewAssignment, change *StatusChange) (ReviewAssignment, error)
+	GetAssignment(id string) (ReviewAssignment, error)
+	ListAssignments(articleID string) ([]ReviewAssignment, error)
+	// CreateReport stores the report and the completed assignment atomically
+	CreateReport(report ReviewReport, assignment ReviewAssignment) (ReviewReport, error)
+	ListReports(articleID string) ([]ReviewReport, error)
+	// CreateDecision stores the decision and the status change it makes
+	// atomically
+	CreateDecision(decision EditorDecision, change StatusChange) (EditorDecision, error)
+	ListDecisions(articleID string) ([]EditorDecision, error)
 }
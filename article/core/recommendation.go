@@ -0,0 +1,456 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultRecommendationSize is the number of related articles kept for
+	// each article when the configuration sets none
+	DefaultRecommendationSize = 10
+
+	// MaxRecommendationSize bounds the lists returned for an article
+	MaxRecommendationSize = 50
+
+	// DefaultRecommendationMaxShared is the number of articles a word,
+	// author or citing article may be shared by and still relate them when
+	// the configuration sets none
+	DefaultRecommendationMaxShared = 1000
+)
+
+// RecommendationSignals are the reasons two articles are related, each
+// between 0 and 1
+type RecommendationSignals struct {
+	// Content is the cosine similarity of the TF-IDF vectors of the titles
+	// and abstracts
+	Content float64 `json:"content"`
+	// Authors is the share of the two articles' authors they have in common
+	Authors float64 `json:"authors"`
+	// CoCitation is the number of articles citing both, relative to the
+	// geometric mean of the number citing each
+	CoCitation float64 `json:"co_citation"`
+	// Journal is 1 for articles of the same journal
+	Journal float64 `json:"journal"`
+}
+
+// RecommendationWeights weigh the signals in an article's score
+type RecommendationWeights struct {
+	Content    float64
+	Authors    float64
+	CoCitation float64
+	Journal    float64
+}
+
+// DefaultRecommendationWeights lean on content, with shared authors and
+// co-citations as strong hints and the journal as a tie-breaker
+var DefaultRecommendationWeights = RecommendationWeights{Content: 0.5, Authors: 0.2, CoCitation: 0.2, Journal: 0.1}
+
+// ParseRecommendationWeights reads weights written as
+// "content=0.5,authors=0.2,cocitation=0.2,journal=0.1". Signals left out
+// keep their default weight.
+func ParseRecommendationWeights(text string) (RecommendationWeights, error) {
+	weights := DefaultRecommendationWeights
+	for _, part := range strings.Split(text, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return RecommendationWeights{}, fmt.Errorf("recommendation weight %q must be written as name=value", part)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return RecommendationWeights{}, fmt.Errorf("recommendation weight %q is not a number", part)
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "content":
+			weights.Content = weight
+		case "authors":
+			weights.Authors = weight
+		case "cocitation":
+			weights.CoCitation = weight
+		case "journal":
+			weights.Journal = weight
+		default:
+			return RecommendationWeights{}, fmt.Errorf("unknown recommendation signal %q", name)
+		}
+	}
+	return weights, nil
+}
+
+// Validate checks if the weights can rank articles
+func (w RecommendationWeights) Validate() error {
+	for _, weight := range []float64{w.Content, w.Authors, w.CoCitation, w.Journal} {
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return fmt.Errorf("recommendation weights must be finite and not negative")
+		}
+	}
+	if w.Content+w.Authors+w.CoCitation == 0 {
+		return fmt.Errorf("the content, author or co-citation weight must be positive")
+	}
+	return nil
+}
+
+// Score is the weighted sum of the signals
+func (w RecommendationWeights) Score(signals RecommendationSignals) float64 {
+	return w.Content*signals.Content + w.Authors*signals.Authors +
+		w.CoCitation*signals.CoCitation + w.Journal*signals.Journal
+}
+
+// RecommendationConfig tunes the recommendation job
+type RecommendationConfig struct {
+	Weights RecommendationWeights
+	// Size is the number of related articles returned for each article.
+	// Twice as many are stored, so that articles unpublished since the last
+	// computation can be left out without shortening the list.
+	Size int
+	// MaxShared bounds the work of a recomputation: words, authors and
+	// articles citing others that are shared by more articles than this
+	// relate none of them. Such words weigh little, and comparing every
+	// pair of their articles would take time quadratic in their number.
+	MaxShared int
+}
+
+// Validate checks if the configuration can rank articles
+func (c RecommendationConfig) Validate() error {
+	if err := c.Weights.Validate(); err != nil {
+		return err
+	}
+	if c.Size < 0 || c.Size > MaxRecommendationSize {
+		return fmt.Errorf("recommendation size cannot be negative or exceed %d", MaxRecommendationSize)
+	}
+	if c.MaxShared < 0 {
+		return fmt.Errorf("recommendation sharing limit cannot be negative")
+	}
+	return nil
+}
+
+// ArticleRecommendation is a related article stored for an article
+type ArticleRecommendation struct {
+	ArticleID        string
+	RelatedArticleID string
+	// Rank is the 1-based place of the related article in the list
+	Rank       int
+	Score      float64
+	Signals    RecommendationSignals
+	ComputedAt time.Time
+}
+
+// RelatedArticle is a recommended article with the reasons for it
+type RelatedArticle struct {
+	Article    Article
+	Score      float64
+	Signals    RecommendationSignals
+	ComputedAt time.Time
+}
+
+// RecommendationService suggests related articles for article pages. The
+// lists are expensive to build, so a background job precomputes them for
+// all published articles and requests read the stored lists.
+type RecommendationService struct {
+	repository RecommendationRepository
+	articles   *ArticleService
+	config     RecommendationConfig
+}
+
+func NewRecommendationService(repository RecommendationRepository, articles *ArticleService, config RecommendationConfig) *RecommendationService {
+	if config.Size == 0 {
+		config.Size = DefaultRecommendationSize
+	}
+	if config.MaxShared == 0 {
+		config.MaxShared = DefaultRecommendationMaxShared
+	}
+	return &RecommendationService{repository: repository, articles: articles, config: config}
+}
+
+// RelatedArticles returns up to limit articles related to the article, best
+// first, as of the last computation; a zero limit returns the configured
+// Size. Articles no longer published are left out and the stored spares
+// take their place, so a list only comes up short when more articles than
+// the spares have gone since, or when fewer articles are related at all.
+func (s *RecommendationService) RelatedArticles(articleID string, limit int) ([]RelatedArticle, error) {
+	if limit < 0 || limit > MaxRecommendationSize {
+		return nil, fmt.Errorf("%w: limit cannot be negative or exceed %d", ErrInvalidRecommendationQuery, MaxRecommendationSize)
+	}
+	if limit == 0 {
+		limit = s.config.Size
+	}
+	if _, err := s.articles.repository.GetArticleByID(articleID); err != nil {
+		return nil, err
+	}
+	recommendations, err := s.repository.ListRecommendations(articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var related []RelatedArticle
+	for _, recommendation := range recommendations {
+		if len(related) == limit {
+			break
+		}
+		article, err := s.articles.repository.GetArticleByID(recommendation.RelatedArticleID)
+		if errors.Is(err, ErrArticleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if article.Status != StatusPublished {
+			continue
+		}
+		related = append(related, RelatedArticle{
+			Article:    article,
+			Score:      recommendation.Score,
+			Signals:    recommendation.Signals,
+			ComputedAt: recommendation.ComputedAt,
+		})
+	}
+	return related, nil
+}
+
+// Recompute ranks the related articles of every published article and
+// replaces the stored lists. It returns the number of articles with a list.
+func (s *RecommendationService) Recompute() (int, error) {
+	var published []Article
+	citing := make(map[string]map[string]bool)
+	after := ""
+	for {
+		articles, err := s.articles.repository.ListArticlesAfter(after, reindexBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		ids := make([]string, len(articles))
+		for i, article := range articles {
+			if article.Status == StatusPublished {
+				published = append(published, article)
+			}
+			ids[i] = article.ID
+		}
+		references, err := s.articles.repository.ListReferencesOf(ids)
+		if err != nil {
+			return 0, err
+		}
+		for _, reference := range references {
+			if !reference.Internal() {
+				continue
+			}
+			if citing[reference.TargetArticleID] == nil {
+				citing[reference.TargetArticleID] = make(map[string]bool)
+			}
+			citing[reference.TargetArticleID][reference.CitingArticleID] = true
+		}
+		if len(articles) < reindexBatchSize {
+			break
+		}
+		after = articles[len(articles)-1].ID
+	}
+
+	now := time.Now().UTC()
+	signals := relatedSignals(published, citing, s.config.MaxShared)
+	var recommendations []ArticleRecommendation
+	lists := 0
+	for i, article := range published {
+		ranked := make([]ArticleRecommendation, 0, len(signals[i]))
+		for j, pair := range signals[i] {
+			ranked = append(ranked, ArticleRecommendation{
+				ArticleID:        article.ID,
+				RelatedArticleID: published[j].ID,
+				Score:            s.config.Weights.Score(pair),
+				Signals:          pair,
+				ComputedAt:       now,
+			})
+		}
+		sort.Slice(ranked, func(a, b int) bool {
+			if ranked[a].Score != ranked[b].Score {
+				return ranked[a].Score > ranked[b].Score
+			}
+			return ranked[a].RelatedArticleID < ranked[b].RelatedArticleID
+		})
+		if len(ranked) > 2*s.config.Size {
+			ranked = ranked[:2*s.config.Size]
+		}
+		for rank := range ranked {
+			ranked[rank].Rank = rank + 1
+		}
+		if len(ranked) > 0 {
+			lists++
+		}
+		recommendations = append(recommendations, ranked...)
+	}
+	return lists, s.repository.ReplaceRecommendations(recommendations)
+}
+
+// relatedSignals returns the signals of every pair of articles that share
+// words, authors or citing articles, by index into articles. Sharing only
+// the journal does not make articles related. Words, authors and citing
+// articles shared by more than maxShared articles are skipped, which keeps
+// the number of pairs compared below maxShared times the number of words,
+// bylines and references.
+func relatedSignals(articles []Article, citing map[string]map[string]bool, maxShared int) []map[int]RecommendationSignals {
+	signals := make([]map[int]RecommendationSignals, len(articles))
+	for i := range signals {
+		signals[i] = make(map[int]RecommendationSignals)
+	}
+	update := func(i, j int, set func(*RecommendationSignals)) {
+		for _, pair := range [][2]int{{i, j}, {j, i}} {
+			current := signals[pair[0]][pair[1]]
+			set(&current)
+			signals[pair[0]][pair[1]] = current
+		}
+	}
+
+	vectors := tfidfVectors(articles)
+	postings := make(map[string][]int)
+	for i, vector := range vectors {
+		for term := range vector {
+			postings[term] = append(postings[term], i)
+		}
+	}
+	dots := make(map[[2]int]float64)
+	for term, documents := range postings {
+		if len(documents) > maxShared {
+			continue
+		}
+		for a := 0; a < len(documents); a++ {
+			for b := a + 1; b < len(documents); b++ {
+				i, j := documents[a], documents[b]
+				dots[[2]int{i, j}] += vectors[i][term] * vectors[j][term]
+			}
+		}
+	}
+	for pair, dot := range dots {
+		if dot > 0 {
+			update(pair[0], pair[1], func(s *RecommendationSignals) { s.Content = math.Min(dot, 1) })
+		}
+	}
+
+	byAuthor := make(map[string][]int)
+	for i, article := range articles {
+		for _, authorID := range article.AuthorIDs() {
+			byAuthor[authorID] = append(byAuthor[authorID], i)
+		}
+	}
+	shared := make(map[[2]int]int)
+	for _, written := range byAuthor {
+		if len(written) > maxShared {
+			continue
+		}
+		for a := 0; a < len(written); a++ {
+			for b := a + 1; b < len(written); b++ {
+				shared[[2]int{written[a], written[b]}]++
+			}
+		}
+	}
+	for pair, count := range shared {
+		union := len(articles[pair[0]].Authors) + len(articles[pair[1]].Authors) - count
+		update(pair[0], pair[1], func(s *RecommendationSignals) { s.Authors = float64(count) / float64(union) })
+	}
+
+	citedBy := make(map[string][]int)
+	for i, article := range articles {
+		for citingID := range citing[article.ID] {
+			citedBy[citingID] = append(citedBy[citingID], i)
+		}
+	}
+	coCited := make(map[[2]int]int)
+	for _, cited := range citedBy {
+		if len(cited) > maxShared {
+			continue
+		}
+		sort.Ints(cited)
+		for a := 0; a < len(cited); a++ {
+			for b := a + 1; b < len(cited); b++ {
+				coCited[[2]int{cited[a], cited[b]}]++
+			}
+		}
+	}
+	for pair, count := range coCited {
+		norm := math.Sqrt(float64(len(citing[articles[pair[0]].ID]) * len(citing[articles[pair[1]].ID])))
+		update(pair[0], pair[1], func(s *RecommendationSignals) { s.CoCitation = float64(count) / norm })
+	}
+
+	for i := range signals {
+		for j, pair := range signals[i] {
+			if articles[i].JournalID == articles[j].JournalID {
+				pair.Journal = 1
+				signals[i][j] = pair
+			}
+		}
+	}
+	return signals
+}
+
+// tfidfVectors returns the unit-length TF-IDF vectors of the articles'
+// titles and abstracts. Terms are analysed as for search, and title terms
+// count with the title's search boost.
+func tfidfVectors(articles []Article) []map[string]float64 {
+	vectors := make([]map[string]float64, len(articles))
+	documentFrequency := make(map[string]int)
+	for i, article := range articles {
+		document := NewSearchDocument(article)
+		vector := make(map[string]float64)
+		for _, field := range SearchFields {
+			for _, token := range AnalyzeText(document.Text(field)) {
+				vector[token.Term] += field.Boost()
+			}
+		}
+		for term := range vector {
+			documentFrequency[term]++
+		}
+		vectors[i] = vector
+	}
+
+	n := float64(len(articles))
+	for _, vector := range vectors {
+		norm := 0.0
+		for term, frequency := range vector {
+			weight := (1 + math.Log(frequency)) * math.Log(1+n/float64(documentFrequency[term]))
+			vector[term] = weight
+			norm += weight * weight
+		}
+		norm = math.Sqrt(norm)
+		for term := range vector {
+			vector[term] /= norm
+		}
+	}
+	return vectors
+}
+
+// RecommendationJob recomputes the related articles at an interval
+type RecommendationJob struct {
+	recommendations *RecommendationService
+	interval        time.Duration
+}
+
+func NewRecommendationJob(recommendations *RecommendationService, interval time.Duration) *RecommendationJob {
+	return &RecommendationJob{recommendations: recommendations, interval: interval}
+}
+
+// Run recomputes the lists right away and then at every interval until the
+// context is cancelled
+func (j *RecommendationJob) Run(ctx context.Context) error {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if lists, err := j.recommendations.Recompute(); err != nil {
+			log.Printf("Recommendation job: %v", err)
+		} else {
+			log.Printf("Recommendation job: related articles of %d article(s) recomputed", lists)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package core_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestParseRecommendationWeights(t *testing.T) {
+	weights, err := core.ParseRecommendationWeights(" authors=0.4, cocitation = 0 ,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := core.DefaultRecommendationWeights
+	want.Authors, want.CoCitation = 0.4, 0
+	if weights != want {
+		t.Errorf("ParseRecommendationWeights() = %+v, want %+v", weights, want)
+	}
+	if weights, err := core.ParseRecommendationWeights(""); err != nil || weights != core.DefaultRecommendationWeights {
+		t.Errorf("ParseRecommendationWeights(\"\") = %+v, %v", weights, err)
+	}
+
+	for _, text := range []string{"content", "content=much", "citations=0.2"} {
+		if _, err := core.ParseRecommendationWeights(text); err == nil {
+			t.Errorf("ParseRecommendationWeights(%q) accepted it", text)
+		}
+	}
+}
+
+func TestRecommendationConfigValidate(t *testing.T) {
+	valid := core.RecommendationConfig{Weights: core.DefaultRecommendationWeights}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*core.RecommendationConfig)
+	}{
+		{"negative weight", func(c *core.RecommendationConfig) { c.Weights.Journal = -0.1 }},
+		{"NaN weight", func(c *core.RecommendationConfig) { c.Weights.Content = math.NaN() }},
+		{"infinite weight", func(c *core.RecommendationConfig) { c.Weights.Authors = math.Inf(1) }},
+		{"journal weight only", func(c *core.RecommendationConfig) { c.Weights = core.RecommendationWeights{Journal: 1} }},
+		{"negative size", func(c *core.RecommendationConfig) { c.Size = -1 }},
+		{"size too large", func(c *core.RecommendationConfig) { c.Size = core.MaxRecommendationSize + 1 }},
+		{"negative sharing limit", func(c *core.RecommendationConfig) { c.MaxShared = -1 }},
+	}
+	for _, test := range tests {
+		config := valid
+		test.change(&config)
+		if err := config.Validate(); err == nil {
+			t.Errorf("%s: Validate() accepted %+v", test.name, config)
+		}
+	}
+}
+
+// relatedIDs returns the IDs of the related articles, best first
+func relatedIDs(t *testing.T, recommendations *core.RecommendationService, articleID string) []string {
+	t.Helper()
+	related, err := recommendations.RelatedArticles(articleID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, article := range related {
+		ids = append(ids, article.Article.ID)
+	}
+	return ids
+}
+
+func TestRecommendationRecompute(t *testing.T) {
+	f := newFixture(t).withRelatedArticles(t)
+	recommendations := core.NewRecommendationService(adapters.NewInMemoryRecommendationRepository(), f.service,
+		core.RecommendationConfig{Weights: core.DefaultRecommendationWeights})
+
+	lists, err := recommendations.Recompute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lists != 5 {
+		t.Errorf("Recompute() = %d lists, want 5", lists)
+	}
+
+	related, err := recommendations.RelatedArticles("r1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(related) != 2 || related[0].Article.ID != "r2" || related[1].Article.ID != "r3" {
+		t.Fatalf("RelatedArticles(r1) = %+v, want r2 and r3", related)
+	}
+	if signals := related[0].Signals; signals.Content <= 0 || signals.Authors != 0 || signals.Journal != 1 {
+		t.Errorf("signals of r2 = %+v", signals)
+	}
+	if signals := related[1].Signals; signals != (core.RecommendationSignals{Authors: 1}) {
+		t.Errorf("signals of r3 = %+v", signals)
+	}
+	if related[0].Score != core.DefaultRecommendationWeights.Score(related[0].Signals) || related[0].ComputedAt.IsZero() {
+		t.Errorf("r2 = %+v", related[0])
+	}
+
+	// Co-cited articles are related; the draft citing them has no list
+	related, err = recommendations.RelatedArticles("r4", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(related) != 1 || related[0].Article.ID != "r5" || related[0].Signals.CoCitation != 1 || related[0].Signals.Journal != 1 {
+		t.Errorf("RelatedArticles(r4) = %+v, want r5 co-cited", related)
+	}
+	for _, id := range []string{"r6", "c1"} {
+		if ids := relatedIDs(t, recommendations, id); len(ids) != 0 {
+			t.Errorf("RelatedArticles(%s) = %v, want none", id, ids)
+		}
+	}
+
+	if related, err := recommendations.RelatedArticles("r1", 1); err != nil || len(related) != 1 {
+		t.Errorf("RelatedArticles(r1, 1) = %d articles, %v", len(related), err)
+	}
+	for _, limit := range []int{-1, core.MaxRecommendationSize + 1} {
+		if _, err := recommendations.RelatedArticles("r1", limit); !errors.Is(err, core.ErrInvalidRecommendationQuery) {
+			t.Errorf("RelatedArticles(limit %d) = %v, want ErrInvalidRecommendationQuery", limit, err)
+		}
+	}
+	if _, err := recommendations.RelatedArticles("r9", 0); !errors.Is(err, core.ErrArticleNotFound) {
+		t.Errorf("RelatedArticles() of an unknown article = %v, want ErrArticleNotFound", err)
+	}
+}
+
+func TestRecommendationsSkipWidelySharedSignals(t *testing.T) {
+	f := newFixture(t).withRelatedArticles(t)
+	// A third article by author_1 whose title shares "graphs" with r1 and r2
+	extra := newArticle("r7", "Graphs of Engines")
+	extra.Abstract = "Drawings."
+	f.create(t, extra)
+	f.publish(t, "r7")
+
+	for _, test := range []struct {
+		maxShared int
+		want      []string
+	}{
+		{0, []string{"r2", "r3", "r7"}},
+		// "graphs" and author_1 are shared by three articles, and only
+		// "planar" and "colouring" still relate r1 to r2
+		{2, []string{"r2"}},
+		{1, nil},
+	} {
+		recommendations := core.NewRecommendationService(adapters.NewInMemoryRecommendationRepository(), f.service,
+			core.RecommendationConfig{Weights: core.DefaultRecommendationWeights, MaxShared: test.maxShared})
+		if _, err := recommendations.Recompute(); err != nil {
+			t.Fatal(err)
+		}
+		ids := relatedIDs(t, recommendations, "r1")
+		sort.Strings(ids)
+		if !reflect.DeepEqual(ids, test.want) {
+			t.Errorf("MaxShared %d: RelatedArticles(r1) = %v, want %v", test.maxShared, ids, test.want)
+		}
+	}
+}
+
+func TestRelatedArticlesFillGapsFromSpares(t *testing.T) {
+	f := newFixture(t).withRelatedArticles(t)
+	recommendations := core.NewRecommendationService(adapters.NewInMemoryRecommendationRepository(), f.service,
+		core.RecommendationConfig{Weights: core.DefaultRecommendationWeights, Size: 1})
+	if _, err := recommendations.Recompute(); err != nil {
+		t.Fatal(err)
+	}
+	if ids := relatedIDs(t, recommendations, "r1"); !reflect.DeepEqual(ids, []string{"r2"}) {
+		t.Errorf("RelatedArticles(r1) = %v, want r2", ids)
+	}
+
+	// r2 is unpublished after the computation; the spare takes its place
+	r2, err := f.service.GetArticleByID("r2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2.Status, r2.PublishedAt = core.StatusAccepted, nil
+	if _, err := f.articles.UpdateArticleStatus(r2, core.StatusTransition{ArticleID: "r2", From: core.StatusPublished, To: core.StatusAccepted}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := relatedIDs(t, recommendations, "r1"); !reflect.DeepEqual(ids, []string{"r3"}) {
+		t.Errorf("RelatedArticles(r1) after r2 was unpublished = %v, want r3", ids)
+	}
+	if related, err := recommendations.RelatedArticles("r1", 2); err != nil || len(related) != 1 {
+		t.Errorf("RelatedArticles(r1, 2) = %d articles, %v, want only the spare", len(related), err)
+	}
+}
@@ -0,0 +1,345 @@
+package core
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+type Reviewer struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	Affiliation string `json:"affiliation"`
+	// AuthorID links the reviewer to their own articles, which are used for
+	// matching and conflict checks. It is empty for reviewers who have not
+	// published in this service.
+	AuthorID  string    `json:"author_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validate checks if the reviewer data is valid
+func (r Reviewer) Validate() error {
+	if strings.TrimSpace(r.ID) == "" {
+		return fmt.Errorf("%w: ID cannot be empty", ErrInvalidReview)
+	}
+
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: reviewer name cannot be empty", ErrInvalidReview)
+	}
+
+	if _, err := mail.ParseAddress(r.Email); err != nil {
+		return fmt.Errorf("%w: invalid reviewer email %q", ErrInvalidReview, r.Email)
+	}
+
+	return nil
+}
+
+// AssignmentStatus tracks whether a reviewer still owes a report
+type AssignmentStatus string
+
+const (
+	AssignmentPending   AssignmentStatus = "pending"
+	AssignmentCompleted AssignmentStatus = "completed"
+)
+
+// ReviewAssignment asks a reviewer to review an article by the due date
+type ReviewAssignment struct {
+	ID          string           `json:"id"`
+	ArticleID   string           `json:"article_id"`
+	ReviewerID  string           `json:"reviewer_id"`
+	Status      AssignmentStatus `json:"status"`
+	AssignedAt  time.Time        `json:"assigned_at"`
+	DueDate     time.Time        `json:"due_date"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty"`
+}
+
+// Overdue reports whether the report is still outstanding after the due date
+func (a ReviewAssignment) Overdue(now time.Time) bool {
+	return a.Status == AssignmentPending && now.After(a.DueDate)
+}
+
+// Recommendation is a reviewer's verdict on an article
+type Recommendation string
+
+const (
+	RecommendAccept        Recommendation = "accept"
+	RecommendMinorRevision Recommendation = "minor_revision"
+	RecommendMajorRevision Recommendation = "major_revision"
+	RecommendReject        Recommendation = "reject"
+)
+
+func (r Recommendation) Valid() bool {
+	switch r {
+	case RecommendAccept, RecommendMinorRevision, RecommendMajorRevision, RecommendReject:
+		return true
+	}
+	return false
+}
+
+// ReviewReport is a reviewer's report for an assignment
+type ReviewReport struct {
+	ID             string         `json:"id"`
+	AssignmentID   string         `json:"assignment_id"`
+	ArticleID      string         `json:"article_id"`
+	ReviewerID     string         `json:"reviewer_id"`
+	Recommendation Recommendation `json:"recommendation"`
+	Comments       string         `json:"comments"`
+	SubmittedAt    time.Time      `json:"submitted_at"`
+}
+
+// DecisionOutcome is the editor's verdict, which moves the article to
+// accepted or rejected
+type DecisionOutcome string
+
+const (
+	DecisionAccept DecisionOutcome = "accept"
+	DecisionReject DecisionOutcome = "reject"
+)
+
+// EditorDecision records the outcome of peer review for an article
+type EditorDecision struct {
+	ID        string          `json:"id"`
+	ArticleID string          `json:"article_id"`
+	EditorID  string          `json:"editor_id"`
+	Outcome   DecisionOutcome `json:"outcome"`
+	Comments  string          `json:"comments"`
+	DecidedAt time.Time       `json:"decided_at"`
+}
+
+// ReviewService runs peer review and drives the article workflow from its
+// outcome
+type ReviewService struct {
+	repository ReviewRepository
+	articles   *ArticleService
+}
+
+func NewReviewService(repository ReviewRepository, articles *ArticleService) *ReviewService {
+	return &ReviewService{repository: repository, articles: articles}
+}
+
+// WithActor returns a copy of the service that records decisions and
+// workflow transitions under the given actor
+func (s *ReviewService) WithActor(actor string) *ReviewService {
+	scoped := *s
+	scoped.articles = s.articles.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that records decisions and
+// workflow transitions under the given caller
+func (s *ReviewService) WithCaller(caller eventing.Caller) *ReviewService {
+	scoped := *s
+	scoped.articles = s.articles.WithCaller(caller)
+	return &scoped
+}
+
+func (s *ReviewService) RegisterReviewer(reviewer Reviewer) (Reviewer, error) {
+	if err := reviewer.Validate(); err != nil {
+		return Reviewer{}, err
+	}
+
+	reviewer.CreatedAt = time.Now().UTC()
+	return s.repository.CreateReviewer(reviewer)
+}
+
+func (s *ReviewService) GetReviewer(id string) (Reviewer, error) {
+	return s.repository.GetReviewer(id)
+}
+
+func (s *ReviewService) ListReviewers() ([]Reviewer, error) {
+	return s.repository.ListReviewers()
+}
+
+// AssignReviewer invites a reviewer to review an article. The first
+// assignment moves a submitted article into review.
+func (s *ReviewService) AssignReviewer(articleID, reviewerID string, dueDate time.Time) (ReviewAssignment, error) {
+	article, err := s.articles.GetArticleByID(articleID)
+	if err != nil {
+		return ReviewAssignment{}, err
+	}
+	if article.Status != StatusSubmitted && article.Status != StatusUnderReview {
+		return ReviewAssignment{}, fmt.Errorf("%w: article %s is %s", ErrReviewNotOpen, articleID, article.Status)
+	}
+
+	if _, err := s.repository.GetReviewer(reviewerID); err != nil {
+		return ReviewAssignment{}, err
+	}
+
+	now := time.Now().UTC()
+	if !dueDate.After(now) {
+		return ReviewAssignment{}, fmt.Errorf("%w: due date must be in the future", ErrInvalidReview)
+	}
+
+	assignments, err := s.repository.ListAssignments(articleID)
+	if err != nil {
+		return ReviewAssignment{}, err
+	}
+	for _, assignment := range assignments {
+		if assignment.ReviewerID == reviewerID {
+			return ReviewAssignment{}, fmt.Errorf("%w: reviewer %s is already assigned to article %s",
+				ErrInvalidReview, reviewerID, articleID)
+		}
+	}
+
+	// The first assignment starts the review in the same write
+	var change *StatusChange
+	if article.Status == StatusSubmitted {
+		started, err := s.articles.prepareTransition(articleID, StatusUnderReview, "", nil)
+		if err != nil {
+			return ReviewAssignment{}, err
+		}
+		change = &started
+	}
+
+	return s.repository.CreateAssignment(ReviewAssignment{
+		ID:         NewID(),
+		ArticleID:  articleID,
+		ReviewerID: reviewerID,
+		Status:     AssignmentPending,
+		AssignedAt: now,
+		DueDate:    dueDate.UTC(),
+	}, change)
+}
+
+// SuggestReviewers ranks registered reviewers for an article by how close
+// their own articles are to its title and abstract. Reviewers with a conflict
+// of interest are returned separately and reviewers already assigned to the
+// article are left out.
+func (s *ReviewService) SuggestReviewers(articleID string, limit int) (ReviewerSuggestions, error) {
+	article, err := s.articles.GetArticleByID(articleID)
+	if err != nil {
+		return ReviewerSuggestions{}, err
+	}
+
+	reviewers, err := s.repository.ListReviewers()
+	if err != nil {
+		return ReviewerSuggestions{}, err
+	}
+	assignments, err := s.repository.ListAssignments(articleID)
+	if err != nil {
+		return ReviewerSuggestions{}, err
+	}
+	assigned := make(map[string]bool, len(assignments))
+	for _, assignment := range assignments {
+		assigned[assignment.ReviewerID] = true
+	}
+
+	submission := Submission{Article: article}
+	for _, author := range article.Authors {
+		if author.Affiliation != "" {
+			submission.Affiliations = append(submission.Affiliations, author.Affiliation)
+		}
+	}
+
+	var candidates []ReviewerCandidate
+	for _, reviewer := range reviewers {
+		if assigned[reviewer.ID] {
+			continue
+		}
+
+		candidate := ReviewerCandidate{Reviewer: reviewer}
+		if reviewer.AuthorID != "" {
+			if candidate.Articles, err = s.articles.ListArticlesByAuthor(reviewer.AuthorID); err != nil {
+				return ReviewerSuggestions{}, err
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return MatchReviewers(submission, candidates, limit), nil
+}
+
+func (s *ReviewService) ListAssignments(articleID string) ([]ReviewAssignment, error) {
+	return s.repository.ListAssignments(articleID)
+}
+
+// SubmitReport stores the reviewer's report and completes the assignment
+func (s *ReviewService) SubmitReport(assignmentID string, recommendation Recommendation, comments string) (ReviewReport, error) {
+	if !recommendation.Valid() {
+		return ReviewReport{}, fmt.Errorf("%w: unknown recommendation %q", ErrInvalidReview, recommendation)
+	}
+
+	assignment, err := s.repository.GetAssignment(assignmentID)
+	if err != nil {
+		return ReviewReport{}, err
+	}
+	if assignment.Status != AssignmentPending {
+		return ReviewReport{}, fmt.Errorf("%w: assignment %s is already %s", ErrInvalidReview, assignmentID, assignment.Status)
+	}
+
+	article, err := s.articles.GetArticleByID(assignment.ArticleID)
+	if err != nil {
+		return ReviewReport{}, err
+	}
+	if article.Status != StatusUnderReview {
+		return ReviewReport{}, fmt.Errorf("%w: article %s is %s", ErrReviewNotOpen, article.ID, article.Status)
+	}
+
+	now := time.Now().UTC()
+	report := ReviewReport{
+		ID:             NewID(),
+		AssignmentID:   assignment.ID,
+		ArticleID:      assignment.ArticleID,
+		ReviewerID:     assignment.ReviewerID,
+		Recommendation: recommendation,
+		Comments:       comments,
+		SubmittedAt:    now,
+	}
+	assignment.Status = AssignmentCompleted
+	assignment.CompletedAt = &now
+
+	return s.repository.CreateReport(report, assignment)
+}
+
+func (s *ReviewService) ListReports(articleID string) ([]ReviewReport, error) {
+	return s.repository.ListReports(articleID)
+}
+
+// RecordDecision closes peer review. At least one report is required, and
+// the outcome accepts or rejects the article.
+func (s *ReviewService) RecordDecision(articleID string, outcome DecisionOutcome, comments string) (EditorDecision, error) {
+	if outcome != DecisionAccept && outcome != DecisionReject {
+		return EditorDecision{}, fmt.Errorf("%w: unknown decision %q", ErrInvalidReview, outcome)
+	}
+
+	reports, err := s.repository.ListReports(articleID)
+	if err != nil {
+		return EditorDecision{}, err
+	}
+	if len(reports) == 0 {
+		return EditorDecision{}, &GuardError{ArticleID: articleID, To: decisionStatus(outcome), Reason: "no review reports have been submitted"}
+	}
+
+	reason := ""
+	if outcome == DecisionReject {
+		reason = comments
+	}
+	change, err := s.articles.prepareTransition(articleID, decisionStatus(outcome), reason, nil)
+	if err != nil {
+		return EditorDecision{}, err
+	}
+
+	return s.repository.CreateDecision(EditorDecision{
+		ID:        NewID(),
+		ArticleID: articleID,
+		EditorID:  s.articles.caller.Actor,
+		Outcome:   outcome,
+		Comments:  comments,
+		DecidedAt: time.Now().UTC(),
+	}, change)
+}
+
+func (s *ReviewService) ListDecisions(articleID string) ([]EditorDecision, error) {
+	return s.repository.ListDecisions(articleID)
+}
+
+func decisionStatus(outcome DecisionOutcome) ArticleStatus {
+	if outcome == DecisionAccept {
+		return StatusAccepted
+	}
+	return StatusRejected
+}
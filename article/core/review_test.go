@@ -0,0 +1,216 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+var errReviewStore = errors.New("review store unavailable")
+
+// failingReviewRepository refuses review records, like a database that goes
+// away during the write
+type failingReviewRepository struct {
+	*adapters.InMemoryReviewRepository
+	fail bool
+}
+
+func (r *failingReviewRepository) CreateAssignment(assignment core.ReviewAssignment, change *core.StatusChange) (core.ReviewAssignment, error) {
+	if r.fail {
+		return core.ReviewAssignment{}, errReviewStore
+	}
+	return r.InMemoryReviewRepository.CreateAssignment(assignment, change)
+}
+
+func (r *failingReviewRepository) CreateDecision(decision core.EditorDecision, change core.StatusChange) (core.EditorDecision, error) {
+	if r.fail {
+		return core.EditorDecision{}, errReviewStore
+	}
+	return r.InMemoryReviewRepository.CreateDecision(decision, change)
+}
+
+// reviewFixture has a submitted article a1 and a registered reviewer r1
+type reviewFixture struct {
+	fixture
+	reviews *core.ReviewService
+	repo    *failingReviewRepository
+}
+
+func newReviewFixture(t *testing.T) reviewFixture {
+	t.Helper()
+	f := reviewFixture{fixture: newFixture(t)}
+	f.repo = &failingReviewRepository{InMemoryReviewRepository: adapters.NewInMemoryReviewRepository(f.articles)}
+	f.reviews = core.NewReviewService(f.repo, f.service).WithActor("editor")
+
+	f.create(t, newArticle("a1", "Graph Colouring"))
+	if _, err := f.service.SubmitArticle("a1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.reviews.RegisterReviewer(core.Reviewer{ID: "r1", Name: "Grace Hopper", Email: "grace@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func timeInAWeek() time.Time {
+	return time.Now().Add(7 * 24 * time.Hour)
+}
+
+func (f reviewFixture) status(t *testing.T) core.ArticleStatus {
+	t.Helper()
+	article, err := f.service.GetArticleByID("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return article.Status
+}
+
+func TestPeerReview(t *testing.T) {
+	f := newReviewFixture(t)
+	due := time.Now().Add(14 * 24 * time.Hour)
+
+	assignment, err := f.reviews.AssignReviewer("a1", "r1", due)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.status(t); got != core.StatusUnderReview {
+		t.Fatalf("status after the first assignment = %s, want under_review", got)
+	}
+	if _, err := f.reviews.AssignReviewer("a1", "r1", due); !errors.Is(err, core.ErrInvalidReview) {
+		t.Errorf("assigning r1 twice = %v, want ErrInvalidReview", err)
+	}
+
+	// A decision needs a report
+	if _, err := f.reviews.RecordDecision("a1", core.DecisionAccept, ""); !errors.Is(err, core.ErrTransitionBlocked) {
+		t.Fatalf("RecordDecision(no reports) = %v, want ErrTransitionBlocked", err)
+	}
+	if _, err := f.reviews.SubmitReport(assignment.ID, core.RecommendMinorRevision, "Fix the proofs."); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.reviews.SubmitReport(assignment.ID, core.RecommendAccept, ""); !errors.Is(err, core.ErrInvalidReview) {
+		t.Errorf("second report = %v, want ErrInvalidReview", err)
+	}
+
+	decision, err := f.reviews.RecordDecision("a1", core.DecisionAccept, "Well done.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decision.EditorID != "editor" {
+		t.Errorf("editor = %q, want editor", decision.EditorID)
+	}
+	if got := f.status(t); got != core.StatusAccepted {
+		t.Errorf("status after acceptance = %s, want accepted", got)
+	}
+
+	// The review is closed
+	if _, err := f.reviews.RecordDecision("a1", core.DecisionReject, ""); !errors.Is(err, core.ErrInvalidTransition) {
+		t.Errorf("second decision = %v, want ErrInvalidTransition", err)
+	}
+	decisions, err := f.reviews.ListDecisions("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 1 {
+		t.Errorf("got %d decisions, want 1", len(decisions))
+	}
+}
+
+// history returns the statuses a1 moved to
+func (f reviewFixture) history(t *testing.T) []core.ArticleStatus {
+	t.Helper()
+	transitions, err := f.service.GetStatusHistory("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statuses []core.ArticleStatus
+	for _, transition := range transitions {
+		statuses = append(statuses, transition.To)
+	}
+	return statuses
+}
+
+func TestAssignReviewerStartsReviewOnlyWithTheAssignment(t *testing.T) {
+	f := newReviewFixture(t)
+	f.repo.fail = true
+
+	_, err := f.reviews.AssignReviewer("a1", "r1", time.Now().Add(time.Hour))
+	if !errors.Is(err, errReviewStore) {
+		t.Fatalf("AssignReviewer() = %v, want the review store error", err)
+	}
+
+	assignments, err := f.reviews.ListAssignments("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 0 {
+		t.Errorf("assignments = %+v, want none", assignments)
+	}
+	if got := f.status(t); got != core.StatusSubmitted {
+		t.Errorf("status = %s, want submitted", got)
+	}
+	if got := f.history(t); len(got) != 1 {
+		t.Errorf("history = %v, want only the submission", got)
+	}
+}
+
+func TestRecordDecisionClosesReviewOnlyWithTheDecision(t *testing.T) {
+	f := newReviewFixture(t)
+	assignment, err := f.reviews.AssignReviewer("a1", "r1", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.reviews.SubmitReport(assignment.ID, core.RecommendReject, "Not novel."); err != nil {
+		t.Fatal(err)
+	}
+
+	f.repo.fail = true
+	if _, err := f.reviews.RecordDecision("a1", core.DecisionReject, "Not novel."); !errors.Is(err, errReviewStore) {
+		t.Fatalf("RecordDecision() = %v, want the review store error", err)
+	}
+
+	decisions, err := f.reviews.ListDecisions("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decisions) != 0 {
+		t.Errorf("decisions = %+v, want none", decisions)
+	}
+	if got := f.status(t); got != core.StatusUnderReview {
+		t.Errorf("status = %s, want under_review", got)
+	}
+	if got := f.history(t); len(got) != 2 {
+		t.Errorf("history = %v, want the submission and the review", got)
+	}
+}
+
+func TestReviewRecordsAreNotStoredWithAStaleStatusChange(t *testing.T) {
+	f := newReviewFixture(t)
+	article, err := f.service.GetArticleByID("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The change was prepared while a1 was still a draft
+	stale := core.StatusChange{
+		Article:    article,
+		Transition: core.StatusTransition{ArticleID: "a1", From: core.StatusDraft, To: core.StatusSubmitted},
+	}
+
+	assignment := core.ReviewAssignment{ID: "x1", ArticleID: "a1", ReviewerID: "r1", Status: core.AssignmentPending}
+	if _, err := f.repo.CreateAssignment(assignment, &stale); !errors.Is(err, core.ErrInvalidTransition) {
+		t.Errorf("CreateAssignment() = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.repo.GetAssignment("x1"); !errors.Is(err, core.ErrAssignmentNotFound) {
+		t.Errorf("GetAssignment() = %v, want ErrAssignmentNotFound", err)
+	}
+
+	decision := core.EditorDecision{ID: "d1", ArticleID: "a1", Outcome: core.DecisionReject}
+	if _, err := f.repo.CreateDecision(decision, stale); !errors.Is(err, core.ErrInvalidTransition) {
+		t.Errorf("CreateDecision() = %v, want ErrInvalidTransition", err)
+	}
+	if decisions, err := f.repo.ListDecisions("a1"); err != nil || len(decisions) != 0 {
+		t.Errorf("ListDecisions() = %+v, %v, want none", decisions, err)
+	}
+}
@@ -0,0 +1,449 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultSearchLimit is the number of results in a page when the query
+	// sets none
+	DefaultSearchLimit = 20
+
+	// MaxSearchLimit bounds result pages
+	MaxSearchLimit = 100
+
+	// snippetWidth is the approximate length of abstract snippets in bytes
+	snippetWidth = 200
+	// snippetLead is how much text precedes the first highlight of a snippet
+	snippetLead = 40
+	ellipsis    = "…"
+)
+
+// SearchField is a part of an article that is searched
+type SearchField string
+
+const (
+	SearchFieldTitle    SearchField = "title"
+	SearchFieldAbstract SearchField = "abstract"
+)
+
+// SearchFields are the searched fields in the order snippets are given
+var SearchFields = []SearchField{SearchFieldTitle, SearchFieldAbstract}
+
+// Boost returns the weight of matches in the field. A title match counts
+// for three abstract matches, since titles name what an article is about.
+func (f SearchField) Boost() float64 {
+	if f == SearchFieldTitle {
+		return 3
+	}
+	return 1
+}
+
+// SearchDocument is what a search index stores of an article
+type SearchDocument struct {
+	ArticleID string
+	JournalID string
+	AuthorIDs []string
+	Status    ArticleStatus
+	Title     string
+	Abstract  string
+}
+
+// NewSearchDocument returns the search document of the article
+func NewSearchDocument(article Article) SearchDocument {
+	return SearchDocument{
+		ArticleID: article.ID,
+		JournalID: article.JournalID,
+		AuthorIDs: article.AuthorIDs(),
+		Status:    article.Status,
+		Title:     article.Title,
+		Abstract:  article.Abstract,
+	}
+}
+
+// Text returns the document's text in the field
+func (d SearchDocument) Text(field SearchField) string {
+	if field == SearchFieldTitle {
+		return d.Title
+	}
+	return d.Abstract
+}
+
+// SearchQuery is a full-text query with optional filters. Words are
+// matched after stemming, so "networks" finds "networking"; text in double
+// quotes is a phrase whose words must appear next to each other. Articles
+// must contain every phrase of the query and, when it has no phrases, at
+// least one of its words. They are ranked by BM25.
+type SearchQuery struct {
+	Text string
+	// JournalID, AuthorID and Status restrict the results when set
+	JournalID string
+	AuthorID  string
+	Status    ArticleStatus
+	// Limit is the page size; zero selects the default
+	Limit  int
+	Offset int
+}
+
+// Validate checks if the query can be run
+func (q SearchQuery) Validate() error {
+	if len(ParseSearchText(q.Text)) == 0 {
+		return fmt.Errorf("%w: the query has no searchable words", ErrInvalidSearchQuery)
+	}
+	if q.Status != "" && !q.Status.Valid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidSearchQuery, q.Status)
+	}
+	if q.Limit < 0 || q.Limit > MaxSearchLimit {
+		return fmt.Errorf("%w: limit cannot be negative or exceed %d", ErrInvalidSearchQuery, MaxSearchLimit)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("%w: offset cannot be negative", ErrInvalidSearchQuery)
+	}
+	return nil
+}
+
+// Matches reports whether the document passes the query's filters
+func (q SearchQuery) Matches(document SearchDocument) bool {
+	return (q.JournalID == "" || document.JournalID == q.JournalID) &&
+		(q.AuthorID == "" || containsString(document.AuthorIDs, q.AuthorID)) &&
+		(q.Status == "" || document.Status == q.Status)
+}
+
+// SearchMatch is the byte range of a matched word in a document field
+type SearchMatch struct {
+	Field SearchField
+	Start int
+	End   int
+}
+
+// SearchHit is a document that matches a query
+type SearchHit struct {
+	ArticleID string
+	Score     float64
+	// Matches are the words the query matched, in field and text order
+	Matches []SearchMatch
+}
+
+// SearchHits is a page of hits, best first, and the number of documents
+// that match the query in total
+type SearchHits struct {
+	Total int
+	Hits  []SearchHit
+}
+
+// SearchToken is an indexed term together with the position of the word
+// it came from, counting stop words, and the word's byte range in the text
+type SearchToken struct {
+	Term     string
+	Position int
+	Start    int
+	End      int
+}
+
+// searchStopWords are too common to search for. They still take up a
+// position, so phrases match only when the stop words between their terms
+// are the same number.
+var searchStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "in": true, "is": true, "it": true, "its": true,
+	"of": true, "on": true, "or": true, "that": true, "the": true, "this": true, "to": true,
+	"was": true, "were": true, "with": true,
+}
+
+// AnalyzeText splits text into words of letters and digits and turns them
+// into terms: lower case, without diacritics and stemmed. Stop words are
+// dropped.
+func AnalyzeText(text string) []SearchToken {
+	var tokens []SearchToken
+	position := 0
+	for start := 0; start < len(text); {
+		r, size := utf8.DecodeRuneInString(text[start:])
+		if !isWordRune(r) {
+			start += size
+			continue
+		}
+		end := start + size
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if !isWordRune(r) {
+				break
+			}
+			end += size
+		}
+
+		word := foldWord(text[start:end])
+		if !searchStopWords[word] {
+			tokens = append(tokens, SearchToken{Term: stem(word), Position: position, Start: start, End: end})
+		}
+		position++
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// foldWord lower-cases the word and strips the diacritics of Latin letters
+func foldWord(word string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(word) {
+		if folded, ok := foldedLetters[r]; ok {
+			b.WriteString(folded)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var foldedLetters = func() map[rune]string {
+	letters := make(map[rune]string)
+	for base, accented := range map[string]string{
+		"a": "àáâãäåāăą", "c": "çćĉċč", "d": "ďđ", "e": "èéêëēĕėęě", "g": "ĝğġģ",
+		"h": "ĥħ", "i": "ìíîïĩīĭįı", "j": "ĵ", "k": "ķ", "l": "ĺļľŀł", "n": "ñńņňŉ",
+		"o": "òóôõöøōŏő", "r": "ŕŗř", "s": "śŝşš", "t": "ţťŧ", "u": "ùúûüũūŭůűų",
+		"w": "ŵ", "y": "ýÿŷ", "z": "źżž", "ss": "ß", "ae": "æ", "oe": "œ",
+	} {
+		for _, r := range accented {
+			letters[r] = base
+		}
+	}
+	return letters
+}()
+
+// SearchClause is a word of a query or a phrase. Phrase tokens keep their
+// positions, so the index can check that the words follow each other.
+type SearchClause struct {
+	Tokens []SearchToken
+	Phrase bool
+}
+
+// ParseSearchText splits query text into clauses: one for each word and
+// one for each double-quoted phrase. An unclosed quote extends to the end
+// of the text. Stop words and phrases of only stop words give no clause.
+func ParseSearchText(text string) []SearchClause {
+	var clauses []SearchClause
+	parts := strings.Split(text, `"`)
+	for i, part := range parts {
+		tokens := AnalyzeText(part)
+		// Odd parts were between quotes
+		if i%2 == 1 && len(tokens) > 1 {
+			clauses = append(clauses, SearchClause{Tokens: tokens, Phrase: true})
+			continue
+		}
+		for _, token := range tokens {
+			clauses = append(clauses, SearchClause{Tokens: []SearchToken{token}})
+		}
+	}
+	return clauses
+}
+
+// SearchResult is an article that matches a query with its score and the
+// snippets of its text that matched
+type SearchResult struct {
+	Article  Article
+	Score    float64
+	Snippets []SearchSnippet
+}
+
+// SearchSnippet is an excerpt of a field. Highlights are the byte ranges of
+// the matched words in Text.
+type SearchSnippet struct {
+	Field      SearchField
+	Text       string
+	Highlights []TextRange
+}
+
+// TextRange is a byte range of a text
+type TextRange struct {
+	Start int
+	End   int
+}
+
+// SearchResults is a page of results and the number of articles that match
+// the query in total
+type SearchResults struct {
+	Total   int
+	Results []SearchResult
+}
+
+// SearchService searches the titles and abstracts of articles. The index is
+// kept up to date from article events and can be rebuilt from the
+// repository.
+type SearchService struct {
+	index    SearchIndex
+	articles *ArticleService
+}
+
+func NewSearchService(index SearchIndex, articles *ArticleService) *SearchService {
+	return &SearchService{index: index, articles: articles}
+}
+
+// Search returns a page of the articles that match the query, best first
+func (s *SearchService) Search(query SearchQuery) (SearchResults, error) {
+	if err := query.Validate(); err != nil {
+		return SearchResults{}, err
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultSearchLimit
+	}
+
+	hits, err := s.index.Search(query)
+	if err != nil {
+		return SearchResults{}, err
+	}
+	results := SearchResults{Total: hits.Total}
+	for _, hit := range hits.Hits {
+		article, err := s.articles.repository.GetArticleByID(hit.ArticleID)
+		if err == ErrArticleNotFound {
+			continue
+		}
+		if err != nil {
+			return SearchResults{}, err
+		}
+		results.Results = append(results.Results, SearchResult{
+			Article:  article,
+			Score:    hit.Score,
+			Snippets: searchSnippets(NewSearchDocument(article), hit.Matches),
+		})
+	}
+	return results, nil
+}
+
+// HandleEvent refreshes the article of an event that may change its text,
+// authors, journal or status. Refreshes read the article's current state,
+// so duplicate or late events are harmless.
+func (s *SearchService) HandleEvent(event Event) error {
+	switch event.Type {
+	case EventArticleCreated, EventArticleUpdated, EventArticleStatusChanged:
+		return s.RefreshArticle(event.AggregateID)
+	}
+	return nil
+}
+
+// RefreshArticle indexes the article's current state
+func (s *SearchService) RefreshArticle(articleID string) error {
+	article, err := s.articles.repository.GetArticleByID(articleID)
+	if err == ErrArticleNotFound {
+		return s.index.RemoveDocument(articleID)
+	}
+	if err != nil {
+		return err
+	}
+	return s.index.IndexDocument(NewSearchDocument(article))
+}
+
+// reindexBatchSize is the number of articles read at a time when the index
+// is rebuilt
+const reindexBatchSize = 500
+
+// Reindex indexes every stored article and returns how many there are. It
+// fills an empty index on startup; articles already indexed are replaced.
+func (s *SearchService) Reindex() (int, error) {
+	count, after := 0, ""
+	for {
+		articles, err := s.articles.repository.ListArticlesAfter(after, reindexBatchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, article := range articles {
+			if err := s.index.IndexDocument(NewSearchDocument(article)); err != nil {
+				return count, err
+			}
+		}
+		count += len(articles)
+		if len(articles) < reindexBatchSize {
+			return count, nil
+		}
+		after = articles[len(articles)-1].ID
+	}
+}
+
+// searchSnippets returns a snippet of every field with matches. Titles are
+// given whole; abstracts are cut around their densest run of matches.
+func searchSnippets(document SearchDocument, matches []SearchMatch) []SearchSnippet {
+	var snippets []SearchSnippet
+	for _, field := range SearchFields {
+		text := document.Text(field)
+		var ranges []TextRange
+		for _, match := range matches {
+			// Matches of an older version of the text are ignored
+			if match.Field == field && match.Start >= 0 && match.End <= len(text) && match.Start < match.End {
+				ranges = append(ranges, TextRange{Start: match.Start, End: match.End})
+			}
+		}
+		if len(ranges) == 0 {
+			continue
+		}
+		sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+		if field == SearchFieldTitle {
+			snippets = append(snippets, SearchSnippet{Field: field, Text: text, Highlights: ranges})
+			continue
+		}
+		snippets = append(snippets, excerpt(field, text, ranges))
+	}
+	return snippets
+}
+
+// excerpt cuts about snippetWidth bytes of text around the window with the
+// most highlights, at word boundaries, and marks the cuts with ellipses
+func excerpt(field SearchField, text string, ranges []TextRange) SearchSnippet {
+	best, bestCount := 0, 0
+	for i := range ranges {
+		count := 0
+		for j := i; j < len(ranges) && ranges[j].End <= ranges[i].Start+snippetWidth; j++ {
+			count++
+		}
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+
+	start := ranges[best].Start - snippetLead
+	if start <= 0 {
+		start = 0
+	} else if space := strings.IndexFunc(text[start:ranges[best].Start], unicode.IsSpace); space >= 0 {
+		start += space + 1
+	} else {
+		start = ranges[best].Start
+	}
+	// A match longer than the snippet is shown whole
+	end := max(start+snippetWidth, ranges[best].End)
+	if end >= len(text) {
+		end = len(text)
+	} else if space := strings.LastIndexFunc(text[ranges[best].End:end], unicode.IsSpace); space >= 0 {
+		end = ranges[best].End + space
+	} else {
+		end = ranges[best].End
+	}
+
+	// Line breaks become spaces, which keeps the offsets
+	body := strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' || r == '\t' {
+			return ' '
+		}
+		return r
+	}, text[start:end])
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = ellipsis
+	}
+	if end < len(text) {
+		suffix = ellipsis
+	}
+
+	snippet := SearchSnippet{Field: field, Text: prefix + body + suffix}
+	for _, r := range ranges {
+		if r.Start < start || r.End > end {
+			continue
+		}
+		snippet.Highlights = append(snippet.Highlights, TextRange{Start: r.Start - start + len(prefix), End: r.End - start + len(prefix)})
+	}
+	return snippet
+}
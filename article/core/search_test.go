@@ -0,0 +1,195 @@
+package core_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestAnalyzeText(t *testing.T) {
+	tokens := core.AnalyzeText("The Networks of Gödel's proofs")
+	var terms []string
+	for _, token := range tokens {
+		terms = append(terms, token.Term)
+	}
+	if strings.Join(terms, " ") != "network godel s proof" {
+		t.Errorf("terms = %q", terms)
+	}
+	// Stop words keep their position; offsets are bytes of the original text
+	if first := tokens[0]; first.Position != 1 || first.Start != 4 || first.End != 12 {
+		t.Errorf("first token = %+v", first)
+	}
+	if godel := tokens[1]; godel.Position != 3 || "The Networks of Gödel's proofs"[godel.Start:godel.End] != "Gödel" {
+		t.Errorf("second token = %+v", godel)
+	}
+	if networking := core.AnalyzeText("networking"); len(networking) != 1 || networking[0].Term != tokens[0].Term {
+		t.Errorf("networking and networks stem differently: %+v", networking)
+	}
+}
+
+func TestParseSearchText(t *testing.T) {
+	clauses := core.ParseSearchText(`graph "colouring of planar" the "maps`)
+	if len(clauses) != 3 {
+		t.Fatalf("clauses = %+v", clauses)
+	}
+	if clauses[0].Phrase || !clauses[1].Phrase || len(clauses[1].Tokens) != 2 || clauses[2].Phrase {
+		t.Errorf("clauses = %+v", clauses)
+	}
+	if len(core.ParseSearchText(`the "of a"`)) != 0 {
+		t.Errorf("stop words gave clauses")
+	}
+}
+
+func searchIDs(results core.SearchResults) []string {
+	var ids []string
+	for _, result := range results.Results {
+		ids = append(ids, result.Article.ID)
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	f := newFixture(t).withSearchArticles(t)
+
+	tests := []struct {
+		query core.SearchQuery
+		want  []string
+	}{
+		// Title matches outweigh abstract matches
+		{core.SearchQuery{Text: "graph"}, []string{"s4", "s1", "s2"}},
+		{core.SearchQuery{Text: "networks"}, []string{"s3"}},
+		{core.SearchQuery{Text: `"planar maps"`}, []string{"s2"}},
+		{core.SearchQuery{Text: `"maps planar"`}, nil},
+		{core.SearchQuery{Text: `planar "four colours"`}, []string{"s1"}},
+		{core.SearchQuery{Text: "graph", AuthorID: "author_2"}, []string{"s4"}},
+		{core.SearchQuery{Text: "graph", Status: core.StatusSubmitted}, nil},
+		{core.SearchQuery{Text: "graph", JournalID: "journal_9"}, nil},
+		{core.SearchQuery{Text: "graph", Limit: 1, Offset: 1}, []string{"s1"}},
+	}
+	for _, test := range tests {
+		results, err := f.search.Search(test.query)
+		if err != nil {
+			t.Fatalf("Search(%+v) = %v", test.query, err)
+		}
+		if got := searchIDs(results); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Search(%+v) = %v, want %v", test.query, got, test.want)
+		}
+	}
+
+	results, err := f.search.Search(core.SearchQuery{Text: "graph", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results.Total != 3 || len(results.Results) != 1 {
+		t.Errorf("Search(limit 1) = %d of %d results", len(results.Results), results.Total)
+	}
+	snippets := results.Results[0].Snippets
+	if len(snippets) != 2 || snippets[0].Field != core.SearchFieldTitle ||
+		!reflect.DeepEqual(snippets[0].Highlights, []core.TextRange{{Start: 7, End: 13}}) ||
+		!reflect.DeepEqual(snippets[1].Highlights, []core.TextRange{{Start: 0, End: 5}}) {
+		t.Errorf("snippets = %+v", snippets)
+	}
+
+	// Events keep the index current
+	edited, err := f.service.GetArticleByID("s3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited.Title = "Neural Graphs"
+	if _, err := f.service.UpdateArticle(edited); err != nil {
+		t.Fatal(err)
+	}
+	f.handlePendingSearch(t)
+	if results, err := f.search.Search(core.SearchQuery{Text: "graph"}); err != nil || results.Total != 4 {
+		t.Errorf("Search() after the edit = %v, %v", searchIDs(results), err)
+	}
+}
+
+// handlePendingSearch feeds the events waiting in the outbox to the
+// fixture's search service, as the relay would
+func (f fixture) handlePendingSearch(t *testing.T) {
+	t.Helper()
+	events, err := f.articles.PendingEvents(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range events {
+		if err := f.search.HandleEvent(event); err != nil {
+			t.Fatal(err)
+		}
+		if err := f.articles.MarkEventPublished(event.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSearchQueryIsChecked(t *testing.T) {
+	f := newFixture(t).withSearchArticles(t)
+	for _, query := range []core.SearchQuery{
+		{Text: "  "},
+		{Text: "the of"},
+		{Text: "graph", Status: "lost"},
+		{Text: "graph", Limit: -1},
+		{Text: "graph", Limit: core.MaxSearchLimit + 1},
+		{Text: "graph", Offset: -1},
+	} {
+		if _, err := f.search.Search(query); !errors.Is(err, core.ErrInvalidSearchQuery) {
+			t.Errorf("Search(%+v) = %v, want ErrInvalidSearchQuery", query, err)
+		}
+	}
+}
+
+func TestSearchSnippetsCutLongAbstracts(t *testing.T) {
+	f := newFixture(t)
+	article := newArticle("long", "Long Abstract")
+	article.Abstract = strings.Repeat("Filler words before. ", 20) + "The tiling\nproblem is hard. " + strings.Repeat("Filler words after. ", 20)
+	f.create(t, article)
+	search := core.NewSearchService(adapters.NewInMemorySearchIndex(), f.service)
+	if _, err := search.Reindex(); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := search.Search(core.SearchQuery{Text: "tiling"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snippet := results.Results[0].Snippets[0]
+	if snippet.Field != core.SearchFieldAbstract || !strings.HasPrefix(snippet.Text, "…") || !strings.HasSuffix(snippet.Text, "…") ||
+		len(snippet.Text) > 220 || strings.Contains(snippet.Text, "\n") {
+		t.Fatalf("snippet = %+v", snippet)
+	}
+	if highlight := snippet.Highlights[0]; snippet.Text[highlight.Start:highlight.End] != "tiling" {
+		t.Errorf("highlight %+v marks %q", highlight, snippet.Text[highlight.Start:highlight.End])
+	}
+}
+
+func TestSearchSnippetsKeepLongWords(t *testing.T) {
+	f := newFixture(t)
+	word := strings.Repeat("tetra", 60)
+	article := newArticle("word", "Long Word")
+	article.Abstract = strings.Repeat("Filler words before. ", 5) + word + strings.Repeat(" Filler words after.", 20)
+	f.create(t, article)
+	search := core.NewSearchService(adapters.NewInMemorySearchIndex(), f.service)
+	if _, err := search.Reindex(); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := search.Search(core.SearchQuery{Text: word})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Results) != 1 {
+		t.Fatalf("Search() = %+v, want the article", results)
+	}
+	snippet := results.Results[0].Snippets[0]
+	if snippet.Field != core.SearchFieldAbstract || len(snippet.Highlights) != 1 || !strings.HasSuffix(snippet.Text, "…") {
+		t.Fatalf("snippet = %+v", snippet)
+	}
+	if highlight := snippet.Highlights[0]; snippet.Text[highlight.Start:highlight.End] != word {
+		t.Errorf("highlight %+v marks %q", highlight, snippet.Text[highlight.Start:highlight.End])
+	}
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ArticleStatus is the position of an article in the publishing workflow
+type ArticleStatus string
+
+const (
+	StatusDraft       ArticleStatus = "draft"
+	StatusSubmitted   ArticleStatus = "submitted"
+	StatusUnderReview ArticleStatus = "under_review"
+	StatusAccepted    ArticleStatus = "accepted"
+	StatusRejected    ArticleStatus = "rejected"
+	StatusPublished   ArticleStatus = "published"
+)
+
+// transitions lists the statuses each status may move to. Rejected and
+// published are terminal.
+var transitions = map[ArticleStatus][]ArticleStatus{
+	StatusDraft:       {StatusSubmitted},
+	StatusSubmitted:   {StatusUnderReview, StatusRejected},
+	StatusUnderReview: {StatusAccepted, StatusRejected},
+	StatusAccepted:    {StatusPublished},
+}
+
+// Valid reports whether the status is part of the workflow
+func (s ArticleStatus) Valid() bool {
+	switch s {
+	case StatusDraft, StatusSubmitted, StatusUnderReview, StatusAccepted, StatusRejected, StatusPublished:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether the workflow allows moving to the target status
+func (s ArticleStatus) CanTransitionTo(target ArticleStatus) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusTransition is one entry in an article's status history
+type StatusTransition struct {
+	ArticleID string        `json:"article_id"`
+	From      ArticleStatus `json:"from"`
+	To        ArticleStatus `json:"to"`
+	Actor     string        `json:"actor"`
+	Reason    string        `json:"reason,omitempty"`
+	At        time.Time     `json:"at"`
+}
+
+// InvalidTransitionError is returned when the workflow does not allow a
+// transition. It matches ErrInvalidTransition with errors.Is.
+type InvalidTransitionError struct {
+	ArticleID string
+	From      ArticleStatus
+	To        ArticleStatus
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("%s: article %s cannot move from %s to %s", ErrInvalidTransition, e.ArticleID, e.From, e.To)
+}
+
+func (e *InvalidTransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
+
+// GuardError is returned when a transition is allowed by the workflow but a
+// business rule blocks it. It matches ErrTransitionBlocked with errors.Is.
+type GuardError struct {
+	ArticleID string
+	To        ArticleStatus
+	Reason    string
+}
+
+func (e *GuardError) Error() string {
+	return fmt.Sprintf("%s: article %s cannot move to %s: %s", ErrTransitionBlocked, e.ArticleID, e.To, e.Reason)
+}
+
+func (e *GuardError) Is(target error) bool {
+	return target == ErrTransitionBlocked
+}
+
+// SubmitArticle moves a draft into the editorial queue
+func (s *ArticleService) SubmitArticle(id string) (Article, error) {
+	return s.transition(id, StatusSubmitted, "", func(article Article) error {
+		if strings.TrimSpace(article.Abstract) == "" {
+			return &GuardError{ArticleID: id, To: StatusSubmitted, Reason: "an abstract is required for submission"}
+		}
+		return nil
+	})
+}
+
+// StartReview sends a submitted article out for peer review
+func (s *ArticleService) StartReview(id string) (Article, error) {
+	return s.transition(id, StatusUnderReview, "", nil)
+}
+
+// AcceptArticle records a positive editorial decision
+func (s *ArticleService) AcceptArticle(id string) (Article, error) {
+	return s.transition(id, StatusAccepted, "", nil)
+}
+
+// RejectArticle records a negative editorial decision, either after review
+// or as a desk rejection of a submitted article
+func (s *ArticleService) RejectArticle(id, reason string) (Article, error) {
+	return s.transition(id, StatusRejected, reason, nil)
+}
+
+// PublishArticle publishes an accepted article. The article's journal must
+// still exist in the journal service.
+func (s *ArticleService) PublishArticle(id string) (Article, error) {
+	return s.transition(id, StatusPublished, "", func(article Article) error {
+		if _, err := s.journals.GetJournal(article.JournalID); err != nil {
+			if errors.Is(err, ErrJournalNotFound) {
+				return &GuardError{ArticleID: id, To: StatusPublished, Reason: fmt.Sprintf("journal %s does not exist", article.JournalID)}
+			}
+			return fmt.Errorf("failed to look up journal %s: %w", article.JournalID, err)
+		}
+		return nil
+	})
+}
+
+// GetStatusHistory returns the transitions of an article, oldest first
+func (s *ArticleService) GetStatusHistory(id string) ([]StatusTransition, error) {
+	if _, err := s.repository.GetArticleByID(id); err != nil {
+		return nil, err
+	}
+	return s.repository.GetStatusHistory(id)
+}
+
+// StatusChange is a workflow step that has been checked but not stored: the
+// article with its new status, the history entry and the events to store
+// with it. Repositories that store another record with the step, such as a
+// review decision, take a StatusChange and apply it in the same transaction.
+type StatusChange struct {
+	Article    Article
+	Transition StatusTransition
+	Events     []Event
+}
+
+// transition applies a workflow step after checking the transition table and
+// the optional guard, then stores the new status with its history entry
+func (s *ArticleService) transition(id string, to ArticleStatus, reason string, guard func(Article) error) (Article, error) {
+	change, err := s.prepareTransition(id, to, reason, guard)
+	if err != nil {
+		return Article{}, err
+	}
+	return s.repository.UpdateArticleStatus(change.Article, change.Transition, change.Events...)
+}
+
+// prepareTransition checks a workflow step and builds the change that stores it
+func (s *ArticleService) prepareTransition(id string, to ArticleStatus, reason string, guard func(Article) error) (StatusChange, error) {
+	before, err := s.repository.GetArticleByID(id)
+	if err != nil {
+		return StatusChange{}, err
+	}
+
+	if !before.Status.CanTransitionTo(to) {
+		return StatusChange{}, &InvalidTransitionError{ArticleID: id, From: before.Status, To: to}
+	}
+	if guard != nil {
+		if err := guard(before); err != nil {
+			return StatusChange{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	after := before
+	after.Status = to
+	if to == StatusPublished {
+		after.PublishedAt = &now
+	}
+	return s.statusChange(before, after, reason, now)
+}
+
+// importPublication records the publication of an imported draft. Imported
+// articles went through peer review elsewhere, so the draft moves straight
+// to published, on the date of its record, and the history entry says so.
+func (s *ArticleService) importPublication(id string, publishedAt time.Time) (Article, error) {
+	before, err := s.repository.GetArticleByID(id)
+	if err != nil {
+		return Article{}, err
+	}
+	if before.Status != StatusDraft {
+		return Article{}, &InvalidTransitionError{ArticleID: id, From: before.Status, To: StatusPublished}
+	}
+
+	after := before
+	after.Status = StatusPublished
+	after.PublishedAt = &publishedAt
+	change, err := s.statusChange(before, after, "imported as published", time.Now().UTC())
+	if err != nil {
+		return Article{}, err
+	}
+	return s.repository.UpdateArticleStatus(change.Article, change.Transition, change.Events...)
+}
+
+// statusChange builds the history entry and events of a workflow step
+func (s *ArticleService) statusChange(before, after Article, reason string, at time.Time) (StatusChange, error) {
+	record := StatusTransition{ArticleID: after.ID, From: before.Status, To: after.Status, Actor: s.caller.Actor, Reason: reason, At: at}
+	events, err := transitionEvents(after, record)
+	if err != nil {
+		return StatusChange{}, err
+	}
+	audit, err := s.auditEvent(AuditTransitionArticle, after.ID, before, after)
+	if err != nil {
+		return StatusChange{}, err
+	}
+
+	return StatusChange{Article: after, Transition: record, Events: append(events, audit)}, nil
+}
+
+func transitionEvents(article Article, record StatusTransition) ([]Event, error) {
+	changed, err := NewEvent(EventArticleStatusChanged, article.ID, record)
+	if err != nil {
+		return nil, err
+	}
+	if record.To != StatusPublished {
+		return []Event{changed}, nil
+	}
+
+	published, err := NewEvent(EventArticlePublished, article.ID, article)
+	if err != nil {
+		return nil, err
+	}
+	return []Event{changed, published}, nil
+}
@@ -0,0 +1,184 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to core.ArticleStatus
+		want     bool
+	}{
+		{core.StatusDraft, core.StatusSubmitted, true},
+		{core.StatusDraft, core.StatusPublished, false},
+		{core.StatusSubmitted, core.StatusUnderReview, true},
+		{core.StatusSubmitted, core.StatusRejected, true},
+		{core.StatusSubmitted, core.StatusAccepted, false},
+		{core.StatusUnderReview, core.StatusAccepted, true},
+		{core.StatusUnderReview, core.StatusRejected, true},
+		{core.StatusAccepted, core.StatusPublished, true},
+		{core.StatusAccepted, core.StatusDraft, false},
+		{core.StatusRejected, core.StatusSubmitted, false},
+		{core.StatusPublished, core.StatusDraft, false},
+	}
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s.CanTransitionTo(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestArticleWorkflow(t *testing.T) {
+	f := newFixture(t)
+	service := f.service.WithActor("editor")
+	f.create(t, newArticle("a1", "Graph Colouring"))
+
+	steps := []func(string) (core.Article, error){
+		service.SubmitArticle,
+		service.StartReview,
+		service.AcceptArticle,
+		service.PublishArticle,
+	}
+	for _, step := range steps {
+		if _, err := step("a1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	article, err := service.GetArticleByID("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if article.Status != core.StatusPublished || article.PublishedAt == nil {
+		t.Errorf("article = %s published at %v, want published with a date", article.Status, article.PublishedAt)
+	}
+
+	history, err := service.GetStatusHistory("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []core.ArticleStatus{core.StatusSubmitted, core.StatusUnderReview, core.StatusAccepted, core.StatusPublished}
+	if len(history) != len(want) {
+		t.Fatalf("history has %d transitions, want %d", len(history), len(want))
+	}
+	from := core.StatusDraft
+	for i, transition := range history {
+		if transition.From != from || transition.To != want[i] || transition.Actor != "editor" {
+			t.Errorf("transition %d = %+v", i, transition)
+		}
+		from = transition.To
+	}
+}
+
+func TestInvalidTransitions(t *testing.T) {
+	f := newFixture(t)
+	f.create(t, newArticle("a1", "Graph Colouring"))
+
+	_, err := f.service.PublishArticle("a1")
+	var invalid *core.InvalidTransitionError
+	if !errors.As(err, &invalid) || !errors.Is(err, core.ErrInvalidTransition) {
+		t.Fatalf("PublishArticle(draft) = %v, want an InvalidTransitionError", err)
+	}
+	if invalid.From != core.StatusDraft || invalid.To != core.StatusPublished {
+		t.Errorf("error = %+v", invalid)
+	}
+
+	if _, err := f.service.RejectArticle("a1", "out of scope"); !errors.Is(err, core.ErrInvalidTransition) {
+		t.Errorf("RejectArticle(draft) = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.service.SubmitArticle("missing"); !errors.Is(err, core.ErrArticleNotFound) {
+		t.Errorf("SubmitArticle(missing) = %v, want ErrArticleNotFound", err)
+	}
+
+	history, err := f.service.GetStatusHistory("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Errorf("refused transitions were recorded: %+v", history)
+	}
+}
+
+func TestTransitionGuards(t *testing.T) {
+	f := newFixture(t)
+
+	noAbstract := newArticle("a1", "Graph Colouring")
+	noAbstract.Abstract = " "
+	f.create(t, noAbstract)
+	_, err := f.service.SubmitArticle("a1")
+	var guard *core.GuardError
+	if !errors.As(err, &guard) || !errors.Is(err, core.ErrTransitionBlocked) {
+		t.Fatalf("SubmitArticle(no abstract) = %v, want a GuardError", err)
+	}
+
+	// The journal service no longer knows the article's journal, which
+	// the service would not let it be created in
+	orphan := newArticle("a2", "Graph Minors")
+	orphan.JournalID, orphan.Status = "gone", core.StatusDraft
+	if _, err := f.articles.CreateArticle(orphan, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, step := range []func(string) (core.Article, error){f.service.SubmitArticle, f.service.StartReview, f.service.AcceptArticle} {
+		if _, err := step("a2"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.service.PublishArticle("a2"); !errors.Is(err, core.ErrTransitionBlocked) {
+		t.Errorf("PublishArticle(unknown journal) = %v, want ErrTransitionBlocked", err)
+	}
+
+	article, err := f.service.GetArticleByID("a2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if article.Status != core.StatusAccepted {
+		t.Errorf("status = %s after a blocked transition, want accepted", article.Status)
+	}
+}
+
+func TestRejectionRecordsTheReason(t *testing.T) {
+	f := newFixture(t)
+	f.create(t, newArticle("a1", "Graph Colouring"))
+	if _, err := f.service.SubmitArticle("a1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.service.RejectArticle("a1", "out of scope"); err != nil {
+		t.Fatal(err)
+	}
+	// Rejected is terminal
+	if _, err := f.service.SubmitArticle("a1"); !errors.Is(err, core.ErrInvalidTransition) {
+		t.Errorf("SubmitArticle(rejected) = %v, want ErrInvalidTransition", err)
+	}
+
+	history, err := f.service.GetStatusHistory("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := history[len(history)-1]; last.To != core.StatusRejected || last.Reason != "out of scope" {
+		t.Errorf("last transition = %+v", last)
+	}
+}
+
+func TestCreateArticleRejectsAnExistingID(t *testing.T) {
+	f := newFixture(t)
+	f.create(t, newArticle("a1", "Graph Colouring"))
+
+	_, err := f.service.CreateArticle(newArticle("a1", "Graph Minors"))
+	if !errors.Is(err, core.ErrArticleExists) {
+		t.Fatalf("CreateArticle(existing ID) = %v, want ErrArticleExists", err)
+	}
+
+	stored, err := f.service.GetArticleByID("a1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Title != "Graph Colouring" {
+		t.Errorf("stored title = %q, the existing article was overwritten", stored.Title)
+	}
+	if got := len(f.auditEntries(t)); got != 1 {
+		t.Errorf("got %d audit records, want 1", got)
+	}
+}
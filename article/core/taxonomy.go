@@ -0,0 +1,385 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultSubjectLimit is the number of articles returned when a subject
+	// query sets no limit
+	DefaultSubjectLimit = 20
+
+	// MaxSubjectLimit bounds subject queries
+	MaxSubjectLimit = 100
+)
+
+// SubjectTerm is a term of the controlled subject vocabulary. Terms form a
+// hierarchy: an article about a term is also about the terms above it.
+type SubjectTerm struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	// ParentID is empty for top-level terms
+	ParentID string `json:"parent_id,omitempty"`
+	// Synonyms are other names the term is known by. Like labels they are
+	// compared ignoring case, accents and punctuation, and each name
+	// belongs to one term only.
+	Synonyms  []string  `json:"synonyms,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate checks if the term data is valid
+func (t SubjectTerm) Validate() error {
+	if strings.TrimSpace(t.ID) == "" {
+		return fmt.Errorf("%w: ID cannot be empty", ErrInvalidSubjectTerm)
+	}
+
+	if NormalizeTitle(t.Label) == "" {
+		return fmt.Errorf("%w: label has no words", ErrInvalidSubjectTerm)
+	}
+
+	if t.ParentID == t.ID {
+		return fmt.Errorf("%w: term %s cannot be its own parent", ErrInvalidSubjectTerm, t.ID)
+	}
+
+	names := map[string]bool{NormalizeTitle(t.Label): true}
+	for i, synonym := range t.Synonyms {
+		name := NormalizeTitle(synonym)
+		if name == "" {
+			return fmt.Errorf("%w: synonym %d has no words", ErrInvalidSubjectTerm, i+1)
+		}
+		if names[name] {
+			return fmt.Errorf("%w: name %q is given more than once", ErrInvalidSubjectTerm, synonym)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+// names returns the normalized label and synonyms of the term
+func (t SubjectTerm) names() []string {
+	names := []string{NormalizeTitle(t.Label)}
+	for _, synonym := range t.Synonyms {
+		names = append(names, NormalizeTitle(synonym))
+	}
+	return names
+}
+
+// Taxonomy is a snapshot of the subject vocabulary for resolving names and
+// walking the hierarchy
+type Taxonomy struct {
+	terms map[string]SubjectTerm
+	// names maps normalized labels and synonyms to term IDs
+	names map[string]string
+	// children maps term IDs to the IDs of the terms below them, in order
+	children map[string][]string
+}
+
+func NewTaxonomy(terms []SubjectTerm) *Taxonomy {
+	t := &Taxonomy{
+		terms:    make(map[string]SubjectTerm, len(terms)),
+		names:    make(map[string]string),
+		children: make(map[string][]string),
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i].ID < terms[j].ID })
+	for _, term := range terms {
+		t.terms[term.ID] = term
+		for _, name := range term.names() {
+			t.names[name] = term.ID
+		}
+		if term.ParentID != "" {
+			t.children[term.ParentID] = append(t.children[term.ParentID], term.ID)
+		}
+	}
+	return t
+}
+
+// Term returns the term with the ID
+func (t *Taxonomy) Term(id string) (SubjectTerm, bool) {
+	term, ok := t.terms[id]
+	return term, ok
+}
+
+// Resolve returns the term with the given ID, or else the term whose label
+// or a synonym matches the name
+func (t *Taxonomy) Resolve(name string) (SubjectTerm, bool) {
+	if term, ok := t.terms[strings.TrimSpace(name)]; ok {
+		return term, true
+	}
+	id, ok := t.names[NormalizeTitle(name)]
+	if !ok {
+		return SubjectTerm{}, false
+	}
+	return t.terms[id], true
+}
+
+// Subtree returns the ID of the term followed by the IDs of all terms below
+// it, level by level
+func (t *Taxonomy) Subtree(id string) []string {
+	ids := []string{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, t.children[ids[i]]...)
+	}
+	return ids
+}
+
+// Ancestors returns the ID of the term followed by the IDs of the terms
+// above it, up to its top-level term
+func (t *Taxonomy) Ancestors(id string) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for id != "" && !seen[id] {
+		seen[id] = true
+		ids = append(ids, id)
+		id = t.terms[id].ParentID
+	}
+	return ids
+}
+
+// checkTerm checks that the term's parent exists, that moving it there
+// creates no cycle and that no other term uses its label or synonyms
+func (t *Taxonomy) checkTerm(term SubjectTerm) error {
+	if term.ParentID != "" {
+		if _, ok := t.terms[term.ParentID]; !ok {
+			return fmt.Errorf("%w: unknown parent term %s", ErrInvalidSubjectTerm, term.ParentID)
+		}
+		for _, id := range t.Ancestors(term.ParentID) {
+			if id == term.ID {
+				return fmt.Errorf("%w: term %s cannot be moved below its own subterm %s", ErrInvalidSubjectTerm, term.ID, term.ParentID)
+			}
+		}
+	}
+	for _, name := range term.names() {
+		if id, ok := t.names[name]; ok && id != term.ID {
+			return fmt.Errorf("%w: %q already names term %s", ErrInvalidSubjectTerm, name, id)
+		}
+	}
+	return nil
+}
+
+// SubjectQuery selects articles by subject
+type SubjectQuery struct {
+	TermID string
+	// IncludeDescendants also selects the articles tagged with a term below
+	// the query's term
+	IncludeDescendants bool
+	Offset             int
+	Limit              int
+}
+
+// Validate checks if the query can be run
+func (q SubjectQuery) Validate() error {
+	if strings.TrimSpace(q.TermID) == "" {
+		return fmt.Errorf("%w: term ID cannot be empty", ErrInvalidSubjectQuery)
+	}
+	if q.Limit < 0 || q.Limit > MaxSubjectLimit {
+		return fmt.Errorf("%w: limit cannot be negative or exceed %d", ErrInvalidSubjectQuery, MaxSubjectLimit)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("%w: offset cannot be negative", ErrInvalidSubjectQuery)
+	}
+	return nil
+}
+
+// TaxonomyService manages the subject vocabulary and finds articles by
+// subject
+type TaxonomyService struct {
+	repository TaxonomyRepository
+	articles   *ArticleService
+}
+
+func NewTaxonomyService(repository TaxonomyRepository, articles *ArticleService) *TaxonomyService {
+	return &TaxonomyService{repository: repository, articles: articles}
+}
+
+// CreateTerm adds a term below its parent, or at the top level when it has
+// none
+func (s *TaxonomyService) CreateTerm(term SubjectTerm) (SubjectTerm, error) {
+	if err := term.Validate(); err != nil {
+		return SubjectTerm{}, err
+	}
+	taxonomy, err := s.taxonomy()
+	if err != nil {
+		return SubjectTerm{}, err
+	}
+	if _, ok := taxonomy.Term(term.ID); ok {
+		return SubjectTerm{}, fmt.Errorf("%w: term %s already exists", ErrInvalidSubjectTerm, term.ID)
+	}
+	if err := taxonomy.checkTerm(term); err != nil {
+		return SubjectTerm{}, err
+	}
+
+	now := time.Now().UTC()
+	term.CreatedAt = now
+	term.UpdatedAt = now
+	return s.repository.CreateTerm(term)
+}
+
+func (s *TaxonomyService) GetTerm(id string) (SubjectTerm, error) {
+	return s.repository.GetTerm(id)
+}
+
+// ListTerms returns the whole vocabulary ordered by ID
+func (s *TaxonomyService) ListTerms() ([]SubjectTerm, error) {
+	return s.repository.ListTerms()
+}
+
+// UpdateTerm renames the term, replaces its synonyms or moves it to another
+// parent. Articles refer to terms by ID, so they keep their subjects.
+func (s *TaxonomyService) UpdateTerm(term SubjectTerm) (SubjectTerm, error) {
+	if err := term.Validate(); err != nil {
+		return SubjectTerm{}, err
+	}
+	existing, err := s.repository.GetTerm(term.ID)
+	if err != nil {
+		return SubjectTerm{}, err
+	}
+	taxonomy, err := s.taxonomy()
+	if err != nil {
+		return SubjectTerm{}, err
+	}
+	if err := taxonomy.checkTerm(term); err != nil {
+		return SubjectTerm{}, err
+	}
+
+	term.CreatedAt = existing.CreatedAt
+	term.UpdatedAt = time.Now().UTC()
+	return s.repository.UpdateTerm(term)
+}
+
+// DeleteTerm removes a term no other term or article refers to
+func (s *TaxonomyService) DeleteTerm(id string) error {
+	if _, err := s.repository.GetTerm(id); err != nil {
+		return err
+	}
+	taxonomy, err := s.taxonomy()
+	if err != nil {
+		return err
+	}
+	if below := taxonomy.Subtree(id)[1:]; len(below) > 0 {
+		return fmt.Errorf("%w: term %s has %d subterm(s)", ErrSubjectTermInUse, id, len(below))
+	}
+	tagged, err := s.articles.repository.ListArticlesBySubjects([]string{id}, 0, 1)
+	if err != nil {
+		return err
+	}
+	if tagged.Total > 0 {
+		return fmt.Errorf("%w: term %s is a subject of %d article(s)", ErrSubjectTermInUse, id, tagged.Total)
+	}
+	return s.repository.DeleteTerm(id)
+}
+
+// ListArticlesBySubject returns a page of the articles tagged with the
+// query's term, and with the terms below it if the query asks for them,
+// ordered by ID
+func (s *TaxonomyService) ListArticlesBySubject(query SubjectQuery) (ArticlePage, error) {
+	if err := query.Validate(); err != nil {
+		return ArticlePage{}, err
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultSubjectLimit
+	}
+	if _, err := s.repository.GetTerm(query.TermID); err != nil {
+		return ArticlePage{}, err
+	}
+
+	termIDs := []string{query.TermID}
+	if query.IncludeDescendants {
+		taxonomy, err := s.taxonomy()
+		if err != nil {
+			return ArticlePage{}, err
+		}
+		termIDs = taxonomy.Subtree(query.TermID)
+	}
+	return s.articles.repository.ListArticlesBySubjects(termIDs, query.Offset, query.Limit)
+}
+
+func (s *TaxonomyService) taxonomy() (*Taxonomy, error) {
+	terms, err := s.repository.ListTerms()
+	if err != nil {
+		return nil, err
+	}
+	return NewTaxonomy(terms), nil
+}
+
+// resolveSubjects replaces the article's subjects, which may be term IDs,
+// labels or synonyms, with the IDs of the terms they name
+func (s *ArticleService) resolveSubjects(article *Article) error {
+	if len(article.Subjects) == 0 {
+		return nil
+	}
+	terms, err := s.taxonomy.ListTerms()
+	if err != nil {
+		return err
+	}
+	taxonomy := NewTaxonomy(terms)
+
+	resolved := make([]string, 0, len(article.Subjects))
+	seen := make(map[string]bool, len(article.Subjects))
+	for _, subject := range article.Subjects {
+		term, ok := taxonomy.Resolve(subject)
+		if !ok {
+			return fmt.Errorf("%w: unknown subject %q", ErrInvalidArticle, subject)
+		}
+		if seen[term.ID] {
+			return fmt.Errorf("%w: subject %s is listed more than once", ErrInvalidArticle, term.ID)
+		}
+		seen[term.ID] = true
+		resolved = append(resolved, term.ID)
+	}
+	article.Subjects = resolved
+	return nil
+}
+
+// SubjectScopeWarnings describes the article's subjects that are outside
+// its journal's scope, neither a scope term nor below one. Journals without
+// a scope, and journals the directory does not know, accept every subject.
+// Articles are stored either way; the warnings are for the editors.
+func (s *ArticleService) SubjectScopeWarnings(article Article) ([]string, error) {
+	if len(article.Subjects) == 0 {
+		return nil, nil
+	}
+	journal, err := s.journals.GetJournal(article.JournalID)
+	if errors.Is(err, ErrJournalNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(journal.SubjectScope) == 0 {
+		return nil, nil
+	}
+	terms, err := s.taxonomy.ListTerms()
+	if err != nil {
+		return nil, err
+	}
+	taxonomy := NewTaxonomy(terms)
+
+	inScope := make(map[string]bool, len(journal.SubjectScope))
+	for _, termID := range journal.SubjectScope {
+		inScope[termID] = true
+	}
+	var warnings []string
+	for _, subject := range article.Subjects {
+		covered := false
+		for _, id := range taxonomy.Ancestors(subject) {
+			if inScope[id] {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			label := subject
+			if term, ok := taxonomy.Term(subject); ok {
+				label = term.Label
+			}
+			warnings = append(warnings, fmt.Sprintf("subject %q (%s) is outside the scope of journal %s", label, subject, journal.ID))
+		}
+	}
+	return warnings, nil
+}
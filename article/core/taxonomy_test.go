@@ -0,0 +1,243 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// subjectTerms is a small vocabulary: mathematics with algebra and
+// geometry below it, and group theory below algebra
+func subjectTerms() []core.SubjectTerm {
+	return []core.SubjectTerm{
+		{ID: "math", Label: "Mathematics"},
+		{ID: "algebra", Label: "Algebra", ParentID: "math", Synonyms: []string{"Abstract Algebra"}},
+		{ID: "groups", Label: "Group Theory", ParentID: "algebra"},
+		{ID: "geometry", Label: "Géométrie", ParentID: "math"},
+	}
+}
+
+func TestSubjectTermValidate(t *testing.T) {
+	for _, term := range subjectTerms() {
+		if err := term.Validate(); err != nil {
+			t.Errorf("Validate(%s) = %v", term.ID, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		term core.SubjectTerm
+	}{
+		{"no ID", core.SubjectTerm{ID: " ", Label: "Algebra"}},
+		{"no label", core.SubjectTerm{ID: "algebra", Label: "—"}},
+		{"own parent", core.SubjectTerm{ID: "algebra", Label: "Algebra", ParentID: "algebra"}},
+		{"empty synonym", core.SubjectTerm{ID: "algebra", Label: "Algebra", Synonyms: []string{""}}},
+		{"synonym repeats the label", core.SubjectTerm{ID: "algebra", Label: "Algebra", Synonyms: []string{"ALGEBRA!"}}},
+	}
+	for _, test := range tests {
+		if err := test.term.Validate(); !errors.Is(err, core.ErrInvalidSubjectTerm) {
+			t.Errorf("%s: Validate() = %v, want ErrInvalidSubjectTerm", test.name, err)
+		}
+	}
+}
+
+func TestTaxonomy(t *testing.T) {
+	taxonomy := core.NewTaxonomy(subjectTerms())
+
+	for name, want := range map[string]string{
+		"groups":           "groups",
+		" geometry ":       "geometry",
+		"group  theory":    "groups",
+		"geometrie":        "geometry",
+		"abstract algebra": "algebra",
+	} {
+		if term, ok := taxonomy.Resolve(name); !ok || term.ID != want {
+			t.Errorf("Resolve(%q) = %s, %v, want %s", name, term.ID, ok, want)
+		}
+	}
+	if term, ok := taxonomy.Resolve("topology"); ok {
+		t.Errorf("Resolve(topology) = %s", term.ID)
+	}
+
+	if got := taxonomy.Subtree("math"); !reflect.DeepEqual(got, []string{"math", "algebra", "geometry", "groups"}) {
+		t.Errorf("Subtree(math) = %v", got)
+	}
+	if got := taxonomy.Subtree("groups"); !reflect.DeepEqual(got, []string{"groups"}) {
+		t.Errorf("Subtree(groups) = %v", got)
+	}
+	if got := taxonomy.Ancestors("groups"); !reflect.DeepEqual(got, []string{"groups", "algebra", "math"}) {
+		t.Errorf("Ancestors(groups) = %v", got)
+	}
+}
+
+func TestTaxonomyServiceTerms(t *testing.T) {
+	f := newFixture(t).withSubjectTerms(t)
+
+	tests := []struct {
+		name string
+		term core.SubjectTerm
+	}{
+		{"existing ID", core.SubjectTerm{ID: "algebra", Label: "Linear Algebra"}},
+		{"unknown parent", core.SubjectTerm{ID: "topology", Label: "Topology", ParentID: "science"}},
+		{"name of another term", core.SubjectTerm{ID: "rings", Label: "Rings", Synonyms: []string{"abstract algebra"}}},
+	}
+	for _, test := range tests {
+		if _, err := f.subjects.CreateTerm(test.term); !errors.Is(err, core.ErrInvalidSubjectTerm) {
+			t.Errorf("%s: CreateTerm() = %v, want ErrInvalidSubjectTerm", test.name, err)
+		}
+	}
+
+	created, err := f.subjects.GetTerm("groups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	renamed := created
+	renamed.Label, renamed.Synonyms = "Groups", []string{"Group Theory"}
+	updated, err := f.subjects.UpdateTerm(renamed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) || updated.UpdatedAt.Before(created.UpdatedAt) {
+		t.Errorf("UpdateTerm() = %+v, created %+v", updated, created)
+	}
+	cycle := core.SubjectTerm{ID: "algebra", Label: "Algebra", ParentID: "groups"}
+	if _, err := f.subjects.UpdateTerm(cycle); !errors.Is(err, core.ErrInvalidSubjectTerm) {
+		t.Errorf("UpdateTerm() below its own subterm = %v, want ErrInvalidSubjectTerm", err)
+	}
+	if _, err := f.subjects.UpdateTerm(core.SubjectTerm{ID: "topology", Label: "Topology"}); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("UpdateTerm() of an unknown term = %v, want ErrSubjectTermNotFound", err)
+	}
+
+	// Terms with subterms or articles stay
+	article := newArticle("a1", "Sylow Theorems")
+	article.Subjects = []string{"groups"}
+	f.create(t, article)
+	for _, id := range []string{"algebra", "groups"} {
+		if err := f.subjects.DeleteTerm(id); !errors.Is(err, core.ErrSubjectTermInUse) {
+			t.Errorf("DeleteTerm(%s) = %v, want ErrSubjectTermInUse", id, err)
+		}
+	}
+	if err := f.subjects.DeleteTerm("geometry"); err != nil {
+		t.Errorf("DeleteTerm(geometry) = %v", err)
+	}
+	if _, err := f.subjects.GetTerm("geometry"); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("GetTerm() of the deleted term = %v, want ErrSubjectTermNotFound", err)
+	}
+	if err := f.subjects.DeleteTerm("geometry"); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("DeleteTerm() again = %v, want ErrSubjectTermNotFound", err)
+	}
+	terms, err := f.subjects.ListTerms()
+	if err != nil || len(terms) != 3 || terms[0].ID != "algebra" {
+		t.Errorf("ListTerms() = %+v, %v", terms, err)
+	}
+}
+
+func TestArticleSubjects(t *testing.T) {
+	f := newFixture(t).withSubjectTerms(t)
+
+	// Subjects are given by ID, label or synonym and stored by ID
+	tagged := newArticle("a1", "Sylow Theorems")
+	tagged.Subjects = []string{"Abstract algebra", "GROUP THEORY"}
+	if created := f.create(t, tagged); !reflect.DeepEqual(created.Subjects, []string{"algebra", "groups"}) {
+		t.Errorf("subjects = %v", created.Subjects)
+	}
+	for _, subjects := range [][]string{{"topology"}, {"algebra", "Algebra"}, {" "}} {
+		article := newArticle("a9", "Rings")
+		article.Subjects = subjects
+		if _, err := f.service.CreateArticle(article); !errors.Is(err, core.ErrInvalidArticle) {
+			t.Errorf("CreateArticle() with subjects %q = %v, want ErrInvalidArticle", subjects, err)
+		}
+	}
+
+	for id, subject := range map[string]string{"a2": "groups", "a3": "geometry", "a4": "math"} {
+		article := newArticle(id, "Article "+id)
+		article.Subjects = []string{subject}
+		f.create(t, article)
+	}
+	tests := []struct {
+		query core.SubjectQuery
+		want  []string
+		total int
+	}{
+		{core.SubjectQuery{TermID: "algebra"}, []string{"a1"}, 1},
+		{core.SubjectQuery{TermID: "algebra", IncludeDescendants: true}, []string{"a1", "a2"}, 2},
+		{core.SubjectQuery{TermID: "math", IncludeDescendants: true, Offset: 1, Limit: 2}, []string{"a2", "a3"}, 4},
+		{core.SubjectQuery{TermID: "geometry", Offset: 5}, nil, 1},
+	}
+	for _, test := range tests {
+		page, err := f.subjects.ListArticlesBySubject(test.query)
+		if err != nil {
+			t.Fatalf("ListArticlesBySubject(%+v) = %v", test.query, err)
+		}
+		var ids []string
+		for _, article := range page.Articles {
+			ids = append(ids, article.ID)
+		}
+		if !reflect.DeepEqual(ids, test.want) || page.Total != test.total {
+			t.Errorf("ListArticlesBySubject(%+v) = %v of %d, want %v of %d", test.query, ids, page.Total, test.want, test.total)
+		}
+	}
+}
+
+func TestSubjectQueryIsChecked(t *testing.T) {
+	f := newFixture(t).withSubjectTerms(t)
+
+	for _, query := range []core.SubjectQuery{
+		{TermID: " "},
+		{TermID: "math", Limit: -1},
+		{TermID: "math", Limit: core.MaxSubjectLimit + 1},
+		{TermID: "math", Offset: -1},
+	} {
+		if _, err := f.subjects.ListArticlesBySubject(query); !errors.Is(err, core.ErrInvalidSubjectQuery) {
+			t.Errorf("ListArticlesBySubject(%+v) = %v, want ErrInvalidSubjectQuery", query, err)
+		}
+	}
+	if _, err := f.subjects.ListArticlesBySubject(core.SubjectQuery{TermID: "topology"}); !errors.Is(err, core.ErrSubjectTermNotFound) {
+		t.Errorf("ListArticlesBySubject() of an unknown term = %v, want ErrSubjectTermNotFound", err)
+	}
+}
+
+func TestSubjectScopeWarnings(t *testing.T) {
+	f := newFixture(t, core.JournalInfo{ID: "journal_2", Name: "Algebra Letters", SubjectScope: []string{"algebra"}}).withSubjectTerms(t)
+
+	article := newArticle("a1", "Groups and Shapes")
+	article.JournalID = "journal_2"
+	article.Subjects = []string{"groups", "geometry", "algebra"}
+	warnings, err := f.service.SubjectScopeWarnings(article)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"Géométrie" (geometry)`) {
+		t.Errorf("SubjectScopeWarnings() = %q, want one about geometry", warnings)
+	}
+
+	// Journals without a scope, or unknown to the directory, take anything
+	for _, journalID := range []string{testJournalID, "journal_9"} {
+		article.JournalID = journalID
+		if warnings, err := f.service.SubjectScopeWarnings(article); err != nil || len(warnings) != 0 {
+			t.Errorf("SubjectScopeWarnings() in %s = %q, %v", journalID, warnings, err)
+		}
+	}
+	service := core.NewArticleService(f.articles, f.authors, wrappingJournalDirectory{f.journals}, f.taxonomy)
+	if warnings, err := service.SubjectScopeWarnings(article); err != nil || len(warnings) != 0 {
+		t.Errorf("SubjectScopeWarnings() with a wrapped not-found error = %q, %v", warnings, err)
+	}
+}
+
+// wrappingJournalDirectory adds context to the errors of its directory, as
+// remote directories do
+type wrappingJournalDirectory struct {
+	core.JournalDirectory
+}
+
+func (d wrappingJournalDirectory) GetJournal(id string) (core.JournalInfo, error) {
+	journal, err := d.JournalDirectory.GetJournal(id)
+	if err != nil {
+		return core.JournalInfo{}, fmt.Errorf("journal service: %w", err)
+	}
+	return journal, nil
+}
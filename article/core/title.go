@@ -0,0 +1,288 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultTitleSimilarity is the similarity fuzzy title lookups require
+	// when the query sets none
+	DefaultTitleSimilarity = 0.4
+
+	// DefaultTitleLimit is the number of matches returned when the query
+	// sets no limit
+	DefaultTitleLimit = 10
+
+	// MaxTitleLimit bounds title lookups
+	MaxTitleLimit = 100
+
+	// MaxTitleCandidates is the number of articles sharing the most trigrams
+	// with a fuzzy query that are scored. Fuzzy matches are only counted
+	// among them.
+	MaxTitleCandidates = 200
+)
+
+// NormalizeTitle returns the key titles are looked up by: the title's words
+// lower-cased and without diacritics, separated by single spaces.
+// Punctuation, whitespace, ligatures, fullwidth forms and decomposed accents
+// make no difference, so "Deep  Learning: A Survey" and "deep learning — a
+// survey" share a key.
+func NormalizeTitle(title string) string {
+	var b strings.Builder
+	for _, r := range title {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks of decomposed letters
+		case r >= 0xFF01 && r <= 0xFF5E:
+			b.WriteRune(r - 0xFEE0)
+		default:
+			if expanded, ok := titleLigatures[r]; ok {
+				b.WriteString(expanded)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+
+	words := strings.FieldsFunc(b.String(), func(r rune) bool { return !isWordRune(r) })
+	for i, word := range words {
+		words[i] = foldWord(word)
+	}
+	return strings.Join(words, " ")
+}
+
+var titleLigatures = map[rune]string{
+	'ﬀ': "ff", 'ﬁ': "fi", 'ﬂ': "fl", 'ﬃ': "ffi", 'ﬄ': "ffl", 'ﬅ': "st", 'ﬆ': "st",
+}
+
+// TitleTrigrams returns the distinct trigrams of a normalized title key in
+// order. Each word is padded with two spaces in front and one behind, so
+// words share trigrams with their misspellings even when they are short.
+func TitleTrigrams(key string) []string {
+	seen := make(map[string]bool)
+	var trigrams []string
+	for _, word := range strings.Fields(key) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			trigram := string(runes[i : i+3])
+			if !seen[trigram] {
+				seen[trigram] = true
+				trigrams = append(trigrams, trigram)
+			}
+		}
+	}
+	sort.Strings(trigrams)
+	return trigrams
+}
+
+// TitleSimilarity is the share of trigrams two normalized title keys have
+// in common, from 0 for titles without common trigrams to 1 for equal keys
+func TitleSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	trigrams := make(map[string]bool)
+	for _, trigram := range TitleTrigrams(a) {
+		trigrams[trigram] = true
+	}
+	shared, union := 0, len(trigrams)
+	for _, trigram := range TitleTrigrams(b) {
+		if trigrams[trigram] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// titleDistance is the Levenshtein distance between two keys in characters
+func titleDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// TitleQuery looks articles up by title. Exact lookups find the articles
+// whose normalized title equals the query's; fuzzy lookups also find titles
+// with at least Threshold similarity.
+type TitleQuery struct {
+	Title string
+	Fuzzy bool
+	// Threshold is the similarity fuzzy matches need, DefaultTitleSimilarity
+	// when zero
+	Threshold float64
+	Limit     int
+	Offset    int
+}
+
+// Validate checks if the query can be run
+func (q TitleQuery) Validate() error {
+	if NormalizeTitle(q.Title) == "" {
+		return fmt.Errorf("%w: the title has no words", ErrInvalidTitleQuery)
+	}
+	if q.Threshold < 0 || q.Threshold > 1 {
+		return fmt.Errorf("%w: threshold must be between 0 and 1", ErrInvalidTitleQuery)
+	}
+	if q.Limit < 0 || q.Limit > MaxTitleLimit {
+		return fmt.Errorf("%w: limit cannot be negative or exceed %d", ErrInvalidTitleQuery, MaxTitleLimit)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("%w: offset cannot be negative", ErrInvalidTitleQuery)
+	}
+	return nil
+}
+
+// ArticlePage is a page of articles and the number of articles over all
+// pages
+type ArticlePage struct {
+	Articles []Article
+	Total    int
+}
+
+// TitleMatch is an article found by title
+type TitleMatch struct {
+	Article Article
+	// Similarity is the trigram similarity of the normalized titles
+	Similarity float64
+	// Distance is the edit distance between the normalized titles
+	Distance int
+}
+
+// TitleMatches is a page of title matches and the number of matches over
+// all pages
+type TitleMatches struct {
+	Matches []TitleMatch
+	Total   int
+	// TotalCapped is set when a fuzzy lookup scored MaxTitleCandidates
+	// articles, so more articles than Total may match
+	TotalCapped bool
+}
+
+// GetArticlesByTitle returns a page of the articles whose normalized title
+// equals the given one, ordered by ID. Titles are not unique unless their
+// journal requires it, so there may be several.
+func (s *ArticleService) GetArticlesByTitle(title string, offset, limit int) (ArticlePage, error) {
+	if err := (TitleQuery{Title: title, Limit: limit, Offset: offset}).Validate(); err != nil {
+		return ArticlePage{}, err
+	}
+	if limit == 0 {
+		limit = DefaultTitleLimit
+	}
+	return s.repository.ListArticlesByTitle(NormalizeTitle(title), "", offset, limit)
+}
+
+// FindArticlesByTitle returns a page of the articles matching the query.
+// Exact matches are ordered by ID; fuzzy ones most similar first, then
+// closest by edit distance, then by ID. Fuzzy lookups only score the
+// MaxTitleCandidates articles sharing the most trigrams with the query, so
+// their Total is capped at that many and TotalCapped reports when the cap
+// was reached.
+func (s *ArticleService) FindArticlesByTitle(query TitleQuery) (TitleMatches, error) {
+	if err := query.Validate(); err != nil {
+		return TitleMatches{}, err
+	}
+	if query.Threshold == 0 {
+		query.Threshold = DefaultTitleSimilarity
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultTitleLimit
+	}
+
+	if !query.Fuzzy {
+		page, err := s.GetArticlesByTitle(query.Title, query.Offset, query.Limit)
+		if err != nil {
+			return TitleMatches{}, err
+		}
+		result := TitleMatches{Total: page.Total}
+		for _, article := range page.Articles {
+			result.Matches = append(result.Matches, TitleMatch{Article: article, Similarity: 1})
+		}
+		return result, nil
+	}
+
+	key := NormalizeTitle(query.Title)
+	candidates, err := s.repository.ListTitleCandidates(key, MaxTitleCandidates)
+	if err != nil {
+		return TitleMatches{}, err
+	}
+	var matches []TitleMatch
+	for _, article := range candidates {
+		candidateKey := NormalizeTitle(article.Title)
+		similarity := TitleSimilarity(key, candidateKey)
+		if similarity < query.Threshold {
+			continue
+		}
+		matches = append(matches, TitleMatch{Article: article, Similarity: similarity, Distance: titleDistance(key, candidateKey)})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Similarity != matches[j].Similarity {
+			return matches[i].Similarity > matches[j].Similarity
+		}
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].Article.ID < matches[j].Article.ID
+	})
+
+	result := TitleMatches{Total: len(matches), TotalCapped: len(candidates) == MaxTitleCandidates}
+	if query.Offset < len(matches) {
+		matches = matches[query.Offset:]
+		if len(matches) > query.Limit {
+			matches = matches[:query.Limit]
+		}
+		result.Matches = matches
+	}
+	return result, nil
+}
+
+// checkUniqueTitle returns ErrDuplicateTitle when the article's journal
+// requires unique titles and another of its articles has the same
+// normalized title, and reports whether the journal requires them so the
+// repository can enforce it against concurrent writes. It returns
+// ErrJournalNotFound for journals the directory does not know.
+func (s *ArticleService) checkUniqueTitle(article Article) (bool, error) {
+	journal, err := s.journals.GetJournal(article.JournalID)
+	if errors.Is(err, ErrJournalNotFound) {
+		return false, fmt.Errorf("%w: %s", ErrJournalNotFound, article.JournalID)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to look up journal %s: %w", article.JournalID, err)
+	}
+	if !journal.UniqueArticleTitles {
+		return false, nil
+	}
+
+	page, err := s.repository.ListArticlesByTitle(NormalizeTitle(article.Title), article.JournalID, 0, 2)
+	if err != nil {
+		return false, err
+	}
+	for _, other := range page.Articles {
+		if other.ID != article.ID {
+			return false, fmt.Errorf("%w: article %s of journal %s is already titled %q", ErrDuplicateTitle, other.ID, journal.ID, other.Title)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,205 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		title, want string
+	}{
+		{"Deep  Learning: A Survey", "deep learning a survey"},
+		{"deep learning — a survey", "deep learning a survey"},
+		{"Ｄｅｅｐ Ｌｅａｒｎｉｎｇ", "deep learning"},
+		{"Eﬃcient Précis", "efficient precis"},
+		{"Gödel's Proof", "godel s proof"},
+		{" -- ", ""},
+	}
+	for _, test := range tests {
+		if got := core.NormalizeTitle(test.title); got != test.want {
+			t.Errorf("NormalizeTitle(%q) = %q, want %q", test.title, got, test.want)
+		}
+	}
+}
+
+func TestTitleTrigrams(t *testing.T) {
+	want := []string{"  a", "  g", " a ", " go", "go ", "gog", "og "}
+	if got := core.TitleTrigrams("go a gog"); !reflect.DeepEqual(got, want) {
+		t.Errorf("TitleTrigrams() = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		a, b     string
+		min, max float64
+	}{
+		{"graph colouring", "graph colouring", 1, 1},
+		{"graph colouring", "graph coloring", 0.6, 0.9},
+		{"graph colouring", "neural networks", 0, 0.1},
+		{"", "", 1, 1},
+	}
+	for _, test := range tests {
+		similarity := core.TitleSimilarity(test.a, test.b)
+		if similarity < test.min || similarity > test.max {
+			t.Errorf("TitleSimilarity(%q, %q) = %.2f, want %.2f to %.2f", test.a, test.b, similarity, test.min, test.max)
+		}
+		if reverse := core.TitleSimilarity(test.b, test.a); reverse != similarity {
+			t.Errorf("TitleSimilarity(%q, %q) = %.2f but %.2f the other way round", test.a, test.b, similarity, reverse)
+		}
+	}
+}
+
+func TestFindArticlesByTitle(t *testing.T) {
+	f := newFixture(t)
+	for id, title := range map[string]string{
+		"t1": "Graph Colouring",
+		"t2": "graph colouring!",
+		"t3": "Graph Coloring",
+		"t4": "Graph Colourings of Planar Maps",
+		"t5": "Neural Networks",
+	} {
+		f.create(t, newArticle(id, title))
+	}
+
+	page, err := f.service.GetArticlesByTitle("GRAPH — colouring", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 2 || len(page.Articles) != 2 || page.Articles[0].ID != "t1" || page.Articles[1].ID != "t2" {
+		t.Errorf("GetArticlesByTitle() = %+v", page)
+	}
+	if page, err := f.service.GetArticlesByTitle("Graph Colouring", 1, 1); err != nil || page.Total != 2 || len(page.Articles) != 1 || page.Articles[0].ID != "t2" {
+		t.Errorf("GetArticlesByTitle(offset 1) = %+v, %v", page, err)
+	}
+
+	fuzzy, err := f.service.FindArticlesByTitle(core.TitleQuery{Title: "Graph Colouring", Fuzzy: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, match := range fuzzy.Matches {
+		ids = append(ids, match.Article.ID)
+	}
+	// Equal keys first by ID, then the closest spelling, then the longer title
+	if !reflect.DeepEqual(ids, []string{"t1", "t2", "t3", "t4"}) || fuzzy.Total != 4 || fuzzy.TotalCapped {
+		t.Fatalf("fuzzy matches = %v of %d, capped %v", ids, fuzzy.Total, fuzzy.TotalCapped)
+	}
+	if first, third := fuzzy.Matches[0], fuzzy.Matches[2]; first.Similarity != 1 || first.Distance != 0 || third.Distance != 1 {
+		t.Errorf("matches = %+v", fuzzy.Matches)
+	}
+
+	strict, err := f.service.FindArticlesByTitle(core.TitleQuery{Title: "Graph Colouring", Fuzzy: true, Threshold: 0.9, Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strict.Total != 2 || len(strict.Matches) != 1 || strict.Matches[0].Article.ID != "t2" {
+		t.Errorf("strict fuzzy matches = %+v", strict)
+	}
+	exact, err := f.service.FindArticlesByTitle(core.TitleQuery{Title: "Neural networks"})
+	if err != nil || exact.Total != 1 || exact.Matches[0].Article.ID != "t5" || exact.Matches[0].Similarity != 1 {
+		t.Errorf("exact matches = %+v, %v", exact, err)
+	}
+}
+
+func TestFuzzyTitleTotalIsCapped(t *testing.T) {
+	f := newFixture(t)
+	for i := 0; i <= core.MaxTitleCandidates; i++ {
+		f.create(t, newArticle(fmt.Sprintf("g%d", i), fmt.Sprintf("Graph Colouring %d", i)))
+	}
+
+	// Only the candidates sharing the most trigrams are scored
+	matches, err := f.service.FindArticlesByTitle(core.TitleQuery{Title: "Graph Colouring", Fuzzy: true, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matches.Total != core.MaxTitleCandidates || !matches.TotalCapped {
+		t.Errorf("FindArticlesByTitle() = %d matches, capped %v, want %d capped", matches.Total, matches.TotalCapped, core.MaxTitleCandidates)
+	}
+}
+
+func TestUniqueTitles(t *testing.T) {
+	f := newFixture(t, core.JournalInfo{ID: "journal_2", Name: "Science", UniqueArticleTitles: true})
+	inScience := func(id, title string) core.Article {
+		article := newArticle(id, title)
+		article.JournalID = "journal_2"
+		return article
+	}
+	f.create(t, inScience("s1", "Graph Colouring"))
+	f.create(t, newArticle("n1", "Graph Colouring"))
+	f.create(t, newArticle("n2", "Graph colouring!"))
+
+	if _, err := f.service.CreateArticle(inScience("s2", "graph  COLOURING")); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("CreateArticle() of a duplicate title = %v, want ErrDuplicateTitle", err)
+	}
+	moved := newArticle("n1", "Graph Colouring")
+	moved.JournalID = "journal_2"
+	if _, err := f.service.UpdateArticle(moved); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("UpdateArticle() into a journal with the title = %v, want ErrDuplicateTitle", err)
+	}
+	renamed := f.create(t, inScience("s3", "Planar Graphs"))
+	renamed.Title = "Graph Colouring"
+	if _, err := f.service.UpdateArticle(renamed); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("UpdateArticle() to a duplicate title = %v, want ErrDuplicateTitle", err)
+	}
+
+	// The repository enforces the rule itself, for writes that race past
+	// the service's check
+	if _, err := f.articles.CreateArticle(inScience("s4", "Graph Colouring"), true); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("repository CreateArticle() of a duplicate title = %v, want ErrDuplicateTitle", err)
+	}
+	if _, err := f.articles.UpdateArticle(renamed, true); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("repository UpdateArticle() to a duplicate title = %v, want ErrDuplicateTitle", err)
+	}
+	if _, err := f.articles.CreateArticle(newArticle("n3", "Graph Colouring"), false); err != nil {
+		t.Errorf("repository CreateArticle() without the rule = %v", err)
+	}
+
+	// Duplicates stored before the journal required unique titles stay
+	// editable as long as the title is kept
+	legacy := newArticle("n2", "Graph colouring!")
+	legacy.Abstract = "A longer abstract."
+	if _, err := f.service.UpdateArticle(legacy); err != nil {
+		t.Errorf("UpdateArticle() of an earlier duplicate = %v", err)
+	}
+
+	// An edit that keeps the title still holds it against racing writes
+	edited := newArticle("s1", "Graph Colouring")
+	edited.JournalID, edited.Abstract = "journal_2", "A revised abstract."
+	if _, err := f.service.UpdateArticle(edited); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.articles.CreateArticle(inScience("s5", "Graph Colouring"), true); !errors.Is(err, core.ErrDuplicateTitle) {
+		t.Errorf("repository CreateArticle() of a duplicate after an edit = %v, want ErrDuplicateTitle", err)
+	}
+
+	// Articles of journals the directory does not know are rejected
+	orphan := newArticle("o1", "Graph Minors")
+	orphan.JournalID = "journal_9"
+	if _, err := f.service.CreateArticle(orphan); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("CreateArticle() in an unknown journal = %v, want ErrJournalNotFound", err)
+	}
+	moved.JournalID = "journal_9"
+	if _, err := f.service.UpdateArticle(moved); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("UpdateArticle() into an unknown journal = %v, want ErrJournalNotFound", err)
+	}
+}
+
+func TestTitleQueryIsChecked(t *testing.T) {
+	f := newFixture(t)
+	for _, query := range []core.TitleQuery{
+		{Title: " ?! "},
+		{Title: "Graph", Threshold: 1.5},
+		{Title: "Graph", Threshold: -0.1},
+		{Title: "Graph", Limit: -1},
+		{Title: "Graph", Limit: core.MaxTitleLimit + 1},
+		{Title: "Graph", Offset: -1},
+	} {
+		if _, err := f.service.FindArticlesByTitle(query); !errors.Is(err, core.ErrInvalidTitleQuery) {
+			t.Errorf("FindArticlesByTitle(%+v) = %v, want ErrInvalidTitleQuery", query, err)
+		}
+	}
+}
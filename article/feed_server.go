@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+// FeedServer serves the Atom and RSS feeds of journals at
+// /feeds/{journal}/{format}
+type FeedServer struct {
+	feeds *core.FeedService
+}
+
+func NewFeedServer(feeds *core.FeedService) *FeedServer {
+	return &FeedServer{feeds: feeds}
+}
+
+// ServeHTTP answers conditional requests: the ETag is a hash of the
+// rendered feed and Last-Modified is the time an entry last changed.
+// Feed readers that send either validator get 304 Not Modified until the
+// feed changes.
+func (s *FeedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format, err := core.ParseFeedFormat(r.PathValue("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	journalID := r.PathValue("journal")
+	feed, err := s.feeds.JournalFeed(journalID, format)
+	if errors.Is(err, core.ErrJournalNotFound) {
+		http.Error(w, "journal not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Feeds: failed to build the %s feed of journal %s: %v", format, journalID, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	content, err := core.RenderFeed(feed, format)
+	if err != nil {
+		log.Printf("Feeds: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, "", feed.LastModified(), bytes.NewReader(content))
+}
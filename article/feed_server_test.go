@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFeedServer(t *testing.T) {
+	service, _, _ := newTestService(t)
+	publishTestArticle(t, service, "a1", "Four Colour Theorem")
+	feeds, err := newFeedService(service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /feeds/{journal}/{format}", NewFeedServer(feeds))
+	fetch := func(path string, header http.Header) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, path, nil)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	atom := fetch("/feeds/journal_1/atom", nil)
+	if atom.Code != http.StatusOK || !strings.Contains(atom.Body.String(), "Four Colour Theorem") {
+		t.Fatalf("Atom feed = %d %s", atom.Code, atom.Body)
+	}
+	etag, lastModified := atom.Header().Get("ETag"), atom.Header().Get("Last-Modified")
+	if !strings.HasPrefix(atom.Header().Get("Content-Type"), "application/atom+xml") || etag == "" || lastModified == "" {
+		t.Errorf("Atom feed headers = %v", atom.Header())
+	}
+
+	// Either validator of the unchanged feed answers 304
+	for name, value := range map[string]string{"If-None-Match": etag, "If-Modified-Since": lastModified} {
+		if again := fetch("/feeds/journal_1/atom", http.Header{name: {value}}); again.Code != http.StatusNotModified || again.Body.Len() != 0 {
+			t.Errorf("Atom feed with %s = %d", name, again.Code)
+		}
+	}
+
+	// A newly published article changes the feed and its ETag
+	publishTestArticle(t, service, "a2", "Planar Graphs")
+	changed := fetch("/feeds/journal_1/atom", http.Header{"If-None-Match": {etag}})
+	if changed.Code != http.StatusOK || changed.Header().Get("ETag") == etag || !strings.Contains(changed.Body.String(), "Planar Graphs") {
+		t.Errorf("Atom feed after publishing = %d, ETag %s", changed.Code, changed.Header().Get("ETag"))
+	}
+
+	rss := fetch("/feeds/journal_1/rss", nil)
+	if rss.Code != http.StatusOK || !strings.HasPrefix(rss.Header().Get("Content-Type"), "application/rss+xml") {
+		t.Errorf("RSS feed = %d, Content-Type %s", rss.Code, rss.Header().Get("Content-Type"))
+	}
+	for _, path := range []string{"/feeds/journal_9/atom", "/feeds/journal_1/json"} {
+		if missing := fetch(path, nil); missing.Code != http.StatusNotFound {
+			t.Errorf("%s = %d, want 404", path, missing.Code)
+		}
+	}
+}
@@ -5,6 +5,7 @@ replace github.com/realBagher/hexaservice-go/article => ./article
 go 1.24.3
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/go-sql-driver/mysql v1.8.1
 	google.golang.org/grpc v1.74.2
 	google.golang.org/protobuf v1.36.7
@@ -17,3 +18,7 @@ require (
 	golang.org/x/text v0.25.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
 )
+
+require github.com/realBagher/hexaservice-go/eventing v0.0.0
+
+replace github.com/realBagher/hexaservice-go/eventing => ../eventing
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// CreateAuthor implements the gRPC CreateAuthor method
+func (s *ArticleGRPCServer) CreateAuthor(ctx context.Context, req *proto.CreateAuthorRequest) (*proto.CreateAuthorResponse, error) {
+	author, err := s.authors.CreateAuthor(fromProtoAuthor(req.Author))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+// GetAuthor implements the gRPC GetAuthor method
+func (s *ArticleGRPCServer) GetAuthor(ctx context.Context, req *proto.GetAuthorRequest) (*proto.GetAuthorResponse, error) {
+	author, err := s.authors.GetAuthor(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+// UpdateAuthor implements the gRPC UpdateAuthor method
+func (s *ArticleGRPCServer) UpdateAuthor(ctx context.Context, req *proto.UpdateAuthorRequest) (*proto.UpdateAuthorResponse, error) {
+	author, err := s.authors.UpdateAuthor(fromProtoAuthor(req.Author))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.UpdateAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+// ListAuthors implements the gRPC ListAuthors method
+func (s *ArticleGRPCServer) ListAuthors(ctx context.Context, req *proto.ListAuthorsRequest) (*proto.ListAuthorsResponse, error) {
+	authors, err := s.authors.ListAuthors()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListAuthorsResponse{}
+	for _, author := range authors {
+		resp.Authors = append(resp.Authors, toProtoAuthor(author))
+	}
+	return resp, nil
+}
+
+// ListArticlesByAuthor implements the gRPC ListArticlesByAuthor method
+func (s *ArticleGRPCServer) ListArticlesByAuthor(ctx context.Context, req *proto.ListArticlesByAuthorRequest) (*proto.ListArticlesByAuthorResponse, error) {
+	if _, err := s.authors.GetAuthor(req.AuthorId); err != nil {
+		return nil, grpcError(err)
+	}
+
+	articles, err := s.service.ListArticlesByAuthor(req.AuthorId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListArticlesByAuthorResponse{}
+	for _, article := range articles {
+		resp.Articles = append(resp.Articles, toProtoArticle(article))
+	}
+	return resp, nil
+}
+
+// FindDuplicateAuthors implements the gRPC FindDuplicateAuthors method
+func (s *ArticleGRPCServer) FindDuplicateAuthors(ctx context.Context, req *proto.FindDuplicateAuthorsRequest) (*proto.FindDuplicateAuthorsResponse, error) {
+	clusters, err := s.merges.FindDuplicates(req.MinScore)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.FindDuplicateAuthorsResponse{}
+	for _, cluster := range clusters {
+		protoCluster := &proto.AuthorCluster{Score: cluster.Score, Evidence: cluster.Evidence}
+		for _, author := range cluster.Authors {
+			protoCluster.Authors = append(protoCluster.Authors, toProtoAuthor(author))
+		}
+		resp.Clusters = append(resp.Clusters, protoCluster)
+	}
+	return resp, nil
+}
+
+// MergeAuthors implements the gRPC MergeAuthors method
+func (s *ArticleGRPCServer) MergeAuthors(ctx context.Context, req *proto.MergeAuthorsRequest) (*proto.MergeAuthorsResponse, error) {
+	merge, err := s.merges.WithCaller(callerFromContext(ctx)).MergeAuthors(req.SourceId, req.TargetId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.MergeAuthorsResponse{Merge: toProtoMerge(merge)}, nil
+}
+
+// UndoAuthorMerge implements the gRPC UndoAuthorMerge method
+func (s *ArticleGRPCServer) UndoAuthorMerge(ctx context.Context, req *proto.UndoAuthorMergeRequest) (*proto.UndoAuthorMergeResponse, error) {
+	merge, err := s.merges.WithCaller(callerFromContext(ctx)).UndoMerge(req.MergeId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.UndoAuthorMergeResponse{Merge: toProtoMerge(merge)}, nil
+}
+
+// ListAuthorMerges implements the gRPC ListAuthorMerges method
+func (s *ArticleGRPCServer) ListAuthorMerges(ctx context.Context, req *proto.ListAuthorMergesRequest) (*proto.ListAuthorMergesResponse, error) {
+	merges, err := s.merges.ListMerges()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListAuthorMergesResponse{}
+	for _, merge := range merges {
+		resp.Merges = append(resp.Merges, toProtoMerge(merge))
+	}
+	return resp, nil
+}
+
+func toProtoMerge(merge core.AuthorMerge) *proto.AuthorMerge {
+	protoMerge := &proto.AuthorMerge{
+		Id:           merge.ID,
+		Source:       toProtoAuthor(merge.Source),
+		TargetBefore: toProtoAuthor(merge.TargetBefore),
+		Target:       toProtoAuthor(merge.Target),
+		Actor:        merge.Actor,
+		MergedAt:     timestamppb.New(merge.MergedAt),
+	}
+	for _, change := range merge.Changes {
+		protoMerge.ChangedArticleIds = append(protoMerge.ChangedArticleIds, change.ArticleID)
+	}
+	if merge.UndoneAt != nil {
+		protoMerge.UndoneAt = timestamppb.New(*merge.UndoneAt)
+	}
+	return protoMerge
+}
+
+func toProtoAuthor(author core.Author) *proto.Author {
+	return &proto.Author{
+		Id:          author.ID,
+		Name:        author.Name,
+		Orcid:       author.ORCID,
+		Affiliation: author.Affiliation,
+		Email:       author.Email,
+		CreatedAt:   timestamppb.New(author.CreatedAt),
+		UpdatedAt:   timestamppb.New(author.UpdatedAt),
+	}
+}
+
+func fromProtoAuthor(author *proto.Author) core.Author {
+	return core.Author{
+		ID:          author.GetId(),
+		Name:        author.GetName(),
+		ORCID:       author.GetOrcid(),
+		Affiliation: author.GetAffiliation(),
+		Email:       author.GetEmail(),
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// GetAuthorMetrics implements the gRPC GetAuthorMetrics method
+func (s *ArticleGRPCServer) GetAuthorMetrics(ctx context.Context, req *proto.GetAuthorMetricsRequest) (*proto.GetAuthorMetricsResponse, error) {
+	metrics, err := s.metrics.GetAuthorMetrics(req.AuthorId, req.JournalId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetAuthorMetricsResponse{Metrics: toProtoAuthorMetrics(metrics)}, nil
+}
+
+// GetJournalLeaderboard implements the gRPC GetJournalLeaderboard method
+func (s *ArticleGRPCServer) GetJournalLeaderboard(ctx context.Context, req *proto.GetJournalLeaderboardRequest) (*proto.GetJournalLeaderboardResponse, error) {
+	ranked, err := s.metrics.Leaderboard(req.JournalId, core.LeaderboardOrder(req.Order), int(req.Limit))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.GetJournalLeaderboardResponse{}
+	for _, metrics := range ranked {
+		resp.Authors = append(resp.Authors, toProtoAuthorMetrics(metrics))
+	}
+	return resp, nil
+}
+
+func toProtoAuthorMetrics(metrics core.AuthorMetrics) *proto.AuthorMetrics {
+	byYear := make(map[int32]int32, len(metrics.PublicationsByYear))
+	for year, count := range metrics.PublicationsByYear {
+		byYear[int32(year)] = int32(count)
+	}
+	return &proto.AuthorMetrics{
+		AuthorId:           metrics.AuthorID,
+		JournalId:          metrics.JournalID,
+		Publications:       int32(metrics.Publications),
+		PublicationsByYear: byYear,
+		TotalCitations:     int32(metrics.TotalCitations),
+		HIndex:             int32(metrics.HIndex),
+		I10Index:           int32(metrics.I10Index),
+		UpdatedAt:          timestamppb.New(metrics.UpdatedAt),
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	journalproto "github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// CitationDataGRPCServer serves the journal service's CitationData API, which
+// feeds its journal metrics
+type CitationDataGRPCServer struct {
+	journalproto.UnimplementedCitationDataServer
+	service *core.ArticleService
+}
+
+func NewCitationDataGRPCServer(service *core.ArticleService) *CitationDataGRPCServer {
+	return &CitationDataGRPCServer{service: service}
+}
+
+// GetJournalCitations implements the gRPC GetJournalCitations method
+func (s *CitationDataGRPCServer) GetJournalCitations(ctx context.Context, req *journalproto.GetJournalCitationsRequest) (*journalproto.GetJournalCitationsResponse, error) {
+	items, err := s.service.JournalCitableItems(req.JournalId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &journalproto.GetJournalCitationsResponse{}
+	for _, item := range items {
+		citationYears := make([]int32, 0, len(item.CitationYears))
+		for _, year := range item.CitationYears {
+			citationYears = append(citationYears, int32(year))
+		}
+		resp.Items = append(resp.Items, &journalproto.CitableItem{
+			ArticleId:     item.ArticleID,
+			Year:          int32(item.Year),
+			CitationYears: citationYears,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// SetArticleReferences implements the gRPC SetArticleReferences method
+func (s *ArticleGRPCServer) SetArticleReferences(ctx context.Context, req *proto.SetArticleReferencesRequest) (*proto.SetArticleReferencesResponse, error) {
+	references := make([]core.Reference, 0, len(req.References))
+	for _, reference := range req.References {
+		references = append(references, core.Reference{
+			TargetArticleID: reference.GetArticleId(),
+			DOI:             reference.GetDoi(),
+			Text:            reference.GetText(),
+		})
+	}
+
+	stored, err := s.service.WithCaller(callerFromContext(ctx)).SetReferences(req.ArticleId, references)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.SetArticleReferencesResponse{References: toProtoReferences(stored)}, nil
+}
+
+// ListArticleReferences implements the gRPC ListArticleReferences method
+func (s *ArticleGRPCServer) ListArticleReferences(ctx context.Context, req *proto.ListArticleReferencesRequest) (*proto.ListArticleReferencesResponse, error) {
+	references, err := s.service.ListReferences(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ListArticleReferencesResponse{References: toProtoReferences(references)}, nil
+}
+
+// ListCitingReferences implements the gRPC ListCitingReferences method
+func (s *ArticleGRPCServer) ListCitingReferences(ctx context.Context, req *proto.ListCitingReferencesRequest) (*proto.ListCitingReferencesResponse, error) {
+	if (req.ArticleId == "") == (req.Doi == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of article_id and doi must be set")
+	}
+
+	var references []core.Reference
+	var err error
+	if req.ArticleId != "" {
+		references, err = s.service.ListCitedBy(req.ArticleId)
+	} else {
+		references, err = s.service.ListCitedByDOI(req.Doi)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ListCitingReferencesResponse{References: toProtoReferences(references)}, nil
+}
+
+// GetCitationGraph implements the gRPC GetCitationGraph method
+func (s *ArticleGRPCServer) GetCitationGraph(ctx context.Context, req *proto.GetCitationGraphRequest) (*proto.GetCitationGraphResponse, error) {
+	graph, err := s.service.CitationGraph(req.ArticleId, core.CitationDirection(req.Direction), int(req.MaxDepth))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.GetCitationGraphResponse{
+		Edges:     toProtoReferences(graph.Edges),
+		Truncated: graph.Truncated,
+	}
+	for _, node := range graph.Nodes {
+		resp.Nodes = append(resp.Nodes, &proto.CitationNode{
+			ArticleId: node.ArticleID,
+			Doi:       node.DOI,
+			Depth:     int32(node.Depth),
+		})
+	}
+	return resp, nil
+}
+
+func toProtoReferences(references []core.Reference) []*proto.Reference {
+	converted := make([]*proto.Reference, 0, len(references))
+	for _, reference := range references {
+		converted = append(converted, &proto.Reference{
+			CitingArticleId: reference.CitingArticleID,
+			Position:        int32(reference.Position),
+			ArticleId:       reference.TargetArticleID,
+			Doi:             reference.DOI,
+			Text:            reference.Text,
+		})
+	}
+	return converted
+}
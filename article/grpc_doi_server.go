@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// RegisterArticleDOI implements the gRPC RegisterArticleDOI method
+func (s *ArticleGRPCServer) RegisterArticleDOI(ctx context.Context, req *proto.RegisterArticleDOIRequest) (*proto.RegisterArticleDOIResponse, error) {
+	article, err := s.dois.WithCaller(callerFromContext(ctx)).RegisterDOI(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RegisterArticleDOIResponse{Article: toProtoArticle(article)}, nil
+}
+
+// GetArticleDepositXML implements the gRPC GetArticleDepositXML method
+func (s *ArticleGRPCServer) GetArticleDepositXML(ctx context.Context, req *proto.GetArticleDepositXMLRequest) (*proto.GetArticleDepositXMLResponse, error) {
+	data, err := s.dois.DepositXML(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetArticleDepositXMLResponse{Xml: data}, nil
+}
+
+// RefreshArticleDOI implements the gRPC RefreshArticleDOI method
+func (s *ArticleGRPCServer) RefreshArticleDOI(ctx context.Context, req *proto.RefreshArticleDOIRequest) (*proto.RefreshArticleDOIResponse, error) {
+	article, err := s.dois.WithCaller(callerFromContext(ctx)).RefreshDeposit(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RefreshArticleDOIResponse{Article: toProtoArticle(article)}, nil
+}
+
+// GetArticleByDOI implements the gRPC GetArticleByDOI method
+func (s *ArticleGRPCServer) GetArticleByDOI(ctx context.Context, req *proto.GetArticleByDOIRequest) (*proto.GetArticleByDOIResponse, error) {
+	article, err := s.dois.GetArticleByDOI(req.Doi)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetArticleByDOIResponse{Article: toProtoArticle(article)}, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// FindSimilarArticles implements the gRPC FindSimilarArticles method
+func (s *ArticleGRPCServer) FindSimilarArticles(ctx context.Context, req *proto.FindSimilarArticlesRequest) (*proto.FindSimilarArticlesResponse, error) {
+	candidates, err := s.duplicates.FindDuplicates(core.DuplicateQuery{
+		ArticleID: req.ArticleId,
+		Title:     req.Title,
+		Abstract:  req.Abstract,
+		Threshold: req.Threshold,
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.FindSimilarArticlesResponse{Articles: toProtoSimilarArticles(candidates)}, nil
+}
+
+func toProtoSimilarArticles(candidates []core.DuplicateCandidate) []*proto.SimilarArticle {
+	var articles []*proto.SimilarArticle
+	for _, candidate := range candidates {
+		articles = append(articles, &proto.SimilarArticle{Article: toProtoArticle(candidate.Article), Similarity: candidate.Similarity})
+	}
+	return articles
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// ExportArticle implements the gRPC ExportArticle method
+func (s *ArticleGRPCServer) ExportArticle(ctx context.Context, req *proto.ExportArticleRequest) (*proto.ExportArticleResponse, error) {
+	entry, err := s.exports.ExportArticle(req.ArticleId, core.ExportFormat(req.Format))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ExportArticleResponse{Entry: toProtoExportedArticle(entry)}, nil
+}
+
+// ExportArticles implements the gRPC ExportArticles method. Entries are
+// sent as they are rendered, so large exports start arriving immediately.
+func (s *ArticleGRPCServer) ExportArticles(req *proto.ExportArticlesRequest, stream proto.ArticleService_ExportArticlesServer) error {
+	query := core.ExportQuery{
+		ArticleIDs: req.ArticleIds,
+		JournalID:  req.JournalId,
+		AuthorID:   req.AuthorId,
+		Status:     core.ArticleStatus(req.Status),
+	}
+	err := s.exports.Export(query, core.ExportFormat(req.Format), func(entry core.ExportedArticle) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		return stream.Send(&proto.ExportArticlesResponse{Entry: toProtoExportedArticle(entry)})
+	})
+	if ctxErr := stream.Context().Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	if err != nil {
+		return grpcError(err)
+	}
+	return nil
+}
+
+func toProtoExportedArticle(entry core.ExportedArticle) *proto.ExportedArticle {
+	return &proto.ExportedArticle{
+		ArticleId:   entry.ArticleID,
+		Key:         entry.Key,
+		ContentType: entry.ContentType,
+		Content:     entry.Content,
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// maxImportSize bounds the file an ImportArticles call may upload
+const maxImportSize = 32 << 20
+
+// ImportArticles implements the gRPC ImportArticles method. The whole file
+// is received before the first record is imported.
+func (s *ArticleGRPCServer) ImportArticles(stream proto.ArticleService_ImportArticlesServer) error {
+	var options *proto.ImportOptions
+	var data bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.Options != nil {
+			if options != nil {
+				return status.Error(codes.InvalidArgument, "options must be sent once, in the first message")
+			}
+			options = req.Options
+		} else if options == nil {
+			return status.Error(codes.InvalidArgument, "the first message must carry the import options")
+		}
+		if data.Len()+len(req.Data) > maxImportSize {
+			return status.Errorf(codes.ResourceExhausted, "import files are limited to %d bytes", maxImportSize)
+		}
+		data.Write(req.Data)
+	}
+	if options == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the import options")
+	}
+
+	report, err := s.imports.WithCaller(callerFromContext(stream.Context())).Import(data.Bytes(), core.ImportOptions{
+		Format:    core.ImportFormat(options.Format),
+		DryRun:    options.DryRun,
+		JournalID: options.JournalId,
+	})
+	if err != nil {
+		return grpcError(err)
+	}
+
+	resp := &proto.ImportArticlesResponse{
+		DryRun:          report.DryRun,
+		Total:           int32(report.Total),
+		Succeeded:       int32(report.Succeeded),
+		Failed:          int32(report.Failed),
+		JournalsCreated: int32(report.JournalsCreated),
+		AuthorsCreated:  int32(report.AuthorsCreated),
+	}
+	for _, result := range report.Results {
+		resp.Results = append(resp.Results, &proto.ImportRecordResult{
+			Index:              int32(result.Index),
+			Line:               int32(result.Line),
+			Key:                result.Key,
+			Outcome:            string(result.Outcome),
+			ArticleId:          result.ArticleID,
+			JournalId:          result.JournalID,
+			JournalCreated:     result.JournalCreated,
+			AuthorsCreated:     int32(result.AuthorsCreated),
+			Error:              result.Error,
+			References:         int32(result.References),
+			Warnings:           result.Warnings,
+			PossibleDuplicates: result.PossibleDuplicates,
+		})
+	}
+	return stream.SendAndClose(resp)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// PlaceArticle implements the gRPC PlaceArticle method
+func (s *ArticleGRPCServer) PlaceArticle(ctx context.Context, req *proto.PlaceArticleRequest) (*proto.PlaceArticleResponse, error) {
+	placement, err := s.service.WithCaller(callerFromContext(ctx)).PlaceArticle(core.ArticlePlacement{
+		ArticleID: req.Placement.GetArticleId(),
+		IssueID:   req.Placement.GetIssueId(),
+		Sequence:  int(req.Placement.GetSequence()),
+		FirstPage: int(req.Placement.GetFirstPage()),
+		LastPage:  int(req.Placement.GetLastPage()),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.PlaceArticleResponse{Placement: toProtoPlacement(placement)}, nil
+}
+
+// GetArticlePlacement implements the gRPC GetArticlePlacement method
+func (s *ArticleGRPCServer) GetArticlePlacement(ctx context.Context, req *proto.GetArticlePlacementRequest) (*proto.GetArticlePlacementResponse, error) {
+	placement, err := s.service.GetPlacement(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetArticlePlacementResponse{Placement: toProtoPlacement(placement)}, nil
+}
+
+// ListIssueArticles implements the gRPC ListIssueArticles method
+func (s *ArticleGRPCServer) ListIssueArticles(ctx context.Context, req *proto.ListIssueArticlesRequest) (*proto.ListIssueArticlesResponse, error) {
+	placements, err := s.service.ListIssueArticles(req.IssueId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListIssueArticlesResponse{}
+	for _, placement := range placements {
+		resp.Placements = append(resp.Placements, toProtoPlacement(placement))
+	}
+	return resp, nil
+}
+
+func toProtoPlacement(placement core.ArticlePlacement) *proto.ArticlePlacement {
+	return &proto.ArticlePlacement{
+		ArticleId: placement.ArticleID,
+		IssueId:   placement.IssueID,
+		Sequence:  int32(placement.Sequence),
+		FirstPage: int32(placement.FirstPage),
+		LastPage:  int32(placement.LastPage),
+		PlacedAt:  timestamppb.New(placement.PlacedAt),
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// GetRelatedArticles implements the gRPC GetRelatedArticles method
+func (s *ArticleGRPCServer) GetRelatedArticles(ctx context.Context, req *proto.GetRelatedArticlesRequest) (*proto.GetRelatedArticlesResponse, error) {
+	related, err := s.recommendations.RelatedArticles(req.ArticleId, int(req.Limit))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	response := &proto.GetRelatedArticlesResponse{}
+	for _, entry := range related {
+		response.Articles = append(response.Articles, &proto.RelatedArticle{
+			Article: toProtoArticle(entry.Article),
+			Score:   entry.Score,
+			Signals: &proto.RecommendationSignals{
+				Content:    entry.Signals.Content,
+				Authors:    entry.Signals.Authors,
+				CoCitation: entry.Signals.CoCitation,
+				Journal:    entry.Signals.Journal,
+			},
+		})
+		response.ComputedAt = timestamppb.New(entry.ComputedAt)
+	}
+	return response, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// RegisterReviewer implements the gRPC RegisterReviewer method
+func (s *ArticleGRPCServer) RegisterReviewer(ctx context.Context, req *proto.RegisterReviewerRequest) (*proto.RegisterReviewerResponse, error) {
+	reviewer, err := s.reviews.RegisterReviewer(core.Reviewer{
+		ID:          req.GetReviewer().GetId(),
+		Name:        req.GetReviewer().GetName(),
+		Email:       req.GetReviewer().GetEmail(),
+		Affiliation: req.GetReviewer().GetAffiliation(),
+		AuthorID:    req.GetReviewer().GetAuthorId(),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RegisterReviewerResponse{Reviewer: toProtoReviewer(reviewer)}, nil
+}
+
+// ListReviewers implements the gRPC ListReviewers method
+func (s *ArticleGRPCServer) ListReviewers(ctx context.Context, req *proto.ListReviewersRequest) (*proto.ListReviewersResponse, error) {
+	reviewers, err := s.reviews.ListReviewers()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListReviewersResponse{}
+	for _, reviewer := range reviewers {
+		resp.Reviewers = append(resp.Reviewers, toProtoReviewer(reviewer))
+	}
+	return resp, nil
+}
+
+// SuggestReviewers implements the gRPC SuggestReviewers method
+func (s *ArticleGRPCServer) SuggestReviewers(ctx context.Context, req *proto.SuggestReviewersRequest) (*proto.SuggestReviewersResponse, error) {
+	suggestions, err := s.reviews.SuggestReviewers(req.ArticleId, int(req.Limit))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.SuggestReviewersResponse{}
+	for _, match := range suggestions.Matches {
+		resp.Matches = append(resp.Matches, &proto.ReviewerMatch{
+			Reviewer:    toProtoReviewer(match.Reviewer),
+			Score:       match.Score,
+			SharedTerms: match.SharedTerms,
+		})
+	}
+	for _, conflict := range suggestions.Conflicts {
+		reasons := make([]string, 0, len(conflict.Reasons))
+		for _, reason := range conflict.Reasons {
+			reasons = append(reasons, string(reason))
+		}
+		resp.Conflicts = append(resp.Conflicts, &proto.ReviewerConflict{
+			Reviewer: toProtoReviewer(conflict.Reviewer),
+			Reasons:  reasons,
+		})
+	}
+	return resp, nil
+}
+
+// AssignReviewer implements the gRPC AssignReviewer method
+func (s *ArticleGRPCServer) AssignReviewer(ctx context.Context, req *proto.AssignReviewerRequest) (*proto.AssignReviewerResponse, error) {
+	if req.DueDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "due_date is required")
+	}
+
+	assignment, err := s.reviews.WithCaller(callerFromContext(ctx)).AssignReviewer(req.ArticleId, req.ReviewerId, req.DueDate.AsTime())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.AssignReviewerResponse{Assignment: toProtoAssignment(assignment)}, nil
+}
+
+// ListReviewAssignments implements the gRPC ListReviewAssignments method
+func (s *ArticleGRPCServer) ListReviewAssignments(ctx context.Context, req *proto.ListReviewAssignmentsRequest) (*proto.ListReviewAssignmentsResponse, error) {
+	assignments, err := s.reviews.ListAssignments(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListReviewAssignmentsResponse{}
+	for _, assignment := range assignments {
+		resp.Assignments = append(resp.Assignments, toProtoAssignment(assignment))
+	}
+	return resp, nil
+}
+
+// SubmitReviewReport implements the gRPC SubmitReviewReport method
+func (s *ArticleGRPCServer) SubmitReviewReport(ctx context.Context, req *proto.SubmitReviewReportRequest) (*proto.SubmitReviewReportResponse, error) {
+	report, err := s.reviews.SubmitReport(req.AssignmentId, core.Recommendation(req.Recommendation), req.Comments)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.SubmitReviewReportResponse{Report: toProtoReport(report)}, nil
+}
+
+// ListReviewReports implements the gRPC ListReviewReports method
+func (s *ArticleGRPCServer) ListReviewReports(ctx context.Context, req *proto.ListReviewReportsRequest) (*proto.ListReviewReportsResponse, error) {
+	reports, err := s.reviews.ListReports(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListReviewReportsResponse{}
+	for _, report := range reports {
+		resp.Reports = append(resp.Reports, toProtoReport(report))
+	}
+	return resp, nil
+}
+
+// RecordEditorDecision implements the gRPC RecordEditorDecision method
+func (s *ArticleGRPCServer) RecordEditorDecision(ctx context.Context, req *proto.RecordEditorDecisionRequest) (*proto.RecordEditorDecisionResponse, error) {
+	decision, err := s.reviews.WithCaller(callerFromContext(ctx)).RecordDecision(req.ArticleId, core.DecisionOutcome(req.Outcome), req.Comments)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	article, err := s.service.GetArticleByID(decision.ArticleID)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RecordEditorDecisionResponse{Decision: toProtoDecision(decision), Article: toProtoArticle(article)}, nil
+}
+
+// ListEditorDecisions implements the gRPC ListEditorDecisions method
+func (s *ArticleGRPCServer) ListEditorDecisions(ctx context.Context, req *proto.ListEditorDecisionsRequest) (*proto.ListEditorDecisionsResponse, error) {
+	decisions, err := s.reviews.ListDecisions(req.ArticleId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListEditorDecisionsResponse{}
+	for _, decision := range decisions {
+		resp.Decisions = append(resp.Decisions, toProtoDecision(decision))
+	}
+	return resp, nil
+}
+
+func toProtoReviewer(reviewer core.Reviewer) *proto.Reviewer {
+	return &proto.Reviewer{
+		Id:          reviewer.ID,
+		Name:        reviewer.Name,
+		Email:       reviewer.Email,
+		Affiliation: reviewer.Affiliation,
+		AuthorId:    reviewer.AuthorID,
+		CreatedAt:   timestamppb.New(reviewer.CreatedAt),
+	}
+}
+
+func toProtoAssignment(assignment core.ReviewAssignment) *proto.ReviewAssignment {
+	protoAssignment := &proto.ReviewAssignment{
+		Id:         assignment.ID,
+		ArticleId:  assignment.ArticleID,
+		ReviewerId: assignment.ReviewerID,
+		Status:     string(assignment.Status),
+		AssignedAt: timestamppb.New(assignment.AssignedAt),
+		DueDate:    timestamppb.New(assignment.DueDate),
+		Overdue:    assignment.Overdue(time.Now()),
+	}
+	if assignment.CompletedAt != nil {
+		protoAssignment.CompletedAt = timestamppb.New(*assignment.CompletedAt)
+	}
+	return protoAssignment
+}
+
+func toProtoReport(report core.ReviewReport) *proto.ReviewReport {
+	return &proto.ReviewReport{
+		Id:             report.ID,
+		AssignmentId:   report.AssignmentID,
+		ArticleId:      report.ArticleID,
+		ReviewerId:     report.ReviewerID,
+		Recommendation: string(report.Recommendation),
+		Comments:       report.Comments,
+		SubmittedAt:    timestamppb.New(report.SubmittedAt),
+	}
+}
+
+func toProtoDecision(decision core.EditorDecision) *proto.EditorDecision {
+	return &proto.EditorDecision{
+		Id:        decision.ID,
+		ArticleId: decision.ArticleID,
+		EditorId:  decision.EditorID,
+		Outcome:   string(decision.Outcome),
+		Comments:  decision.Comments,
+		DecidedAt: timestamppb.New(decision.DecidedAt),
+	}
+}
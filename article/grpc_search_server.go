@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// SearchArticles implements the gRPC SearchArticles method
+func (s *ArticleGRPCServer) SearchArticles(ctx context.Context, req *proto.SearchArticlesRequest) (*proto.SearchArticlesResponse, error) {
+	results, err := s.search.Search(core.SearchQuery{
+		Text:      req.Query,
+		JournalID: req.JournalId,
+		AuthorID:  req.AuthorId,
+		Status:    core.ArticleStatus(req.Status),
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	response := &proto.SearchArticlesResponse{Total: int32(results.Total)}
+	for _, result := range results.Results {
+		converted := &proto.SearchResult{Article: toProtoArticle(result.Article), Score: result.Score}
+		for _, snippet := range result.Snippets {
+			protoSnippet := &proto.SearchSnippet{Field: string(snippet.Field), Text: snippet.Text}
+			for _, highlight := range snippet.Highlights {
+				protoSnippet.Highlights = append(protoSnippet.Highlights, &proto.TextRange{Start: int32(highlight.Start), End: int32(highlight.End)})
+			}
+			converted.Snippets = append(converted.Snippets, protoSnippet)
+		}
+		response.Results = append(response.Results, converted)
+	}
+	return response, nil
+}
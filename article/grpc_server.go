@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// ArticleGRPCServer implements the gRPC server interface
+type ArticleGRPCServer struct {
+	proto.UnimplementedArticleServiceServer
+	service         *core.ArticleService
+	webhooks        *eventing.WebhookService
+	audit           *eventing.AuditService
+	reviews         *core.ReviewService
+	authors         *core.AuthorService
+	merges          *core.DisambiguationService
+	metrics         *core.BibliometricsService
+	dois            *core.DOIService
+	exports         *core.ExportService
+	imports         *core.ImportService
+	search          *core.SearchService
+	duplicates      *core.DuplicateService
+	recommendations *core.RecommendationService
+	taxonomy        *core.TaxonomyService
+}
+
+// NewArticleGRPCServer creates a new gRPC server instance
+func NewArticleGRPCServer(service *core.ArticleService, webhooks *eventing.WebhookService, audit *eventing.AuditService,
+	reviews *core.ReviewService, authors *core.AuthorService, merges *core.DisambiguationService,
+	metrics *core.BibliometricsService, dois *core.DOIService, exports *core.ExportService,
+	imports *core.ImportService, search *core.SearchService, duplicates *core.DuplicateService,
+	recommendations *core.RecommendationService, taxonomy *core.TaxonomyService) *ArticleGRPCServer {
+	return &ArticleGRPCServer{
+		service:         service,
+		webhooks:        webhooks,
+		audit:           audit,
+		reviews:         reviews,
+		authors:         authors,
+		merges:          merges,
+		metrics:         metrics,
+		dois:            dois,
+		exports:         exports,
+		imports:         imports,
+		search:          search,
+		duplicates:      duplicates,
+		recommendations: recommendations,
+		taxonomy:        taxonomy,
+	}
+}
+
+// GetArticle implements the gRPC GetArticle method
+func (s *ArticleGRPCServer) GetArticle(ctx context.Context, req *proto.GetArticleRequest) (*proto.GetArticleResponse, error) {
+	article, err := s.service.GetArticleByID(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetArticleResponse{Article: toProtoArticle(article)}, nil
+}
+
+// CreateArticle implements the gRPC CreateArticle method
+func (s *ArticleGRPCServer) CreateArticle(ctx context.Context, req *proto.CreateArticleRequest) (*proto.CreateArticleResponse, error) {
+	article, duplicates, err := s.service.WithCaller(callerFromContext(ctx)).CreateArticleScreened(fromProtoArticle(req.Article))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateArticleResponse{
+		Article:            toProtoArticle(article),
+		PossibleDuplicates: toProtoSimilarArticles(duplicates),
+		SubjectWarnings:    s.subjectWarnings(article),
+	}, nil
+}
+
+// UpdateArticle implements the gRPC UpdateArticle method
+func (s *ArticleGRPCServer) UpdateArticle(ctx context.Context, req *proto.UpdateArticleRequest) (*proto.UpdateArticleResponse, error) {
+	article, err := s.service.WithCaller(callerFromContext(ctx)).UpdateArticle(fromProtoArticle(req.Article))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.UpdateArticleResponse{Article: toProtoArticle(article), SubjectWarnings: s.subjectWarnings(article)}, nil
+}
+
+// TransitionArticle implements the gRPC TransitionArticle method
+func (s *ArticleGRPCServer) TransitionArticle(ctx context.Context, req *proto.TransitionArticleRequest) (*proto.TransitionArticleResponse, error) {
+	service := s.service.WithCaller(callerFromContext(ctx))
+
+	var article core.Article
+	var err error
+	switch core.ArticleStatus(req.Status) {
+	case core.StatusSubmitted:
+		article, err = service.SubmitArticle(req.Id)
+	case core.StatusUnderReview:
+		article, err = service.StartReview(req.Id)
+	case core.StatusAccepted:
+		article, err = service.AcceptArticle(req.Id)
+	case core.StatusRejected:
+		article, err = service.RejectArticle(req.Id, req.Reason)
+	case core.StatusPublished:
+		article, err = service.PublishArticle(req.Id)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "cannot transition to status %q", req.Status)
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &proto.TransitionArticleResponse{Article: toProtoArticle(article)}, nil
+}
+
+// GetStatusHistory implements the gRPC GetStatusHistory method
+func (s *ArticleGRPCServer) GetStatusHistory(ctx context.Context, req *proto.GetStatusHistoryRequest) (*proto.GetStatusHistoryResponse, error) {
+	history, err := s.service.GetStatusHistory(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.GetStatusHistoryResponse{}
+	for _, transition := range history {
+		resp.Transitions = append(resp.Transitions, &proto.StatusTransition{
+			From:   string(transition.From),
+			To:     string(transition.To),
+			Actor:  transition.Actor,
+			Reason: transition.Reason,
+			At:     timestamppb.New(transition.At),
+		})
+	}
+	return resp, nil
+}
+
+// ListAuditEntries implements the gRPC ListAuditEntries method
+func (s *ArticleGRPCServer) ListAuditEntries(ctx context.Context, req *proto.ListAuditEntriesRequest) (*proto.ListAuditEntriesResponse, error) {
+	query := eventing.AuditQuery{EntityID: req.EntityId, Limit: int(req.Limit)}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+
+	entries, err := s.audit.ListEntries(query)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListAuditEntriesResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &proto.AuditEntry{
+			Sequence:  entry.Sequence,
+			EntityId:  entry.EntityID,
+			Actor:     entry.Actor,
+			Operation: string(entry.Operation),
+			Before:    string(entry.Before),
+			After:     string(entry.After),
+			Timestamp: timestamppb.New(entry.Timestamp),
+			PrevHash:  entry.PrevHash,
+			Hash:      entry.Hash,
+			Peer:      entry.Peer,
+			EventId:   entry.EventID,
+		})
+	}
+	return resp, nil
+}
+
+// VerifyAuditLog implements the gRPC VerifyAuditLog method
+func (s *ArticleGRPCServer) VerifyAuditLog(ctx context.Context, req *proto.VerifyAuditLogRequest) (*proto.VerifyAuditLogResponse, error) {
+	verified, err := s.audit.VerifyChain()
+	if errors.Is(err, eventing.ErrAuditChainBroken) {
+		return &proto.VerifyAuditLogResponse{Valid: false, VerifiedEntries: verified, Error: err.Error()}, nil
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.VerifyAuditLogResponse{Valid: true, VerifiedEntries: verified}, nil
+}
+
+// CreateWebhookSubscription implements the gRPC CreateWebhookSubscription method
+func (s *ArticleGRPCServer) CreateWebhookSubscription(ctx context.Context, req *proto.CreateWebhookSubscriptionRequest) (*proto.CreateWebhookSubscriptionResponse, error) {
+	eventTypes := make([]core.EventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		eventTypes = append(eventTypes, core.EventType(eventType))
+	}
+
+	subscription, err := s.webhooks.CreateSubscription(eventing.WebhookSubscription{
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &proto.CreateWebhookSubscriptionResponse{Subscription: toProtoSubscription(subscription)}, nil
+}
+
+// ListWebhookSubscriptions implements the gRPC ListWebhookSubscriptions method
+func (s *ArticleGRPCServer) ListWebhookSubscriptions(ctx context.Context, req *proto.ListWebhookSubscriptionsRequest) (*proto.ListWebhookSubscriptionsResponse, error) {
+	subscriptions, err := s.webhooks.ListSubscriptions()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListWebhookSubscriptionsResponse{}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, toProtoSubscription(subscription))
+	}
+	return resp, nil
+}
+
+// DeleteWebhookSubscription implements the gRPC DeleteWebhookSubscription method
+func (s *ArticleGRPCServer) DeleteWebhookSubscription(ctx context.Context, req *proto.DeleteWebhookSubscriptionRequest) (*proto.DeleteWebhookSubscriptionResponse, error) {
+	if err := s.webhooks.DeleteSubscription(req.Id); err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+// ListWebhookDeliveries implements the gRPC ListWebhookDeliveries method
+func (s *ArticleGRPCServer) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.webhooks.ListDeliveries(eventing.DeliveryQuery{
+		SubscriptionID: req.SubscriptionId,
+		Status:         eventing.DeliveryStatus(req.Status),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListWebhookDeliveriesResponse{}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toProtoDelivery(delivery))
+	}
+	return resp, nil
+}
+
+// RedeliverWebhook implements the gRPC RedeliverWebhook method
+func (s *ArticleGRPCServer) RedeliverWebhook(ctx context.Context, req *proto.RedeliverWebhookRequest) (*proto.RedeliverWebhookResponse, error) {
+	delivery, err := s.webhooks.Redeliver(req.DeliveryId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RedeliverWebhookResponse{Delivery: toProtoDelivery(delivery)}, nil
+}
+
+func toProtoArticle(article core.Article) *proto.Article {
+	protoArticle := &proto.Article{
+		Id:            article.ID,
+		Title:         article.Title,
+		Abstract:      article.Abstract,
+		JournalId:     article.JournalID,
+		CreatedAt:     article.CreatedAt,
+		UpdatedAt:     article.UpdatedAt,
+		Status:        string(article.Status),
+		CitationCount: int32(article.CitationCount),
+		Keywords:      article.Keywords,
+		Subjects:      article.Subjects,
+	}
+	if article.PublishedAt != nil {
+		protoArticle.PublishedAt = timestamppb.New(*article.PublishedAt)
+	}
+	protoArticle.Doi = article.DOI
+	if article.Deposit != nil {
+		protoArticle.DoiDeposit = &proto.DOIDeposit{
+			Status:      string(article.Deposit.Status),
+			BatchId:     article.Deposit.BatchID,
+			Message:     article.Deposit.Message,
+			SubmittedAt: timestamppb.New(article.Deposit.SubmittedAt),
+			UpdatedAt:   timestamppb.New(article.Deposit.UpdatedAt),
+		}
+	}
+	for _, author := range article.Authors {
+		protoArticle.Authors = append(protoArticle.Authors, &proto.ArticleAuthor{
+			AuthorId:      author.AuthorID,
+			Orcid:         author.ORCID,
+			Affiliation:   author.Affiliation,
+			Corresponding: author.Corresponding,
+		})
+	}
+	if len(article.Authors) > 0 {
+		protoArticle.AuthorId = article.Authors[0].AuthorID
+	}
+	return protoArticle
+}
+
+func fromProtoArticle(article *proto.Article) core.Article {
+	converted := core.Article{
+		ID:        article.GetId(),
+		Title:     article.GetTitle(),
+		Abstract:  article.GetAbstract(),
+		JournalID: article.GetJournalId(),
+		Keywords:  article.GetKeywords(),
+		Subjects:  article.GetSubjects(),
+	}
+	for _, author := range article.GetAuthors() {
+		converted.Authors = append(converted.Authors, core.ArticleAuthor{
+			AuthorID:      author.GetAuthorId(),
+			ORCID:         author.GetOrcid(),
+			Affiliation:   author.GetAffiliation(),
+			Corresponding: author.GetCorresponding(),
+		})
+	}
+	// Older clients only send the single author ID
+	if len(converted.Authors) == 0 && article.GetAuthorId() != "" {
+		converted.Authors = []core.ArticleAuthor{{AuthorID: article.GetAuthorId(), Corresponding: true}}
+	}
+	return converted
+}
+
+func toProtoSubscription(subscription eventing.WebhookSubscription) *proto.WebhookSubscription {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return &proto.WebhookSubscription{
+		Id:         subscription.ID,
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func toProtoDelivery(delivery eventing.WebhookDelivery) *proto.WebhookDelivery {
+	return &proto.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.Event.ID,
+		EventType:      string(delivery.Event.Type),
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		LastStatusCode: int32(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+	}
+}
+
+// actorMetadataKey is the metadata key callers use to identify themselves
+// for the audit log
+const actorMetadataKey = "x-actor"
+
+// callerFromContext identifies the caller of a request for the audit log.
+// The actor is taken from the x-actor header, which the service does not
+// authenticate: any client can claim any actor, so the network address of
+// the peer is recorded alongside it.
+func callerFromContext(ctx context.Context) eventing.Caller {
+	caller := eventing.Caller{Actor: actorFromContext(ctx)}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		caller.Peer = p.Addr.String()
+	}
+	return caller
+}
+
+// actorFromContext returns the actor from the request metadata, falling back
+// to the system actor for unattributed calls
+func actorFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return core.SystemActor
+	}
+	if values := md.Get(actorMetadataKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	return core.SystemActor
+}
+
+// grpcError maps domain errors to gRPC status codes
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrArticleNotFound),
+		errors.Is(err, eventing.ErrWebhookNotFound),
+		errors.Is(err, eventing.ErrDeliveryNotFound),
+		errors.Is(err, core.ErrReviewerNotFound),
+		errors.Is(err, core.ErrAuthorNotFound),
+		errors.Is(err, core.ErrMergeNotFound),
+		errors.Is(err, core.ErrAssignmentNotFound),
+		errors.Is(err, core.ErrIssueNotFound),
+		errors.Is(err, core.ErrPlacementNotFound),
+		errors.Is(err, core.ErrAuthorMetricsNotFound),
+		errors.Is(err, core.ErrSubjectTermNotFound),
+		errors.Is(err, core.ErrJournalNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrInvalidTransition),
+		errors.Is(err, core.ErrTransitionBlocked),
+		errors.Is(err, core.ErrReviewNotOpen),
+		errors.Is(err, core.ErrInvalidMerge),
+		errors.Is(err, core.ErrPlacementNotAllowed),
+		errors.Is(err, core.ErrDOINotAllowed),
+		errors.Is(err, core.ErrSubjectTermInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, core.ErrInvalidArticle),
+		errors.Is(err, eventing.ErrInvalidWebhook),
+		errors.Is(err, core.ErrInvalidReview),
+		errors.Is(err, core.ErrInvalidAuthor),
+		errors.Is(err, core.ErrInvalidPlacement),
+		errors.Is(err, core.ErrInvalidReference),
+		errors.Is(err, core.ErrInvalidCitationQuery),
+		errors.Is(err, core.ErrInvalidMetricsQuery),
+		errors.Is(err, core.ErrInvalidDOI),
+		errors.Is(err, core.ErrInvalidDeposit),
+		errors.Is(err, core.ErrInvalidExportQuery),
+		errors.Is(err, core.ErrInvalidImport),
+		errors.Is(err, core.ErrInvalidSearchQuery),
+		errors.Is(err, core.ErrInvalidTitleQuery),
+		errors.Is(err, core.ErrInvalidDuplicateQuery),
+		errors.Is(err, core.ErrInvalidRecommendationQuery),
+		errors.Is(err, core.ErrInvalidSubjectTerm),
+		errors.Is(err, core.ErrInvalidSubjectQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, core.ErrArticleExists),
+		errors.Is(err, core.ErrDuplicateTitle):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, core.ErrAuthorListChanged):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/realBagher/hexaservice-go/article/adapters"
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// duplicateAbstract is long enough for near-duplicate screening
+var duplicateAbstract = strings.Repeat("Colourings of planar graphs with four colours. ", 8)
+
+// newTestService returns an article service on in-memory adapters with
+// journal_1 and author_1, which screens new articles for duplicates
+func newTestService(t *testing.T) (*core.ArticleService, *core.DuplicateService, *adapters.InMemoryJournalDirectory) {
+	t.Helper()
+	authors := adapters.NewInMemoryAuthorRepository()
+	if _, err := authors.CreateAuthor(core.Author{ID: "author_1", Name: "Josiah Carberry"}); err != nil {
+		t.Fatal(err)
+	}
+	journals := adapters.NewInMemoryJournalDirectory(core.JournalInfo{ID: "journal_1", Name: "Nature"})
+	service := core.NewArticleService(adapters.NewInMemoryArticleRepository(), authors, journals,
+		adapters.NewInMemoryTaxonomyRepository())
+	duplicates := core.NewDuplicateService(adapters.NewInMemoryDuplicateIndex(), service)
+	return service.WithDuplicateScreen(duplicates), duplicates, journals
+}
+
+// publishTestArticle creates an article and moves it through review to
+// published
+func publishTestArticle(t *testing.T, service *core.ArticleService, id, title string) {
+	t.Helper()
+	article := fromProtoArticle(testArticle(id, title))
+	article.Abstract = "On " + title + "."
+	if _, err := service.CreateArticle(article); err != nil {
+		t.Fatal(err)
+	}
+	for _, step := range []func(string) (core.Article, error){
+		service.SubmitArticle, service.StartReview, service.AcceptArticle, service.PublishArticle,
+	} {
+		if _, err := step(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newTestClient serves the article service of newTestService over an
+// in-process connection
+func newTestClient(t *testing.T) (proto.ArticleServiceClient, *core.ArticleService) {
+	t.Helper()
+	service, duplicates, journals := newTestService(t)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	proto.RegisterArticleServiceServer(server, NewArticleGRPCServer(service, nil, nil, nil, nil, nil, nil, nil, nil,
+		core.NewImportService(service, journals), nil, duplicates, nil, nil))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return proto.NewArticleServiceClient(conn), service
+}
+
+func testArticle(id, title string) *proto.Article {
+	return &proto.Article{
+		Id:        id,
+		Title:     title,
+		Abstract:  duplicateAbstract,
+		JournalId: "journal_1",
+		Authors:   []*proto.ArticleAuthor{{AuthorId: "author_1", Corresponding: true}},
+	}
+}
+
+func TestGRPCCreateArticleReportsPossibleDuplicates(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	first, err := client.CreateArticle(ctx, &proto.CreateArticleRequest{Article: testArticle("a1", "Four Colour Theorem")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.PossibleDuplicates) != 0 {
+		t.Errorf("CreateArticle(a1) reported duplicates %v", first.PossibleDuplicates)
+	}
+
+	resubmitted, err := client.CreateArticle(ctx, &proto.CreateArticleRequest{Article: testArticle("a2", "The Four Colour Theorem")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duplicates := resubmitted.PossibleDuplicates; len(duplicates) != 1 || duplicates[0].Article.Id != "a1" || duplicates[0].Similarity <= 0 {
+		t.Errorf("CreateArticle(a2) reported duplicates %v, want a1", duplicates)
+	}
+
+	_, err = client.CreateArticle(ctx, &proto.CreateArticleRequest{Article: testArticle("a3", " ")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateArticle() without a title = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGRPCImportArticlesReportsPossibleDuplicates(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+	if _, err := client.CreateArticle(ctx, &proto.CreateArticleRequest{Article: testArticle("a1", "Four Colour Theorem")}); err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.ImportArticles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := `@article{appel1977,
+  author   = {Carberry, Josiah},
+  title    = {The Four Colour Theorem},
+  abstract = {` + duplicateAbstract + `},
+  year     = 1977
+}`
+	if err := stream.Send(&proto.ImportArticlesRequest{
+		Options: &proto.ImportOptions{Format: string(core.ImportBibTeX), JournalId: "journal_1"},
+		Data:    []byte(file),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Succeeded != 1 || len(report.Results) != 1 {
+		t.Fatalf("ImportArticles() = %v", report)
+	}
+	if duplicates := report.Results[0].PossibleDuplicates; len(duplicates) != 1 || duplicates[0] != "a1" {
+		t.Errorf("possible duplicates of the imported article = %v, want a1", duplicates)
+	}
+}
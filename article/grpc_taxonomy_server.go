@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// CreateSubjectTerm implements the gRPC CreateSubjectTerm method
+func (s *ArticleGRPCServer) CreateSubjectTerm(ctx context.Context, req *proto.CreateSubjectTermRequest) (*proto.CreateSubjectTermResponse, error) {
+	term, err := s.taxonomy.CreateTerm(fromProtoSubjectTerm(req.Term))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateSubjectTermResponse{Term: toProtoSubjectTerm(term)}, nil
+}
+
+// GetSubjectTerm implements the gRPC GetSubjectTerm method
+func (s *ArticleGRPCServer) GetSubjectTerm(ctx context.Context, req *proto.GetSubjectTermRequest) (*proto.GetSubjectTermResponse, error) {
+	term, err := s.taxonomy.GetTerm(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetSubjectTermResponse{Term: toProtoSubjectTerm(term)}, nil
+}
+
+// ListSubjectTerms implements the gRPC ListSubjectTerms method
+func (s *ArticleGRPCServer) ListSubjectTerms(ctx context.Context, req *proto.ListSubjectTermsRequest) (*proto.ListSubjectTermsResponse, error) {
+	terms, err := s.taxonomy.ListTerms()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	response := &proto.ListSubjectTermsResponse{}
+	for _, term := range terms {
+		response.Terms = append(response.Terms, toProtoSubjectTerm(term))
+	}
+	return response, nil
+}
+
+// UpdateSubjectTerm implements the gRPC UpdateSubjectTerm method
+func (s *ArticleGRPCServer) UpdateSubjectTerm(ctx context.Context, req *proto.UpdateSubjectTermRequest) (*proto.UpdateSubjectTermResponse, error) {
+	term, err := s.taxonomy.UpdateTerm(fromProtoSubjectTerm(req.Term))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.UpdateSubjectTermResponse{Term: toProtoSubjectTerm(term)}, nil
+}
+
+// DeleteSubjectTerm implements the gRPC DeleteSubjectTerm method
+func (s *ArticleGRPCServer) DeleteSubjectTerm(ctx context.Context, req *proto.DeleteSubjectTermRequest) (*proto.DeleteSubjectTermResponse, error) {
+	if err := s.taxonomy.DeleteTerm(req.Id); err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.DeleteSubjectTermResponse{}, nil
+}
+
+// ListArticlesBySubject implements the gRPC ListArticlesBySubject method
+func (s *ArticleGRPCServer) ListArticlesBySubject(ctx context.Context, req *proto.ListArticlesBySubjectRequest) (*proto.ListArticlesBySubjectResponse, error) {
+	page, err := s.taxonomy.ListArticlesBySubject(core.SubjectQuery{
+		TermID:             req.TermId,
+		IncludeDescendants: req.IncludeDescendants,
+		Offset:             int(req.Offset),
+		Limit:              int(req.Limit),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	response := &proto.ListArticlesBySubjectResponse{Total: int32(page.Total)}
+	for _, article := range page.Articles {
+		response.Articles = append(response.Articles, toProtoArticle(article))
+	}
+	return response, nil
+}
+
+// subjectWarnings checks a stored article against its journal's scope.
+// Failing to check only loses the warnings.
+func (s *ArticleGRPCServer) subjectWarnings(article core.Article) []string {
+	warnings, err := s.service.SubjectScopeWarnings(article)
+	if err != nil {
+		log.Printf("Taxonomy: failed to check the subjects of article %s: %v", article.ID, err)
+	}
+	return warnings
+}
+
+func toProtoSubjectTerm(term core.SubjectTerm) *proto.SubjectTerm {
+	return &proto.SubjectTerm{
+		Id:        term.ID,
+		Label:     term.Label,
+		ParentId:  term.ParentID,
+		Synonyms:  term.Synonyms,
+		CreatedAt: timestamppb.New(term.CreatedAt),
+		UpdatedAt: timestamppb.New(term.UpdatedAt),
+	}
+}
+
+func fromProtoSubjectTerm(term *proto.SubjectTerm) core.SubjectTerm {
+	return core.SubjectTerm{
+		ID:       term.GetId(),
+		Label:    term.GetLabel(),
+		ParentID: term.GetParentId(),
+		Synonyms: term.GetSynonyms(),
+	}
+}
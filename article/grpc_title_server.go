@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+// FindArticlesByTitle implements the gRPC FindArticlesByTitle method
+func (s *ArticleGRPCServer) FindArticlesByTitle(ctx context.Context, req *proto.FindArticlesByTitleRequest) (*proto.FindArticlesByTitleResponse, error) {
+	matches, err := s.service.FindArticlesByTitle(core.TitleQuery{
+		Title:     req.Title,
+		Fuzzy:     req.Fuzzy,
+		Threshold: req.Threshold,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	response := &proto.FindArticlesByTitleResponse{Total: int32(matches.Total), TotalCapped: matches.TotalCapped}
+	for _, match := range matches.Matches {
+		response.Matches = append(response.Matches, &proto.TitleMatch{
+			Article:    toProtoArticle(match.Article),
+			Similarity: match.Similarity,
+			Distance:   int32(match.Distance),
+		})
+	}
+	return response, nil
+}
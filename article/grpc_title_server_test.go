@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+func TestGRPCFindArticlesByTitleReportsCappedTotals(t *testing.T) {
+	client, service := newTestClient(t)
+	for i := 0; i <= core.MaxTitleCandidates; i++ {
+		article := fromProtoArticle(testArticle(fmt.Sprintf("g%03d", i), fmt.Sprintf("Graph Colouring %d", i)))
+		article.Abstract = fmt.Sprintf("Abstract %d.", i)
+		if _, err := service.CreateArticle(article); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fuzzy, err := client.FindArticlesByTitle(context.Background(), &proto.FindArticlesByTitleRequest{Title: "Graph Colouring", Fuzzy: true, Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fuzzy.TotalCapped || fuzzy.Total != core.MaxTitleCandidates || len(fuzzy.Matches) != 5 {
+		t.Errorf("fuzzy FindArticlesByTitle() = %d matches of %d, capped %t", len(fuzzy.Matches), fuzzy.Total, fuzzy.TotalCapped)
+	}
+
+	exact, err := client.FindArticlesByTitle(context.Background(), &proto.FindArticlesByTitleRequest{Title: "graph colouring 7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exact.TotalCapped || exact.Total != 1 || exact.Matches[0].Article.Id != "g007" {
+		t.Errorf("exact FindArticlesByTitle() = %v", exact)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/realBagher/hexaservice-go/article/proto"
+)
+
+const (
+	importChunkSize = 64 << 10
+	importTimeout   = 10 * time.Minute
+)
+
+// importExtensions maps file extensions to import formats
+var importExtensions = map[string]string{
+	".bib": "bibtex",
+	".ris": "ris",
+	".csv": "csv",
+	".xml": "jats",
+}
+
+// runImportCommand implements "article import": it streams a file to a
+// running article service's ImportArticles method and prints the report.
+// It returns the process exit code.
+func runImportCommand(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost"+grpcPort, "address of the article service")
+	format := flags.String("format", "", `"bibtex", "ris", "csv" or "jats"; defaults to the file extension`)
+	dryRun := flags.Bool("dry-run", false, "validate the records without importing them")
+	journalID := flags.String("journal", "", "journal for records that name none")
+	actor := flags.String("actor", "", "actor the import is attributed to in the audit log")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: article import [flags] FILE")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = importExtensions[strings.ToLower(filepath.Ext(path))]
+		if *format == "" {
+			fmt.Fprintf(os.Stderr, "Cannot tell the format of %s; use -format\n", path)
+			return 2
+		}
+	}
+
+	resp, err := importFile(*addr, path, *actor, &proto.ImportOptions{Format: *format, DryRun: *dryRun, JournalId: *journalID})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Import failed: %v\n", err)
+		return 1
+	}
+
+	for _, result := range resp.Results {
+		label := fmt.Sprintf("record %d (line %d)", result.Index, result.Line)
+		if result.Key != "" {
+			label += " " + result.Key
+		}
+		switch {
+		case result.Error != "":
+			fmt.Printf("%s: %s: %s\n", label, result.Outcome, result.Error)
+		case result.ArticleId != "":
+			fmt.Printf("%s: %s as article %s\n", label, result.Outcome, result.ArticleId)
+		default:
+			fmt.Printf("%s: %s\n", label, result.Outcome)
+		}
+		for _, warning := range result.Warnings {
+			fmt.Printf("  warning: %s\n", warning)
+		}
+	}
+
+	verb := "imported"
+	if resp.DryRun {
+		verb = "valid"
+	}
+	fmt.Printf("%d of %d record(s) %s, %d failed; %d journal(s) and %d author(s) created\n",
+		resp.Succeeded, resp.Total, verb, resp.Failed, resp.JournalsCreated, resp.AuthorsCreated)
+	if resp.Failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// importFile uploads the file in chunks after the options
+func importFile(addr, path, actor string, options *proto.ImportOptions) (*proto.ImportArticlesResponse, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create article service client: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), importTimeout)
+	defer cancel()
+	if actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, actorMetadataKey, actor)
+	}
+
+	stream, err := proto.NewArticleServiceClient(conn).ImportArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&proto.ImportArticlesRequest{Options: options}); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, importChunkSize)
+	for {
+		n, err := file.Read(chunk)
+		if n > 0 {
+			if err := stream.Send(&proto.ImportArticlesRequest{Data: chunk[:n]}); err != nil {
+				if errors.Is(err, io.EOF) {
+					// The server ended the call; CloseAndRecv returns its error
+					break
+				}
+				return nil, err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
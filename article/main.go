@@ -2,29 +2,115 @@ package main
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/realBagher/hexaservice-go/article/adapters"
 	"github.com/realBagher/hexaservice-go/article/core"
-	"github.com/realBagher/hexaservice-go/journal/proto"
+	"github.com/realBagher/hexaservice-go/article/proto"
+	"github.com/realBagher/hexaservice-go/eventing"
+	eventadapters "github.com/realBagher/hexaservice-go/eventing/adapters"
+	journalproto "github.com/realBagher/hexaservice-go/journal/proto"
 )
 
 const (
 	mysqlDSNEnvVar = "MYSQL_DSN"
 	testArticleID  = "1"
 	mysqlArticleID = "mysql_1"
+	grpcPort       = ":50052"
+	journalAddr    = "localhost:50051"
+	journalTimeout = 5 * time.Second
+	demoIssueID    = "issue_1"
+
+	// demoUniqueTitleJournalID is a demo journal that requires unique titles
+	demoUniqueTitleJournalID = "journal_2"
+
+	// demoSubjectScopeTermID is the subject term the demo journal_1 covers
+	demoSubjectScopeTermID = "cs"
+
+	relayInterval    = time.Second
+	dispatchInterval = time.Second
+	webhookTimeout   = 10 * time.Second
+
+	// DOI registration; without CROSSREF_URL deposits go to a local
+	// Crossref stand-in
+	doiPrefixEnvVar        = "DOI_PREFIX"
+	doiSuffixPatternEnvVar = "DOI_SUFFIX_PATTERN"
+	articleURLEnvVar       = "ARTICLE_URL_PATTERN"
+	crossrefURLEnvVar      = "CROSSREF_URL"
+	crossrefUsernameEnvVar = "CROSSREF_USERNAME"
+	crossrefPasswordEnvVar = "CROSSREF_PASSWORD"
+	defaultDOIPrefix       = "10.5555"
+	defaultArticleURL      = "https://articles.example.org/articles/{article}"
+	crossrefStandInUser    = "standin"
+	crossrefTimeout        = 30 * time.Second
+	doiPollInterval        = time.Minute
+
+	// HTTP server for harvesters and feed readers
+	httpAddrEnvVar  = "HTTP_ADDR"
+	defaultHTTPAddr = ":8080"
+
+	// OAI-PMH data provider; record identifiers are scoped by the
+	// repository identifier, so it must not change once harvested
+	oaiBaseURLEnvVar       = "OAI_BASE_URL"
+	oaiRepositoryIDEnvVar  = "OAI_REPOSITORY_IDENTIFIER"
+	oaiAdminEmailEnvVar    = "OAI_ADMIN_EMAIL"
+	defaultOAIBaseURL      = "http://localhost:8080/oai"
+	defaultOAIRepositoryID = "articles.example.org"
+	defaultOAIAdminEmail   = "oai@articles.example.org"
+	oaiRepositoryName      = "Hexaservice Articles"
+	demoHarvestPageSize    = 2
+
+	// Journal feeds
+	feedURLEnvVar  = "FEED_URL_PATTERN"
+	defaultFeedURL = "http://localhost:8080/feeds/{journal}/{format}"
+
+	// Related-article recommendations; weights are written as
+	// "content=0.5,authors=0.2,cocitation=0.2,journal=0.1" and intervals
+	// as Go durations
+	recommendationWeightsEnvVar   = "RECOMMENDATION_WEIGHTS"
+	recommendationIntervalEnvVar  = "RECOMMENDATION_INTERVAL"
+	defaultRecommendationInterval = "1h"
 )
 
+// articleStore is implemented by repositories that keep an event outbox
+// next to the articles table
+type articleStore interface {
+	core.ArticleRepository
+	eventing.OutboxRepository
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImportCommand(os.Args[2:]))
+	}
+
+	// Start gRPC server in a separate goroutine
+	go func() {
+		if err := startGRPCServer(); err != nil {
+			log.Fatalf("gRPC server failed: %v", err)
+		}
+	}()
+
 	if err := runDemo(); err != nil {
 		log.Fatalf("Demo failed: %v", err)
 	}
+
+	// Keep the main goroutine alive
+	select {}
 }
 
 func runDemo() error {
@@ -51,19 +137,294 @@ func runDemo() error {
 	return nil
 }
 
-func fetchJournal(journalID string) (*proto.Journal, error) {
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+// repositories bundles the storage adapters used by the gRPC server
+type repositories struct {
+	articles articleStore
+	webhooks eventing.WebhookRepository
+	auditLog eventing.AuditLog
+	reviews  core.ReviewRepository
+	authors  core.AuthorRepository
+	metrics  core.AuthorMetricsRepository
+	taxonomy core.TaxonomyRepository
+	// recommendations holds the lists precomputed by the recommendation job
+	recommendations core.RecommendationRepository
+}
+
+// newRepositories returns MySQL backed repositories when the DSN is set and
+// falls back to in-memory storage otherwise
+func newRepositories() repositories {
+	articles := adapters.NewInMemoryArticleRepository()
+	inMemory := repositories{
+		articles: articles,
+		webhooks: eventadapters.NewInMemoryWebhookRepository(),
+		auditLog: eventadapters.NewInMemoryAuditLog(),
+		reviews:  adapters.NewInMemoryReviewRepository(articles),
+		authors:  adapters.NewInMemoryAuthorRepository(),
+		metrics:  adapters.NewInMemoryAuthorMetricsRepository(),
+		taxonomy: adapters.NewInMemoryTaxonomyRepository(),
+
+		recommendations: adapters.NewInMemoryRecommendationRepository(),
+	}
+
+	dsn := os.Getenv(mysqlDSNEnvVar)
+	if dsn == "" {
+		return inMemory
+	}
+
+	db, err := adapters.NewMySQLConnection(dsn)
+	if err != nil {
+		log.Printf("Failed to connect to MySQL, falling back to in-memory: %v", err)
+		return inMemory
+	}
+
+	// Authors come first: migrating old articles creates author records
+	authorRepo := adapters.NewMySQLAuthorRepository(db)
+	articleRepo := adapters.NewMySQLArticleRepository(db)
+	webhookRepo := eventadapters.NewMySQLWebhookRepository(db, adapters.TablePrefix)
+	auditLog := eventadapters.NewMySQLAuditLog(db, adapters.TablePrefix)
+	reviewRepo := adapters.NewMySQLReviewRepository(db)
+	metricsRepo := adapters.NewMySQLAuthorMetricsRepository(db)
+	recommendationRepo := adapters.NewMySQLRecommendationRepository(db)
+	taxonomyRepo := adapters.NewMySQLTaxonomyRepository(db)
+	for _, repo := range []interface{ InitializeSchema() error }{authorRepo, articleRepo, webhookRepo, auditLog, reviewRepo, metricsRepo,
+		recommendationRepo, taxonomyRepo} {
+		if err := repo.InitializeSchema(); err != nil {
+			log.Printf("Failed to initialize MySQL schema, falling back to in-memory: %v", err)
+			return inMemory
+		}
+	}
+
+	return repositories{articles: articleRepo, webhooks: webhookRepo, auditLog: auditLog, reviews: reviewRepo, authors: authorRepo, metrics: metricsRepo,
+		taxonomy: taxonomyRepo, recommendations: recommendationRepo}
+}
+
+func startGRPCServer() error {
+	// Create repositories and services for the gRPC server
+	repos := newRepositories()
+	journalConn, err := grpc.NewClient(journalAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to create journal service client: %w", err)
+	}
+	journals := adapters.NewGRPCJournalDirectory(journalConn, journalTimeout)
+
+	// The near-duplicate index is rebuilt from the repository on startup. The
+	// article service screens every article it creates against it and adds
+	// the article right away; article events keep edited articles current.
+	service := core.NewArticleService(repos.articles, repos.authors, journals, repos.taxonomy)
+	duplicates := core.NewDuplicateService(adapters.NewInMemoryDuplicateIndex(), service)
+	indexed, err := duplicates.Reindex()
+	if err != nil {
+		return fmt.Errorf("failed to build the duplicate index: %w", err)
+	}
+	log.Printf("Indexed %d article(s) for duplicate detection", indexed)
+	service = service.WithDuplicateScreen(duplicates)
+	taxonomy := core.NewTaxonomyService(repos.taxonomy, service)
+	authors := core.NewAuthorService(repos.authors)
+	merges := core.NewDisambiguationService(repos.authors, service)
+	audit := eventing.NewAuditService(repos.auditLog)
+	reviews := core.NewReviewService(repos.reviews, service)
+	webhooks := eventing.NewWebhookService(repos.webhooks, eventadapters.NewHTTPWebhookSender(webhookTimeout), eventing.DefaultRetryPolicy, core.EventTypes)
+	metrics := core.NewBibliometricsService(repos.metrics, service)
+	dois, err := newDOIService(service)
+	if err != nil {
+		return err
+	}
+	exports := core.NewExportService(service)
+	imports := core.NewImportService(service, journals)
+	harvest, err := newHarvestService(service, 0)
+	if err != nil {
+		return err
+	}
+	feeds, err := newFeedService(service)
+	if err != nil {
+		return err
+	}
+	recommendations, err := newRecommendationService(repos.recommendations, service)
+	if err != nil {
+		return err
+	}
+	recommendationJob, err := newRecommendationJob(recommendations)
+	if err != nil {
+		return err
+	}
+
+	// The search index is rebuilt from the repository on startup and kept
+	// current by article events
+	search := core.NewSearchService(adapters.NewInMemorySearchIndex(), service)
+	if indexed, err = search.Reindex(); err != nil {
+		return fmt.Errorf("failed to build the search index: %w", err)
+	}
+	log.Printf("Indexed %d article(s) for search", indexed)
+
+	// Relay outbox events to the local publisher, which feeds the audit log
+	// and the webhooks and keeps the author metrics, search and duplicate
+	// indexes current
+	publisher := eventadapters.NewInMemoryEventPublisher(eventadapters.DefaultHandlerRetryPolicy)
+	publisher.Subscribe("log", logEvent)
+	publisher.Subscribe("audit", audit.HandleEvent)
+	publisher.Subscribe("webhooks", webhooks.HandleEvent)
+	publisher.Subscribe("metrics", metrics.HandleEvent)
+	publisher.Subscribe("search", search.HandleEvent)
+	publisher.Subscribe("duplicates", duplicates.HandleEvent)
+	relay := eventing.NewOutboxRelay(repos.articles, publisher, relayInterval)
+	dispatcher := eventing.NewWebhookDispatcher(webhooks, dispatchInterval)
+	runInBackground("Outbox relay", relay.Run)
+	runInBackground("Event handler retries", func(ctx context.Context) error {
+		return publisher.Run(ctx, relayInterval)
+	})
+	runInBackground("Webhook dispatcher", dispatcher.Run)
+	runInBackground("DOI status poller", core.NewDOIStatusPoller(dois, doiPollInterval).Run)
+	runInBackground("Recommendation job", recommendationJob.Run)
+
+	// Create gRPC server
+	grpcServer := grpc.NewServer()
+	articleGRPCServer := NewArticleGRPCServer(service, webhooks, audit, reviews, authors, merges, metrics, dois, exports, imports, search, duplicates,
+		recommendations, taxonomy)
+
+	proto.RegisterArticleServiceServer(grpcServer, articleGRPCServer)
+	journalproto.RegisterCitationDataServer(grpcServer, NewCitationDataGRPCServer(service))
+	reflection.Register(grpcServer)
+
+	// Serve harvesters and feed readers over HTTP next to the gRPC API
+	httpAddr := envOrDefault(httpAddrEnvVar, defaultHTTPAddr)
+	mux := http.NewServeMux()
+	mux.Handle("/oai", NewOAIPMHServer(harvest, envOrDefault(oaiBaseURLEnvVar, defaultOAIBaseURL)))
+	mux.Handle("GET /feeds/{journal}/{format}", NewFeedServer(feeds))
+	go func() {
+		log.Printf("HTTP server starting on %s", httpAddr)
+		if err := http.ListenAndServe(httpAddr, mux); err != nil {
+			log.Printf("HTTP server stopped: %v", err)
+		}
+	}()
+
+	// Start listening
+	listener, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %s: %w", grpcPort, err)
+	}
+
+	log.Printf("gRPC server starting on port %s", grpcPort)
+	return grpcServer.Serve(listener)
+}
+
+// newDOIService configures DOI minting from the environment. Deposits go to
+// the Crossref deposit API at CROSSREF_URL, or to a stand-in served on a
+// local port when it is unset.
+func newDOIService(service *core.ArticleService) (*core.DOIService, error) {
+	config := core.DOIConfig{
+		Prefix:             envOrDefault(doiPrefixEnvVar, defaultDOIPrefix),
+		SuffixPattern:      envOrDefault(doiSuffixPatternEnvVar, core.DefaultDOISuffixPattern),
+		ResourceURLPattern: envOrDefault(articleURLEnvVar, defaultArticleURL),
+		DepositorName:      "Hexaservice Articles",
+		DepositorEmail:     "doi@articles.example.org",
+		Registrant:         "Hexaservice",
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid DOI configuration: %w", err)
+	}
+
+	baseURL := os.Getenv(crossrefURLEnvVar)
+	username, password := os.Getenv(crossrefUsernameEnvVar), os.Getenv(crossrefPasswordEnvVar)
+	if baseURL == "" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, fmt.Errorf("failed to start Crossref stand-in: %w", err)
+		}
+		username, password = crossrefStandInUser, crossrefStandInUser
+		standIn := adapters.NewCrossrefStandIn(username, password)
+		go func() {
+			if err := http.Serve(listener, standIn); err != nil {
+				log.Printf("Crossref stand-in stopped: %v", err)
+			}
+		}()
+		baseURL = "http://" + listener.Addr().String()
+	}
+
+	registrar := adapters.NewHTTPCrossrefRegistrar(baseURL, username, password, crossrefTimeout)
+	return core.NewDOIService(service, registrar, config), nil
+}
+
+// newHarvestService configures the OAI-PMH data provider from the
+// environment. A zero page size selects the default.
+func newHarvestService(service *core.ArticleService, pageSize int) (*core.HarvestService, error) {
+	config := core.HarvestConfig{
+		RepositoryName:       oaiRepositoryName,
+		RepositoryIdentifier: envOrDefault(oaiRepositoryIDEnvVar, defaultOAIRepositoryID),
+		AdminEmail:           envOrDefault(oaiAdminEmailEnvVar, defaultOAIAdminEmail),
+		ArticleURLPattern:    envOrDefault(articleURLEnvVar, defaultArticleURL),
+		PageSize:             pageSize,
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid OAI-PMH configuration: %w", err)
+	}
+	return core.NewHarvestService(service, config), nil
+}
+
+// newFeedService configures journal feeds from the environment
+func newFeedService(service *core.ArticleService) (*core.FeedService, error) {
+	config := core.FeedConfig{
+		FeedURLPattern:    envOrDefault(feedURLEnvVar, defaultFeedURL),
+		ArticleURLPattern: envOrDefault(articleURLEnvVar, defaultArticleURL),
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid feed configuration: %w", err)
+	}
+	return core.NewFeedService(service, config), nil
+}
+
+// newRecommendationService configures related-article ranking from the
+// environment; weights left out of RECOMMENDATION_WEIGHTS keep their default
+func newRecommendationService(repository core.RecommendationRepository, service *core.ArticleService) (*core.RecommendationService, error) {
+	weights, err := core.ParseRecommendationWeights(os.Getenv(recommendationWeightsEnvVar))
+	if err != nil {
+		return nil, fmt.Errorf("invalid recommendation configuration: %w", err)
+	}
+	config := core.RecommendationConfig{Weights: weights, Size: core.DefaultRecommendationSize}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid recommendation configuration: %w", err)
+	}
+	return core.NewRecommendationService(repository, service, config), nil
+}
+
+// newRecommendationJob recomputes the recommendations at the interval set
+// by RECOMMENDATION_INTERVAL
+func newRecommendationJob(recommendations *core.RecommendationService) (*core.RecommendationJob, error) {
+	interval, err := time.ParseDuration(envOrDefault(recommendationIntervalEnvVar, defaultRecommendationInterval))
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid recommendation configuration: %s must be a positive duration", recommendationIntervalEnvVar)
+	}
+	return core.NewRecommendationJob(recommendations, interval), nil
+}
+
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// runInBackground starts a worker that runs for the lifetime of the process
+func runInBackground(name string, run func(ctx context.Context) error) {
+	go func() {
+		if err := run(context.Background()); err != nil {
+			log.Printf("%s stopped: %v", name, err)
+		}
+	}()
+}
+
+func fetchJournal(journalID string) (*journalproto.Journal, error) {
+	conn, err := grpc.Dial(journalAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Close()
 
-	client := proto.NewJournalServiceClient(conn)
+	client := journalproto.NewJournalServiceClient(conn)
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 
-	res, err := client.GetJournal(ctx, &proto.GetJournalRequest{Id: journalID})
+	res, err := client.GetJournal(ctx, &journalproto.GetJournalRequest{Id: journalID})
 	if err != nil {
 		return nil, err
 	}
@@ -74,11 +435,70 @@ func demonstrateInMemoryRepository() error {
 	fmt.Println("=== Using InMemory Repository ===")
 
 	repo := adapters.NewInMemoryArticleRepository()
-	service := core.NewArticleService(repo)
+	authorRepo := adapters.NewInMemoryAuthorRepository()
+	auditLog := eventadapters.NewInMemoryAuditLog()
+	taxonomyRepo := adapters.NewInMemoryTaxonomyRepository()
+	journals := demoJournalDirectory()
+	service := core.NewArticleService(repo, authorRepo, journals, taxonomyRepo).WithActor("demo-author")
+	duplicates := core.NewDuplicateService(adapters.NewInMemoryDuplicateIndex(), service)
+	service = service.WithDuplicateScreen(duplicates)
+	reviews := core.NewReviewService(adapters.NewInMemoryReviewRepository(repo), service)
+
+	authors := core.NewAuthorService(authorRepo)
+	if err := demonstrateAuthors(authors); err != nil {
+		return err
+	}
 
 	testArticle := createTestArticle(testArticleID)
 
-	return demonstrateArticleOperations(service, testArticle)
+	if err := demonstrateArticleOperations(service, testArticle); err != nil {
+		return err
+	}
+	if err := demonstrateArticleLifecycle(service, reviews, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateCitations(service, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateDOIRegistration(service, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateExport(core.NewExportService(service), testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateImport(core.NewImportService(service, journals).WithActor("demo-admin")); err != nil {
+		return err
+	}
+	if err := demonstrateHarvest(service); err != nil {
+		return err
+	}
+	if err := demonstrateFeeds(service, testArticle.JournalID); err != nil {
+		return err
+	}
+	if err := demonstrateTitleLookup(service, testArticle.ID); err != nil {
+		return err
+	}
+	merges := core.NewDisambiguationService(authorRepo, service).WithActor("demo-admin")
+	if err := demonstrateAuthorMerge(service, authors, merges, testArticle.ID); err != nil {
+		return err
+	}
+	metrics := core.NewBibliometricsService(adapters.NewInMemoryAuthorMetricsRepository(), service)
+	search := core.NewSearchService(adapters.NewInMemorySearchIndex(), service)
+	if err := demonstrateOutboxRelay(repo, auditLog, metrics, search, duplicates); err != nil {
+		return err
+	}
+	if err := demonstrateAuditLog(auditLog, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateDuplicates(service, duplicates, testArticle.ID); err != nil {
+		return err
+	}
+	recommendations := core.NewRecommendationService(adapters.NewInMemoryRecommendationRepository(), service,
+		core.RecommendationConfig{Weights: core.DefaultRecommendationWeights})
+	if err := demonstrateRecommendations(recommendations, testArticle.ID); err != nil {
+		return err
+	}
+	return demonstrateTaxonomy(service, core.NewTaxonomyService(taxonomyRepo, service), testArticle.ID)
 }
 
 func demonstrateMySQLRepository(dsn string) error {
@@ -94,15 +514,198 @@ func demonstrateMySQLRepository(dsn string) error {
 		}
 	}()
 
+	authorRepo := adapters.NewMySQLAuthorRepository(db)
+	if err := authorRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize author schema: %w", err)
+	}
+
 	repo := adapters.NewMySQLArticleRepository(db)
 	if err := repo.InitializeSchema(); err != nil {
 		return fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
-	service := core.NewArticleService(repo)
+	auditLog := eventadapters.NewMySQLAuditLog(db, adapters.TablePrefix)
+	if err := auditLog.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize audit schema: %w", err)
+	}
+
+	reviewRepo := adapters.NewMySQLReviewRepository(db)
+	if err := reviewRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize review schema: %w", err)
+	}
+
+	metricsRepo := adapters.NewMySQLAuthorMetricsRepository(db)
+	if err := metricsRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize author metrics schema: %w", err)
+	}
+
+	recommendationRepo := adapters.NewMySQLRecommendationRepository(db)
+	if err := recommendationRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize recommendation schema: %w", err)
+	}
+
+	taxonomyRepo := adapters.NewMySQLTaxonomyRepository(db)
+	if err := taxonomyRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize taxonomy schema: %w", err)
+	}
+
+	journals := demoJournalDirectory()
+	service := core.NewArticleService(repo, authorRepo, journals, taxonomyRepo).WithActor("demo-author")
+	duplicates := core.NewDuplicateService(adapters.NewInMemoryDuplicateIndex(), service)
+	service = service.WithDuplicateScreen(duplicates)
+	reviews := core.NewReviewService(reviewRepo, service)
+	authors := core.NewAuthorService(authorRepo)
+	if err := demonstrateAuthors(authors); err != nil {
+		return err
+	}
+
 	testArticle := createTestArticle(mysqlArticleID)
 
-	return demonstrateArticleOperations(service, testArticle)
+	if err := demonstrateArticleOperations(service, testArticle); err != nil {
+		return err
+	}
+	if err := demonstrateArticleLifecycle(service, reviews, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateCitations(service, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateDOIRegistration(service, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateExport(core.NewExportService(service), testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateImport(core.NewImportService(service, journals).WithActor("demo-admin")); err != nil {
+		return err
+	}
+	if err := demonstrateHarvest(service); err != nil {
+		return err
+	}
+	if err := demonstrateFeeds(service, testArticle.JournalID); err != nil {
+		return err
+	}
+	if err := demonstrateTitleLookup(service, testArticle.ID); err != nil {
+		return err
+	}
+	merges := core.NewDisambiguationService(authorRepo, service).WithActor("demo-admin")
+	if err := demonstrateAuthorMerge(service, authors, merges, testArticle.ID); err != nil {
+		return err
+	}
+	search := core.NewSearchService(adapters.NewInMemorySearchIndex(), service)
+	if err := demonstrateOutboxRelay(repo, auditLog, core.NewBibliometricsService(metricsRepo, service), search, duplicates); err != nil {
+		return err
+	}
+	if err := demonstrateAuditLog(auditLog, testArticle.ID); err != nil {
+		return err
+	}
+	if err := demonstrateDuplicates(service, duplicates, testArticle.ID); err != nil {
+		return err
+	}
+	recommendations := core.NewRecommendationService(recommendationRepo, service,
+		core.RecommendationConfig{Weights: core.DefaultRecommendationWeights})
+	if err := demonstrateRecommendations(recommendations, testArticle.ID); err != nil {
+		return err
+	}
+	return demonstrateTaxonomy(service, core.NewTaxonomyService(taxonomyRepo, service), testArticle.ID)
+}
+
+// demonstrateRecommendations runs the recommendation job once and shows why
+// each article is related to the test article
+func demonstrateRecommendations(recommendations *core.RecommendationService, articleID string) error {
+	lists, err := recommendations.Recompute()
+	if err != nil {
+		return fmt.Errorf("failed to compute recommendations: %w", err)
+	}
+	fmt.Printf("Computed related articles of %d article(s)\n", lists)
+
+	related, err := recommendations.RelatedArticles(articleID, 3)
+	if err != nil {
+		return fmt.Errorf("failed to get articles related to %s: %w", articleID, err)
+	}
+	fmt.Printf("Articles related to %s:\n", articleID)
+	for _, entry := range related {
+		fmt.Printf("  %.3f %s %q (content %.2f, authors %.2f, co-citation %.2f, journal %.0f)\n",
+			entry.Score, entry.Article.ID, entry.Article.Title, entry.Signals.Content, entry.Signals.Authors,
+			entry.Signals.CoCitation, entry.Signals.Journal)
+	}
+
+	if _, err := recommendations.RelatedArticles(articleID, core.MaxRecommendationSize+1); !errors.Is(err, core.ErrInvalidRecommendationQuery) {
+		return fmt.Errorf("oversized related article limit was not refused: %v", err)
+	}
+	fmt.Println("Oversized related article limit refused")
+	return nil
+}
+
+// demoSubjectTerms is a small subject hierarchy: computer science and
+// mathematics, with machine learning below artificial intelligence
+var demoSubjectTerms = []core.SubjectTerm{
+	{ID: demoSubjectScopeTermID, Label: "Computer science"},
+	{ID: "cs.ai", Label: "Artificial intelligence", ParentID: demoSubjectScopeTermID, Synonyms: []string{"AI"}},
+	{ID: "cs.ml", Label: "Machine learning", ParentID: "cs.ai", Synonyms: []string{"Statistical learning"}},
+	{ID: "math", Label: "Mathematics"},
+	{ID: "math.co", Label: "Combinatorics", ParentID: "math", Synonyms: []string{"Graph theory"}},
+}
+
+// demonstrateTaxonomy builds the subject hierarchy, classifies the test
+// article by term names, and finds it by a broader subject
+func demonstrateTaxonomy(service *core.ArticleService, taxonomy *core.TaxonomyService, articleID string) error {
+	for _, term := range demoSubjectTerms {
+		if _, err := taxonomy.GetTerm(term.ID); !errors.Is(err, core.ErrSubjectTermNotFound) {
+			continue
+		}
+		if _, err := taxonomy.CreateTerm(term); err != nil {
+			return fmt.Errorf("failed to create subject term %s: %w", term.ID, err)
+		}
+	}
+	terms, err := taxonomy.ListTerms()
+	if err != nil {
+		return fmt.Errorf("failed to list subject terms: %w", err)
+	}
+	fmt.Printf("Subject taxonomy has %d term(s)\n", len(terms))
+
+	moved := demoSubjectTerms[0]
+	moved.ParentID = "cs.ml"
+	if _, err := taxonomy.UpdateTerm(moved); !errors.Is(err, core.ErrInvalidSubjectTerm) {
+		return fmt.Errorf("cyclic subject hierarchy was not refused: %v", err)
+	}
+	fmt.Printf("Refused to move %s below its own subterm cs.ml\n", moved.ID)
+
+	article, err := service.GetArticleByID(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve article %s: %w", articleID, err)
+	}
+	article.Keywords = []string{"neural networks", "gradient descent"}
+	article.Subjects = []string{"statistical learning", "Graph Theory"}
+	article, err = service.UpdateArticle(article)
+	if err != nil {
+		return fmt.Errorf("failed to classify article %s: %w", articleID, err)
+	}
+	fmt.Printf("Article %s has keywords %v and subjects %v\n", article.ID, article.Keywords, article.Subjects)
+	warnings, err := service.SubjectScopeWarnings(article)
+	if err != nil {
+		return fmt.Errorf("failed to check the subjects of article %s: %w", articleID, err)
+	}
+	for _, warning := range warnings {
+		fmt.Printf("Scope warning: %s\n", warning)
+	}
+
+	for _, query := range []core.SubjectQuery{
+		{TermID: "cs.ai"},
+		{TermID: "cs.ai", IncludeDescendants: true},
+	} {
+		page, err := taxonomy.ListArticlesBySubject(query)
+		if err != nil {
+			return fmt.Errorf("failed to list articles of subject %s: %w", query.TermID, err)
+		}
+		fmt.Printf("Subject %s (descendants %t): %d article(s)\n", query.TermID, query.IncludeDescendants, page.Total)
+	}
+
+	if err := taxonomy.DeleteTerm("cs.ml"); !errors.Is(err, core.ErrSubjectTermInUse) {
+		return fmt.Errorf("deleting a subject in use was not refused: %v", err)
+	}
+	fmt.Println("Refused to delete subject cs.ml while articles have it")
+	return nil
 }
 
 func createTestArticle(id string) core.Article {
@@ -110,11 +713,35 @@ func createTestArticle(id string) core.Article {
 		ID:        id,
 		Title:     "Advanced Machine Learning Techniques",
 		Abstract:  "This paper explores cutting-edge machine learning algorithms and their applications in modern data science.",
-		AuthorID:  "author_1",
+		Authors:   []core.ArticleAuthor{{AuthorID: "author_1", Corresponding: true}},
 		JournalID: "journal_1",
 	}
 }
 
+// demonstrateAuthors registers the author of the test article. The author is
+// kept between MySQL runs, so an existing record is reused.
+func demonstrateAuthors(authors *core.AuthorService) error {
+	if err := core.ValidateORCID("0000-0002-1825-0098"); err != nil {
+		fmt.Printf("Rejected ORCID: %v\n", err)
+	}
+
+	author, err := authors.GetAuthor("author_1")
+	if errors.Is(err, core.ErrAuthorNotFound) {
+		author, err = authors.CreateAuthor(core.Author{
+			ID:          "author_1",
+			Name:        "Josiah Carberry",
+			ORCID:       "0000-0002-1825-0097",
+			Affiliation: "Brown University",
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to register author: %w", err)
+	}
+	fmt.Printf("Author: %s (%s, ORCID %s)\n", author.Name, author.Affiliation, author.ORCID)
+
+	return nil
+}
+
 func demonstrateArticleOperations(service *core.ArticleService, article core.Article) error {
 	// Create article
 	createdArticle, err := service.CreateArticle(article)
@@ -130,12 +757,683 @@ func demonstrateArticleOperations(service *core.ArticleService, article core.Art
 	}
 	fmt.Printf("Retrieved article by ID: %+v\n", retrievedArticle)
 
-	// Retrieve article by title
-	retrievedByTitle, err := service.GetArticleByTitle(article.Title)
+	// Retrieve articles by title; titles need not be unique
+	byTitle, err := service.GetArticlesByTitle(article.Title, 0, 0)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve article by title: %w", err)
+		return fmt.Errorf("failed to retrieve articles by title: %w", err)
+	}
+	var retrievedByTitle core.Article
+	for _, match := range byTitle.Articles {
+		if match.ID == article.ID {
+			retrievedByTitle = match
+		}
+	}
+	if retrievedByTitle.ID == "" {
+		return fmt.Errorf("article %s not found by its title", article.ID)
 	}
 	fmt.Printf("Retrieved article by title: %+v\n", retrievedByTitle)
 
+	// Update article
+	retrievedByTitle.Abstract = "An updated survey of machine learning algorithms and their applications."
+	updatedArticle, err := service.UpdateArticle(retrievedByTitle)
+	if err != nil {
+		return fmt.Errorf("failed to update article: %w", err)
+	}
+	fmt.Printf("Updated article: %+v\n", updatedArticle)
+
+	return nil
+}
+
+func demonstrateArticleLifecycle(service *core.ArticleService, reviews *core.ReviewService, articleID string) error {
+	// Publishing a draft is rejected by the workflow
+	if _, err := service.PublishArticle(articleID); errors.Is(err, core.ErrInvalidTransition) {
+		fmt.Printf("Rejected transition: %v\n", err)
+	}
+
+	if _, err := service.SubmitArticle(articleID); err != nil {
+		return fmt.Errorf("failed to submit article: %w", err)
+	}
+	if err := demonstratePeerReview(reviews.WithActor("demo-editor"), articleID); err != nil {
+		return err
+	}
+	if err := demonstrateIssuePlacement(service.WithActor("demo-editor"), articleID); err != nil {
+		return err
+	}
+
+	article, err := service.PublishArticle(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to publish article: %w", err)
+	}
+	fmt.Printf("Article %s is now %s\n", article.ID, article.Status)
+
+	history, err := service.GetStatusHistory(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to get status history: %w", err)
+	}
+	for _, transition := range history {
+		fmt.Printf("Status history: %s -> %s by %s\n", transition.From, transition.To, transition.Actor)
+	}
+
+	return nil
+}
+
+// demonstrateIssuePlacement puts the accepted article first in the demo issue
+func demonstrateIssuePlacement(service *core.ArticleService, articleID string) error {
+	placement, err := service.PlaceArticle(core.ArticlePlacement{
+		ArticleID: articleID,
+		IssueID:   demoIssueID,
+		Sequence:  1,
+		FirstPage: 1,
+		LastPage:  12,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to place article: %w", err)
+	}
+	fmt.Printf("Placed article %s in issue %s at position %d, pages %d-%d\n",
+		placement.ArticleID, placement.IssueID, placement.Sequence, placement.FirstPage, placement.LastPage)
+
+	placements, err := service.ListIssueArticles(demoIssueID)
+	if err != nil {
+		return fmt.Errorf("failed to list issue articles: %w", err)
+	}
+	fmt.Printf("Issue %s has %d article(s)\n", demoIssueID, len(placements))
+
+	return nil
+}
+
+// demonstrateCitations adds a follow-up article that cites the published
+// article and an external work, then walks the citation graph both ways
+func demonstrateCitations(service *core.ArticleService, articleID string) error {
+	followUp := createTestArticle(articleID + "_followup")
+	followUp.Title = "Machine Learning Techniques Revisited"
+	if _, err := service.CreateArticle(followUp); err != nil {
+		return fmt.Errorf("failed to create follow-up article: %w", err)
+	}
+
+	// An article cannot cite itself
+	_, err := service.SetReferences(followUp.ID, []core.Reference{{TargetArticleID: followUp.ID}})
+	if errors.Is(err, core.ErrInvalidReference) {
+		fmt.Printf("Rejected references: %v\n", err)
+	}
+
+	references, err := service.SetReferences(followUp.ID, []core.Reference{
+		{TargetArticleID: articleID, Text: "Carberry J. Advanced Machine Learning Techniques"},
+		{DOI: "https://doi.org/10.1038/nature14539", Text: "LeCun Y, Bengio Y, Hinton G. (2015) Deep learning. Nature 521"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set references: %w", err)
+	}
+	for _, reference := range references {
+		fmt.Printf("Reference %d of %s: article=%q doi=%q\n",
+			reference.Position, reference.CitingArticleID, reference.TargetArticleID, reference.DOI)
+	}
+
+	cited, err := service.GetArticleByID(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to get cited article: %w", err)
+	}
+	citedBy, err := service.ListCitedBy(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to list citing references: %w", err)
+	}
+	fmt.Printf("Article %s has %d citation(s), cited by %d reference(s)\n", cited.ID, cited.CitationCount, len(citedBy))
+
+	for _, direction := range []core.CitationDirection{core.CitationReferences, core.CitationCitedBy} {
+		root := followUp.ID
+		if direction == core.CitationCitedBy {
+			root = articleID
+		}
+		graph, err := service.CitationGraph(root, direction, 2)
+		if err != nil {
+			return fmt.Errorf("failed to walk citation graph: %w", err)
+		}
+		fmt.Printf("Citation graph of %s (%s, depth %d): %d node(s), %d edge(s)\n",
+			root, direction, graph.MaxDepth, len(graph.Nodes), len(graph.Edges))
+	}
+
+	if _, err := service.CitationGraph(articleID, core.CitationReferences, core.MaxCitationDepth+1); errors.Is(err, core.ErrInvalidCitationQuery) {
+		fmt.Printf("Rejected graph query: %v\n", err)
+	}
+
+	return nil
+}
+
+// demonstrateDOIRegistration previews the Crossref deposit of the published
+// article, registers its DOI and collects the outcome of the deposit
+func demonstrateDOIRegistration(service *core.ArticleService, articleID string) error {
+	dois, err := newDOIService(service.WithActor("demo-editor"))
+	if err != nil {
+		return err
+	}
+
+	// Only published articles get DOIs
+	if _, err := dois.RegisterDOI(articleID + "_followup"); errors.Is(err, core.ErrDOINotAllowed) {
+		fmt.Printf("Rejected DOI registration: %v\n", err)
+	}
+
+	data, err := dois.DepositXML(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to build deposit XML: %w", err)
+	}
+	fmt.Printf("Crossref deposit preview for %s: %d bytes\n", articleID, len(data))
+
+	article, err := dois.RegisterDOI(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to register DOI: %w", err)
+	}
+	fmt.Printf("Deposited DOI %s (batch %s): %s\n", article.DOI, article.Deposit.BatchID, article.Deposit.Status)
+
+	article, err = dois.RefreshDeposit(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to refresh DOI deposit: %w", err)
+	}
+	fmt.Printf("DOI %s is %s: %s\n", article.DOI, article.Deposit.Status, article.Deposit.Message)
+
+	resolved, err := dois.GetArticleByDOI("https://doi.org/" + article.DOI)
+	if err != nil {
+		return fmt.Errorf("failed to resolve DOI: %w", err)
+	}
+	fmt.Printf("DOI %s resolves to article %s\n", article.DOI, resolved.ID)
+	return nil
+}
+
+// demonstrateExport prints the published article's BibTeX entry and exports
+// every article of its journal in the other formats
+func demonstrateExport(exports *core.ExportService, articleID string) error {
+	entry, err := exports.ExportArticle(articleID, core.ExportBibTeX)
+	if err != nil {
+		return fmt.Errorf("failed to export article: %w", err)
+	}
+	fmt.Printf("BibTeX export of %s:\n%s", articleID, entry.Content)
+
+	for _, format := range []core.ExportFormat{core.ExportRIS, core.ExportCSLJSON} {
+		var keys []string
+		err := exports.Export(core.ExportQuery{JournalID: "journal_1"}, format, func(entry core.ExportedArticle) error {
+			keys = append(keys, entry.Key)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export journal articles: %w", err)
+		}
+		fmt.Printf("Exported %d article(s) of journal_1 as %s: %v\n", len(keys), format, keys)
+	}
+
+	if _, err := exports.ExportArticle(articleID, "endnote"); errors.Is(err, core.ErrInvalidExportQuery) {
+		fmt.Printf("Rejected export: %v\n", err)
+	}
+	return nil
+}
+
+// demoImportFile is a small back catalogue: one article of a known journal,
+// one of a journal the directory does not know yet and one without authors
+const demoImportFile = `
+@article{hinton2006fast,
+  author  = {Hinton, Geoffrey E. and Osindero, Simon},
+  title   = {A Fast Learning Algorithm for Deep Belief Nets},
+  journal = {Nature},
+  year    = 2006,
+  month   = jul,
+  doi     = {10.5555/demo.2006.1}
+}
+
+@article{erdos1959random,
+  author  = {Erd{\H o}s, Paul and R{\'e}nyi, Alfr{\'e}d},
+  title   = {On Random Graphs {I} \& {II}},
+  journal = {Publicationes Mathematicae},
+  issn    = {0033-3883},
+  year    = {1959}
+}
+
+@article{anonymous,
+  title = {An Article Without Authors}
+}
+`
+
+// demonstrateImport validates the demo file in a dry run, then imports it
+func demonstrateImport(imports *core.ImportService) error {
+	for _, dryRun := range []bool{true, false} {
+		report, err := imports.Import([]byte(demoImportFile), core.ImportOptions{Format: core.ImportBibTeX, DryRun: dryRun})
+		if err != nil {
+			return fmt.Errorf("failed to import articles: %w", err)
+		}
+		fmt.Printf("Import (dry run %t): %d of %d succeeded, %d journal(s) and %d author(s) created\n",
+			dryRun, report.Succeeded, report.Total, report.JournalsCreated, report.AuthorsCreated)
+		for _, result := range report.Results {
+			fmt.Printf("  record %d (line %d) %s: %s %s\n", result.Index, result.Line, result.Key, result.Outcome, result.Error)
+		}
+	}
+	return nil
+}
+
+// demonstrateHarvest harvests the published articles through the OAI-PMH
+// provider, following resumption tokens through pages of two records
+func demonstrateHarvest(service *core.ArticleService) error {
+	harvest, err := newHarvestService(service, demoHarvestPageSize)
+	if err != nil {
+		return err
+	}
+	provider := NewOAIPMHServer(harvest, defaultOAIBaseURL)
+	request := func(query string) (oaiResponse, []byte) {
+		recorder := httptest.NewRecorder()
+		provider.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/oai?"+query, nil))
+		var response oaiResponse
+		if err := xml.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			log.Printf("OAI-PMH demo: failed to read response to %s: %v", query, err)
+		}
+		return response, recorder.Body.Bytes()
+	}
+
+	identify, _ := request("verb=Identify")
+	if identify.Identify == nil {
+		return fmt.Errorf("OAI-PMH Identify failed: %+v", identify.Errors)
+	}
+	fmt.Printf("OAI-PMH repository %q, earliest datestamp %s\n", identify.Identify.RepositoryName, identify.Identify.EarliestDatestamp)
+
+	sets, _ := request("verb=ListSets")
+	if sets.ListSets == nil {
+		return fmt.Errorf("OAI-PMH ListSets failed: %+v", sets.Errors)
+	}
+	for _, set := range sets.ListSets.Sets {
+		fmt.Printf("OAI-PMH set %s: %s\n", set.Spec, set.Name)
+	}
+
+	var identifiers []string
+	query := "verb=ListRecords&metadataPrefix=oai_dc"
+	for page := 1; ; page++ {
+		list, _ := request(query)
+		if list.ListRecords == nil {
+			return fmt.Errorf("OAI-PMH ListRecords failed: %+v", list.Errors)
+		}
+		for _, record := range list.ListRecords.Records {
+			identifiers = append(identifiers, record.Header.Identifier)
+		}
+		fmt.Printf("OAI-PMH page %d: %d record(s)\n", page, len(list.ListRecords.Records))
+		token := list.ListRecords.ResumptionToken
+		if token == nil || token.Token == "" {
+			break
+		}
+		query = "verb=ListRecords&resumptionToken=" + token.Token
+	}
+	fmt.Printf("OAI-PMH harvested %s\n", strings.Join(identifiers, ", "))
+
+	_, record := request("verb=GetRecord&metadataPrefix=oai_dc&identifier=" + harvest.Identifier(testArticleID))
+	fmt.Printf("OAI-PMH record of %s:\n%s\n", testArticleID, record)
+
+	bad, _ := request("verb=ListAll")
+	if len(bad.Errors) == 0 {
+		return fmt.Errorf("OAI-PMH accepted an unknown verb")
+	}
+	fmt.Printf("OAI-PMH unknown verb: %s\n", bad.Errors[0].Code)
+	return nil
+}
+
+// demonstrateFeeds fetches the Atom feed of the test article's journal and
+// fetches it again with each validator, which the server answers with 304
+// Not Modified, and then fetches the RSS feed
+func demonstrateFeeds(service *core.ArticleService, journalID string) error {
+	feeds, err := newFeedService(service)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /feeds/{journal}/{format}", NewFeedServer(feeds))
+	fetch := func(format string, header http.Header) *http.Response {
+		request := httptest.NewRequest(http.MethodGet, "/feeds/"+journalID+"/"+format, nil)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		return recorder.Result()
+	}
+
+	atom := fetch("atom", nil)
+	if atom.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch the Atom feed of journal %s: %s", journalID, atom.Status)
+	}
+	body, _ := io.ReadAll(atom.Body)
+	fmt.Printf("Atom feed of journal %s (ETag %s, Last-Modified %s):\n%s",
+		journalID, atom.Header.Get("ETag"), atom.Header.Get("Last-Modified"), body)
+
+	for name, value := range map[string]string{"If-None-Match": atom.Header.Get("ETag"), "If-Modified-Since": atom.Header.Get("Last-Modified")} {
+		again := fetch("atom", http.Header{name: {value}})
+		if again.StatusCode != http.StatusNotModified {
+			return fmt.Errorf("conditional feed request with %s returned %s", name, again.Status)
+		}
+		fmt.Printf("Atom feed with %s: %s\n", name, again.Status)
+	}
+
+	rss := fetch("rss", nil)
+	if rss.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch the RSS feed of journal %s: %s", journalID, rss.Status)
+	}
+	body, _ = io.ReadAll(rss.Body)
+	fmt.Printf("RSS feed of journal %s:\n%s", journalID, body)
+	return nil
+}
+
+// demonstrateTitleLookup finds the test article by titles that differ in
+// case, punctuation, whitespace and accents, and by a misspelled title. It
+// then pages through two articles sharing a title and shows that a journal
+// requiring unique titles refuses a second one.
+func demonstrateTitleLookup(service *core.ArticleService, articleID string) error {
+	for _, title := range []string{
+		"advanced machine-learning techniques!",
+		"  Advanced   Machine Learning: Techniques ",
+		"Advanced Machine Learning Te\u0301chniques",
+	} {
+		matches, err := service.FindArticlesByTitle(core.TitleQuery{Title: title})
+		if err != nil {
+			return fmt.Errorf("title lookup for %q failed: %w", title, err)
+		}
+		if matches.Total == 0 {
+			return fmt.Errorf("title lookup for %q found no article", title)
+		}
+		fmt.Printf("Title %q found %s\n", title, matches.Matches[0].Article.ID)
+	}
+
+	title := "Advancd Machine Lerning Technique"
+	matches, err := service.FindArticlesByTitle(core.TitleQuery{Title: title, Fuzzy: true, Limit: 3})
+	if err != nil {
+		return fmt.Errorf("fuzzy title lookup for %q failed: %w", title, err)
+	}
+	fmt.Printf("Fuzzy title %q: %d candidate(s)\n", title, matches.Total)
+	for _, match := range matches.Matches {
+		fmt.Printf("  %.2f (distance %d) %s %q\n", match.Similarity, match.Distance, match.Article.ID, match.Article.Title)
+	}
+
+	for i, journalID := range []string{"journal_1", "journal_1", demoUniqueTitleJournalID} {
+		introduction := createTestArticle(fmt.Sprintf("%s_introduction_%d", articleID, i+1))
+		introduction.Title = "Introduction"
+		introduction.JournalID = journalID
+		if _, err := service.CreateArticle(introduction); err != nil {
+			return fmt.Errorf("failed to create article %s: %w", introduction.ID, err)
+		}
+	}
+	for offset := 0; ; offset++ {
+		page, err := service.GetArticlesByTitle("introduction", offset, 1)
+		if err != nil {
+			return fmt.Errorf("failed to list articles titled Introduction: %w", err)
+		}
+		if len(page.Articles) == 0 {
+			break
+		}
+		fmt.Printf("Introduction %d of %d: %s in %s\n", offset+1, page.Total, page.Articles[0].ID, page.Articles[0].JournalID)
+	}
+
+	duplicate := createTestArticle(articleID + "_introduction_duplicate")
+	duplicate.Title = "INTRODUCTION."
+	duplicate.JournalID = demoUniqueTitleJournalID
+	if _, err = service.CreateArticle(duplicate); !errors.Is(err, core.ErrDuplicateTitle) {
+		return fmt.Errorf("duplicate title in journal %s was not refused: %v", demoUniqueTitleJournalID, err)
+	}
+	fmt.Printf("Duplicate title refused: %v\n", err)
+	return nil
+}
+
+func demonstratePeerReview(reviews *core.ReviewService, articleID string) error {
+	reviewer, err := reviews.RegisterReviewer(core.Reviewer{
+		ID:          "reviewer_" + articleID,
+		Name:        "Ada Lovelace",
+		Email:       "ada@example.org",
+		Affiliation: "Analytical Engine Society",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register reviewer: %w", err)
+	}
+
+	// The submitting author is also a registered reviewer and must not be
+	// suggested for their own article
+	if _, err := reviews.RegisterReviewer(core.Reviewer{
+		ID:       "reviewer_author_" + articleID,
+		Name:     "Article Author",
+		Email:    "author@example.org",
+		AuthorID: "author_1",
+	}); err != nil {
+		return fmt.Errorf("failed to register reviewer: %w", err)
+	}
+
+	suggestions, err := reviews.SuggestReviewers(articleID, 5)
+	if err != nil {
+		return fmt.Errorf("failed to suggest reviewers: %w", err)
+	}
+	for _, match := range suggestions.Matches {
+		fmt.Printf("Suggested reviewer %s (score %.3f)\n", match.Reviewer.ID, match.Score)
+	}
+	for _, conflict := range suggestions.Conflicts {
+		fmt.Printf("Excluded reviewer %s: %v\n", conflict.Reviewer.ID, conflict.Reasons)
+	}
+
+	assignment, err := reviews.AssignReviewer(articleID, reviewer.ID, time.Now().Add(14*24*time.Hour))
+	if err != nil {
+		return fmt.Errorf("failed to assign reviewer: %w", err)
+	}
+	fmt.Printf("Assigned reviewer %s, due %s\n", assignment.ReviewerID, assignment.DueDate.Format(time.DateOnly))
+
+	report, err := reviews.SubmitReport(assignment.ID, core.RecommendMinorRevision, "Clarify the evaluation setup.")
+	if err != nil {
+		return fmt.Errorf("failed to submit review report: %w", err)
+	}
+	fmt.Printf("Review report from %s: %s\n", report.ReviewerID, report.Recommendation)
+
+	decision, err := reviews.RecordDecision(articleID, core.DecisionAccept, "Accepted after review.")
+	if err != nil {
+		return fmt.Errorf("failed to record editor decision: %w", err)
+	}
+	fmt.Printf("Editor %s decided: %s\n", decision.EditorID, decision.Outcome)
+
+	return nil
+}
+
+// demonstrateAuthorMerge registers a duplicate of the test article's author,
+// merges it away and undoes the merge
+func demonstrateAuthorMerge(service *core.ArticleService, authors *core.AuthorService, merges *core.DisambiguationService, articleID string) error {
+	duplicate, err := authors.CreateAuthor(core.Author{
+		ID:          "author_dup_" + articleID,
+		Name:        "Carberry, J.",
+		Affiliation: "Brown University",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register duplicate author: %w", err)
+	}
+
+	followUp := createTestArticle(articleID + "_follow_up")
+	followUp.Title = "Machine Learning Techniques Revisited"
+	followUp.Authors = []core.ArticleAuthor{{AuthorID: duplicate.ID, Corresponding: true}}
+	if _, err := service.CreateArticle(followUp); err != nil {
+		return fmt.Errorf("failed to create article: %w", err)
+	}
+
+	clusters, err := merges.FindDuplicates(0)
+	if err != nil {
+		return fmt.Errorf("failed to find duplicate authors: %w", err)
+	}
+	for _, cluster := range clusters {
+		fmt.Printf("Possible duplicates (score %.2f, %v):", cluster.Score, cluster.Evidence)
+		for _, author := range cluster.Authors {
+			fmt.Printf(" %s %q", author.ID, author.Name)
+		}
+		fmt.Println()
+	}
+
+	merge, err := merges.MergeAuthors(duplicate.ID, "author_1")
+	if err != nil {
+		return fmt.Errorf("failed to merge authors: %w", err)
+	}
+	merged, err := service.GetArticleByID(followUp.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve article by ID: %w", err)
+	}
+	fmt.Printf("Merged %s into %s; %s is now by %v\n", merge.Source.ID, merge.Target.ID, merged.ID, merged.AuthorIDs())
+
+	if _, err := merges.UndoMerge(merge.ID); err != nil {
+		return fmt.Errorf("failed to undo author merge: %w", err)
+	}
+	restored, err := service.GetArticleByID(followUp.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve article by ID: %w", err)
+	}
+	fmt.Printf("Undid merge %s; %s is by %v again\n", merge.ID, restored.ID, restored.AuthorIDs())
+
+	return nil
+}
+
+// demoJournalDirectory stands in for the journal service during the demo
+func demoJournalDirectory() *adapters.InMemoryJournalDirectory {
+	return adapters.NewInMemoryJournalDirectory(core.JournalInfo{
+		ID:             "journal_1",
+		Name:           "Nature",
+		PrintISSN:      "0028-0836",
+		ElectronicISSN: "1476-4687",
+		ISSNL:          "0028-0836",
+		SubjectScope:   []string{demoSubjectScopeTermID},
+	}, core.JournalInfo{
+		ID:                  demoUniqueTitleJournalID,
+		Name:                "Journal of Unique Titles",
+		UniqueArticleTitles: true,
+	}).
+		WithIssues(core.IssueInfo{ID: demoIssueID, JournalID: "journal_1", Volume: 1, Number: 1})
+}
+
+func demonstrateAuditLog(auditLog eventing.AuditLog, articleID string) error {
+	audit := eventing.NewAuditService(auditLog)
+
+	entries, err := audit.ListEntries(eventing.AuditQuery{EntityID: articleID})
+	if err != nil {
+		return fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	for _, entry := range entries {
+		fmt.Printf("Audit #%d: %s by %s (event %s) before=%s after=%s\n",
+			entry.Sequence, entry.Operation, entry.Actor, entry.EventID, string(entry.Before), string(entry.After))
+	}
+
+	verified, err := audit.VerifyChain()
+	if err != nil {
+		return fmt.Errorf("audit chain verification failed: %w", err)
+	}
+	fmt.Printf("Verified %d audit entries\n", verified)
+
+	return nil
+}
+
+// demonstrateOutboxRelay publishes the stored events, which also appends
+// the audit entries stored with each change, brings the author metrics up to
+// date and fills the search and duplicate indexes, and shows the resulting
+// journal leaderboard and some searches
+func demonstrateOutboxRelay(outbox eventing.OutboxRepository, auditLog eventing.AuditLog, metrics *core.BibliometricsService,
+	search *core.SearchService, duplicates *core.DuplicateService) error {
+	publisher := eventadapters.NewInMemoryEventPublisher(eventadapters.DefaultHandlerRetryPolicy)
+	publisher.Subscribe("log", func(event core.Event) error {
+		if event.Type != eventing.EventAuditRecorded {
+			fmt.Printf("Published event: %s %s\n", event.Type, event.AggregateID)
+		}
+		return nil
+	})
+	publisher.Subscribe("audit", eventing.NewAuditService(auditLog).HandleEvent)
+	publisher.Subscribe("metrics", metrics.HandleEvent)
+	publisher.Subscribe("search", search.HandleEvent)
+	publisher.Subscribe("duplicates", duplicates.HandleEvent)
+
+	relay := eventing.NewOutboxRelay(outbox, publisher, relayInterval)
+	for {
+		relayed, err := relay.RelayPending()
+		if err != nil {
+			return fmt.Errorf("failed to relay outbox events: %w", err)
+		}
+		if relayed == 0 {
+			break
+		}
+	}
+	if waiting := publisher.RetryDue(); waiting > 0 {
+		return fmt.Errorf("event handlers failed on %d event(s)", waiting)
+	}
+
+	ranked, err := metrics.Leaderboard("journal_1", core.LeaderboardByHIndex, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	for i, author := range ranked {
+		fmt.Printf("Leaderboard #%d: %s h-index %d, i10 %d, %d citation(s) in %d publication(s) %v\n",
+			i+1, author.AuthorID, author.HIndex, author.I10Index, author.TotalCitations, author.Publications, author.PublicationsByYear)
+	}
+
+	return demonstrateSearch(search)
+}
+
+// demonstrateSearch runs word, phrase and filtered queries against the index
+// the relayed events filled, and shows that a query of stop words is refused
+func demonstrateSearch(search *core.SearchService) error {
+	for _, query := range []core.SearchQuery{
+		{Text: "learning algorithms"},
+		{Text: `"deep belief nets"`},
+		{Text: "training", AuthorID: "author_1", Status: core.StatusPublished},
+	} {
+		results, err := search.Search(query)
+		if err != nil {
+			return fmt.Errorf("search for %q failed: %w", query.Text, err)
+		}
+		fmt.Printf("Search %q: %d match(es)\n", query.Text, results.Total)
+		for _, result := range results.Results {
+			fmt.Printf("  %.3f %s %q\n", result.Score, result.Article.ID, result.Article.Title)
+			for _, snippet := range result.Snippets {
+				fmt.Printf("    %s: %s\n", snippet.Field, markHighlights(snippet))
+			}
+		}
+	}
+
+	if _, err := search.Search(core.SearchQuery{Text: "of the"}); !errors.Is(err, core.ErrInvalidSearchQuery) {
+		return fmt.Errorf("search for stop words was not refused: %v", err)
+	}
+	fmt.Println("Search for stop words refused")
+	return nil
+}
+
+// demonstrateDuplicates screens a resubmission of the test article with an
+// extended abstract, then checks the text of a submission that has not been
+// created yet
+func demonstrateDuplicates(service *core.ArticleService, duplicates *core.DuplicateService, articleID string) error {
+	original, err := service.GetArticleByID(articleID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve article %s: %w", articleID, err)
+	}
+	resubmission := createTestArticle(articleID + "_resubmission")
+	resubmission.Title = strings.ToUpper(original.Title)
+	resubmission.Abstract = original.Abstract + " We close with open problems."
+	created, candidates, err := service.CreateArticleScreened(resubmission)
+	if err != nil {
+		return fmt.Errorf("failed to create article %s: %w", resubmission.ID, err)
+	}
+	fmt.Printf("Article %s may duplicate %d article(s)\n", created.ID, len(candidates))
+	for _, candidate := range candidates {
+		fmt.Printf("  %.2f %s %q\n", candidate.Similarity, candidate.Article.ID, candidate.Article.Title)
+	}
+
+	title := "A fast learning algorithm for deep belief nets."
+	candidates, err = duplicates.FindDuplicates(core.DuplicateQuery{Title: title})
+	if err != nil {
+		return fmt.Errorf("failed to check %q for duplicates: %w", title, err)
+	}
+	fmt.Printf("Submission %q matches %d article(s)\n", title, len(candidates))
+	for _, candidate := range candidates {
+		fmt.Printf("  %.2f %s %q\n", candidate.Similarity, candidate.Article.ID, candidate.Article.Title)
+	}
+	return nil
+}
+
+// markHighlights brackets the highlighted words of a snippet
+func markHighlights(snippet core.SearchSnippet) string {
+	var b strings.Builder
+	last := 0
+	for _, highlight := range snippet.Highlights {
+		b.WriteString(snippet.Text[last:highlight.Start])
+		b.WriteString("[" + snippet.Text[highlight.Start:highlight.End] + "]")
+		last = highlight.End
+	}
+	b.WriteString(snippet.Text[last:])
+	return b.String()
+}
+
+func logEvent(event core.Event) error {
+	log.Printf("Event %s: %s %s", event.ID, event.Type, event.AggregateID)
 	return nil
 }
@@ -0,0 +1,513 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/article/core"
+)
+
+const (
+	oaiNamespace      = "http://www.openarchives.org/OAI/2.0/"
+	oaiSchemaLocation = "http://www.openarchives.org/OAI/2.0/ http://www.openarchives.org/OAI/2.0/OAI-PMH.xsd"
+	oaiDCPrefix       = "oai_dc"
+	// oaiGranularity is the finest datestamp granularity the repository
+	// supports; day granularity is accepted as well
+	oaiGranularity  = "YYYY-MM-DDThh:mm:ssZ"
+	oaiSecondLayout = "2006-01-02T15:04:05Z"
+	oaiDayLayout    = "2006-01-02"
+)
+
+// OAI-PMH error codes
+const (
+	oaiBadArgument             = "badArgument"
+	oaiBadResumptionToken      = "badResumptionToken"
+	oaiBadVerb                 = "badVerb"
+	oaiCannotDisseminateFormat = "cannotDisseminateFormat"
+	oaiIDDoesNotExist          = "idDoesNotExist"
+	oaiNoRecordsMatch          = "noRecordsMatch"
+	oaiNoSetHierarchy          = "noSetHierarchy"
+)
+
+// oaiVerbArguments lists the arguments each verb accepts besides the verb.
+// Required arguments are true. resumptionToken is exclusive: a request
+// that has one may have no other argument.
+var oaiVerbArguments = map[string]map[string]bool{
+	"Identify":            {},
+	"ListMetadataFormats": {"identifier": false},
+	"ListSets":            {"resumptionToken": false},
+	"GetRecord":           {"identifier": true, "metadataPrefix": true},
+	"ListIdentifiers":     {"metadataPrefix": true, "from": false, "until": false, "set": false, "resumptionToken": false},
+	"ListRecords":         {"metadataPrefix": true, "from": false, "until": false, "set": false, "resumptionToken": false},
+}
+
+type oaiResponse struct {
+	XMLName             xml.Name                `xml:"http://www.openarchives.org/OAI/2.0/ OAI-PMH"`
+	XSINamespace        string                  `xml:"xmlns:xsi,attr"`
+	SchemaLocation      string                  `xml:"xsi:schemaLocation,attr"`
+	ResponseDate        string                  `xml:"responseDate"`
+	Request             oaiRequest              `xml:"request"`
+	Errors              []oaiError              `xml:"error"`
+	Identify            *oaiIdentify            `xml:"Identify"`
+	ListMetadataFormats *oaiListMetadataFormats `xml:"ListMetadataFormats"`
+	ListSets            *oaiListSets            `xml:"ListSets"`
+	GetRecord           *oaiGetRecord           `xml:"GetRecord"`
+	ListIdentifiers     *oaiListIdentifiers     `xml:"ListIdentifiers"`
+	ListRecords         *oaiListRecords         `xml:"ListRecords"`
+}
+
+// oaiRequest echoes the request. Its attributes are left out when the
+// verb or arguments are bad.
+type oaiRequest struct {
+	Verb            string `xml:"verb,attr,omitempty"`
+	Identifier      string `xml:"identifier,attr,omitempty"`
+	MetadataPrefix  string `xml:"metadataPrefix,attr,omitempty"`
+	From            string `xml:"from,attr,omitempty"`
+	Until           string `xml:"until,attr,omitempty"`
+	Set             string `xml:"set,attr,omitempty"`
+	ResumptionToken string `xml:"resumptionToken,attr,omitempty"`
+	BaseURL         string `xml:",chardata"`
+}
+
+type oaiError struct {
+	Code    string `xml:"code,attr"`
+	Message string `xml:",chardata"`
+}
+
+type oaiIdentify struct {
+	RepositoryName    string                   `xml:"repositoryName"`
+	BaseURL           string                   `xml:"baseURL"`
+	ProtocolVersion   string                   `xml:"protocolVersion"`
+	AdminEmail        string                   `xml:"adminEmail"`
+	EarliestDatestamp string                   `xml:"earliestDatestamp"`
+	DeletedRecord     string                   `xml:"deletedRecord"`
+	Granularity       string                   `xml:"granularity"`
+	Description       oaiIdentifierDescription `xml:"description>oai-identifier"`
+}
+
+// oaiIdentifierDescription describes the oai identifier scheme the
+// repository's record identifiers follow
+type oaiIdentifierDescription struct {
+	Namespace            string `xml:"xmlns,attr"`
+	SchemaLocation       string `xml:"xsi:schemaLocation,attr"`
+	Scheme               string `xml:"scheme"`
+	RepositoryIdentifier string `xml:"repositoryIdentifier"`
+	Delimiter            string `xml:"delimiter"`
+	SampleIdentifier     string `xml:"sampleIdentifier"`
+}
+
+type oaiMetadataFormat struct {
+	Prefix    string `xml:"metadataPrefix"`
+	Schema    string `xml:"schema"`
+	Namespace string `xml:"metadataNamespace"`
+}
+
+type oaiListMetadataFormats struct {
+	Formats []oaiMetadataFormat `xml:"metadataFormat"`
+}
+
+type oaiSet struct {
+	Spec string `xml:"setSpec"`
+	Name string `xml:"setName"`
+}
+
+type oaiListSets struct {
+	Sets []oaiSet `xml:"set"`
+}
+
+type oaiHeader struct {
+	Identifier string   `xml:"identifier"`
+	Datestamp  string   `xml:"datestamp"`
+	SetSpecs   []string `xml:"setSpec"`
+}
+
+type oaiRecord struct {
+	Header   oaiHeader        `xml:"header"`
+	Metadata *core.DublinCore `xml:"metadata>oai_dc:dc"`
+}
+
+type oaiGetRecord struct {
+	Record oaiRecord `xml:"record"`
+}
+
+type oaiResumptionToken struct {
+	// Cursor is the number of records returned before this response
+	Cursor int    `xml:"cursor,attr"`
+	Token  string `xml:",chardata"`
+}
+
+type oaiListIdentifiers struct {
+	Headers         []oaiHeader         `xml:"header"`
+	ResumptionToken *oaiResumptionToken `xml:"resumptionToken"`
+}
+
+type oaiListRecords struct {
+	Records         []oaiRecord         `xml:"record"`
+	ResumptionToken *oaiResumptionToken `xml:"resumptionToken"`
+}
+
+// oaiToken is the state a resumption token carries: the original request's
+// arguments and the position after the last record returned. Listings
+// resume by keyset, so tokens do not expire.
+type oaiToken struct {
+	Prefix    string    `json:"p"`
+	Set       string    `json:"s,omitempty"`
+	From      string    `json:"f,omitempty"`
+	Until     string    `json:"u,omitempty"`
+	Datestamp time.Time `json:"d"`
+	ArticleID string    `json:"a"`
+	Cursor    int       `json:"c"`
+}
+
+func (t oaiToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeOAIToken(value string) (oaiToken, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return oaiToken{}, false
+	}
+	var token oaiToken
+	if err := json.Unmarshal(data, &token); err != nil || token.Prefix == "" || token.ArticleID == "" || token.Cursor < 0 {
+		return oaiToken{}, false
+	}
+	return token, true
+}
+
+// OAIPMHServer is an OAI-PMH 2.0 data provider serving the published
+// articles in Dublin Core
+type OAIPMHServer struct {
+	harvest *core.HarvestService
+	baseURL string
+}
+
+func NewOAIPMHServer(harvest *core.HarvestService, baseURL string) *OAIPMHServer {
+	return &OAIPMHServer{harvest: harvest, baseURL: baseURL}
+}
+
+// ServeHTTP answers GET and POST requests. Protocol errors are reported in
+// the response body with status 200, as OAI-PMH requires.
+func (s *OAIPMHServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	response, err := s.respond(r)
+	if err != nil {
+		log.Printf("OAI-PMH: failed to answer %s: %v", r.URL.RawQuery, err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	data, err := xml.MarshalIndent(response, "", "  ")
+	if err != nil {
+		log.Printf("OAI-PMH: failed to encode response: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.Write([]byte(xml.Header))
+	w.Write(data)
+}
+
+// respond builds the response to a request. Protocol errors are part of
+// the response; the error is a failure of the service.
+func (s *OAIPMHServer) respond(r *http.Request) (*oaiResponse, error) {
+	response := &oaiResponse{
+		XSINamespace:   "http://www.w3.org/2001/XMLSchema-instance",
+		SchemaLocation: oaiSchemaLocation,
+		ResponseDate:   time.Now().UTC().Format(oaiSecondLayout),
+		Request:        oaiRequest{BaseURL: s.baseURL},
+	}
+	fail := func(code, message string) (*oaiResponse, error) {
+		if code == oaiBadVerb || code == oaiBadArgument {
+			response.Request = oaiRequest{BaseURL: s.baseURL}
+		}
+		response.Errors = append(response.Errors, oaiError{Code: code, Message: message})
+		return response, nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return fail(oaiBadArgument, "the request could not be parsed")
+	}
+	verbs := r.Form["verb"]
+	if len(verbs) != 1 {
+		return fail(oaiBadVerb, "the request must have exactly one verb")
+	}
+	accepted, ok := oaiVerbArguments[verbs[0]]
+	if !ok {
+		return fail(oaiBadVerb, "unknown verb "+verbs[0])
+	}
+
+	args := make(map[string]string, len(r.Form))
+	for name, values := range r.Form {
+		if name == "verb" {
+			continue
+		}
+		if _, ok := accepted[name]; !ok {
+			return fail(oaiBadArgument, "illegal argument "+name)
+		}
+		if len(values) != 1 || values[0] == "" {
+			return fail(oaiBadArgument, "argument "+name+" must have exactly one value")
+		}
+		args[name] = values[0]
+	}
+	if _, ok := args["resumptionToken"]; ok {
+		if len(args) > 1 {
+			return fail(oaiBadArgument, "resumptionToken is an exclusive argument")
+		}
+	} else {
+		for name, required := range accepted {
+			if _, ok := args[name]; required && !ok {
+				return fail(oaiBadArgument, "missing argument "+name)
+			}
+		}
+	}
+
+	// The request is well formed, so it is echoed with its arguments
+	response.Request = oaiRequest{
+		Verb:            verbs[0],
+		Identifier:      args["identifier"],
+		MetadataPrefix:  args["metadataPrefix"],
+		From:            args["from"],
+		Until:           args["until"],
+		Set:             args["set"],
+		ResumptionToken: args["resumptionToken"],
+		BaseURL:         s.baseURL,
+	}
+
+	var err error
+	switch verbs[0] {
+	case "Identify":
+		err = s.identify(response)
+	case "ListMetadataFormats":
+		err = s.listMetadataFormats(response, args)
+	case "ListSets":
+		err = s.listSets(response, args)
+	case "GetRecord":
+		err = s.getRecord(response, args)
+	default:
+		err = s.list(response, verbs[0] == "ListRecords", args)
+	}
+	var failure *oaiFailure
+	if errors.As(err, &failure) {
+		return fail(failure.code, failure.message)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// oaiFailure is a protocol error reported to the harvester
+type oaiFailure struct {
+	code    string
+	message string
+}
+
+func (f *oaiFailure) Error() string {
+	return f.code + ": " + f.message
+}
+
+func oaiFail(code, message string) error {
+	return &oaiFailure{code: code, message: message}
+}
+
+func (s *OAIPMHServer) identify(response *oaiResponse) error {
+	config := s.harvest.Config()
+	earliest, err := s.harvest.EarliestDatestamp()
+	if err != nil {
+		return err
+	}
+
+	identify := &oaiIdentify{
+		RepositoryName:    config.RepositoryName,
+		BaseURL:           s.baseURL,
+		ProtocolVersion:   "2.0",
+		AdminEmail:        config.AdminEmail,
+		EarliestDatestamp: earliest.Format(oaiSecondLayout),
+		DeletedRecord:     "no",
+		Granularity:       oaiGranularity,
+	}
+	identify.Description = oaiIdentifierDescription{
+		Namespace:            "http://www.openarchives.org/OAI/2.0/oai-identifier",
+		SchemaLocation:       "http://www.openarchives.org/OAI/2.0/oai-identifier http://www.openarchives.org/OAI/2.0/oai-identifier.xsd",
+		Scheme:               "oai",
+		RepositoryIdentifier: config.RepositoryIdentifier,
+		Delimiter:            ":",
+		SampleIdentifier:     s.harvest.Identifier(testArticleID),
+	}
+	response.Identify = identify
+	return nil
+}
+
+func (s *OAIPMHServer) listMetadataFormats(response *oaiResponse, args map[string]string) error {
+	if identifier, ok := args["identifier"]; ok {
+		if err := s.checkRecord(identifier); err != nil {
+			return err
+		}
+	}
+	response.ListMetadataFormats = &oaiListMetadataFormats{Formats: []oaiMetadataFormat{{
+		Prefix:    oaiDCPrefix,
+		Schema:    "http://www.openarchives.org/OAI/2.0/oai_dc.xsd",
+		Namespace: "http://www.openarchives.org/OAI/2.0/oai_dc/",
+	}}}
+	return nil
+}
+
+func (s *OAIPMHServer) listSets(response *oaiResponse, args map[string]string) error {
+	// Set lists fit in one response, so no token was ever handed out
+	if _, ok := args["resumptionToken"]; ok {
+		return oaiFail(oaiBadResumptionToken, "the resumption token is invalid")
+	}
+	sets, err := s.harvest.ListSets()
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return oaiFail(oaiNoSetHierarchy, "the repository has no sets yet")
+	}
+	response.ListSets = &oaiListSets{}
+	for _, set := range sets {
+		response.ListSets.Sets = append(response.ListSets.Sets, oaiSet{Spec: set.Spec, Name: set.Name})
+	}
+	return nil
+}
+
+func (s *OAIPMHServer) getRecord(response *oaiResponse, args map[string]string) error {
+	if args["metadataPrefix"] != oaiDCPrefix {
+		return oaiFail(oaiCannotDisseminateFormat, "only oai_dc is supported")
+	}
+	record, err := s.harvest.GetRecord(args["identifier"])
+	if errors.Is(err, core.ErrArticleNotFound) {
+		return oaiFail(oaiIDDoesNotExist, "no record has the identifier "+args["identifier"])
+	}
+	if err != nil {
+		return err
+	}
+	response.GetRecord = &oaiGetRecord{Record: toOAIRecord(record)}
+	return nil
+}
+
+// checkRecord reports idDoesNotExist for identifiers of no record
+func (s *OAIPMHServer) checkRecord(identifier string) error {
+	_, err := s.harvest.GetRecord(identifier)
+	if errors.Is(err, core.ErrArticleNotFound) {
+		return oaiFail(oaiIDDoesNotExist, "no record has the identifier "+identifier)
+	}
+	return err
+}
+
+// list answers ListRecords and ListIdentifiers, starting a listing or
+// resuming one from its token
+func (s *OAIPMHServer) list(response *oaiResponse, withMetadata bool, args map[string]string) error {
+	token := oaiToken{Prefix: args["metadataPrefix"], Set: args["set"], From: args["from"], Until: args["until"]}
+	resuming := false
+	if value, ok := args["resumptionToken"]; ok {
+		if token, ok = decodeOAIToken(value); !ok {
+			return oaiFail(oaiBadResumptionToken, "the resumption token is invalid")
+		}
+		resuming = true
+	}
+	if token.Prefix != oaiDCPrefix {
+		return oaiFail(oaiCannotDisseminateFormat, "only oai_dc is supported")
+	}
+
+	query := core.HarvestQuery{JournalID: token.Set}
+	from, fromGranularity, err := parseOAIDate(token.From, false)
+	if err != nil {
+		return oaiFail(oaiBadArgument, "from must be a date or a UTC time of the form "+oaiGranularity)
+	}
+	until, untilGranularity, err := parseOAIDate(token.Until, true)
+	if err != nil {
+		return oaiFail(oaiBadArgument, "until must be a date or a UTC time of the form "+oaiGranularity)
+	}
+	if token.From != "" && token.Until != "" {
+		if fromGranularity != untilGranularity {
+			return oaiFail(oaiBadArgument, "from and until must have the same granularity")
+		}
+		if !from.Before(until) {
+			return oaiFail(oaiBadArgument, "from must not be later than until")
+		}
+	}
+	query.From, query.Until = from, until
+	if resuming {
+		query.After = &core.HarvestCursor{Datestamp: token.Datestamp, ArticleID: token.ArticleID}
+	}
+
+	page, err := s.harvest.List(query, withMetadata)
+	if err != nil {
+		return err
+	}
+	if len(page.Records) == 0 && !resuming {
+		return oaiFail(oaiNoRecordsMatch, "no records match the request")
+	}
+
+	// Incomplete lists end in a token; the last part of a resumed list
+	// ends in an empty one
+	var resumption *oaiResumptionToken
+	if page.Next != nil {
+		next := token
+		next.Datestamp, next.ArticleID = page.Next.Datestamp, page.Next.ArticleID
+		next.Cursor = token.Cursor + len(page.Records)
+		resumption = &oaiResumptionToken{Cursor: token.Cursor, Token: next.encode()}
+	} else if resuming {
+		resumption = &oaiResumptionToken{Cursor: token.Cursor}
+	}
+
+	if withMetadata {
+		response.ListRecords = &oaiListRecords{ResumptionToken: resumption}
+		for _, record := range page.Records {
+			response.ListRecords.Records = append(response.ListRecords.Records, toOAIRecord(record))
+		}
+	} else {
+		response.ListIdentifiers = &oaiListIdentifiers{ResumptionToken: resumption}
+		for _, record := range page.Records {
+			response.ListIdentifiers.Headers = append(response.ListIdentifiers.Headers, toOAIHeader(record))
+		}
+	}
+	return nil
+}
+
+// parseOAIDate reads a from or until argument in day or second granularity.
+// An until bound is returned as the exclusive end of the day or second it
+// names. Empty arguments leave the bound open.
+func parseOAIDate(value string, until bool) (time.Time, string, error) {
+	if value == "" {
+		return time.Time{}, "", nil
+	}
+	if date, err := time.Parse(oaiDayLayout, value); err == nil {
+		if until {
+			date = date.AddDate(0, 0, 1)
+		}
+		return date, oaiDayLayout, nil
+	}
+	date, err := time.Parse(oaiSecondLayout, value)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if until {
+		date = date.Add(time.Second)
+	}
+	return date, oaiSecondLayout, nil
+}
+
+func toOAIHeader(record core.HarvestRecord) oaiHeader {
+	return oaiHeader{
+		Identifier: record.Identifier,
+		Datestamp:  record.Datestamp.UTC().Format(oaiSecondLayout),
+		SetSpecs:   record.SetSpecs,
+	}
+}
+
+func toOAIRecord(record core.HarvestRecord) oaiRecord {
+	return oaiRecord{Header: toOAIHeader(record), Metadata: record.Metadata}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// oaiRequestFunc sends a query to the provider and decodes its response
+type oaiRequestFunc func(method, query string) (int, oaiResponse)
+
+// newTestProvider serves two published articles and a draft through the
+// OAI-PMH provider, one record per page
+func newTestProvider(t *testing.T) oaiRequestFunc {
+	t.Helper()
+	service, _, _ := newTestService(t)
+	publishTestArticle(t, service, "a1", "Four Colour Theorem")
+	publishTestArticle(t, service, "a2", "Planar Graphs")
+	if _, err := service.CreateArticle(fromProtoArticle(testArticle("a3", "Unpublished Graphs"))); err != nil {
+		t.Fatal(err)
+	}
+	harvest, err := newHarvestService(service, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider := NewOAIPMHServer(harvest, defaultOAIBaseURL)
+
+	return func(method, query string) (int, oaiResponse) {
+		t.Helper()
+		request := httptest.NewRequest(method, "/oai?"+query, nil)
+		if method == http.MethodPost {
+			request = httptest.NewRequest(method, "/oai", strings.NewReader(query))
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		recorder := httptest.NewRecorder()
+		provider.ServeHTTP(recorder, request)
+
+		var response oaiResponse
+		if recorder.Code == http.StatusOK {
+			if err := xml.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatalf("%s %s: %v", method, query, err)
+			}
+		}
+		return recorder.Code, response
+	}
+}
+
+func TestOAIPMHIdentify(t *testing.T) {
+	request := newTestProvider(t)
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		code, response := request(method, "verb=Identify")
+		if code != http.StatusOK || response.Identify == nil {
+			t.Fatalf("%s Identify = %d %+v", method, code, response.Errors)
+		}
+		if identify := response.Identify; identify.BaseURL != defaultOAIBaseURL || identify.EarliestDatestamp == "" ||
+			identify.Description.RepositoryIdentifier != defaultOAIRepositoryID {
+			t.Errorf("%s Identify = %+v", method, identify)
+		}
+	}
+	if code, _ := request(http.MethodPut, "verb=Identify"); code != http.StatusMethodNotAllowed {
+		t.Errorf("PUT Identify = %d, want 405", code)
+	}
+}
+
+func TestOAIPMHListIdentifiersResumes(t *testing.T) {
+	request := newTestProvider(t)
+
+	// Only published articles are harvested, one per page here
+	var identifiers []string
+	query := "verb=ListIdentifiers&metadataPrefix=oai_dc"
+	for page := 0; page < 3; page++ {
+		_, response := request(http.MethodGet, query)
+		list := response.ListIdentifiers
+		if list == nil {
+			t.Fatalf("ListIdentifiers page %d = %+v", page, response.Errors)
+		}
+		for _, header := range list.Headers {
+			identifiers = append(identifiers, header.Identifier)
+		}
+		if list.ResumptionToken == nil || list.ResumptionToken.Token == "" {
+			break
+		}
+		query = "verb=ListIdentifiers&resumptionToken=" + url.QueryEscape(list.ResumptionToken.Token)
+	}
+	want := []string{"oai:" + defaultOAIRepositoryID + ":a1", "oai:" + defaultOAIRepositoryID + ":a2"}
+	if strings.Join(identifiers, " ") != strings.Join(want, " ") {
+		t.Errorf("harvested %v, want %v", identifiers, want)
+	}
+
+	_, response := request(http.MethodGet, "verb=GetRecord&metadataPrefix=oai_dc&identifier="+want[1])
+	if response.GetRecord == nil || response.GetRecord.Record.Header.Identifier != want[1] {
+		t.Errorf("GetRecord(%s) = %+v", want[1], response.Errors)
+	}
+}
+
+func TestOAIPMHErrors(t *testing.T) {
+	request := newTestProvider(t)
+
+	tests := []struct {
+		query string
+		code  string
+	}{
+		{"verb=ListAll", oaiBadVerb},
+		{"verb=Identify&verb=ListSets", oaiBadVerb},
+		{"verb=GetRecord&metadataPrefix=oai_dc", oaiBadArgument},
+		{"verb=Identify&set=journal_1", oaiBadArgument},
+		{"verb=ListRecords&metadataPrefix=oai_dc&resumptionToken=abc", oaiBadArgument},
+		{"verb=ListRecords&metadataPrefix=oai_dc&from=2024-01-01&until=2024-01-02T00:00:00Z", oaiBadArgument},
+		{"verb=ListRecords&resumptionToken=abc", oaiBadResumptionToken},
+		{"verb=ListRecords&metadataPrefix=marc", oaiCannotDisseminateFormat},
+		{"verb=ListRecords&metadataPrefix=oai_dc&set=journal_9", oaiNoRecordsMatch},
+		{"verb=GetRecord&metadataPrefix=oai_dc&identifier=oai:" + defaultOAIRepositoryID + ":a3", oaiIDDoesNotExist},
+	}
+	for _, test := range tests {
+		code, response := request(http.MethodGet, test.query)
+		if code != http.StatusOK || len(response.Errors) != 1 || response.Errors[0].Code != test.code {
+			t.Errorf("%s = %d %+v, want %s", test.query, code, response.Errors, test.code)
+			continue
+		}
+		// Bad requests are not echoed with their arguments
+		if echoed := response.Request.Verb != ""; echoed == (test.code == oaiBadVerb || test.code == oaiBadArgument) {
+			t.Errorf("%s echoed as %+v", test.query, response.Request)
+		}
+	}
+}
@@ -0,0 +1,9095 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v6.32.0
+// source: article.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Article struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Abstract string                 `protobuf:"bytes,3,opt,name=abstract,proto3" json:"abstract,omitempty"`
+	// Deprecated: use authors. Set to the first author on reads; on writes it
+	// is used as the sole author when authors is empty.
+	//
+	// Deprecated: Marked as deprecated in article.proto.
+	AuthorId  string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	JournalId string `protobuf:"bytes,5,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// One of "draft", "submitted", "under_review", "accepted", "rejected" or "published"
+	Status      string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// Authors in byline order
+	Authors []*ArticleAuthor `protobuf:"bytes,10,rep,name=authors,proto3" json:"authors,omitempty"`
+	// Number of articles of this service citing the article; ignored on writes
+	CitationCount int32 `protobuf:"varint,11,opt,name=citation_count,json=citationCount,proto3" json:"citation_count,omitempty"`
+	// DOI minted for the article; ignored on writes
+	Doi string `protobuf:"bytes,12,opt,name=doi,proto3" json:"doi,omitempty"`
+	// Latest DOI deposit; ignored on writes
+	DoiDeposit *DOIDeposit `protobuf:"bytes,13,opt,name=doi_deposit,json=doiDeposit,proto3" json:"doi_deposit,omitempty"`
+	// Free-text keywords chosen by the authors
+	Keywords []string `protobuf:"bytes,14,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	// IDs of the article's subject terms. Writes may name a term by its label
+	// or a synonym instead.
+	Subjects      []string `protobuf:"bytes,15,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_article_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Article) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetAbstract() string {
+	if x != nil {
+		return x.Abstract
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in article.proto.
+func (x *Article) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Article) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *Article) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Article) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Article) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Article) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Article) GetAuthors() []*ArticleAuthor {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *Article) GetCitationCount() int32 {
+	if x != nil {
+		return x.CitationCount
+	}
+	return 0
+}
+
+func (x *Article) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+func (x *Article) GetDoiDeposit() *DOIDeposit {
+	if x != nil {
+		return x.DoiDeposit
+	}
+	return nil
+}
+
+func (x *Article) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *Article) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+type DOIDeposit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of "submitted", "registered" or "failed"
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BatchId string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Message from the registration agency or the submission error
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DOIDeposit) Reset() {
+	*x = DOIDeposit{}
+	mi := &file_article_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DOIDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DOIDeposit) ProtoMessage() {}
+
+func (x *DOIDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DOIDeposit.ProtoReflect.Descriptor instead.
+func (*DOIDeposit) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DOIDeposit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DOIDeposit) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *DOIDeposit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DOIDeposit) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *DOIDeposit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ArticleAuthor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Orcid         string                 `protobuf:"bytes,2,opt,name=orcid,proto3" json:"orcid,omitempty"`
+	Affiliation   string                 `protobuf:"bytes,3,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	Corresponding bool                   `protobuf:"varint,4,opt,name=corresponding,proto3" json:"corresponding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleAuthor) Reset() {
+	*x = ArticleAuthor{}
+	mi := &file_article_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleAuthor) ProtoMessage() {}
+
+func (x *ArticleAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleAuthor.ProtoReflect.Descriptor instead.
+func (*ArticleAuthor) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArticleAuthor) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ArticleAuthor) GetOrcid() string {
+	if x != nil {
+		return x.Orcid
+	}
+	return ""
+}
+
+func (x *ArticleAuthor) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *ArticleAuthor) GetCorresponding() bool {
+	if x != nil {
+		return x.Corresponding
+	}
+	return false
+}
+
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Orcid         string                 `protobuf:"bytes,3,opt,name=orcid,proto3" json:"orcid,omitempty"`
+	Affiliation   string                 `protobuf:"bytes,4,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_article_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Author) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetOrcid() string {
+	if x != nil {
+		return x.Orcid
+	}
+	return ""
+}
+
+func (x *Author) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *Author) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Author) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Author) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorRequest) Reset() {
+	*x = CreateAuthorRequest{}
+	mi := &file_article_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorRequest) ProtoMessage() {}
+
+func (x *CreateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAuthorRequest) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type CreateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorResponse) Reset() {
+	*x = CreateAuthorResponse{}
+	mi := &file_article_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorResponse) ProtoMessage() {}
+
+func (x *CreateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type GetAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorRequest) Reset() {
+	*x = GetAuthorRequest{}
+	mi := &file_article_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorRequest) ProtoMessage() {}
+
+func (x *GetAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAuthorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorResponse) Reset() {
+	*x = GetAuthorResponse{}
+	mi := &file_article_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorResponse) ProtoMessage() {}
+
+func (x *GetAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type UpdateAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorRequest) Reset() {
+	*x = UpdateAuthorRequest{}
+	mi := &file_article_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorRequest) ProtoMessage() {}
+
+func (x *UpdateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateAuthorRequest) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type UpdateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorResponse) Reset() {
+	*x = UpdateAuthorResponse{}
+	mi := &file_article_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorResponse) ProtoMessage() {}
+
+func (x *UpdateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type ListAuthorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_article_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsRequest) ProtoMessage() {}
+
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{10}
+}
+
+type ListAuthorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_article_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsResponse) ProtoMessage() {}
+
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAuthorsResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type ListArticlesByAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesByAuthorRequest) Reset() {
+	*x = ListArticlesByAuthorRequest{}
+	mi := &file_article_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesByAuthorRequest) ProtoMessage() {}
+
+func (x *ListArticlesByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListArticlesByAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type ListArticlesByAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesByAuthorResponse) Reset() {
+	*x = ListArticlesByAuthorResponse{}
+	mi := &file_article_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesByAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesByAuthorResponse) ProtoMessage() {}
+
+func (x *ListArticlesByAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesByAuthorResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesByAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListArticlesByAuthorResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type GetArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_article_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetArticleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleResponse) Reset() {
+	*x = GetArticleResponse{}
+	mi := &file_article_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleResponse) ProtoMessage() {}
+
+func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_article_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateArticleRequest) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type CreateArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Stored articles the new one closely matches, most similar first
+	PossibleDuplicates []*SimilarArticle `protobuf:"bytes,2,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	// Subjects outside the scope of the article's journal
+	SubjectWarnings []string `protobuf:"bytes,3,rep,name=subject_warnings,json=subjectWarnings,proto3" json:"subject_warnings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateArticleResponse) Reset() {
+	*x = CreateArticleResponse{}
+	mi := &file_article_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleResponse) ProtoMessage() {}
+
+func (x *CreateArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleResponse.ProtoReflect.Descriptor instead.
+func (*CreateArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *CreateArticleResponse) GetPossibleDuplicates() []*SimilarArticle {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
+func (x *CreateArticleResponse) GetSubjectWarnings() []string {
+	if x != nil {
+		return x.SubjectWarnings
+	}
+	return nil
+}
+
+type UpdateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_article_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateArticleRequest) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type UpdateArticleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Subjects outside the scope of the article's journal
+	SubjectWarnings []string `protobuf:"bytes,2,rep,name=subject_warnings,json=subjectWarnings,proto3" json:"subject_warnings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateArticleResponse) Reset() {
+	*x = UpdateArticleResponse{}
+	mi := &file_article_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleResponse) ProtoMessage() {}
+
+func (x *UpdateArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *UpdateArticleResponse) GetSubjectWarnings() []string {
+	if x != nil {
+		return x.SubjectWarnings
+	}
+	return nil
+}
+
+type TransitionArticleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Target status; the workflow decides whether the move is allowed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Optional explanation, recorded for rejections
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionArticleRequest) Reset() {
+	*x = TransitionArticleRequest{}
+	mi := &file_article_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionArticleRequest) ProtoMessage() {}
+
+func (x *TransitionArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionArticleRequest.ProtoReflect.Descriptor instead.
+func (*TransitionArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TransitionArticleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionArticleRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TransitionArticleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TransitionArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionArticleResponse) Reset() {
+	*x = TransitionArticleResponse{}
+	mi := &file_article_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionArticleResponse) ProtoMessage() {}
+
+func (x *TransitionArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionArticleResponse.ProtoReflect.Descriptor instead.
+func (*TransitionArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransitionArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type StatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_article_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StatusTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusTransition) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusHistoryRequest) Reset() {
+	*x = GetStatusHistoryRequest{}
+	mi := &file_article_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetStatusHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetStatusHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*StatusTransition    `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusHistoryResponse) Reset() {
+	*x = GetStatusHistoryResponse{}
+	mi := &file_article_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetStatusHistoryResponse) GetTransitions() []*StatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type AuthorCluster struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Authors []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	Score   float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// compatible_name, same_given_name, same_affiliation or shared_co_authors
+	Evidence      []string `protobuf:"bytes,3,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorCluster) Reset() {
+	*x = AuthorCluster{}
+	mi := &file_article_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorCluster) ProtoMessage() {}
+
+func (x *AuthorCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorCluster.ProtoReflect.Descriptor instead.
+func (*AuthorCluster) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuthorCluster) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *AuthorCluster) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AuthorCluster) GetEvidence() []string {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type FindDuplicateAuthorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowest pair score to report; 0 uses the default of 0.5
+	MinScore      float64 `protobuf:"fixed64,1,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateAuthorsRequest) Reset() {
+	*x = FindDuplicateAuthorsRequest{}
+	mi := &file_article_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateAuthorsRequest) ProtoMessage() {}
+
+func (x *FindDuplicateAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindDuplicateAuthorsRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+type FindDuplicateAuthorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*AuthorCluster       `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicateAuthorsResponse) Reset() {
+	*x = FindDuplicateAuthorsResponse{}
+	mi := &file_article_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicateAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateAuthorsResponse) ProtoMessage() {}
+
+func (x *FindDuplicateAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindDuplicateAuthorsResponse) GetClusters() []*AuthorCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type AuthorMerge struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source            *Author                `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	TargetBefore      *Author                `protobuf:"bytes,3,opt,name=target_before,json=targetBefore,proto3" json:"target_before,omitempty"`
+	Target            *Author                `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	ChangedArticleIds []string               `protobuf:"bytes,5,rep,name=changed_article_ids,json=changedArticleIds,proto3" json:"changed_article_ids,omitempty"`
+	Actor             string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	UndoneAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=undone_at,json=undoneAt,proto3" json:"undone_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AuthorMerge) Reset() {
+	*x = AuthorMerge{}
+	mi := &file_article_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorMerge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorMerge) ProtoMessage() {}
+
+func (x *AuthorMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorMerge.ProtoReflect.Descriptor instead.
+func (*AuthorMerge) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuthorMerge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthorMerge) GetSource() *Author {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *AuthorMerge) GetTargetBefore() *Author {
+	if x != nil {
+		return x.TargetBefore
+	}
+	return nil
+}
+
+func (x *AuthorMerge) GetTarget() *Author {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *AuthorMerge) GetChangedArticleIds() []string {
+	if x != nil {
+		return x.ChangedArticleIds
+	}
+	return nil
+}
+
+func (x *AuthorMerge) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuthorMerge) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *AuthorMerge) GetUndoneAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UndoneAt
+	}
+	return nil
+}
+
+type MergeAuthorsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Author that is removed; its articles move to the target
+	SourceId      string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAuthorsRequest) Reset() {
+	*x = MergeAuthorsRequest{}
+	mi := &file_article_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAuthorsRequest) ProtoMessage() {}
+
+func (x *MergeAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MergeAuthorsRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MergeAuthorsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type MergeAuthorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merge         *AuthorMerge           `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAuthorsResponse) Reset() {
+	*x = MergeAuthorsResponse{}
+	mi := &file_article_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAuthorsResponse) ProtoMessage() {}
+
+func (x *MergeAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MergeAuthorsResponse) GetMerge() *AuthorMerge {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+type UndoAuthorMergeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MergeId       string                 `protobuf:"bytes,1,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoAuthorMergeRequest) Reset() {
+	*x = UndoAuthorMergeRequest{}
+	mi := &file_article_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoAuthorMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoAuthorMergeRequest) ProtoMessage() {}
+
+func (x *UndoAuthorMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoAuthorMergeRequest.ProtoReflect.Descriptor instead.
+func (*UndoAuthorMergeRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UndoAuthorMergeRequest) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+type UndoAuthorMergeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merge         *AuthorMerge           `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoAuthorMergeResponse) Reset() {
+	*x = UndoAuthorMergeResponse{}
+	mi := &file_article_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoAuthorMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoAuthorMergeResponse) ProtoMessage() {}
+
+func (x *UndoAuthorMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoAuthorMergeResponse.ProtoReflect.Descriptor instead.
+func (*UndoAuthorMergeResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UndoAuthorMergeResponse) GetMerge() *AuthorMerge {
+	if x != nil {
+		return x.Merge
+	}
+	return nil
+}
+
+type ListAuthorMergesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorMergesRequest) Reset() {
+	*x = ListAuthorMergesRequest{}
+	mi := &file_article_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorMergesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorMergesRequest) ProtoMessage() {}
+
+func (x *ListAuthorMergesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorMergesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorMergesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{33}
+}
+
+type ListAuthorMergesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merges        []*AuthorMerge         `protobuf:"bytes,1,rep,name=merges,proto3" json:"merges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorMergesResponse) Reset() {
+	*x = ListAuthorMergesResponse{}
+	mi := &file_article_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorMergesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorMergesResponse) ProtoMessage() {}
+
+func (x *ListAuthorMergesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorMergesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorMergesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAuthorMergesResponse) GetMerges() []*AuthorMerge {
+	if x != nil {
+		return x.Merges
+	}
+	return nil
+}
+
+// AuthorMetrics are computed from the author's published articles, across
+// all journals or within journal_id when it is set
+type AuthorMetrics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId           string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	JournalId          string                 `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Publications       int32                  `protobuf:"varint,3,opt,name=publications,proto3" json:"publications,omitempty"`
+	PublicationsByYear map[int32]int32        `protobuf:"bytes,4,rep,name=publications_by_year,json=publicationsByYear,proto3" json:"publications_by_year,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TotalCitations     int32                  `protobuf:"varint,5,opt,name=total_citations,json=totalCitations,proto3" json:"total_citations,omitempty"`
+	HIndex             int32                  `protobuf:"varint,6,opt,name=h_index,json=hIndex,proto3" json:"h_index,omitempty"`
+	I10Index           int32                  `protobuf:"varint,7,opt,name=i10_index,json=i10Index,proto3" json:"i10_index,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AuthorMetrics) Reset() {
+	*x = AuthorMetrics{}
+	mi := &file_article_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorMetrics) ProtoMessage() {}
+
+func (x *AuthorMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorMetrics.ProtoReflect.Descriptor instead.
+func (*AuthorMetrics) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AuthorMetrics) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AuthorMetrics) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *AuthorMetrics) GetPublications() int32 {
+	if x != nil {
+		return x.Publications
+	}
+	return 0
+}
+
+func (x *AuthorMetrics) GetPublicationsByYear() map[int32]int32 {
+	if x != nil {
+		return x.PublicationsByYear
+	}
+	return nil
+}
+
+func (x *AuthorMetrics) GetTotalCitations() int32 {
+	if x != nil {
+		return x.TotalCitations
+	}
+	return 0
+}
+
+func (x *AuthorMetrics) GetHIndex() int32 {
+	if x != nil {
+		return x.HIndex
+	}
+	return 0
+}
+
+func (x *AuthorMetrics) GetI10Index() int32 {
+	if x != nil {
+		return x.I10Index
+	}
+	return 0
+}
+
+func (x *AuthorMetrics) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAuthorMetricsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Optional; limits the metrics to articles of one journal
+	JournalId     string `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorMetricsRequest) Reset() {
+	*x = GetAuthorMetricsRequest{}
+	mi := &file_article_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorMetricsRequest) ProtoMessage() {}
+
+func (x *GetAuthorMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAuthorMetricsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *GetAuthorMetricsRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+type GetAuthorMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *AuthorMetrics         `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorMetricsResponse) Reset() {
+	*x = GetAuthorMetricsResponse{}
+	mi := &file_article_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorMetricsResponse) ProtoMessage() {}
+
+func (x *GetAuthorMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAuthorMetricsResponse) GetMetrics() *AuthorMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type GetJournalLeaderboardRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JournalId string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	// One of "h_index" (default), "total_citations", "i10_index" or "publications"
+	Order string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// Defaults to 10
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalLeaderboardRequest) Reset() {
+	*x = GetJournalLeaderboardRequest{}
+	mi := &file_article_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetJournalLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetJournalLeaderboardRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *GetJournalLeaderboardRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *GetJournalLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetJournalLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []*AuthorMetrics       `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalLeaderboardResponse) Reset() {
+	*x = GetJournalLeaderboardResponse{}
+	mi := &file_article_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetJournalLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetJournalLeaderboardResponse) GetAuthors() []*AuthorMetrics {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type Reviewer struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email       string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Affiliation string                 `protobuf:"bytes,4,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Author ID of the reviewer's own articles, used for matching
+	AuthorId      string `protobuf:"bytes,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reviewer) Reset() {
+	*x = Reviewer{}
+	mi := &file_article_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reviewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviewer) ProtoMessage() {}
+
+func (x *Reviewer) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviewer.ProtoReflect.Descriptor instead.
+func (*Reviewer) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Reviewer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reviewer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reviewer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Reviewer) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *Reviewer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reviewer) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type RegisterReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer      *Reviewer              `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterReviewerRequest) Reset() {
+	*x = RegisterReviewerRequest{}
+	mi := &file_article_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReviewerRequest) ProtoMessage() {}
+
+func (x *RegisterReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReviewerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RegisterReviewerRequest) GetReviewer() *Reviewer {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+type RegisterReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer      *Reviewer              `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterReviewerResponse) Reset() {
+	*x = RegisterReviewerResponse{}
+	mi := &file_article_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReviewerResponse) ProtoMessage() {}
+
+func (x *RegisterReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterReviewerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RegisterReviewerResponse) GetReviewer() *Reviewer {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+type ListReviewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewersRequest) Reset() {
+	*x = ListReviewersRequest{}
+	mi := &file_article_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewersRequest) ProtoMessage() {}
+
+func (x *ListReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewersRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{43}
+}
+
+type ListReviewersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewers     []*Reviewer            `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewersResponse) Reset() {
+	*x = ListReviewersResponse{}
+	mi := &file_article_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewersResponse) ProtoMessage() {}
+
+func (x *ListReviewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewersResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewersResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListReviewersResponse) GetReviewers() []*Reviewer {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type SuggestReviewersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// Maximum number of matches; 0 returns all
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestReviewersRequest) Reset() {
+	*x = SuggestReviewersRequest{}
+	mi := &file_article_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestReviewersRequest) ProtoMessage() {}
+
+func (x *SuggestReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestReviewersRequest.ProtoReflect.Descriptor instead.
+func (*SuggestReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SuggestReviewersRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *SuggestReviewersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReviewerMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer      *Reviewer              `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	SharedTerms   []string               `protobuf:"bytes,3,rep,name=shared_terms,json=sharedTerms,proto3" json:"shared_terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerMatch) Reset() {
+	*x = ReviewerMatch{}
+	mi := &file_article_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerMatch) ProtoMessage() {}
+
+func (x *ReviewerMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerMatch.ProtoReflect.Descriptor instead.
+func (*ReviewerMatch) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReviewerMatch) GetReviewer() *Reviewer {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+func (x *ReviewerMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReviewerMatch) GetSharedTerms() []string {
+	if x != nil {
+		return x.SharedTerms
+	}
+	return nil
+}
+
+type ReviewerConflict struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer *Reviewer              `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// submitting_author, co_author or same_affiliation
+	Reasons       []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerConflict) Reset() {
+	*x = ReviewerConflict{}
+	mi := &file_article_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerConflict) ProtoMessage() {}
+
+func (x *ReviewerConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerConflict.ProtoReflect.Descriptor instead.
+func (*ReviewerConflict) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReviewerConflict) GetReviewer() *Reviewer {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+func (x *ReviewerConflict) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type SuggestReviewersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*ReviewerMatch       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Conflicts     []*ReviewerConflict    `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestReviewersResponse) Reset() {
+	*x = SuggestReviewersResponse{}
+	mi := &file_article_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestReviewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestReviewersResponse) ProtoMessage() {}
+
+func (x *SuggestReviewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestReviewersResponse.ProtoReflect.Descriptor instead.
+func (*SuggestReviewersResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SuggestReviewersResponse) GetMatches() []*ReviewerMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SuggestReviewersResponse) GetConflicts() []*ReviewerConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ReviewAssignment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId  string                 `protobuf:"bytes,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ReviewerId string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// pending or completed
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Overdue       bool                   `protobuf:"varint,8,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAssignment) Reset() {
+	*x = ReviewAssignment{}
+	mi := &file_article_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAssignment) ProtoMessage() {}
+
+func (x *ReviewAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAssignment.ProtoReflect.Descriptor instead.
+func (*ReviewAssignment) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReviewAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *ReviewAssignment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *ReviewAssignment) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ReviewAssignment) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type AssignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignReviewerRequest) Reset() {
+	*x = AssignReviewerRequest{}
+	mi := &file_article_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignReviewerRequest) ProtoMessage() {}
+
+func (x *AssignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*AssignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AssignReviewerRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *AssignReviewerRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *AssignReviewerRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type AssignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *ReviewAssignment      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignReviewerResponse) Reset() {
+	*x = AssignReviewerResponse{}
+	mi := &file_article_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignReviewerResponse) ProtoMessage() {}
+
+func (x *AssignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*AssignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AssignReviewerResponse) GetAssignment() *ReviewAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type ListReviewAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewAssignmentsRequest) Reset() {
+	*x = ListReviewAssignmentsRequest{}
+	mi := &file_article_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListReviewAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListReviewAssignmentsRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type ListReviewAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*ReviewAssignment    `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewAssignmentsResponse) Reset() {
+	*x = ListReviewAssignmentsResponse{}
+	mi := &file_article_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListReviewAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListReviewAssignmentsResponse) GetAssignments() []*ReviewAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ReviewReport struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	ArticleId    string                 `protobuf:"bytes,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	ReviewerId   string                 `protobuf:"bytes,4,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// accept, minor_revision, major_revision or reject
+	Recommendation string                 `protobuf:"bytes,5,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	Comments       string                 `protobuf:"bytes,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	SubmittedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewReport) Reset() {
+	*x = ReviewReport{}
+	mi := &file_article_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReport) ProtoMessage() {}
+
+func (x *ReviewReport) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReport.ProtoReflect.Descriptor instead.
+func (*ReviewReport) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReviewReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *ReviewReport) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type SubmitReviewReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId   string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Recommendation string                 `protobuf:"bytes,2,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	Comments       string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitReviewReportRequest) Reset() {
+	*x = SubmitReviewReportRequest{}
+	mi := &file_article_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewReportRequest) ProtoMessage() {}
+
+func (x *SubmitReviewReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewReportRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewReportRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SubmitReviewReportRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *SubmitReviewReportRequest) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *SubmitReviewReportRequest) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+type SubmitReviewReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ReviewReport          `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewReportResponse) Reset() {
+	*x = SubmitReviewReportResponse{}
+	mi := &file_article_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewReportResponse) ProtoMessage() {}
+
+func (x *SubmitReviewReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewReportResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReviewReportResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SubmitReviewReportResponse) GetReport() *ReviewReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ListReviewReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewReportsRequest) Reset() {
+	*x = ListReviewReportsRequest{}
+	mi := &file_article_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewReportsRequest) ProtoMessage() {}
+
+func (x *ListReviewReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewReportsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListReviewReportsRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type ListReviewReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*ReviewReport        `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewReportsResponse) Reset() {
+	*x = ListReviewReportsResponse{}
+	mi := &file_article_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewReportsResponse) ProtoMessage() {}
+
+func (x *ListReviewReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewReportsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListReviewReportsResponse) GetReports() []*ReviewReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type EditorDecision struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArticleId string                 `protobuf:"bytes,2,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	EditorId  string                 `protobuf:"bytes,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	// accept or reject
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Comments      string                 `protobuf:"bytes,5,opt,name=comments,proto3" json:"comments,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditorDecision) Reset() {
+	*x = EditorDecision{}
+	mi := &file_article_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditorDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditorDecision) ProtoMessage() {}
+
+func (x *EditorDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditorDecision.ProtoReflect.Descriptor instead.
+func (*EditorDecision) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EditorDecision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditorDecision) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *EditorDecision) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+func (x *EditorDecision) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *EditorDecision) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *EditorDecision) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type RecordEditorDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Comments      string                 `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEditorDecisionRequest) Reset() {
+	*x = RecordEditorDecisionRequest{}
+	mi := &file_article_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEditorDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEditorDecisionRequest) ProtoMessage() {}
+
+func (x *RecordEditorDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEditorDecisionRequest.ProtoReflect.Descriptor instead.
+func (*RecordEditorDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RecordEditorDecisionRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *RecordEditorDecisionRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RecordEditorDecisionRequest) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+type RecordEditorDecisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decision      *EditorDecision        `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"`
+	Article       *Article               `protobuf:"bytes,2,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEditorDecisionResponse) Reset() {
+	*x = RecordEditorDecisionResponse{}
+	mi := &file_article_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEditorDecisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEditorDecisionResponse) ProtoMessage() {}
+
+func (x *RecordEditorDecisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEditorDecisionResponse.ProtoReflect.Descriptor instead.
+func (*RecordEditorDecisionResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RecordEditorDecisionResponse) GetDecision() *EditorDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return nil
+}
+
+func (x *RecordEditorDecisionResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ListEditorDecisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEditorDecisionsRequest) Reset() {
+	*x = ListEditorDecisionsRequest{}
+	mi := &file_article_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEditorDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEditorDecisionsRequest) ProtoMessage() {}
+
+func (x *ListEditorDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEditorDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEditorDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListEditorDecisionsRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type ListEditorDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*EditorDecision      `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEditorDecisionsResponse) Reset() {
+	*x = ListEditorDecisionsResponse{}
+	mi := &file_article_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEditorDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEditorDecisionsResponse) ProtoMessage() {}
+
+func (x *ListEditorDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEditorDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEditorDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListEditorDecisionsResponse) GetDecisions() []*EditorDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type ArticlePlacement struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	IssueId   string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	// Position of the article in the issue's table of contents
+	Sequence int32 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Zero when the issue has not been paginated yet
+	FirstPage     int32                  `protobuf:"varint,4,opt,name=first_page,json=firstPage,proto3" json:"first_page,omitempty"`
+	LastPage      int32                  `protobuf:"varint,5,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticlePlacement) Reset() {
+	*x = ArticlePlacement{}
+	mi := &file_article_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticlePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticlePlacement) ProtoMessage() {}
+
+func (x *ArticlePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticlePlacement.ProtoReflect.Descriptor instead.
+func (*ArticlePlacement) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ArticlePlacement) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ArticlePlacement) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ArticlePlacement) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ArticlePlacement) GetFirstPage() int32 {
+	if x != nil {
+		return x.FirstPage
+	}
+	return 0
+}
+
+func (x *ArticlePlacement) GetLastPage() int32 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ArticlePlacement) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+type PlaceArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *ArticlePlacement      `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceArticleRequest) Reset() {
+	*x = PlaceArticleRequest{}
+	mi := &file_article_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceArticleRequest) ProtoMessage() {}
+
+func (x *PlaceArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceArticleRequest.ProtoReflect.Descriptor instead.
+func (*PlaceArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PlaceArticleRequest) GetPlacement() *ArticlePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+type PlaceArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *ArticlePlacement      `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceArticleResponse) Reset() {
+	*x = PlaceArticleResponse{}
+	mi := &file_article_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceArticleResponse) ProtoMessage() {}
+
+func (x *PlaceArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceArticleResponse.ProtoReflect.Descriptor instead.
+func (*PlaceArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PlaceArticleResponse) GetPlacement() *ArticlePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+type GetArticlePlacementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticlePlacementRequest) Reset() {
+	*x = GetArticlePlacementRequest{}
+	mi := &file_article_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticlePlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticlePlacementRequest) ProtoMessage() {}
+
+func (x *GetArticlePlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticlePlacementRequest.ProtoReflect.Descriptor instead.
+func (*GetArticlePlacementRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetArticlePlacementRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type GetArticlePlacementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *ArticlePlacement      `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticlePlacementResponse) Reset() {
+	*x = GetArticlePlacementResponse{}
+	mi := &file_article_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticlePlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticlePlacementResponse) ProtoMessage() {}
+
+func (x *GetArticlePlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticlePlacementResponse.ProtoReflect.Descriptor instead.
+func (*GetArticlePlacementResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetArticlePlacementResponse) GetPlacement() *ArticlePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+type ListIssueArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueArticlesRequest) Reset() {
+	*x = ListIssueArticlesRequest{}
+	mi := &file_article_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueArticlesRequest) ProtoMessage() {}
+
+func (x *ListIssueArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListIssueArticlesRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type ListIssueArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placements    []*ArticlePlacement    `protobuf:"bytes,1,rep,name=placements,proto3" json:"placements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueArticlesResponse) Reset() {
+	*x = ListIssueArticlesResponse{}
+	mi := &file_article_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueArticlesResponse) ProtoMessage() {}
+
+func (x *ListIssueArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListIssueArticlesResponse) GetPlacements() []*ArticlePlacement {
+	if x != nil {
+		return x.Placements
+	}
+	return nil
+}
+
+// Reference cites either another article of this service (article_id) or an
+// external work (doi), never both
+type Reference struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CitingArticleId string                 `protobuf:"bytes,1,opt,name=citing_article_id,json=citingArticleId,proto3" json:"citing_article_id,omitempty"`
+	// 1-based position in the reference list; assigned on writes
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// At most one of article_id and doi is set; references with neither
+	// give only their text
+	ArticleId string `protobuf:"bytes,3,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Doi       string `protobuf:"bytes,4,opt,name=doi,proto3" json:"doi,omitempty"`
+	// The reference as printed
+	Text          string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	mi := &file_article_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Reference) GetCitingArticleId() string {
+	if x != nil {
+		return x.CitingArticleId
+	}
+	return ""
+}
+
+func (x *Reference) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Reference) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *Reference) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+func (x *Reference) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SetArticleReferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	References    []*Reference           `protobuf:"bytes,2,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetArticleReferencesRequest) Reset() {
+	*x = SetArticleReferencesRequest{}
+	mi := &file_article_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArticleReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArticleReferencesRequest) ProtoMessage() {}
+
+func (x *SetArticleReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArticleReferencesRequest.ProtoReflect.Descriptor instead.
+func (*SetArticleReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetArticleReferencesRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *SetArticleReferencesRequest) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type SetArticleReferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	References    []*Reference           `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetArticleReferencesResponse) Reset() {
+	*x = SetArticleReferencesResponse{}
+	mi := &file_article_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetArticleReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetArticleReferencesResponse) ProtoMessage() {}
+
+func (x *SetArticleReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetArticleReferencesResponse.ProtoReflect.Descriptor instead.
+func (*SetArticleReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetArticleReferencesResponse) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type ListArticleReferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticleReferencesRequest) Reset() {
+	*x = ListArticleReferencesRequest{}
+	mi := &file_article_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticleReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticleReferencesRequest) ProtoMessage() {}
+
+func (x *ListArticleReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticleReferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticleReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListArticleReferencesRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type ListArticleReferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	References    []*Reference           `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticleReferencesResponse) Reset() {
+	*x = ListArticleReferencesResponse{}
+	mi := &file_article_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticleReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticleReferencesResponse) ProtoMessage() {}
+
+func (x *ListArticleReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticleReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListArticleReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListArticleReferencesResponse) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// Exactly one of article_id and doi must be set
+type ListCitingReferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Doi           string                 `protobuf:"bytes,2,opt,name=doi,proto3" json:"doi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitingReferencesRequest) Reset() {
+	*x = ListCitingReferencesRequest{}
+	mi := &file_article_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitingReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitingReferencesRequest) ProtoMessage() {}
+
+func (x *ListCitingReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitingReferencesRequest.ProtoReflect.Descriptor instead.
+func (*ListCitingReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCitingReferencesRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ListCitingReferencesRequest) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+type ListCitingReferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	References    []*Reference           `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitingReferencesResponse) Reset() {
+	*x = ListCitingReferencesResponse{}
+	mi := &file_article_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitingReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitingReferencesResponse) ProtoMessage() {}
+
+func (x *ListCitingReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitingReferencesResponse.ProtoReflect.Descriptor instead.
+func (*ListCitingReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListCitingReferencesResponse) GetReferences() []*Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type GetCitationGraphRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// "references" (default) follows cited works, "cited_by" follows citing articles
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Number of citation edges to follow from the article, 1 to 5; 0 means 1
+	MaxDepth      int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCitationGraphRequest) Reset() {
+	*x = GetCitationGraphRequest{}
+	mi := &file_article_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCitationGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitationGraphRequest) ProtoMessage() {}
+
+func (x *GetCitationGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitationGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetCitationGraphRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCitationGraphRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *GetCitationGraphRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *GetCitationGraphRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type CitationNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set for articles of this service
+	ArticleId string `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// Set for external works
+	Doi           string `protobuf:"bytes,2,opt,name=doi,proto3" json:"doi,omitempty"`
+	Depth         int32  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitationNode) Reset() {
+	*x = CitationNode{}
+	mi := &file_article_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CitationNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitationNode) ProtoMessage() {}
+
+func (x *CitationNode) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitationNode.ProtoReflect.Descriptor instead.
+func (*CitationNode) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CitationNode) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *CitationNode) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+func (x *CitationNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type GetCitationGraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by depth, starting with the requested article
+	Nodes []*CitationNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*Reference    `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Set when the graph was cut off at the node limit
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCitationGraphResponse) Reset() {
+	*x = GetCitationGraphResponse{}
+	mi := &file_article_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCitationGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitationGraphResponse) ProtoMessage() {}
+
+func (x *GetCitationGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitationGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetCitationGraphResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCitationGraphResponse) GetNodes() []*CitationNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetCitationGraphResponse) GetEdges() []*Reference {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetCitationGraphResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type RegisterArticleDOIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterArticleDOIRequest) Reset() {
+	*x = RegisterArticleDOIRequest{}
+	mi := &file_article_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterArticleDOIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterArticleDOIRequest) ProtoMessage() {}
+
+func (x *RegisterArticleDOIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterArticleDOIRequest.ProtoReflect.Descriptor instead.
+func (*RegisterArticleDOIRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RegisterArticleDOIRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type RegisterArticleDOIResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterArticleDOIResponse) Reset() {
+	*x = RegisterArticleDOIResponse{}
+	mi := &file_article_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterArticleDOIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterArticleDOIResponse) ProtoMessage() {}
+
+func (x *RegisterArticleDOIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterArticleDOIResponse.ProtoReflect.Descriptor instead.
+func (*RegisterArticleDOIResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RegisterArticleDOIResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type GetArticleDepositXMLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleDepositXMLRequest) Reset() {
+	*x = GetArticleDepositXMLRequest{}
+	mi := &file_article_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleDepositXMLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleDepositXMLRequest) ProtoMessage() {}
+
+func (x *GetArticleDepositXMLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleDepositXMLRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleDepositXMLRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetArticleDepositXMLRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type GetArticleDepositXMLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Crossref deposit, UTF-8 encoded
+	Xml           []byte `protobuf:"bytes,1,opt,name=xml,proto3" json:"xml,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleDepositXMLResponse) Reset() {
+	*x = GetArticleDepositXMLResponse{}
+	mi := &file_article_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleDepositXMLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleDepositXMLResponse) ProtoMessage() {}
+
+func (x *GetArticleDepositXMLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleDepositXMLResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleDepositXMLResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetArticleDepositXMLResponse) GetXml() []byte {
+	if x != nil {
+		return x.Xml
+	}
+	return nil
+}
+
+type RefreshArticleDOIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshArticleDOIRequest) Reset() {
+	*x = RefreshArticleDOIRequest{}
+	mi := &file_article_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshArticleDOIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshArticleDOIRequest) ProtoMessage() {}
+
+func (x *RefreshArticleDOIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshArticleDOIRequest.ProtoReflect.Descriptor instead.
+func (*RefreshArticleDOIRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RefreshArticleDOIRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+type RefreshArticleDOIResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshArticleDOIResponse) Reset() {
+	*x = RefreshArticleDOIResponse{}
+	mi := &file_article_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshArticleDOIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshArticleDOIResponse) ProtoMessage() {}
+
+func (x *RefreshArticleDOIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshArticleDOIResponse.ProtoReflect.Descriptor instead.
+func (*RefreshArticleDOIResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RefreshArticleDOIResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type GetArticleByDOIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Doi           string                 `protobuf:"bytes,1,opt,name=doi,proto3" json:"doi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleByDOIRequest) Reset() {
+	*x = GetArticleByDOIRequest{}
+	mi := &file_article_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleByDOIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleByDOIRequest) ProtoMessage() {}
+
+func (x *GetArticleByDOIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleByDOIRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleByDOIRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetArticleByDOIRequest) GetDoi() string {
+	if x != nil {
+		return x.Doi
+	}
+	return ""
+}
+
+type GetArticleByDOIResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleByDOIResponse) Reset() {
+	*x = GetArticleByDOIResponse{}
+	mi := &file_article_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleByDOIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleByDOIResponse) ProtoMessage() {}
+
+func (x *GetArticleByDOIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleByDOIResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleByDOIResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetArticleByDOIResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// ExportedArticle is one rendered citation. CSL-JSON entries are single
+// items; join them into an array to form a bibliography.
+type ExportedArticle struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// BibTeX citation key, also the RIS and CSL-JSON ID; unique per export
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedArticle) Reset() {
+	*x = ExportedArticle{}
+	mi := &file_article_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedArticle) ProtoMessage() {}
+
+func (x *ExportedArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedArticle.ProtoReflect.Descriptor instead.
+func (*ExportedArticle) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExportedArticle) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ExportedArticle) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExportedArticle) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportedArticle) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ExportArticleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// One of "bibtex", "ris", "csl-json" or "jats"
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArticleRequest) Reset() {
+	*x = ExportArticleRequest{}
+	mi := &file_article_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArticleRequest) ProtoMessage() {}
+
+func (x *ExportArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArticleRequest.ProtoReflect.Descriptor instead.
+func (*ExportArticleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExportArticleRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ExportArticleRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *ExportedArticle       `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArticleResponse) Reset() {
+	*x = ExportArticleResponse{}
+	mi := &file_article_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArticleResponse) ProtoMessage() {}
+
+func (x *ExportArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArticleResponse.ProtoReflect.Descriptor instead.
+func (*ExportArticleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportArticleResponse) GetEntry() *ExportedArticle {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// Exactly one of article_ids, journal_id and author_id selects the articles
+type ExportArticlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of "bibtex", "ris", "csl-json" or "jats"
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// At most 1000 IDs, exported in the given order
+	ArticleIds []string `protobuf:"bytes,2,rep,name=article_ids,json=articleIds,proto3" json:"article_ids,omitempty"`
+	JournalId  string   `protobuf:"bytes,3,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	AuthorId   string   `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Only export articles with this status, e.g. "published"
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArticlesRequest) Reset() {
+	*x = ExportArticlesRequest{}
+	mi := &file_article_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArticlesRequest) ProtoMessage() {}
+
+func (x *ExportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ExportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExportArticlesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportArticlesRequest) GetArticleIds() []string {
+	if x != nil {
+		return x.ArticleIds
+	}
+	return nil
+}
+
+func (x *ExportArticlesRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *ExportArticlesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ExportArticlesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ExportArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *ExportedArticle       `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArticlesResponse) Reset() {
+	*x = ExportArticlesResponse{}
+	mi := &file_article_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArticlesResponse) ProtoMessage() {}
+
+func (x *ExportArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ExportArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ExportArticlesResponse) GetEntry() *ExportedArticle {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ImportOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of "bibtex", "ris", "csv" or "jats"
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Validate the records and resolve their journals and authors without
+	// storing anything
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Journal for records that name none
+	JournalId     string `protobuf:"bytes,3,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_article_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportOptions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+// The first message of an import carries the options; the file follows in
+// the data of any number of messages
+type ImportArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ImportOptions         `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportArticlesRequest) Reset() {
+	*x = ImportArticlesRequest{}
+	mi := &file_article_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArticlesRequest) ProtoMessage() {}
+
+func (x *ImportArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ImportArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ImportArticlesRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportArticlesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportRecordResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based position of the record in the file and the line it starts on
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Line  int32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// The record's identifier in the file, e.g. its BibTeX key
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// One of "imported", "valid" (dry run) or "failed"
+	Outcome   string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	ArticleId string `protobuf:"bytes,5,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	JournalId string `protobuf:"bytes,6,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	// Set when the journal was, or in a dry run would be, registered
+	JournalCreated bool   `protobuf:"varint,7,opt,name=journal_created,json=journalCreated,proto3" json:"journal_created,omitempty"`
+	AuthorsCreated int32  `protobuf:"varint,8,opt,name=authors_created,json=authorsCreated,proto3" json:"authors_created,omitempty"`
+	Error          string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of references stored, or in a dry run that would be stored
+	References int32 `protobuf:"varint,10,opt,name=references,proto3" json:"references,omitempty"`
+	// Data of a successful record that was left out, e.g. references
+	// without a DOI
+	Warnings []string `protobuf:"bytes,11,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// IDs of stored articles the imported article closely matches, most
+	// similar first
+	PossibleDuplicates []string `protobuf:"bytes,12,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportRecordResult) Reset() {
+	*x = ImportRecordResult{}
+	mi := &file_article_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRecordResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRecordResult) ProtoMessage() {}
+
+func (x *ImportRecordResult) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRecordResult.ProtoReflect.Descriptor instead.
+func (*ImportRecordResult) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ImportRecordResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportRecordResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRecordResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ImportRecordResult) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ImportRecordResult) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *ImportRecordResult) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *ImportRecordResult) GetJournalCreated() bool {
+	if x != nil {
+		return x.JournalCreated
+	}
+	return false
+}
+
+func (x *ImportRecordResult) GetAuthorsCreated() int32 {
+	if x != nil {
+		return x.AuthorsCreated
+	}
+	return 0
+}
+
+func (x *ImportRecordResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRecordResult) GetReferences() int32 {
+	if x != nil {
+		return x.References
+	}
+	return 0
+}
+
+func (x *ImportRecordResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportRecordResult) GetPossibleDuplicates() []string {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
+type ImportArticlesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DryRun bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total  int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Records imported, or in a dry run found valid
+	Succeeded       int32                 `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed          int32                 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	JournalsCreated int32                 `protobuf:"varint,5,opt,name=journals_created,json=journalsCreated,proto3" json:"journals_created,omitempty"`
+	AuthorsCreated  int32                 `protobuf:"varint,6,opt,name=authors_created,json=authorsCreated,proto3" json:"authors_created,omitempty"`
+	Results         []*ImportRecordResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportArticlesResponse) Reset() {
+	*x = ImportArticlesResponse{}
+	mi := &file_article_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArticlesResponse) ProtoMessage() {}
+
+func (x *ImportArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ImportArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ImportArticlesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportArticlesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetJournalsCreated() int32 {
+	if x != nil {
+		return x.JournalsCreated
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetAuthorsCreated() int32 {
+	if x != nil {
+		return x.AuthorsCreated
+	}
+	return 0
+}
+
+func (x *ImportArticlesResponse) GetResults() []*ImportRecordResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Words are matched after stemming; text in double quotes is a phrase
+type SearchArticlesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Filters applied when set
+	JournalId string `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	AuthorId  string `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Page size, at most 100; 20 when unset
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesRequest) Reset() {
+	*x = SearchArticlesRequest{}
+	mi := &file_article_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesRequest) ProtoMessage() {}
+
+func (x *SearchArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesRequest.ProtoReflect.Descriptor instead.
+func (*SearchArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SearchArticlesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchArticlesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Byte range of a matched word in a snippet's text
+type TextRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_article_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRange) ProtoMessage() {}
+
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TextRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SearchSnippet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "title" or "abstract"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The whole title, or an excerpt of the abstract with ellipses where it
+	// was cut
+	Text          string       `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Highlights    []*TextRange `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSnippet) Reset() {
+	*x = SearchSnippet{}
+	mi := &file_article_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSnippet) ProtoMessage() {}
+
+func (x *SearchSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSnippet.ProtoReflect.Descriptor instead.
+func (*SearchSnippet) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SearchSnippet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchSnippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchSnippet) GetHighlights() []*TextRange {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// BM25 score; only comparable within one query
+	Score         float64          `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Snippets      []*SearchSnippet `protobuf:"bytes,3,rep,name=snippets,proto3" json:"snippets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_article_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SearchResult) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSnippets() []*SearchSnippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+type SearchArticlesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of matching articles over all pages
+	Total         int32           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Results       []*SearchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesResponse) Reset() {
+	*x = SearchArticlesResponse{}
+	mi := &file_article_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesResponse) ProtoMessage() {}
+
+func (x *SearchArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesResponse.ProtoReflect.Descriptor instead.
+func (*SearchArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SearchArticlesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchArticlesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Titles are compared after normalization, so case, accents, punctuation
+// and whitespace make no difference
+type FindArticlesByTitleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Also find similar titles, not only equal ones
+	Fuzzy bool `protobuf:"varint,2,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Trigram similarity fuzzy matches need, between 0 and 1; 0.4 when unset
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Page size, at most 100; 10 when unset
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindArticlesByTitleRequest) Reset() {
+	*x = FindArticlesByTitleRequest{}
+	mi := &file_article_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindArticlesByTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindArticlesByTitleRequest) ProtoMessage() {}
+
+func (x *FindArticlesByTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindArticlesByTitleRequest.ProtoReflect.Descriptor instead.
+func (*FindArticlesByTitleRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *FindArticlesByTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FindArticlesByTitleRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *FindArticlesByTitleRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FindArticlesByTitleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FindArticlesByTitleRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TitleMatch struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Trigram similarity of the normalized titles; 1 for equal titles
+	Similarity float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	// Edit distance between the normalized titles in characters
+	Distance      int32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TitleMatch) Reset() {
+	*x = TitleMatch{}
+	mi := &file_article_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TitleMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleMatch) ProtoMessage() {}
+
+func (x *TitleMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleMatch.ProtoReflect.Descriptor instead.
+func (*TitleMatch) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *TitleMatch) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *TitleMatch) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *TitleMatch) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// Exact matches are ordered by article ID, fuzzy ones by similarity, then
+// distance, then article ID
+type FindArticlesByTitleResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Matches []*TitleMatch          `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Number of matching articles over all pages. Fuzzy lookups only score
+	// the 200 articles sharing the most trigrams with the title, so their
+	// total is at most 200.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Set when a fuzzy lookup reached that cap, so more articles may match
+	TotalCapped   bool `protobuf:"varint,3,opt,name=total_capped,json=totalCapped,proto3" json:"total_capped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindArticlesByTitleResponse) Reset() {
+	*x = FindArticlesByTitleResponse{}
+	mi := &file_article_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindArticlesByTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindArticlesByTitleResponse) ProtoMessage() {}
+
+func (x *FindArticlesByTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindArticlesByTitleResponse.ProtoReflect.Descriptor instead.
+func (*FindArticlesByTitleResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *FindArticlesByTitleResponse) GetMatches() []*TitleMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *FindArticlesByTitleResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindArticlesByTitleResponse) GetTotalCapped() bool {
+	if x != nil {
+		return x.TotalCapped
+	}
+	return false
+}
+
+// Give either an article ID or the title and abstract of a submission that
+// has not been created yet
+type FindSimilarArticlesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Abstract  string                 `protobuf:"bytes,3,opt,name=abstract,proto3" json:"abstract,omitempty"`
+	// Estimated similarity candidates need, between 0 and 1; 0.5 when unset
+	Threshold float64 `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// At most 100; 10 when unset
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarArticlesRequest) Reset() {
+	*x = FindSimilarArticlesRequest{}
+	mi := &file_article_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarArticlesRequest) ProtoMessage() {}
+
+func (x *FindSimilarArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarArticlesRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *FindSimilarArticlesRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *FindSimilarArticlesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FindSimilarArticlesRequest) GetAbstract() string {
+	if x != nil {
+		return x.Abstract
+	}
+	return ""
+}
+
+func (x *FindSimilarArticlesRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FindSimilarArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SimilarArticle struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Estimated Jaccard similarity of the title and abstract word shingles
+	Similarity    float64 `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarArticle) Reset() {
+	*x = SimilarArticle{}
+	mi := &file_article_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarArticle) ProtoMessage() {}
+
+func (x *SimilarArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarArticle.ProtoReflect.Descriptor instead.
+func (*SimilarArticle) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SimilarArticle) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *SimilarArticle) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type FindSimilarArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*SimilarArticle      `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarArticlesResponse) Reset() {
+	*x = FindSimilarArticlesResponse{}
+	mi := &file_article_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarArticlesResponse) ProtoMessage() {}
+
+func (x *FindSimilarArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarArticlesResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FindSimilarArticlesResponse) GetArticles() []*SimilarArticle {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+type GetRelatedArticlesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	// At most 50; the configured list size, ten by default, when unset.
+	// Fewer are returned when fewer published articles are related.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedArticlesRequest) Reset() {
+	*x = GetRelatedArticlesRequest{}
+	mi := &file_article_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedArticlesRequest) ProtoMessage() {}
+
+func (x *GetRelatedArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetRelatedArticlesRequest) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *GetRelatedArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Why two articles are related, each between 0 and 1
+type RecommendationSignals struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cosine similarity of the TF-IDF vectors of the titles and abstracts
+	Content float64 `protobuf:"fixed64,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Share of the authors the articles have in common
+	Authors float64 `protobuf:"fixed64,2,opt,name=authors,proto3" json:"authors,omitempty"`
+	// Articles citing both, relative to the number citing each
+	CoCitation float64 `protobuf:"fixed64,3,opt,name=co_citation,json=coCitation,proto3" json:"co_citation,omitempty"`
+	// 1 for articles of the same journal
+	Journal       float64 `protobuf:"fixed64,4,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationSignals) Reset() {
+	*x = RecommendationSignals{}
+	mi := &file_article_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationSignals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationSignals) ProtoMessage() {}
+
+func (x *RecommendationSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationSignals.ProtoReflect.Descriptor instead.
+func (*RecommendationSignals) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RecommendationSignals) GetContent() float64 {
+	if x != nil {
+		return x.Content
+	}
+	return 0
+}
+
+func (x *RecommendationSignals) GetAuthors() float64 {
+	if x != nil {
+		return x.Authors
+	}
+	return 0
+}
+
+func (x *RecommendationSignals) GetCoCitation() float64 {
+	if x != nil {
+		return x.CoCitation
+	}
+	return 0
+}
+
+func (x *RecommendationSignals) GetJournal() float64 {
+	if x != nil {
+		return x.Journal
+	}
+	return 0
+}
+
+type RelatedArticle struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	// Weighted sum of the signals
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Signals       *RecommendationSignals `protobuf:"bytes,3,opt,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedArticle) Reset() {
+	*x = RelatedArticle{}
+	mi := &file_article_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedArticle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedArticle) ProtoMessage() {}
+
+func (x *RelatedArticle) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedArticle.ProtoReflect.Descriptor instead.
+func (*RelatedArticle) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RelatedArticle) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+func (x *RelatedArticle) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RelatedArticle) GetSignals() *RecommendationSignals {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+type GetRelatedArticlesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Articles []*RelatedArticle      `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	// When the list was computed; unset when none is stored yet
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedArticlesResponse) Reset() {
+	*x = GetRelatedArticlesResponse{}
+	mi := &file_article_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedArticlesResponse) ProtoMessage() {}
+
+func (x *GetRelatedArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedArticlesResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetRelatedArticlesResponse) GetArticles() []*RelatedArticle {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *GetRelatedArticlesResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type SubjectTerm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Empty for top-level terms
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Other names of the term; each name belongs to one term only
+	Synonyms      []string               `protobuf:"bytes,4,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubjectTerm) Reset() {
+	*x = SubjectTerm{}
+	mi := &file_article_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectTerm) ProtoMessage() {}
+
+func (x *SubjectTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectTerm.ProtoReflect.Descriptor instead.
+func (*SubjectTerm) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SubjectTerm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubjectTerm) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SubjectTerm) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *SubjectTerm) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *SubjectTerm) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SubjectTerm) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateSubjectTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *SubjectTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubjectTermRequest) Reset() {
+	*x = CreateSubjectTermRequest{}
+	mi := &file_article_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubjectTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubjectTermRequest) ProtoMessage() {}
+
+func (x *CreateSubjectTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubjectTermRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubjectTermRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateSubjectTermRequest) GetTerm() *SubjectTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type CreateSubjectTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *SubjectTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubjectTermResponse) Reset() {
+	*x = CreateSubjectTermResponse{}
+	mi := &file_article_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubjectTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubjectTermResponse) ProtoMessage() {}
+
+func (x *CreateSubjectTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubjectTermResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubjectTermResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateSubjectTermResponse) GetTerm() *SubjectTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type GetSubjectTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubjectTermRequest) Reset() {
+	*x = GetSubjectTermRequest{}
+	mi := &file_article_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubjectTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubjectTermRequest) ProtoMessage() {}
+
+func (x *GetSubjectTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubjectTermRequest.ProtoReflect.Descriptor instead.
+func (*GetSubjectTermRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetSubjectTermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSubjectTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *SubjectTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubjectTermResponse) Reset() {
+	*x = GetSubjectTermResponse{}
+	mi := &file_article_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubjectTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubjectTermResponse) ProtoMessage() {}
+
+func (x *GetSubjectTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubjectTermResponse.ProtoReflect.Descriptor instead.
+func (*GetSubjectTermResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetSubjectTermResponse) GetTerm() *SubjectTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type ListSubjectTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubjectTermsRequest) Reset() {
+	*x = ListSubjectTermsRequest{}
+	mi := &file_article_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectTermsRequest) ProtoMessage() {}
+
+func (x *ListSubjectTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubjectTermsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubjectTermsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{118}
+}
+
+type ListSubjectTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*SubjectTerm         `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubjectTermsResponse) Reset() {
+	*x = ListSubjectTermsResponse{}
+	mi := &file_article_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubjectTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubjectTermsResponse) ProtoMessage() {}
+
+func (x *ListSubjectTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubjectTermsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubjectTermsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListSubjectTermsResponse) GetTerms() []*SubjectTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type UpdateSubjectTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *SubjectTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubjectTermRequest) Reset() {
+	*x = UpdateSubjectTermRequest{}
+	mi := &file_article_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubjectTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubjectTermRequest) ProtoMessage() {}
+
+func (x *UpdateSubjectTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubjectTermRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubjectTermRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UpdateSubjectTermRequest) GetTerm() *SubjectTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type UpdateSubjectTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *SubjectTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubjectTermResponse) Reset() {
+	*x = UpdateSubjectTermResponse{}
+	mi := &file_article_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubjectTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubjectTermResponse) ProtoMessage() {}
+
+func (x *UpdateSubjectTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubjectTermResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubjectTermResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateSubjectTermResponse) GetTerm() *SubjectTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type DeleteSubjectTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubjectTermRequest) Reset() {
+	*x = DeleteSubjectTermRequest{}
+	mi := &file_article_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubjectTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubjectTermRequest) ProtoMessage() {}
+
+func (x *DeleteSubjectTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubjectTermRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubjectTermRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DeleteSubjectTermRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSubjectTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubjectTermResponse) Reset() {
+	*x = DeleteSubjectTermResponse{}
+	mi := &file_article_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubjectTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubjectTermResponse) ProtoMessage() {}
+
+func (x *DeleteSubjectTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubjectTermResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubjectTermResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{123}
+}
+
+type ListArticlesBySubjectRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TermId string                 `protobuf:"bytes,1,opt,name=term_id,json=termId,proto3" json:"term_id,omitempty"`
+	// Also list the articles of the terms below the given one
+	IncludeDescendants bool `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	// At most 100; 20 when unset
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesBySubjectRequest) Reset() {
+	*x = ListArticlesBySubjectRequest{}
+	mi := &file_article_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesBySubjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesBySubjectRequest) ProtoMessage() {}
+
+func (x *ListArticlesBySubjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesBySubjectRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesBySubjectRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListArticlesBySubjectRequest) GetTermId() string {
+	if x != nil {
+		return x.TermId
+	}
+	return ""
+}
+
+func (x *ListArticlesBySubjectRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListArticlesBySubjectRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListArticlesBySubjectRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListArticlesBySubjectResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Articles []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	// Number of matching articles over all pages
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesBySubjectResponse) Reset() {
+	*x = ListArticlesBySubjectResponse{}
+	mi := &file_article_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesBySubjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesBySubjectResponse) ProtoMessage() {}
+
+func (x *ListArticlesBySubjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesBySubjectResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesBySubjectResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListArticlesBySubjectResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListArticlesBySubjectResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_article_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *WebhookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret used to HMAC-sign payloads; it is never returned by the API
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Event types to deliver; empty subscribes to all events
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_article_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_article_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_article_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{129}
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_article_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_article_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_article_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{132}
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// One of "pending", "succeeded" or "dead_lettered"
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_article_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Filter by status; use "dead_lettered" to inspect the dead-letter store
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_article_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_article_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_article_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_article_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Sequence  int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EntityId  string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Actor     string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operation string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// JSON snapshots of the entity; before is empty for creates
+	Before    string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After     string                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PrevHash  string                 `protobuf:"bytes,8,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash      string                 `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+	// network address of the caller; actor comes from the unauthenticated
+	// x-actor header
+	Peer string `protobuf:"bytes,10,opt,name=peer,proto3" json:"peer,omitempty"`
+	// outbox event the entry was derived from
+	EventId       string `protobuf:"bytes,11,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_article_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *AuditEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Inclusive lower and exclusive upper bound; unset bounds are open
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_article_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListAuditEntriesRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_article_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_article_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{141}
+}
+
+type VerifyAuditLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Number of entries verified before the first broken link
+	VerifiedEntries int64  `protobuf:"varint,2,opt,name=verified_entries,json=verifiedEntries,proto3" json:"verified_entries,omitempty"`
+	Error           string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_article_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_article_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_article_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetVerifiedEntries() int64 {
+	if x != nil {
+		return x.VerifiedEntries
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_article_proto protoreflect.FileDescriptor
+
+const file_article_proto_rawDesc = "" +
+	"\n" +
+	"\rarticle.proto\x12\aarticle\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x03\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\babstract\x18\x03 \x01(\tR\babstract\x12\x1f\n" +
+	"\tauthor_id\x18\x04 \x01(\tB\x02\x18\x01R\bauthorId\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x05 \x01(\tR\tjournalId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12=\n" +
+	"\fpublished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x120\n" +
+	"\aauthors\x18\n" +
+	" \x03(\v2\x16.article.ArticleAuthorR\aauthors\x12%\n" +
+	"\x0ecitation_count\x18\v \x01(\x05R\rcitationCount\x12\x10\n" +
+	"\x03doi\x18\f \x01(\tR\x03doi\x124\n" +
+	"\vdoi_deposit\x18\r \x01(\v2\x13.article.DOIDepositR\n" +
+	"doiDeposit\x12\x1a\n" +
+	"\bkeywords\x18\x0e \x03(\tR\bkeywords\x12\x1a\n" +
+	"\bsubjects\x18\x0f \x03(\tR\bsubjects\"\xd3\x01\n" +
+	"\n" +
+	"DOIDeposit\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\tR\abatchId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12=\n" +
+	"\fsubmitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x01\n" +
+	"\rArticleAuthor\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x14\n" +
+	"\x05orcid\x18\x02 \x01(\tR\x05orcid\x12 \n" +
+	"\vaffiliation\x18\x03 \x01(\tR\vaffiliation\x12$\n" +
+	"\rcorresponding\x18\x04 \x01(\bR\rcorresponding\"\xf0\x01\n" +
+	"\x06Author\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05orcid\x18\x03 \x01(\tR\x05orcid\x12 \n" +
+	"\vaffiliation\x18\x04 \x01(\tR\vaffiliation\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\">\n" +
+	"\x13CreateAuthorRequest\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.article.AuthorR\x06author\"?\n" +
+	"\x14CreateAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.article.AuthorR\x06author\"\"\n" +
+	"\x10GetAuthorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x11GetAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.article.AuthorR\x06author\">\n" +
+	"\x13UpdateAuthorRequest\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.article.AuthorR\x06author\"?\n" +
+	"\x14UpdateAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.article.AuthorR\x06author\"\x14\n" +
+	"\x12ListAuthorsRequest\"@\n" +
+	"\x13ListAuthorsResponse\x12)\n" +
+	"\aauthors\x18\x01 \x03(\v2\x0f.article.AuthorR\aauthors\":\n" +
+	"\x1bListArticlesByAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\"L\n" +
+	"\x1cListArticlesByAuthorResponse\x12,\n" +
+	"\barticles\x18\x01 \x03(\v2\x10.article.ArticleR\barticles\"#\n" +
+	"\x11GetArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x12GetArticleResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"B\n" +
+	"\x14CreateArticleRequest\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\xb8\x01\n" +
+	"\x15CreateArticleResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12H\n" +
+	"\x13possible_duplicates\x18\x02 \x03(\v2\x17.article.SimilarArticleR\x12possibleDuplicates\x12)\n" +
+	"\x10subject_warnings\x18\x03 \x03(\tR\x0fsubjectWarnings\"B\n" +
+	"\x14UpdateArticleRequest\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"n\n" +
+	"\x15UpdateArticleResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12)\n" +
+	"\x10subject_warnings\x18\x02 \x03(\tR\x0fsubjectWarnings\"Z\n" +
+	"\x18TransitionArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"G\n" +
+	"\x19TransitionArticleResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\x90\x01\n" +
+	"\x10StatusTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12*\n" +
+	"\x02at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\")\n" +
+	"\x17GetStatusHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x18GetStatusHistoryResponse\x12;\n" +
+	"\vtransitions\x18\x01 \x03(\v2\x19.article.StatusTransitionR\vtransitions\"l\n" +
+	"\rAuthorCluster\x12)\n" +
+	"\aauthors\x18\x01 \x03(\v2\x0f.article.AuthorR\aauthors\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bevidence\x18\x03 \x03(\tR\bevidence\":\n" +
+	"\x1bFindDuplicateAuthorsRequest\x12\x1b\n" +
+	"\tmin_score\x18\x01 \x01(\x01R\bminScore\"R\n" +
+	"\x1cFindDuplicateAuthorsResponse\x122\n" +
+	"\bclusters\x18\x01 \x03(\v2\x16.article.AuthorClusterR\bclusters\"\xdd\x02\n" +
+	"\vAuthorMerge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x06source\x18\x02 \x01(\v2\x0f.article.AuthorR\x06source\x124\n" +
+	"\rtarget_before\x18\x03 \x01(\v2\x0f.article.AuthorR\ftargetBefore\x12'\n" +
+	"\x06target\x18\x04 \x01(\v2\x0f.article.AuthorR\x06target\x12.\n" +
+	"\x13changed_article_ids\x18\x05 \x03(\tR\x11changedArticleIds\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x127\n" +
+	"\tundone_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bundoneAt\"O\n" +
+	"\x13MergeAuthorsRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"B\n" +
+	"\x14MergeAuthorsResponse\x12*\n" +
+	"\x05merge\x18\x01 \x01(\v2\x14.article.AuthorMergeR\x05merge\"3\n" +
+	"\x16UndoAuthorMergeRequest\x12\x19\n" +
+	"\bmerge_id\x18\x01 \x01(\tR\amergeId\"E\n" +
+	"\x17UndoAuthorMergeResponse\x12*\n" +
+	"\x05merge\x18\x01 \x01(\v2\x14.article.AuthorMergeR\x05merge\"\x19\n" +
+	"\x17ListAuthorMergesRequest\"H\n" +
+	"\x18ListAuthorMergesResponse\x12,\n" +
+	"\x06merges\x18\x01 \x03(\v2\x14.article.AuthorMergeR\x06merges\"\xb2\x03\n" +
+	"\rAuthorMetrics\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\tR\tjournalId\x12\"\n" +
+	"\fpublications\x18\x03 \x01(\x05R\fpublications\x12`\n" +
+	"\x14publications_by_year\x18\x04 \x03(\v2..article.AuthorMetrics.PublicationsByYearEntryR\x12publicationsByYear\x12'\n" +
+	"\x0ftotal_citations\x18\x05 \x01(\x05R\x0etotalCitations\x12\x17\n" +
+	"\ah_index\x18\x06 \x01(\x05R\x06hIndex\x12\x1b\n" +
+	"\ti10_index\x18\a \x01(\x05R\bi10Index\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aE\n" +
+	"\x17PublicationsByYearEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"U\n" +
+	"\x17GetAuthorMetricsRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\tR\tjournalId\"L\n" +
+	"\x18GetAuthorMetricsResponse\x120\n" +
+	"\ametrics\x18\x01 \x01(\v2\x16.article.AuthorMetricsR\ametrics\"i\n" +
+	"\x1cGetJournalLeaderboardRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x14\n" +
+	"\x05order\x18\x02 \x01(\tR\x05order\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Q\n" +
+	"\x1dGetJournalLeaderboardResponse\x120\n" +
+	"\aauthors\x18\x01 \x03(\v2\x16.article.AuthorMetricsR\aauthors\"\xbe\x01\n" +
+	"\bReviewer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12 \n" +
+	"\vaffiliation\x18\x04 \x01(\tR\vaffiliation\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tauthor_id\x18\x06 \x01(\tR\bauthorId\"H\n" +
+	"\x17RegisterReviewerRequest\x12-\n" +
+	"\breviewer\x18\x01 \x01(\v2\x11.article.ReviewerR\breviewer\"I\n" +
+	"\x18RegisterReviewerResponse\x12-\n" +
+	"\breviewer\x18\x01 \x01(\v2\x11.article.ReviewerR\breviewer\"\x16\n" +
+	"\x14ListReviewersRequest\"H\n" +
+	"\x15ListReviewersResponse\x12/\n" +
+	"\treviewers\x18\x01 \x03(\v2\x11.article.ReviewerR\treviewers\"N\n" +
+	"\x17SuggestReviewersRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"w\n" +
+	"\rReviewerMatch\x12-\n" +
+	"\breviewer\x18\x01 \x01(\v2\x11.article.ReviewerR\breviewer\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
+	"\fshared_terms\x18\x03 \x03(\tR\vsharedTerms\"[\n" +
+	"\x10ReviewerConflict\x12-\n" +
+	"\breviewer\x18\x01 \x01(\v2\x11.article.ReviewerR\breviewer\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"\x85\x01\n" +
+	"\x18SuggestReviewersResponse\x120\n" +
+	"\amatches\x18\x01 \x03(\v2\x16.article.ReviewerMatchR\amatches\x127\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x19.article.ReviewerConflictR\tconflicts\"\xc7\x02\n" +
+	"\x10ReviewAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\tR\tarticleId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12;\n" +
+	"\vassigned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x125\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\aoverdue\x18\b \x01(\bR\aoverdue\"\x8e\x01\n" +
+	"\x15AssignReviewerRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x125\n" +
+	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"S\n" +
+	"\x16AssignReviewerResponse\x129\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x19.article.ReviewAssignmentR\n" +
+	"assignment\"=\n" +
+	"\x1cListReviewAssignmentsRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"\\\n" +
+	"\x1dListReviewAssignmentsResponse\x12;\n" +
+	"\vassignments\x18\x01 \x03(\v2\x19.article.ReviewAssignmentR\vassignments\"\x86\x02\n" +
+	"\fReviewReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\tR\fassignmentId\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\tR\tarticleId\x12\x1f\n" +
+	"\vreviewer_id\x18\x04 \x01(\tR\n" +
+	"reviewerId\x12&\n" +
+	"\x0erecommendation\x18\x05 \x01(\tR\x0erecommendation\x12\x1a\n" +
+	"\bcomments\x18\x06 \x01(\tR\bcomments\x12=\n" +
+	"\fsubmitted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\x84\x01\n" +
+	"\x19SubmitReviewReportRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12&\n" +
+	"\x0erecommendation\x18\x02 \x01(\tR\x0erecommendation\x12\x1a\n" +
+	"\bcomments\x18\x03 \x01(\tR\bcomments\"K\n" +
+	"\x1aSubmitReviewReportResponse\x12-\n" +
+	"\x06report\x18\x01 \x01(\v2\x15.article.ReviewReportR\x06report\"9\n" +
+	"\x18ListReviewReportsRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"L\n" +
+	"\x19ListReviewReportsResponse\x12/\n" +
+	"\areports\x18\x01 \x03(\v2\x15.article.ReviewReportR\areports\"\xcd\x01\n" +
+	"\x0eEditorDecision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x02 \x01(\tR\tarticleId\x12\x1b\n" +
+	"\teditor_id\x18\x03 \x01(\tR\beditorId\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x1a\n" +
+	"\bcomments\x18\x05 \x01(\tR\bcomments\x129\n" +
+	"\n" +
+	"decided_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"r\n" +
+	"\x1bRecordEditorDecisionRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x1a\n" +
+	"\bcomments\x18\x03 \x01(\tR\bcomments\"\x7f\n" +
+	"\x1cRecordEditorDecisionResponse\x123\n" +
+	"\bdecision\x18\x01 \x01(\v2\x17.article.EditorDecisionR\bdecision\x12*\n" +
+	"\aarticle\x18\x02 \x01(\v2\x10.article.ArticleR\aarticle\";\n" +
+	"\x1aListEditorDecisionsRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"T\n" +
+	"\x1bListEditorDecisionsResponse\x125\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x17.article.EditorDecisionR\tdecisions\"\xdd\x01\n" +
+	"\x10ArticlePlacement\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x05R\bsequence\x12\x1d\n" +
+	"\n" +
+	"first_page\x18\x04 \x01(\x05R\tfirstPage\x12\x1b\n" +
+	"\tlast_page\x18\x05 \x01(\x05R\blastPage\x127\n" +
+	"\tplaced_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\"N\n" +
+	"\x13PlaceArticleRequest\x127\n" +
+	"\tplacement\x18\x01 \x01(\v2\x19.article.ArticlePlacementR\tplacement\"O\n" +
+	"\x14PlaceArticleResponse\x127\n" +
+	"\tplacement\x18\x01 \x01(\v2\x19.article.ArticlePlacementR\tplacement\";\n" +
+	"\x1aGetArticlePlacementRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"V\n" +
+	"\x1bGetArticlePlacementResponse\x127\n" +
+	"\tplacement\x18\x01 \x01(\v2\x19.article.ArticlePlacementR\tplacement\"5\n" +
+	"\x18ListIssueArticlesRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\"V\n" +
+	"\x19ListIssueArticlesResponse\x129\n" +
+	"\n" +
+	"placements\x18\x01 \x03(\v2\x19.article.ArticlePlacementR\n" +
+	"placements\"\x98\x01\n" +
+	"\tReference\x12*\n" +
+	"\x11citing_article_id\x18\x01 \x01(\tR\x0fcitingArticleId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x03 \x01(\tR\tarticleId\x12\x10\n" +
+	"\x03doi\x18\x04 \x01(\tR\x03doi\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"p\n" +
+	"\x1bSetArticleReferencesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x122\n" +
+	"\n" +
+	"references\x18\x02 \x03(\v2\x12.article.ReferenceR\n" +
+	"references\"R\n" +
+	"\x1cSetArticleReferencesResponse\x122\n" +
+	"\n" +
+	"references\x18\x01 \x03(\v2\x12.article.ReferenceR\n" +
+	"references\"=\n" +
+	"\x1cListArticleReferencesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"S\n" +
+	"\x1dListArticleReferencesResponse\x122\n" +
+	"\n" +
+	"references\x18\x01 \x03(\v2\x12.article.ReferenceR\n" +
+	"references\"N\n" +
+	"\x1bListCitingReferencesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x10\n" +
+	"\x03doi\x18\x02 \x01(\tR\x03doi\"R\n" +
+	"\x1cListCitingReferencesResponse\x122\n" +
+	"\n" +
+	"references\x18\x01 \x03(\v2\x12.article.ReferenceR\n" +
+	"references\"s\n" +
+	"\x17GetCitationGraphRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\"U\n" +
+	"\fCitationNode\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x10\n" +
+	"\x03doi\x18\x02 \x01(\tR\x03doi\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x05R\x05depth\"\x8f\x01\n" +
+	"\x18GetCitationGraphResponse\x12+\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x15.article.CitationNodeR\x05nodes\x12(\n" +
+	"\x05edges\x18\x02 \x03(\v2\x12.article.ReferenceR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\":\n" +
+	"\x19RegisterArticleDOIRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"H\n" +
+	"\x1aRegisterArticleDOIResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"<\n" +
+	"\x1bGetArticleDepositXMLRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"0\n" +
+	"\x1cGetArticleDepositXMLResponse\x12\x10\n" +
+	"\x03xml\x18\x01 \x01(\fR\x03xml\"9\n" +
+	"\x18RefreshArticleDOIRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\"G\n" +
+	"\x19RefreshArticleDOIResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"*\n" +
+	"\x16GetArticleByDOIRequest\x12\x10\n" +
+	"\x03doi\x18\x01 \x01(\tR\x03doi\"E\n" +
+	"\x17GetArticleByDOIResponse\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\"\x7f\n" +
+	"\x0fExportedArticle\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"M\n" +
+	"\x14ExportArticleRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"G\n" +
+	"\x15ExportArticleResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.article.ExportedArticleR\x05entry\"\xa4\x01\n" +
+	"\x15ExportArticlesRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x1f\n" +
+	"\varticle_ids\x18\x02 \x03(\tR\n" +
+	"articleIds\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x03 \x01(\tR\tjournalId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"H\n" +
+	"\x16ExportArticlesResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.article.ExportedArticleR\x05entry\"_\n" +
+	"\rImportOptions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x03 \x01(\tR\tjournalId\"]\n" +
+	"\x15ImportArticlesRequest\x120\n" +
+	"\aoptions\x18\x01 \x01(\v2\x16.article.ImportOptionsR\aoptions\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xfd\x02\n" +
+	"\x12ImportRecordResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x05 \x01(\tR\tarticleId\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x06 \x01(\tR\tjournalId\x12'\n" +
+	"\x0fjournal_created\x18\a \x01(\bR\x0ejournalCreated\x12'\n" +
+	"\x0fauthors_created\x18\b \x01(\x05R\x0eauthorsCreated\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"references\x18\n" +
+	" \x01(\x05R\n" +
+	"references\x12\x1a\n" +
+	"\bwarnings\x18\v \x03(\tR\bwarnings\x12/\n" +
+	"\x13possible_duplicates\x18\f \x03(\tR\x12possibleDuplicates\"\x88\x02\n" +
+	"\x16ImportArticlesResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12)\n" +
+	"\x10journals_created\x18\x05 \x01(\x05R\x0fjournalsCreated\x12'\n" +
+	"\x0fauthors_created\x18\x06 \x01(\x05R\x0eauthorsCreated\x125\n" +
+	"\aresults\x18\a \x03(\v2\x1b.article.ImportRecordResultR\aresults\"\xaf\x01\n" +
+	"\x15SearchArticlesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\tR\tjournalId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"3\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"m\n" +
+	"\rSearchSnippet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x122\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x12.article.TextRangeR\n" +
+	"highlights\"\x84\x01\n" +
+	"\fSearchResult\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x122\n" +
+	"\bsnippets\x18\x03 \x03(\v2\x16.article.SearchSnippetR\bsnippets\"_\n" +
+	"\x16SearchArticlesResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
+	"\aresults\x18\x02 \x03(\v2\x15.article.SearchResultR\aresults\"\x94\x01\n" +
+	"\x1aFindArticlesByTitleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05fuzzy\x18\x02 \x01(\bR\x05fuzzy\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"t\n" +
+	"\n" +
+	"TitleMatch\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\"\x85\x01\n" +
+	"\x1bFindArticlesByTitleResponse\x12-\n" +
+	"\amatches\x18\x01 \x03(\v2\x13.article.TitleMatchR\amatches\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\ftotal_capped\x18\x03 \x01(\bR\vtotalCapped\"\xa1\x01\n" +
+	"\x1aFindSimilarArticlesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\babstract\x18\x03 \x01(\tR\babstract\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\\\n" +
+	"\x0eSimilarArticle\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\"R\n" +
+	"\x1bFindSimilarArticlesResponse\x123\n" +
+	"\barticles\x18\x01 \x03(\v2\x17.article.SimilarArticleR\barticles\"P\n" +
+	"\x19GetRelatedArticlesRequest\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x86\x01\n" +
+	"\x15RecommendationSignals\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\x01R\acontent\x12\x18\n" +
+	"\aauthors\x18\x02 \x01(\x01R\aauthors\x12\x1f\n" +
+	"\vco_citation\x18\x03 \x01(\x01R\n" +
+	"coCitation\x12\x18\n" +
+	"\ajournal\x18\x04 \x01(\x01R\ajournal\"\x8c\x01\n" +
+	"\x0eRelatedArticle\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.article.ArticleR\aarticle\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x128\n" +
+	"\asignals\x18\x03 \x01(\v2\x1e.article.RecommendationSignalsR\asignals\"\x8e\x01\n" +
+	"\x1aGetRelatedArticlesResponse\x123\n" +
+	"\barticles\x18\x01 \x03(\v2\x17.article.RelatedArticleR\barticles\x12;\n" +
+	"\vcomputed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\xe2\x01\n" +
+	"\vSubjectTerm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1a\n" +
+	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x18CreateSubjectTermRequest\x12(\n" +
+	"\x04term\x18\x01 \x01(\v2\x14.article.SubjectTermR\x04term\"E\n" +
+	"\x19CreateSubjectTermResponse\x12(\n" +
+	"\x04term\x18\x01 \x01(\v2\x14.article.SubjectTermR\x04term\"'\n" +
+	"\x15GetSubjectTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x16GetSubjectTermResponse\x12(\n" +
+	"\x04term\x18\x01 \x01(\v2\x14.article.SubjectTermR\x04term\"\x19\n" +
+	"\x17ListSubjectTermsRequest\"F\n" +
+	"\x18ListSubjectTermsResponse\x12*\n" +
+	"\x05terms\x18\x01 \x03(\v2\x14.article.SubjectTermR\x05terms\"D\n" +
+	"\x18UpdateSubjectTermRequest\x12(\n" +
+	"\x04term\x18\x01 \x01(\v2\x14.article.SubjectTermR\x04term\"E\n" +
+	"\x19UpdateSubjectTermResponse\x12(\n" +
+	"\x04term\x18\x01 \x01(\v2\x14.article.SubjectTermR\x04term\"*\n" +
+	"\x18DeleteSubjectTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteSubjectTermResponse\"\x96\x01\n" +
+	"\x1cListArticlesBySubjectRequest\x12\x17\n" +
+	"\aterm_id\x18\x01 \x01(\tR\x06termId\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"c\n" +
+	"\x1dListArticlesBySubjectResponse\x12,\n" +
+	"\barticles\x18\x01 \x03(\v2\x10.article.ArticleR\barticles\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x93\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"m\n" +
+	" CreateWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"e\n" +
+	"!CreateWebhookSubscriptionResponse\x12@\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1c.article.WebhookSubscriptionR\fsubscription\"!\n" +
+	"\x1fListWebhookSubscriptionsRequest\"f\n" +
+	" ListWebhookSubscriptionsResponse\x12B\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1c.article.WebhookSubscriptionR\rsubscriptions\"2\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"#\n" +
+	"!DeleteWebhookSubscriptionResponse\"\xbb\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\a \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"u\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Y\n" +
+	"\x1dListWebhookDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.article.WebhookDeliveryR\n" +
+	"deliveries\":\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"P\n" +
+	"\x18RedeliverWebhookResponse\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.article.WebhookDeliveryR\bdelivery\"\xc1\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x01(\tR\x05after\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n" +
+	"\tprev_hash\x18\b \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\t \x01(\tR\x04hash\x12\x12\n" +
+	"\x04peer\x18\n" +
+	" \x01(\tR\x04peer\x12\x19\n" +
+	"\bevent_id\x18\v \x01(\tR\aeventId\"\xa8\x01\n" +
+	"\x17ListAuditEntriesRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"I\n" +
+	"\x18ListAuditEntriesResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.article.AuditEntryR\aentries\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"o\n" +
+	"\x16VerifyAuditLogResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12)\n" +
+	"\x10verified_entries\x18\x02 \x01(\x03R\x0fverifiedEntries\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error2\x84(\n" +
+	"\x0eArticleService\x12E\n" +
+	"\n" +
+	"GetArticle\x12\x1a.article.GetArticleRequest\x1a\x1b.article.GetArticleResponse\x12N\n" +
+	"\rCreateArticle\x12\x1d.article.CreateArticleRequest\x1a\x1e.article.CreateArticleResponse\x12N\n" +
+	"\rUpdateArticle\x12\x1d.article.UpdateArticleRequest\x1a\x1e.article.UpdateArticleResponse\x12Z\n" +
+	"\x11TransitionArticle\x12!.article.TransitionArticleRequest\x1a\".article.TransitionArticleResponse\x12W\n" +
+	"\x10GetStatusHistory\x12 .article.GetStatusHistoryRequest\x1a!.article.GetStatusHistoryResponse\x12K\n" +
+	"\fCreateAuthor\x12\x1c.article.CreateAuthorRequest\x1a\x1d.article.CreateAuthorResponse\x12B\n" +
+	"\tGetAuthor\x12\x19.article.GetAuthorRequest\x1a\x1a.article.GetAuthorResponse\x12K\n" +
+	"\fUpdateAuthor\x12\x1c.article.UpdateAuthorRequest\x1a\x1d.article.UpdateAuthorResponse\x12H\n" +
+	"\vListAuthors\x12\x1b.article.ListAuthorsRequest\x1a\x1c.article.ListAuthorsResponse\x12c\n" +
+	"\x14ListArticlesByAuthor\x12$.article.ListArticlesByAuthorRequest\x1a%.article.ListArticlesByAuthorResponse\x12c\n" +
+	"\x14FindDuplicateAuthors\x12$.article.FindDuplicateAuthorsRequest\x1a%.article.FindDuplicateAuthorsResponse\x12K\n" +
+	"\fMergeAuthors\x12\x1c.article.MergeAuthorsRequest\x1a\x1d.article.MergeAuthorsResponse\x12T\n" +
+	"\x0fUndoAuthorMerge\x12\x1f.article.UndoAuthorMergeRequest\x1a .article.UndoAuthorMergeResponse\x12W\n" +
+	"\x10ListAuthorMerges\x12 .article.ListAuthorMergesRequest\x1a!.article.ListAuthorMergesResponse\x12W\n" +
+	"\x10GetAuthorMetrics\x12 .article.GetAuthorMetricsRequest\x1a!.article.GetAuthorMetricsResponse\x12f\n" +
+	"\x15GetJournalLeaderboard\x12%.article.GetJournalLeaderboardRequest\x1a&.article.GetJournalLeaderboardResponse\x12W\n" +
+	"\x10RegisterReviewer\x12 .article.RegisterReviewerRequest\x1a!.article.RegisterReviewerResponse\x12N\n" +
+	"\rListReviewers\x12\x1d.article.ListReviewersRequest\x1a\x1e.article.ListReviewersResponse\x12W\n" +
+	"\x10SuggestReviewers\x12 .article.SuggestReviewersRequest\x1a!.article.SuggestReviewersResponse\x12Q\n" +
+	"\x0eAssignReviewer\x12\x1e.article.AssignReviewerRequest\x1a\x1f.article.AssignReviewerResponse\x12f\n" +
+	"\x15ListReviewAssignments\x12%.article.ListReviewAssignmentsRequest\x1a&.article.ListReviewAssignmentsResponse\x12]\n" +
+	"\x12SubmitReviewReport\x12\".article.SubmitReviewReportRequest\x1a#.article.SubmitReviewReportResponse\x12Z\n" +
+	"\x11ListReviewReports\x12!.article.ListReviewReportsRequest\x1a\".article.ListReviewReportsResponse\x12c\n" +
+	"\x14RecordEditorDecision\x12$.article.RecordEditorDecisionRequest\x1a%.article.RecordEditorDecisionResponse\x12`\n" +
+	"\x13ListEditorDecisions\x12#.article.ListEditorDecisionsRequest\x1a$.article.ListEditorDecisionsResponse\x12K\n" +
+	"\fPlaceArticle\x12\x1c.article.PlaceArticleRequest\x1a\x1d.article.PlaceArticleResponse\x12`\n" +
+	"\x13GetArticlePlacement\x12#.article.GetArticlePlacementRequest\x1a$.article.GetArticlePlacementResponse\x12Z\n" +
+	"\x11ListIssueArticles\x12!.article.ListIssueArticlesRequest\x1a\".article.ListIssueArticlesResponse\x12c\n" +
+	"\x14SetArticleReferences\x12$.article.SetArticleReferencesRequest\x1a%.article.SetArticleReferencesResponse\x12f\n" +
+	"\x15ListArticleReferences\x12%.article.ListArticleReferencesRequest\x1a&.article.ListArticleReferencesResponse\x12c\n" +
+	"\x14ListCitingReferences\x12$.article.ListCitingReferencesRequest\x1a%.article.ListCitingReferencesResponse\x12W\n" +
+	"\x10GetCitationGraph\x12 .article.GetCitationGraphRequest\x1a!.article.GetCitationGraphResponse\x12]\n" +
+	"\x12RegisterArticleDOI\x12\".article.RegisterArticleDOIRequest\x1a#.article.RegisterArticleDOIResponse\x12c\n" +
+	"\x14GetArticleDepositXML\x12$.article.GetArticleDepositXMLRequest\x1a%.article.GetArticleDepositXMLResponse\x12Z\n" +
+	"\x11RefreshArticleDOI\x12!.article.RefreshArticleDOIRequest\x1a\".article.RefreshArticleDOIResponse\x12T\n" +
+	"\x0fGetArticleByDOI\x12\x1f.article.GetArticleByDOIRequest\x1a .article.GetArticleByDOIResponse\x12N\n" +
+	"\rExportArticle\x12\x1d.article.ExportArticleRequest\x1a\x1e.article.ExportArticleResponse\x12S\n" +
+	"\x0eExportArticles\x12\x1e.article.ExportArticlesRequest\x1a\x1f.article.ExportArticlesResponse0\x01\x12S\n" +
+	"\x0eImportArticles\x12\x1e.article.ImportArticlesRequest\x1a\x1f.article.ImportArticlesResponse(\x01\x12Q\n" +
+	"\x0eSearchArticles\x12\x1e.article.SearchArticlesRequest\x1a\x1f.article.SearchArticlesResponse\x12`\n" +
+	"\x13FindArticlesByTitle\x12#.article.FindArticlesByTitleRequest\x1a$.article.FindArticlesByTitleResponse\x12`\n" +
+	"\x13FindSimilarArticles\x12#.article.FindSimilarArticlesRequest\x1a$.article.FindSimilarArticlesResponse\x12]\n" +
+	"\x12GetRelatedArticles\x12\".article.GetRelatedArticlesRequest\x1a#.article.GetRelatedArticlesResponse\x12Z\n" +
+	"\x11CreateSubjectTerm\x12!.article.CreateSubjectTermRequest\x1a\".article.CreateSubjectTermResponse\x12Q\n" +
+	"\x0eGetSubjectTerm\x12\x1e.article.GetSubjectTermRequest\x1a\x1f.article.GetSubjectTermResponse\x12W\n" +
+	"\x10ListSubjectTerms\x12 .article.ListSubjectTermsRequest\x1a!.article.ListSubjectTermsResponse\x12Z\n" +
+	"\x11UpdateSubjectTerm\x12!.article.UpdateSubjectTermRequest\x1a\".article.UpdateSubjectTermResponse\x12Z\n" +
+	"\x11DeleteSubjectTerm\x12!.article.DeleteSubjectTermRequest\x1a\".article.DeleteSubjectTermResponse\x12f\n" +
+	"\x15ListArticlesBySubject\x12%.article.ListArticlesBySubjectRequest\x1a&.article.ListArticlesBySubjectResponse\x12r\n" +
+	"\x19CreateWebhookSubscription\x12).article.CreateWebhookSubscriptionRequest\x1a*.article.CreateWebhookSubscriptionResponse\x12o\n" +
+	"\x18ListWebhookSubscriptions\x12(.article.ListWebhookSubscriptionsRequest\x1a).article.ListWebhookSubscriptionsResponse\x12r\n" +
+	"\x19DeleteWebhookSubscription\x12).article.DeleteWebhookSubscriptionRequest\x1a*.article.DeleteWebhookSubscriptionResponse\x12f\n" +
+	"\x15ListWebhookDeliveries\x12%.article.ListWebhookDeliveriesRequest\x1a&.article.ListWebhookDeliveriesResponse\x12W\n" +
+	"\x10RedeliverWebhook\x12 .article.RedeliverWebhookRequest\x1a!.article.RedeliverWebhookResponse\x12W\n" +
+	"\x10ListAuditEntries\x12 .article.ListAuditEntriesRequest\x1a!.article.ListAuditEntriesResponse\x12Q\n" +
+	"\x0eVerifyAuditLog\x12\x1e.article.VerifyAuditLogRequest\x1a\x1f.article.VerifyAuditLogResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_article_proto_rawDescOnce sync.Once
+	file_article_proto_rawDescData []byte
+)
+
+func file_article_proto_rawDescGZIP() []byte {
+	file_article_proto_rawDescOnce.Do(func() {
+		file_article_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_article_proto_rawDesc), len(file_article_proto_rawDesc)))
+	})
+	return file_article_proto_rawDescData
+}
+
+var file_article_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
+var file_article_proto_goTypes = []any{
+	(*Article)(nil),                           // 0: article.Article
+	(*DOIDeposit)(nil),                        // 1: article.DOIDeposit
+	(*ArticleAuthor)(nil),                     // 2: article.ArticleAuthor
+	(*Author)(nil),                            // 3: article.Author
+	(*CreateAuthorRequest)(nil),               // 4: article.CreateAuthorRequest
+	(*CreateAuthorResponse)(nil),              // 5: article.CreateAuthorResponse
+	(*GetAuthorRequest)(nil),                  // 6: article.GetAuthorRequest
+	(*GetAuthorResponse)(nil),                 // 7: article.GetAuthorResponse
+	(*UpdateAuthorRequest)(nil),               // 8: article.UpdateAuthorRequest
+	(*UpdateAuthorResponse)(nil),              // 9: article.UpdateAuthorResponse
+	(*ListAuthorsRequest)(nil),                // 10: article.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),               // 11: article.ListAuthorsResponse
+	(*ListArticlesByAuthorRequest)(nil),       // 12: article.ListArticlesByAuthorRequest
+	(*ListArticlesByAuthorResponse)(nil),      // 13: article.ListArticlesByAuthorResponse
+	(*GetArticleRequest)(nil),                 // 14: article.GetArticleRequest
+	(*GetArticleResponse)(nil),                // 15: article.GetArticleResponse
+	(*CreateArticleRequest)(nil),              // 16: article.CreateArticleRequest
+	(*CreateArticleResponse)(nil),             // 17: article.CreateArticleResponse
+	(*UpdateArticleRequest)(nil),              // 18: article.UpdateArticleRequest
+	(*UpdateArticleResponse)(nil),             // 19: article.UpdateArticleResponse
+	(*TransitionArticleRequest)(nil),          // 20: article.TransitionArticleRequest
+	(*TransitionArticleResponse)(nil),         // 21: article.TransitionArticleResponse
+	(*StatusTransition)(nil),                  // 22: article.StatusTransition
+	(*GetStatusHistoryRequest)(nil),           // 23: article.GetStatusHistoryRequest
+	(*GetStatusHistoryResponse)(nil),          // 24: article.GetStatusHistoryResponse
+	(*AuthorCluster)(nil),                     // 25: article.AuthorCluster
+	(*FindDuplicateAuthorsRequest)(nil),       // 26: article.FindDuplicateAuthorsRequest
+	(*FindDuplicateAuthorsResponse)(nil),      // 27: article.FindDuplicateAuthorsResponse
+	(*AuthorMerge)(nil),                       // 28: article.AuthorMerge
+	(*MergeAuthorsRequest)(nil),               // 29: article.MergeAuthorsRequest
+	(*MergeAuthorsResponse)(nil),              // 30: article.MergeAuthorsResponse
+	(*UndoAuthorMergeRequest)(nil),            // 31: article.UndoAuthorMergeRequest
+	(*UndoAuthorMergeResponse)(nil),           // 32: article.UndoAuthorMergeResponse
+	(*ListAuthorMergesRequest)(nil),           // 33: article.ListAuthorMergesRequest
+	(*ListAuthorMergesResponse)(nil),          // 34: article.ListAuthorMergesResponse
+	(*AuthorMetrics)(nil),                     // 35: article.AuthorMetrics
+	(*GetAuthorMetricsRequest)(nil),           // 36: article.GetAuthorMetricsRequest
+	(*GetAuthorMetricsResponse)(nil),          // 37: article.GetAuthorMetricsResponse
+	(*GetJournalLeaderboardRequest)(nil),      // 38: article.GetJournalLeaderboardRequest
+	(*GetJournalLeaderboardResponse)(nil),     // 39: article.GetJournalLeaderboardResponse
+	(*Reviewer)(nil),                          // 40: article.Reviewer
+	(*RegisterReviewerRequest)(nil),           // 41: article.RegisterReviewerRequest
+	(*RegisterReviewerResponse)(nil),          // 42: article.RegisterReviewerResponse
+	(*ListReviewersRequest)(nil),              // 43: article.ListReviewersRequest
+	(*ListReviewersResponse)(nil),             // 44: article.ListReviewersResponse
+	(*SuggestReviewersRequest)(nil),           // 45: article.SuggestReviewersRequest
+	(*ReviewerMatch)(nil),                     // 46: article.ReviewerMatch
+	(*ReviewerConflict)(nil),                  // 47: article.ReviewerConflict
+	(*SuggestReviewersResponse)(nil),          // 48: article.SuggestReviewersResponse
+	(*ReviewAssignment)(nil),                  // 49: article.ReviewAssignment
+	(*AssignReviewerRequest)(nil),             // 50: article.AssignReviewerRequest
+	(*AssignReviewerResponse)(nil),            // 51: article.AssignReviewerResponse
+	(*ListReviewAssignmentsRequest)(nil),      // 52: article.ListReviewAssignmentsRequest
+	(*ListReviewAssignmentsResponse)(nil),     // 53: article.ListReviewAssignmentsResponse
+	(*ReviewReport)(nil),                      // 54: article.ReviewReport
+	(*SubmitReviewReportRequest)(nil),         // 55: article.SubmitReviewReportRequest
+	(*SubmitReviewReportResponse)(nil),        // 56: article.SubmitReviewReportResponse
+	(*ListReviewReportsRequest)(nil),          // 57: article.ListReviewReportsRequest
+	(*ListReviewReportsResponse)(nil),         // 58: article.ListReviewReportsResponse
+	(*EditorDecision)(nil),                    // 59: article.EditorDecision
+	(*RecordEditorDecisionRequest)(nil),       // 60: article.RecordEditorDecisionRequest
+	(*RecordEditorDecisionResponse)(nil),      // 61: article.RecordEditorDecisionResponse
+	(*ListEditorDecisionsRequest)(nil),        // 62: article.ListEditorDecisionsRequest
+	(*ListEditorDecisionsResponse)(nil),       // 63: article.ListEditorDecisionsResponse
+	(*ArticlePlacement)(nil),                  // 64: article.ArticlePlacement
+	(*PlaceArticleRequest)(nil),               // 65: article.PlaceArticleRequest
+	(*PlaceArticleResponse)(nil),              // 66: article.PlaceArticleResponse
+	(*GetArticlePlacementRequest)(nil),        // 67: article.GetArticlePlacementRequest
+	(*GetArticlePlacementResponse)(nil),       // 68: article.GetArticlePlacementResponse
+	(*ListIssueArticlesRequest)(nil),          // 69: article.ListIssueArticlesRequest
+	(*ListIssueArticlesResponse)(nil),         // 70: article.ListIssueArticlesResponse
+	(*Reference)(nil),                         // 71: article.Reference
+	(*SetArticleReferencesRequest)(nil),       // 72: article.SetArticleReferencesRequest
+	(*SetArticleReferencesResponse)(nil),      // 73: article.SetArticleReferencesResponse
+	(*ListArticleReferencesRequest)(nil),      // 74: article.ListArticleReferencesRequest
+	(*ListArticleReferencesResponse)(nil),     // 75: article.ListArticleReferencesResponse
+	(*ListCitingReferencesRequest)(nil),       // 76: article.ListCitingReferencesRequest
+	(*ListCitingReferencesResponse)(nil),      // 77: article.ListCitingReferencesResponse
+	(*GetCitationGraphRequest)(nil),           // 78: article.GetCitationGraphRequest
+	(*CitationNode)(nil),                      // 79: article.CitationNode
+	(*GetCitationGraphResponse)(nil),          // 80: article.GetCitationGraphResponse
+	(*RegisterArticleDOIRequest)(nil),         // 81: article.RegisterArticleDOIRequest
+	(*RegisterArticleDOIResponse)(nil),        // 82: article.RegisterArticleDOIResponse
+	(*GetArticleDepositXMLRequest)(nil),       // 83: article.GetArticleDepositXMLRequest
+	(*GetArticleDepositXMLResponse)(nil),      // 84: article.GetArticleDepositXMLResponse
+	(*RefreshArticleDOIRequest)(nil),          // 85: article.RefreshArticleDOIRequest
+	(*RefreshArticleDOIResponse)(nil),         // 86: article.RefreshArticleDOIResponse
+	(*GetArticleByDOIRequest)(nil),            // 87: article.GetArticleByDOIRequest
+	(*GetArticleByDOIResponse)(nil),           // 88: article.GetArticleByDOIResponse
+	(*ExportedArticle)(nil),                   // 89: article.ExportedArticle
+	(*ExportArticleRequest)(nil),              // 90: article.ExportArticleRequest
+	(*ExportArticleResponse)(nil),             // 91: article.ExportArticleResponse
+	(*ExportArticlesRequest)(nil),             // 92: article.ExportArticlesRequest
+	(*ExportArticlesResponse)(nil),            // 93: article.ExportArticlesResponse
+	(*ImportOptions)(nil),                     // 94: article.ImportOptions
+	(*ImportArticlesRequest)(nil),             // 95: article.ImportArticlesRequest
+	(*ImportRecordResult)(nil),                // 96: article.ImportRecordResult
+	(*ImportArticlesResponse)(nil),            // 97: article.ImportArticlesResponse
+	(*SearchArticlesRequest)(nil),             // 98: article.SearchArticlesRequest
+	(*TextRange)(nil),                         // 99: article.TextRange
+	(*SearchSnippet)(nil),                     // 100: article.SearchSnippet
+	(*SearchResult)(nil),                      // 101: article.SearchResult
+	(*SearchArticlesResponse)(nil),            // 102: article.SearchArticlesResponse
+	(*FindArticlesByTitleRequest)(nil),        // 103: article.FindArticlesByTitleRequest
+	(*TitleMatch)(nil),                        // 104: article.TitleMatch
+	(*FindArticlesByTitleResponse)(nil),       // 105: article.FindArticlesByTitleResponse
+	(*FindSimilarArticlesRequest)(nil),        // 106: article.FindSimilarArticlesRequest
+	(*SimilarArticle)(nil),                    // 107: article.SimilarArticle
+	(*FindSimilarArticlesResponse)(nil),       // 108: article.FindSimilarArticlesResponse
+	(*GetRelatedArticlesRequest)(nil),         // 109: article.GetRelatedArticlesRequest
+	(*RecommendationSignals)(nil),             // 110: article.RecommendationSignals
+	(*RelatedArticle)(nil),                    // 111: article.RelatedArticle
+	(*GetRelatedArticlesResponse)(nil),        // 112: article.GetRelatedArticlesResponse
+	(*SubjectTerm)(nil),                       // 113: article.SubjectTerm
+	(*CreateSubjectTermRequest)(nil),          // 114: article.CreateSubjectTermRequest
+	(*CreateSubjectTermResponse)(nil),         // 115: article.CreateSubjectTermResponse
+	(*GetSubjectTermRequest)(nil),             // 116: article.GetSubjectTermRequest
+	(*GetSubjectTermResponse)(nil),            // 117: article.GetSubjectTermResponse
+	(*ListSubjectTermsRequest)(nil),           // 118: article.ListSubjectTermsRequest
+	(*ListSubjectTermsResponse)(nil),          // 119: article.ListSubjectTermsResponse
+	(*UpdateSubjectTermRequest)(nil),          // 120: article.UpdateSubjectTermRequest
+	(*UpdateSubjectTermResponse)(nil),         // 121: article.UpdateSubjectTermResponse
+	(*DeleteSubjectTermRequest)(nil),          // 122: article.DeleteSubjectTermRequest
+	(*DeleteSubjectTermResponse)(nil),         // 123: article.DeleteSubjectTermResponse
+	(*ListArticlesBySubjectRequest)(nil),      // 124: article.ListArticlesBySubjectRequest
+	(*ListArticlesBySubjectResponse)(nil),     // 125: article.ListArticlesBySubjectResponse
+	(*WebhookSubscription)(nil),               // 126: article.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),  // 127: article.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 128: article.CreateWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsRequest)(nil),   // 129: article.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 130: article.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 131: article.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 132: article.DeleteWebhookSubscriptionResponse
+	(*WebhookDelivery)(nil),                   // 133: article.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),      // 134: article.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 135: article.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),           // 136: article.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),          // 137: article.RedeliverWebhookResponse
+	(*AuditEntry)(nil),                        // 138: article.AuditEntry
+	(*ListAuditEntriesRequest)(nil),           // 139: article.ListAuditEntriesRequest
+	(*ListAuditEntriesResponse)(nil),          // 140: article.ListAuditEntriesResponse
+	(*VerifyAuditLogRequest)(nil),             // 141: article.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil),            // 142: article.VerifyAuditLogResponse
+	nil,                                       // 143: article.AuthorMetrics.PublicationsByYearEntry
+	(*timestamppb.Timestamp)(nil),             // 144: google.protobuf.Timestamp
+}
+var file_article_proto_depIdxs = []int32{
+	144, // 0: article.Article.published_at:type_name -> google.protobuf.Timestamp
+	2,   // 1: article.Article.authors:type_name -> article.ArticleAuthor
+	1,   // 2: article.Article.doi_deposit:type_name -> article.DOIDeposit
+	144, // 3: article.DOIDeposit.submitted_at:type_name -> google.protobuf.Timestamp
+	144, // 4: article.DOIDeposit.updated_at:type_name -> google.protobuf.Timestamp
+	144, // 5: article.Author.created_at:type_name -> google.protobuf.Timestamp
+	144, // 6: article.Author.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 7: article.CreateAuthorRequest.author:type_name -> article.Author
+	3,   // 8: article.CreateAuthorResponse.author:type_name -> article.Author
+	3,   // 9: article.GetAuthorResponse.author:type_name -> article.Author
+	3,   // 10: article.UpdateAuthorRequest.author:type_name -> article.Author
+	3,   // 11: article.UpdateAuthorResponse.author:type_name -> article.Author
+	3,   // 12: article.ListAuthorsResponse.authors:type_name -> article.Author
+	0,   // 13: article.ListArticlesByAuthorResponse.articles:type_name -> article.Article
+	0,   // 14: article.GetArticleResponse.article:type_name -> article.Article
+	0,   // 15: article.CreateArticleRequest.article:type_name -> article.Article
+	0,   // 16: article.CreateArticleResponse.article:type_name -> article.Article
+	107, // 17: article.CreateArticleResponse.possible_duplicates:type_name -> article.SimilarArticle
+	0,   // 18: article.UpdateArticleRequest.article:type_name -> article.Article
+	0,   // 19: article.UpdateArticleResponse.article:type_name -> article.Article
+	0,   // 20: article.TransitionArticleResponse.article:type_name -> article.Article
+	144, // 21: article.StatusTransition.at:type_name -> google.protobuf.Timestamp
+	22,  // 22: article.GetStatusHistoryResponse.transitions:type_name -> article.StatusTransition
+	3,   // 23: article.AuthorCluster.authors:type_name -> article.Author
+	25,  // 24: article.FindDuplicateAuthorsResponse.clusters:type_name -> article.AuthorCluster
+	3,   // 25: article.AuthorMerge.source:type_name -> article.Author
+	3,   // 26: article.AuthorMerge.target_before:type_name -> article.Author
+	3,   // 27: article.AuthorMerge.target:type_name -> article.Author
+	144, // 28: article.AuthorMerge.merged_at:type_name -> google.protobuf.Timestamp
+	144, // 29: article.AuthorMerge.undone_at:type_name -> google.protobuf.Timestamp
+	28,  // 30: article.MergeAuthorsResponse.merge:type_name -> article.AuthorMerge
+	28,  // 31: article.UndoAuthorMergeResponse.merge:type_name -> article.AuthorMerge
+	28,  // 32: article.ListAuthorMergesResponse.merges:type_name -> article.AuthorMerge
+	143, // 33: article.AuthorMetrics.publications_by_year:type_name -> article.AuthorMetrics.PublicationsByYearEntry
+	144, // 34: article.AuthorMetrics.updated_at:type_name -> google.protobuf.Timestamp
+	35,  // 35: article.GetAuthorMetricsResponse.metrics:type_name -> article.AuthorMetrics
+	35,  // 36: article.GetJournalLeaderboardResponse.authors:type_name -> article.AuthorMetrics
+	144, // 37: article.Reviewer.created_at:type_name -> google.protobuf.Timestamp
+	40,  // 38: article.RegisterReviewerRequest.reviewer:type_name -> article.Reviewer
+	40,  // 39: article.RegisterReviewerResponse.reviewer:type_name -> article.Reviewer
+	40,  // 40: article.ListReviewersResponse.reviewers:type_name -> article.Reviewer
+	40,  // 41: article.ReviewerMatch.reviewer:type_name -> article.Reviewer
+	40,  // 42: article.ReviewerConflict.reviewer:type_name -> article.Reviewer
+	46,  // 43: article.SuggestReviewersResponse.matches:type_name -> article.ReviewerMatch
+	47,  // 44: article.SuggestReviewersResponse.conflicts:type_name -> article.ReviewerConflict
+	144, // 45: article.ReviewAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	144, // 46: article.ReviewAssignment.due_date:type_name -> google.protobuf.Timestamp
+	144, // 47: article.ReviewAssignment.completed_at:type_name -> google.protobuf.Timestamp
+	144, // 48: article.AssignReviewerRequest.due_date:type_name -> google.protobuf.Timestamp
+	49,  // 49: article.AssignReviewerResponse.assignment:type_name -> article.ReviewAssignment
+	49,  // 50: article.ListReviewAssignmentsResponse.assignments:type_name -> article.ReviewAssignment
+	144, // 51: article.ReviewReport.submitted_at:type_name -> google.protobuf.Timestamp
+	54,  // 52: article.SubmitReviewReportResponse.report:type_name -> article.ReviewReport
+	54,  // 53: article.ListReviewReportsResponse.reports:type_name -> article.ReviewReport
+	144, // 54: article.EditorDecision.decided_at:type_name -> google.protobuf.Timestamp
+	59,  // 55: article.RecordEditorDecisionResponse.decision:type_name -> article.EditorDecision
+	0,   // 56: article.RecordEditorDecisionResponse.article:type_name -> article.Article
+	59,  // 57: article.ListEditorDecisionsResponse.decisions:type_name -> article.EditorDecision
+	144, // 58: article.ArticlePlacement.placed_at:type_name -> google.protobuf.Timestamp
+	64,  // 59: article.PlaceArticleRequest.placement:type_name -> article.ArticlePlacement
+	64,  // 60: article.PlaceArticleResponse.placement:type_name -> article.ArticlePlacement
+	64,  // 61: article.GetArticlePlacementResponse.placement:type_name -> article.ArticlePlacement
+	64,  // 62: article.ListIssueArticlesResponse.placements:type_name -> article.ArticlePlacement
+	71,  // 63: article.SetArticleReferencesRequest.references:type_name -> article.Reference
+	71,  // 64: article.SetArticleReferencesResponse.references:type_name -> article.Reference
+	71,  // 65: article.ListArticleReferencesResponse.references:type_name -> article.Reference
+	71,  // 66: article.ListCitingReferencesResponse.references:type_name -> article.Reference
+	79,  // 67: article.GetCitationGraphResponse.nodes:type_name -> article.CitationNode
+	71,  // 68: article.GetCitationGraphResponse.edges:type_name -> article.Reference
+	0,   // 69: article.RegisterArticleDOIResponse.article:type_name -> article.Article
+	0,   // 70: article.RefreshArticleDOIResponse.article:type_name -> article.Article
+	0,   // 71: article.GetArticleByDOIResponse.article:type_name -> article.Article
+	89,  // 72: article.ExportArticleResponse.entry:type_name -> article.ExportedArticle
+	89,  // 73: article.ExportArticlesResponse.entry:type_name -> article.ExportedArticle
+	94,  // 74: article.ImportArticlesRequest.options:type_name -> article.ImportOptions
+	96,  // 75: article.ImportArticlesResponse.results:type_name -> article.ImportRecordResult
+	99,  // 76: article.SearchSnippet.highlights:type_name -> article.TextRange
+	0,   // 77: article.SearchResult.article:type_name -> article.Article
+	100, // 78: article.SearchResult.snippets:type_name -> article.SearchSnippet
+	101, // 79: article.SearchArticlesResponse.results:type_name -> article.SearchResult
+	0,   // 80: article.TitleMatch.article:type_name -> article.Article
+	104, // 81: article.FindArticlesByTitleResponse.matches:type_name -> article.TitleMatch
+	0,   // 82: article.SimilarArticle.article:type_name -> article.Article
+	107, // 83: article.FindSimilarArticlesResponse.articles:type_name -> article.SimilarArticle
+	0,   // 84: article.RelatedArticle.article:type_name -> article.Article
+	110, // 85: article.RelatedArticle.signals:type_name -> article.RecommendationSignals
+	111, // 86: article.GetRelatedArticlesResponse.articles:type_name -> article.RelatedArticle
+	144, // 87: article.GetRelatedArticlesResponse.computed_at:type_name -> google.protobuf.Timestamp
+	144, // 88: article.SubjectTerm.created_at:type_name -> google.protobuf.Timestamp
+	144, // 89: article.SubjectTerm.updated_at:type_name -> google.protobuf.Timestamp
+	113, // 90: article.CreateSubjectTermRequest.term:type_name -> article.SubjectTerm
+	113, // 91: article.CreateSubjectTermResponse.term:type_name -> article.SubjectTerm
+	113, // 92: article.GetSubjectTermResponse.term:type_name -> article.SubjectTerm
+	113, // 93: article.ListSubjectTermsResponse.terms:type_name -> article.SubjectTerm
+	113, // 94: article.UpdateSubjectTermRequest.term:type_name -> article.SubjectTerm
+	113, // 95: article.UpdateSubjectTermResponse.term:type_name -> article.SubjectTerm
+	0,   // 96: article.ListArticlesBySubjectResponse.articles:type_name -> article.Article
+	144, // 97: article.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	126, // 98: article.CreateWebhookSubscriptionResponse.subscription:type_name -> article.WebhookSubscription
+	126, // 99: article.ListWebhookSubscriptionsResponse.subscriptions:type_name -> article.WebhookSubscription
+	144, // 100: article.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	144, // 101: article.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	144, // 102: article.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 103: article.ListWebhookDeliveriesResponse.deliveries:type_name -> article.WebhookDelivery
+	133, // 104: article.RedeliverWebhookResponse.delivery:type_name -> article.WebhookDelivery
+	144, // 105: article.AuditEntry.timestamp:type_name -> google.protobuf.Timestamp
+	144, // 106: article.ListAuditEntriesRequest.from:type_name -> google.protobuf.Timestamp
+	144, // 107: article.ListAuditEntriesRequest.to:type_name -> google.protobuf.Timestamp
+	138, // 108: article.ListAuditEntriesResponse.entries:type_name -> article.AuditEntry
+	14,  // 109: article.ArticleService.GetArticle:input_type -> article.GetArticleRequest
+	16,  // 110: article.ArticleService.CreateArticle:input_type -> article.CreateArticleRequest
+	18,  // 111: article.ArticleService.UpdateArticle:input_type -> article.UpdateArticleRequest
+	20,  // 112: article.ArticleService.TransitionArticle:input_type -> article.TransitionArticleRequest
+	23,  // 113: article.ArticleService.GetStatusHistory:input_type -> article.GetStatusHistoryRequest
+	4,   // 114: article.ArticleService.CreateAuthor:input_type -> article.CreateAuthorRequest
+	6,   // 115: article.ArticleService.GetAuthor:input_type -> article.GetAuthorRequest
+	8,   // 116: article.ArticleService.UpdateAuthor:input_type -> article.UpdateAuthorRequest
+	10,  // 117: article.ArticleService.ListAuthors:input_type -> article.ListAuthorsRequest
+	12,  // 118: article.ArticleService.ListArticlesByAuthor:input_type -> article.ListArticlesByAuthorRequest
+	26,  // 119: article.ArticleService.FindDuplicateAuthors:input_type -> article.FindDuplicateAuthorsRequest
+	29,  // 120: article.ArticleService.MergeAuthors:input_type -> article.MergeAuthorsRequest
+	31,  // 121: article.ArticleService.UndoAuthorMerge:input_type -> article.UndoAuthorMergeRequest
+	33,  // 122: article.ArticleService.ListAuthorMerges:input_type -> article.ListAuthorMergesRequest
+	36,  // 123: article.ArticleService.GetAuthorMetrics:input_type -> article.GetAuthorMetricsRequest
+	38,  // 124: article.ArticleService.GetJournalLeaderboard:input_type -> article.GetJournalLeaderboardRequest
+	41,  // 125: article.ArticleService.RegisterReviewer:input_type -> article.RegisterReviewerRequest
+	43,  // 126: article.ArticleService.ListReviewers:input_type -> article.ListReviewersRequest
+	45,  // 127: article.ArticleService.SuggestReviewers:input_type -> article.SuggestReviewersRequest
+	50,  // 128: article.ArticleService.AssignReviewer:input_type -> article.AssignReviewerRequest
+	52,  // 129: article.ArticleService.ListReviewAssignments:input_type -> article.ListReviewAssignmentsRequest
+	55,  // 130: article.ArticleService.SubmitReviewReport:input_type -> article.SubmitReviewReportRequest
+	57,  // 131: article.ArticleService.ListReviewReports:input_type -> article.ListReviewReportsRequest
+	60,  // 132: article.ArticleService.RecordEditorDecision:input_type -> article.RecordEditorDecisionRequest
+	62,  // 133: article.ArticleService.ListEditorDecisions:input_type -> article.ListEditorDecisionsRequest
+	65,  // 134: article.ArticleService.PlaceArticle:input_type -> article.PlaceArticleRequest
+	67,  // 135: article.ArticleService.GetArticlePlacement:input_type -> article.GetArticlePlacementRequest
+	69,  // 136: article.ArticleService.ListIssueArticles:input_type -> article.ListIssueArticlesRequest
+	72,  // 137: article.ArticleService.SetArticleReferences:input_type -> article.SetArticleReferencesRequest
+	74,  // 138: article.ArticleService.ListArticleReferences:input_type -> article.ListArticleReferencesRequest
+	76,  // 139: article.ArticleService.ListCitingReferences:input_type -> article.ListCitingReferencesRequest
+	78,  // 140: article.ArticleService.GetCitationGraph:input_type -> article.GetCitationGraphRequest
+	81,  // 141: article.ArticleService.RegisterArticleDOI:input_type -> article.RegisterArticleDOIRequest
+	83,  // 142: article.ArticleService.GetArticleDepositXML:input_type -> article.GetArticleDepositXMLRequest
+	85,  // 143: article.ArticleService.RefreshArticleDOI:input_type -> article.RefreshArticleDOIRequest
+	87,  // 144: article.ArticleService.GetArticleByDOI:input_type -> article.GetArticleByDOIRequest
+	90,  // 145: article.ArticleService.ExportArticle:input_type -> article.ExportArticleRequest
+	92,  // 146: article.ArticleService.ExportArticles:input_type -> article.ExportArticlesRequest
+	95,  // 147: article.ArticleService.ImportArticles:input_type -> article.ImportArticlesRequest
+	98,  // 148: article.ArticleService.SearchArticles:input_type -> article.SearchArticlesRequest
+	103, // 149: article.ArticleService.FindArticlesByTitle:input_type -> article.FindArticlesByTitleRequest
+	106, // 150: article.ArticleService.FindSimilarArticles:input_type -> article.FindSimilarArticlesRequest
+	109, // 151: article.ArticleService.GetRelatedArticles:input_type -> article.GetRelatedArticlesRequest
+	114, // 152: article.ArticleService.CreateSubjectTerm:input_type -> article.CreateSubjectTermRequest
+	116, // 153: article.ArticleService.GetSubjectTerm:input_type -> article.GetSubjectTermRequest
+	118, // 154: article.ArticleService.ListSubjectTerms:input_type -> article.ListSubjectTermsRequest
+	120, // 155: article.ArticleService.UpdateSubjectTerm:input_type -> article.UpdateSubjectTermRequest
+	122, // 156: article.ArticleService.DeleteSubjectTerm:input_type -> article.DeleteSubjectTermRequest
+	124, // 157: article.ArticleService.ListArticlesBySubject:input_type -> article.ListArticlesBySubjectRequest
+	127, // 158: article.ArticleService.CreateWebhookSubscription:input_type -> article.CreateWebhookSubscriptionRequest
+	129, // 159: article.ArticleService.ListWebhookSubscriptions:input_type -> article.ListWebhookSubscriptionsRequest
+	131, // 160: article.ArticleService.DeleteWebhookSubscription:input_type -> article.DeleteWebhookSubscriptionRequest
+	134, // 161: article.ArticleService.ListWebhookDeliveries:input_type -> article.ListWebhookDeliveriesRequest
+	136, // 162: article.ArticleService.RedeliverWebhook:input_type -> article.RedeliverWebhookRequest
+	139, // 163: article.ArticleService.ListAuditEntries:input_type -> article.ListAuditEntriesRequest
+	141, // 164: article.ArticleService.VerifyAuditLog:input_type -> article.VerifyAuditLogRequest
+	15,  // 165: article.ArticleService.GetArticle:output_type -> article.GetArticleResponse
+	17,  // 166: article.ArticleService.CreateArticle:output_type -> article.CreateArticleResponse
+	19,  // 167: article.ArticleService.UpdateArticle:output_type -> article.UpdateArticleResponse
+	21,  // 168: article.ArticleService.TransitionArticle:output_type -> article.TransitionArticleResponse
+	24,  // 169: article.ArticleService.GetStatusHistory:output_type -> article.GetStatusHistoryResponse
+	5,   // 170: article.ArticleService.CreateAuthor:output_type -> article.CreateAuthorResponse
+	7,   // 171: article.ArticleService.GetAuthor:output_type -> article.GetAuthorResponse
+	9,   // 172: article.ArticleService.UpdateAuthor:output_type -> article.UpdateAuthorResponse
+	11,  // 173: article.ArticleService.ListAuthors:output_type -> article.ListAuthorsResponse
+	13,  // 174: article.ArticleService.ListArticlesByAuthor:output_type -> article.ListArticlesByAuthorResponse
+	27,  // 175: article.ArticleService.FindDuplicateAuthors:output_type -> article.FindDuplicateAuthorsResponse
+	30,  // 176: article.ArticleService.MergeAuthors:output_type -> article.MergeAuthorsResponse
+	32,  // 177: article.ArticleService.UndoAuthorMerge:output_type -> article.UndoAuthorMergeResponse
+	34,  // 178: article.ArticleService.ListAuthorMerges:output_type -> article.ListAuthorMergesResponse
+	37,  // 179: article.ArticleService.GetAuthorMetrics:output_type -> article.GetAuthorMetricsResponse
+	39,  // 180: article.ArticleService.GetJournalLeaderboard:output_type -> article.GetJournalLeaderboardResponse
+	42,  // 181: article.ArticleService.RegisterReviewer:output_type -> article.RegisterReviewerResponse
+	44,  // 182: article.ArticleService.ListReviewers:output_type -> article.ListReviewersResponse
+	48,  // 183: article.ArticleService.SuggestReviewers:output_type -> article.SuggestReviewersResponse
+	51,  // 184: article.ArticleService.AssignReviewer:output_type -> article.AssignReviewerResponse
+	53,  // 185: article.ArticleService.ListReviewAssignments:output_type -> article.ListReviewAssignmentsResponse
+	56,  // 186: article.ArticleService.SubmitReviewReport:output_type -> article.SubmitReviewReportResponse
+	58,  // 187: article.ArticleService.ListReviewReports:output_type -> article.ListReviewReportsResponse
+	61,  // 188: article.ArticleService.RecordEditorDecision:output_type -> article.RecordEditorDecisionResponse
+	63,  // 189: article.ArticleService.ListEditorDecisions:output_type -> article.ListEditorDecisionsResponse
+	66,  // 190: article.ArticleService.PlaceArticle:output_type -> article.PlaceArticleResponse
+	68,  // 191: article.ArticleService.GetArticlePlacement:output_type -> article.GetArticlePlacementResponse
+	70,  // 192: article.ArticleService.ListIssueArticles:output_type -> article.ListIssueArticlesResponse
+	73,  // 193: article.ArticleService.SetArticleReferences:output_type -> article.SetArticleReferencesResponse
+	75,  // 194: article.ArticleService.ListArticleReferences:output_type -> article.ListArticleReferencesResponse
+	77,  // 195: article.ArticleService.ListCitingReferences:output_type -> article.ListCitingReferencesResponse
+	80,  // 196: article.ArticleService.GetCitationGraph:output_type -> article.GetCitationGraphResponse
+	82,  // 197: article.ArticleService.RegisterArticleDOI:output_type -> article.RegisterArticleDOIResponse
+	84,  // 198: article.ArticleService.GetArticleDepositXML:output_type -> article.GetArticleDepositXMLResponse
+	86,  // 199: article.ArticleService.RefreshArticleDOI:output_type -> article.RefreshArticleDOIResponse
+	88,  // 200: article.ArticleService.GetArticleByDOI:output_type -> article.GetArticleByDOIResponse
+	91,  // 201: article.ArticleService.ExportArticle:output_type -> article.ExportArticleResponse
+	93,  // 202: article.ArticleService.ExportArticles:output_type -> article.ExportArticlesResponse
+	97,  // 203: article.ArticleService.ImportArticles:output_type -> article.ImportArticlesResponse
+	102, // 204: article.ArticleService.SearchArticles:output_type -> article.SearchArticlesResponse
+	105, // 205: article.ArticleService.FindArticlesByTitle:output_type -> article.FindArticlesByTitleResponse
+	108, // 206: article.ArticleService.FindSimilarArticles:output_type -> article.FindSimilarArticlesResponse
+	112, // 207: article.ArticleService.GetRelatedArticles:output_type -> article.GetRelatedArticlesResponse
+	115, // 208: article.ArticleService.CreateSubjectTerm:output_type -> article.CreateSubjectTermResponse
+	117, // 209: article.ArticleService.GetSubjectTerm:output_type -> article.GetSubjectTermResponse
+	119, // 210: article.ArticleService.ListSubjectTerms:output_type -> article.ListSubjectTermsResponse
+	121, // 211: article.ArticleService.UpdateSubjectTerm:output_type -> article.UpdateSubjectTermResponse
+	123, // 212: article.ArticleService.DeleteSubjectTerm:output_type -> article.DeleteSubjectTermResponse
+	125, // 213: article.ArticleService.ListArticlesBySubject:output_type -> article.ListArticlesBySubjectResponse
+	128, // 214: article.ArticleService.CreateWebhookSubscription:output_type -> article.CreateWebhookSubscriptionResponse
+	130, // 215: article.ArticleService.ListWebhookSubscriptions:output_type -> article.ListWebhookSubscriptionsResponse
+	132, // 216: article.ArticleService.DeleteWebhookSubscription:output_type -> article.DeleteWebhookSubscriptionResponse
+	135, // 217: article.ArticleService.ListWebhookDeliveries:output_type -> article.ListWebhookDeliveriesResponse
+	137, // 218: article.ArticleService.RedeliverWebhook:output_type -> article.RedeliverWebhookResponse
+	140, // 219: article.ArticleService.ListAuditEntries:output_type -> article.ListAuditEntriesResponse
+	142, // 220: article.ArticleService.VerifyAuditLog:output_type -> article.VerifyAuditLogResponse
+	165, // [165:221] is the sub-list for method output_type
+	109, // [109:165] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
+}
+
+func init() { file_article_proto_init() }
+func file_article_proto_init() {
+	if File_article_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_article_proto_rawDesc), len(file_article_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   144,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_article_proto_goTypes,
+		DependencyIndexes: file_article_proto_depIdxs,
+		MessageInfos:      file_article_proto_msgTypes,
+	}.Build()
+	File_article_proto = out.File
+	file_article_proto_goTypes = nil
+	file_article_proto_depIdxs = nil
+}
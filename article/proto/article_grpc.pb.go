@@ -0,0 +1,2258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: article.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ArticleService_GetArticle_FullMethodName                = "/article.ArticleService/GetArticle"
+	ArticleService_CreateArticle_FullMethodName             = "/article.ArticleService/CreateArticle"
+	ArticleService_UpdateArticle_FullMethodName             = "/article.ArticleService/UpdateArticle"
+	ArticleService_TransitionArticle_FullMethodName         = "/article.ArticleService/TransitionArticle"
+	ArticleService_GetStatusHistory_FullMethodName          = "/article.ArticleService/GetStatusHistory"
+	ArticleService_CreateAuthor_FullMethodName              = "/article.ArticleService/CreateAuthor"
+	ArticleService_GetAuthor_FullMethodName                 = "/article.ArticleService/GetAuthor"
+	ArticleService_UpdateAuthor_FullMethodName              = "/article.ArticleService/UpdateAuthor"
+	ArticleService_ListAuthors_FullMethodName               = "/article.ArticleService/ListAuthors"
+	ArticleService_ListArticlesByAuthor_FullMethodName      = "/article.ArticleService/ListArticlesByAuthor"
+	ArticleService_FindDuplicateAuthors_FullMethodName      = "/article.ArticleService/FindDuplicateAuthors"
+	ArticleService_MergeAuthors_FullMethodName              = "/article.ArticleService/MergeAuthors"
+	ArticleService_UndoAuthorMerge_FullMethodName           = "/article.ArticleService/UndoAuthorMerge"
+	ArticleService_ListAuthorMerges_FullMethodName          = "/article.ArticleService/ListAuthorMerges"
+	ArticleService_GetAuthorMetrics_FullMethodName          = "/article.ArticleService/GetAuthorMetrics"
+	ArticleService_GetJournalLeaderboard_FullMethodName     = "/article.ArticleService/GetJournalLeaderboard"
+	ArticleService_RegisterReviewer_FullMethodName          = "/article.ArticleService/RegisterReviewer"
+	ArticleService_ListReviewers_FullMethodName             = "/article.ArticleService/ListReviewers"
+	ArticleService_SuggestReviewers_FullMethodName          = "/article.ArticleService/SuggestReviewers"
+	ArticleService_AssignReviewer_FullMethodName            = "/article.ArticleService/AssignReviewer"
+	ArticleService_ListReviewAssignments_FullMethodName     = "/article.ArticleService/ListReviewAssignments"
+	ArticleService_SubmitReviewReport_FullMethodName        = "/article.ArticleService/SubmitReviewReport"
+	ArticleService_ListReviewReports_FullMethodName         = "/article.ArticleService/ListReviewReports"
+	ArticleService_RecordEditorDecision_FullMethodName      = "/article.ArticleService/RecordEditorDecision"
+	ArticleService_ListEditorDecisions_FullMethodName       = "/article.ArticleService/ListEditorDecisions"
+	ArticleService_PlaceArticle_FullMethodName              = "/article.ArticleService/PlaceArticle"
+	ArticleService_GetArticlePlacement_FullMethodName       = "/article.ArticleService/GetArticlePlacement"
+	ArticleService_ListIssueArticles_FullMethodName         = "/article.ArticleService/ListIssueArticles"
+	ArticleService_SetArticleReferences_FullMethodName      = "/article.ArticleService/SetArticleReferences"
+	ArticleService_ListArticleReferences_FullMethodName     = "/article.ArticleService/ListArticleReferences"
+	ArticleService_ListCitingReferences_FullMethodName      = "/article.ArticleService/ListCitingReferences"
+	ArticleService_GetCitationGraph_FullMethodName          = "/article.ArticleService/GetCitationGraph"
+	ArticleService_RegisterArticleDOI_FullMethodName        = "/article.ArticleService/RegisterArticleDOI"
+	ArticleService_GetArticleDepositXML_FullMethodName      = "/article.ArticleService/GetArticleDepositXML"
+	ArticleService_RefreshArticleDOI_FullMethodName         = "/article.ArticleService/RefreshArticleDOI"
+	ArticleService_GetArticleByDOI_FullMethodName           = "/article.ArticleService/GetArticleByDOI"
+	ArticleService_ExportArticle_FullMethodName             = "/article.ArticleService/ExportArticle"
+	ArticleService_ExportArticles_FullMethodName            = "/article.ArticleService/ExportArticles"
+	ArticleService_ImportArticles_FullMethodName            = "/article.ArticleService/ImportArticles"
+	ArticleService_SearchArticles_FullMethodName            = "/article.ArticleService/SearchArticles"
+	ArticleService_FindArticlesByTitle_FullMethodName       = "/article.ArticleService/FindArticlesByTitle"
+	ArticleService_FindSimilarArticles_FullMethodName       = "/article.ArticleService/FindSimilarArticles"
+	ArticleService_GetRelatedArticles_FullMethodName        = "/article.ArticleService/GetRelatedArticles"
+	ArticleService_CreateSubjectTerm_FullMethodName         = "/article.ArticleService/CreateSubjectTerm"
+	ArticleService_GetSubjectTerm_FullMethodName            = "/article.ArticleService/GetSubjectTerm"
+	ArticleService_ListSubjectTerms_FullMethodName          = "/article.ArticleService/ListSubjectTerms"
+	ArticleService_UpdateSubjectTerm_FullMethodName         = "/article.ArticleService/UpdateSubjectTerm"
+	ArticleService_DeleteSubjectTerm_FullMethodName         = "/article.ArticleService/DeleteSubjectTerm"
+	ArticleService_ListArticlesBySubject_FullMethodName     = "/article.ArticleService/ListArticlesBySubject"
+	ArticleService_CreateWebhookSubscription_FullMethodName = "/article.ArticleService/CreateWebhookSubscription"
+	ArticleService_ListWebhookSubscriptions_FullMethodName  = "/article.ArticleService/ListWebhookSubscriptions"
+	ArticleService_DeleteWebhookSubscription_FullMethodName = "/article.ArticleService/DeleteWebhookSubscription"
+	ArticleService_ListWebhookDeliveries_FullMethodName     = "/article.ArticleService/ListWebhookDeliveries"
+	ArticleService_RedeliverWebhook_FullMethodName          = "/article.ArticleService/RedeliverWebhook"
+	ArticleService_ListAuditEntries_FullMethodName          = "/article.ArticleService/ListAuditEntries"
+	ArticleService_VerifyAuditLog_FullMethodName            = "/article.ArticleService/VerifyAuditLog"
+)
+
+// ArticleServiceClient is the client API for ArticleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArticleServiceClient interface {
+	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
+	// Mutating calls are attributed to the actor in the "x-actor" metadata key
+	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*CreateArticleResponse, error)
+	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*UpdateArticleResponse, error)
+	TransitionArticle(ctx context.Context, in *TransitionArticleRequest, opts ...grpc.CallOption) (*TransitionArticleResponse, error)
+	GetStatusHistory(ctx context.Context, in *GetStatusHistoryRequest, opts ...grpc.CallOption) (*GetStatusHistoryResponse, error)
+	CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error)
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
+	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*UpdateAuthorResponse, error)
+	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
+	ListArticlesByAuthor(ctx context.Context, in *ListArticlesByAuthorRequest, opts ...grpc.CallOption) (*ListArticlesByAuthorResponse, error)
+	FindDuplicateAuthors(ctx context.Context, in *FindDuplicateAuthorsRequest, opts ...grpc.CallOption) (*FindDuplicateAuthorsResponse, error)
+	MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error)
+	UndoAuthorMerge(ctx context.Context, in *UndoAuthorMergeRequest, opts ...grpc.CallOption) (*UndoAuthorMergeResponse, error)
+	ListAuthorMerges(ctx context.Context, in *ListAuthorMergesRequest, opts ...grpc.CallOption) (*ListAuthorMergesResponse, error)
+	GetAuthorMetrics(ctx context.Context, in *GetAuthorMetricsRequest, opts ...grpc.CallOption) (*GetAuthorMetricsResponse, error)
+	// GetJournalLeaderboard ranks the journal's authors by a metric
+	GetJournalLeaderboard(ctx context.Context, in *GetJournalLeaderboardRequest, opts ...grpc.CallOption) (*GetJournalLeaderboardResponse, error)
+	RegisterReviewer(ctx context.Context, in *RegisterReviewerRequest, opts ...grpc.CallOption) (*RegisterReviewerResponse, error)
+	ListReviewers(ctx context.Context, in *ListReviewersRequest, opts ...grpc.CallOption) (*ListReviewersResponse, error)
+	SuggestReviewers(ctx context.Context, in *SuggestReviewersRequest, opts ...grpc.CallOption) (*SuggestReviewersResponse, error)
+	AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*AssignReviewerResponse, error)
+	ListReviewAssignments(ctx context.Context, in *ListReviewAssignmentsRequest, opts ...grpc.CallOption) (*ListReviewAssignmentsResponse, error)
+	SubmitReviewReport(ctx context.Context, in *SubmitReviewReportRequest, opts ...grpc.CallOption) (*SubmitReviewReportResponse, error)
+	ListReviewReports(ctx context.Context, in *ListReviewReportsRequest, opts ...grpc.CallOption) (*ListReviewReportsResponse, error)
+	RecordEditorDecision(ctx context.Context, in *RecordEditorDecisionRequest, opts ...grpc.CallOption) (*RecordEditorDecisionResponse, error)
+	ListEditorDecisions(ctx context.Context, in *ListEditorDecisionsRequest, opts ...grpc.CallOption) (*ListEditorDecisionsResponse, error)
+	// PlaceArticle assigns an accepted article to an unpublished issue of its
+	// journal, replacing any earlier placement
+	PlaceArticle(ctx context.Context, in *PlaceArticleRequest, opts ...grpc.CallOption) (*PlaceArticleResponse, error)
+	GetArticlePlacement(ctx context.Context, in *GetArticlePlacementRequest, opts ...grpc.CallOption) (*GetArticlePlacementResponse, error)
+	ListIssueArticles(ctx context.Context, in *ListIssueArticlesRequest, opts ...grpc.CallOption) (*ListIssueArticlesResponse, error)
+	// SetArticleReferences replaces the article's reference list
+	SetArticleReferences(ctx context.Context, in *SetArticleReferencesRequest, opts ...grpc.CallOption) (*SetArticleReferencesResponse, error)
+	ListArticleReferences(ctx context.Context, in *ListArticleReferencesRequest, opts ...grpc.CallOption) (*ListArticleReferencesResponse, error)
+	// ListCitingReferences returns the references citing an article or DOI
+	ListCitingReferences(ctx context.Context, in *ListCitingReferencesRequest, opts ...grpc.CallOption) (*ListCitingReferencesResponse, error)
+	GetCitationGraph(ctx context.Context, in *GetCitationGraphRequest, opts ...grpc.CallOption) (*GetCitationGraphResponse, error)
+	// RegisterArticleDOI deposits a published article's metadata with the
+	// registration agency, minting its DOI on the first deposit
+	RegisterArticleDOI(ctx context.Context, in *RegisterArticleDOIRequest, opts ...grpc.CallOption) (*RegisterArticleDOIResponse, error)
+	// GetArticleDepositXML previews the deposit without submitting it
+	GetArticleDepositXML(ctx context.Context, in *GetArticleDepositXMLRequest, opts ...grpc.CallOption) (*GetArticleDepositXMLResponse, error)
+	// RefreshArticleDOI collects the outcome of a submitted deposit
+	RefreshArticleDOI(ctx context.Context, in *RefreshArticleDOIRequest, opts ...grpc.CallOption) (*RefreshArticleDOIResponse, error)
+	GetArticleByDOI(ctx context.Context, in *GetArticleByDOIRequest, opts ...grpc.CallOption) (*GetArticleByDOIResponse, error)
+	// ExportArticle renders an article's citation as BibTeX, RIS or CSL-JSON,
+	// or its front matter and references as a JATS document
+	ExportArticle(ctx context.Context, in *ExportArticleRequest, opts ...grpc.CallOption) (*ExportArticleResponse, error)
+	// ExportArticles streams the citations of the selected articles, one
+	// entry per message
+	ExportArticles(ctx context.Context, in *ExportArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportArticlesResponse], error)
+	// ImportArticles loads a BibTeX, RIS, CSV or JATS file streamed in chunks and
+	// reports on every record
+	ImportArticles(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArticlesRequest, ImportArticlesResponse], error)
+	// SearchArticles ranks articles by the relevance of their titles and
+	// abstracts to a query and highlights the matches
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error)
+	// FindArticlesByTitle looks articles up by normalized title, or ranks
+	// articles with similar titles in fuzzy mode
+	FindArticlesByTitle(ctx context.Context, in *FindArticlesByTitleRequest, opts ...grpc.CallOption) (*FindArticlesByTitleResponse, error)
+	// FindSimilarArticles finds near-duplicates of an article or of a
+	// submission's text by MinHash similarity
+	FindSimilarArticles(ctx context.Context, in *FindSimilarArticlesRequest, opts ...grpc.CallOption) (*FindSimilarArticlesResponse, error)
+	// GetRelatedArticles returns the articles recommended alongside an
+	// article as of the last run of the recommendation job
+	GetRelatedArticles(ctx context.Context, in *GetRelatedArticlesRequest, opts ...grpc.CallOption) (*GetRelatedArticlesResponse, error)
+	// Subject taxonomy. Terms with subterms or articles cannot be deleted.
+	CreateSubjectTerm(ctx context.Context, in *CreateSubjectTermRequest, opts ...grpc.CallOption) (*CreateSubjectTermResponse, error)
+	GetSubjectTerm(ctx context.Context, in *GetSubjectTermRequest, opts ...grpc.CallOption) (*GetSubjectTermResponse, error)
+	ListSubjectTerms(ctx context.Context, in *ListSubjectTermsRequest, opts ...grpc.CallOption) (*ListSubjectTermsResponse, error)
+	UpdateSubjectTerm(ctx context.Context, in *UpdateSubjectTermRequest, opts ...grpc.CallOption) (*UpdateSubjectTermResponse, error)
+	DeleteSubjectTerm(ctx context.Context, in *DeleteSubjectTermRequest, opts ...grpc.CallOption) (*DeleteSubjectTermResponse, error)
+	ListArticlesBySubject(ctx context.Context, in *ListArticlesBySubjectRequest, opts ...grpc.CallOption) (*ListArticlesBySubjectResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+}
+
+type articleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArticleServiceClient(cc grpc.ClientConnInterface) ArticleServiceClient {
+	return &articleServiceClient{cc}
+}
+
+func (c *articleServiceClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*CreateArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CreateArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*UpdateArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) TransitionArticle(ctx context.Context, in *TransitionArticleRequest, opts ...grpc.CallOption) (*TransitionArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransitionArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_TransitionArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetStatusHistory(ctx context.Context, in *GetStatusHistoryRequest, opts ...grpc.CallOption) (*GetStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CreateAuthor(ctx context.Context, in *CreateAuthorRequest, opts ...grpc.CallOption) (*CreateAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAuthorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CreateAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*UpdateAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAuthorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticlesByAuthor(ctx context.Context, in *ListArticlesByAuthorRequest, opts ...grpc.CallOption) (*ListArticlesByAuthorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArticlesByAuthorResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListArticlesByAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) FindDuplicateAuthors(ctx context.Context, in *FindDuplicateAuthorsRequest, opts ...grpc.CallOption) (*FindDuplicateAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindDuplicateAuthorsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_FindDuplicateAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAuthorsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_MergeAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UndoAuthorMerge(ctx context.Context, in *UndoAuthorMergeRequest, opts ...grpc.CallOption) (*UndoAuthorMergeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoAuthorMergeResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UndoAuthorMerge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListAuthorMerges(ctx context.Context, in *ListAuthorMergesRequest, opts ...grpc.CallOption) (*ListAuthorMergesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuthorMergesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListAuthorMerges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetAuthorMetrics(ctx context.Context, in *GetAuthorMetricsRequest, opts ...grpc.CallOption) (*GetAuthorMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuthorMetricsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetAuthorMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetJournalLeaderboard(ctx context.Context, in *GetJournalLeaderboardRequest, opts ...grpc.CallOption) (*GetJournalLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalLeaderboardResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetJournalLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RegisterReviewer(ctx context.Context, in *RegisterReviewerRequest, opts ...grpc.CallOption) (*RegisterReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterReviewerResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RegisterReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListReviewers(ctx context.Context, in *ListReviewersRequest, opts ...grpc.CallOption) (*ListReviewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewersResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SuggestReviewers(ctx context.Context, in *SuggestReviewersRequest, opts ...grpc.CallOption) (*SuggestReviewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestReviewersResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SuggestReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*AssignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignReviewerResponse)
+	err := c.cc.Invoke(ctx, ArticleService_AssignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListReviewAssignments(ctx context.Context, in *ListReviewAssignmentsRequest, opts ...grpc.CallOption) (*ListReviewAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewAssignmentsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListReviewAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SubmitReviewReport(ctx context.Context, in *SubmitReviewReportRequest, opts ...grpc.CallOption) (*SubmitReviewReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReviewReportResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SubmitReviewReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListReviewReports(ctx context.Context, in *ListReviewReportsRequest, opts ...grpc.CallOption) (*ListReviewReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewReportsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListReviewReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RecordEditorDecision(ctx context.Context, in *RecordEditorDecisionRequest, opts ...grpc.CallOption) (*RecordEditorDecisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordEditorDecisionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RecordEditorDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListEditorDecisions(ctx context.Context, in *ListEditorDecisionsRequest, opts ...grpc.CallOption) (*ListEditorDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEditorDecisionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListEditorDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) PlaceArticle(ctx context.Context, in *PlaceArticleRequest, opts ...grpc.CallOption) (*PlaceArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_PlaceArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticlePlacement(ctx context.Context, in *GetArticlePlacementRequest, opts ...grpc.CallOption) (*GetArticlePlacementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticlePlacementResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticlePlacement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListIssueArticles(ctx context.Context, in *ListIssueArticlesRequest, opts ...grpc.CallOption) (*ListIssueArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListIssueArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SetArticleReferences(ctx context.Context, in *SetArticleReferencesRequest, opts ...grpc.CallOption) (*SetArticleReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetArticleReferencesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SetArticleReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticleReferences(ctx context.Context, in *ListArticleReferencesRequest, opts ...grpc.CallOption) (*ListArticleReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArticleReferencesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListArticleReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListCitingReferences(ctx context.Context, in *ListCitingReferencesRequest, opts ...grpc.CallOption) (*ListCitingReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCitingReferencesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListCitingReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetCitationGraph(ctx context.Context, in *GetCitationGraphRequest, opts ...grpc.CallOption) (*GetCitationGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCitationGraphResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetCitationGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RegisterArticleDOI(ctx context.Context, in *RegisterArticleDOIRequest, opts ...grpc.CallOption) (*RegisterArticleDOIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterArticleDOIResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RegisterArticleDOI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleDepositXML(ctx context.Context, in *GetArticleDepositXMLRequest, opts ...grpc.CallOption) (*GetArticleDepositXMLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleDepositXMLResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleDepositXML_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RefreshArticleDOI(ctx context.Context, in *RefreshArticleDOIRequest, opts ...grpc.CallOption) (*RefreshArticleDOIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshArticleDOIResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RefreshArticleDOI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticleByDOI(ctx context.Context, in *GetArticleByDOIRequest, opts ...grpc.CallOption) (*GetArticleByDOIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleByDOIResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticleByDOI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ExportArticle(ctx context.Context, in *ExportArticleRequest, opts ...grpc.CallOption) (*ExportArticleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportArticleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ExportArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ExportArticles(ctx context.Context, in *ExportArticlesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportArticlesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[0], ArticleService_ExportArticles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportArticlesRequest, ExportArticlesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ExportArticlesClient = grpc.ServerStreamingClient[ExportArticlesResponse]
+
+func (c *articleServiceClient) ImportArticles(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportArticlesRequest, ImportArticlesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArticleService_ServiceDesc.Streams[1], ArticleService_ImportArticles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportArticlesRequest, ImportArticlesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ImportArticlesClient = grpc.ClientStreamingClient[ImportArticlesRequest, ImportArticlesResponse]
+
+func (c *articleServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) FindArticlesByTitle(ctx context.Context, in *FindArticlesByTitleRequest, opts ...grpc.CallOption) (*FindArticlesByTitleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindArticlesByTitleResponse)
+	err := c.cc.Invoke(ctx, ArticleService_FindArticlesByTitle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) FindSimilarArticles(ctx context.Context, in *FindSimilarArticlesRequest, opts ...grpc.CallOption) (*FindSimilarArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSimilarArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_FindSimilarArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetRelatedArticles(ctx context.Context, in *GetRelatedArticlesRequest, opts ...grpc.CallOption) (*GetRelatedArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetRelatedArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CreateSubjectTerm(ctx context.Context, in *CreateSubjectTermRequest, opts ...grpc.CallOption) (*CreateSubjectTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSubjectTermResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CreateSubjectTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetSubjectTerm(ctx context.Context, in *GetSubjectTermRequest, opts ...grpc.CallOption) (*GetSubjectTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubjectTermResponse)
+	err := c.cc.Invoke(ctx, ArticleService_GetSubjectTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListSubjectTerms(ctx context.Context, in *ListSubjectTermsRequest, opts ...grpc.CallOption) (*ListSubjectTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubjectTermsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListSubjectTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateSubjectTerm(ctx context.Context, in *UpdateSubjectTermRequest, opts ...grpc.CallOption) (*UpdateSubjectTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSubjectTermResponse)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateSubjectTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DeleteSubjectTerm(ctx context.Context, in *DeleteSubjectTermRequest, opts ...grpc.CallOption) (*DeleteSubjectTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubjectTermResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DeleteSubjectTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticlesBySubject(ctx context.Context, in *ListArticlesBySubjectRequest, opts ...grpc.CallOption) (*ListArticlesBySubjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArticlesBySubjectResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListArticlesBySubject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, ArticleService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, ArticleService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListAuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, ArticleService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArticleServiceServer is the server API for ArticleService service.
+// All implementations must embed UnimplementedArticleServiceServer
+// for forward compatibility.
+type ArticleServiceServer interface {
+	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
+	// Mutating calls are attributed to the actor in the "x-actor" metadata key
+	CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleResponse, error)
+	UpdateArticle(context.Context, *UpdateArticleRequest) (*UpdateArticleResponse, error)
+	TransitionArticle(context.Context, *TransitionArticleRequest) (*TransitionArticleResponse, error)
+	GetStatusHistory(context.Context, *GetStatusHistoryRequest) (*GetStatusHistoryResponse, error)
+	CreateAuthor(context.Context, *CreateAuthorRequest) (*CreateAuthorResponse, error)
+	GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error)
+	UpdateAuthor(context.Context, *UpdateAuthorRequest) (*UpdateAuthorResponse, error)
+	ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
+	ListArticlesByAuthor(context.Context, *ListArticlesByAuthorRequest) (*ListArticlesByAuthorResponse, error)
+	FindDuplicateAuthors(context.Context, *FindDuplicateAuthorsRequest) (*FindDuplicateAuthorsResponse, error)
+	MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error)
+	UndoAuthorMerge(context.Context, *UndoAuthorMergeRequest) (*UndoAuthorMergeResponse, error)
+	ListAuthorMerges(context.Context, *ListAuthorMergesRequest) (*ListAuthorMergesResponse, error)
+	GetAuthorMetrics(context.Context, *GetAuthorMetricsRequest) (*GetAuthorMetricsResponse, error)
+	// GetJournalLeaderboard ranks the journal's authors by a metric
+	GetJournalLeaderboard(context.Context, *GetJournalLeaderboardRequest) (*GetJournalLeaderboardResponse, error)
+	RegisterReviewer(context.Context, *RegisterReviewerRequest) (*RegisterReviewerResponse, error)
+	ListReviewers(context.Context, *ListReviewersRequest) (*ListReviewersResponse, error)
+	SuggestReviewers(context.Context, *SuggestReviewersRequest) (*SuggestReviewersResponse, error)
+	AssignReviewer(context.Context, *AssignReviewerRequest) (*AssignReviewerResponse, error)
+	ListReviewAssignments(context.Context, *ListReviewAssignmentsRequest) (*ListReviewAssignmentsResponse, error)
+	SubmitReviewReport(context.Context, *SubmitReviewReportRequest) (*SubmitReviewReportResponse, error)
+	ListReviewReports(context.Context, *ListReviewReportsRequest) (*ListReviewReportsResponse, error)
+	RecordEditorDecision(context.Context, *RecordEditorDecisionRequest) (*RecordEditorDecisionResponse, error)
+	ListEditorDecisions(context.Context, *ListEditorDecisionsRequest) (*ListEditorDecisionsResponse, error)
+	// PlaceArticle assigns an accepted article to an unpublished issue of its
+	// journal, replacing any earlier placement
+	PlaceArticle(context.Context, *PlaceArticleRequest) (*PlaceArticleResponse, error)
+	GetArticlePlacement(context.Context, *GetArticlePlacementRequest) (*GetArticlePlacementResponse, error)
+	ListIssueArticles(context.Context, *ListIssueArticlesRequest) (*ListIssueArticlesResponse, error)
+	// SetArticleReferences replaces the article's reference list
+	SetArticleReferences(context.Context, *SetArticleReferencesRequest) (*SetArticleReferencesResponse, error)
+	ListArticleReferences(context.Context, *ListArticleReferencesRequest) (*ListArticleReferencesResponse, error)
+	// ListCitingReferences returns the references citing an article or DOI
+	ListCitingReferences(context.Context, *ListCitingReferencesRequest) (*ListCitingReferencesResponse, error)
+	GetCitationGraph(context.Context, *GetCitationGraphRequest) (*GetCitationGraphResponse, error)
+	// RegisterArticleDOI deposits a published article's metadata with the
+	// registration agency, minting its DOI on the first deposit
+	RegisterArticleDOI(context.Context, *RegisterArticleDOIRequest) (*RegisterArticleDOIResponse, error)
+	// GetArticleDepositXML previews the deposit without submitting it
+	GetArticleDepositXML(context.Context, *GetArticleDepositXMLRequest) (*GetArticleDepositXMLResponse, error)
+	// RefreshArticleDOI collects the outcome of a submitted deposit
+	RefreshArticleDOI(context.Context, *RefreshArticleDOIRequest) (*RefreshArticleDOIResponse, error)
+	GetArticleByDOI(context.Context, *GetArticleByDOIRequest) (*GetArticleByDOIResponse, error)
+	// ExportArticle renders an article's citation as BibTeX, RIS or CSL-JSON,
+	// or its front matter and references as a JATS document
+	ExportArticle(context.Context, *ExportArticleRequest) (*ExportArticleResponse, error)
+	// ExportArticles streams the citations of the selected articles, one
+	// entry per message
+	ExportArticles(*ExportArticlesRequest, grpc.ServerStreamingServer[ExportArticlesResponse]) error
+	// ImportArticles loads a BibTeX, RIS, CSV or JATS file streamed in chunks and
+	// reports on every record
+	ImportArticles(grpc.ClientStreamingServer[ImportArticlesRequest, ImportArticlesResponse]) error
+	// SearchArticles ranks articles by the relevance of their titles and
+	// abstracts to a query and highlights the matches
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error)
+	// FindArticlesByTitle looks articles up by normalized title, or ranks
+	// articles with similar titles in fuzzy mode
+	FindArticlesByTitle(context.Context, *FindArticlesByTitleRequest) (*FindArticlesByTitleResponse, error)
+	// FindSimilarArticles finds near-duplicates of an article or of a
+	// submission's text by MinHash similarity
+	FindSimilarArticles(context.Context, *FindSimilarArticlesRequest) (*FindSimilarArticlesResponse, error)
+	// GetRelatedArticles returns the articles recommended alongside an
+	// article as of the last run of the recommendation job
+	GetRelatedArticles(context.Context, *GetRelatedArticlesRequest) (*GetRelatedArticlesResponse, error)
+	// Subject taxonomy. Terms with subterms or articles cannot be deleted.
+	CreateSubjectTerm(context.Context, *CreateSubjectTermRequest) (*CreateSubjectTermResponse, error)
+	GetSubjectTerm(context.Context, *GetSubjectTermRequest) (*GetSubjectTermResponse, error)
+	ListSubjectTerms(context.Context, *ListSubjectTermsRequest) (*ListSubjectTermsResponse, error)
+	UpdateSubjectTerm(context.Context, *UpdateSubjectTermRequest) (*UpdateSubjectTermResponse, error)
+	DeleteSubjectTerm(context.Context, *DeleteSubjectTermRequest) (*DeleteSubjectTermResponse, error)
+	ListArticlesBySubject(context.Context, *ListArticlesBySubjectRequest) (*ListArticlesBySubjectResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+// UnimplementedArticleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedArticleServiceServer struct{}
+
+func (UnimplementedArticleServiceServer) GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateArticle(context.Context, *UpdateArticleRequest) (*UpdateArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) TransitionArticle(context.Context, *TransitionArticleRequest) (*TransitionArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) GetStatusHistory(context.Context, *GetStatusHistoryRequest) (*GetStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusHistory not implemented")
+}
+func (UnimplementedArticleServiceServer) CreateAuthor(context.Context, *CreateAuthorRequest) (*CreateAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuthor not implemented")
+}
+func (UnimplementedArticleServiceServer) GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateAuthor(context.Context, *UpdateAuthorRequest) (*UpdateAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuthor not implemented")
+}
+func (UnimplementedArticleServiceServer) ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthors not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticlesByAuthor(context.Context, *ListArticlesByAuthorRequest) (*ListArticlesByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticlesByAuthor not implemented")
+}
+func (UnimplementedArticleServiceServer) FindDuplicateAuthors(context.Context, *FindDuplicateAuthorsRequest) (*FindDuplicateAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicateAuthors not implemented")
+}
+func (UnimplementedArticleServiceServer) MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAuthors not implemented")
+}
+func (UnimplementedArticleServiceServer) UndoAuthorMerge(context.Context, *UndoAuthorMergeRequest) (*UndoAuthorMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoAuthorMerge not implemented")
+}
+func (UnimplementedArticleServiceServer) ListAuthorMerges(context.Context, *ListAuthorMergesRequest) (*ListAuthorMergesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthorMerges not implemented")
+}
+func (UnimplementedArticleServiceServer) GetAuthorMetrics(context.Context, *GetAuthorMetricsRequest) (*GetAuthorMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorMetrics not implemented")
+}
+func (UnimplementedArticleServiceServer) GetJournalLeaderboard(context.Context, *GetJournalLeaderboardRequest) (*GetJournalLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalLeaderboard not implemented")
+}
+func (UnimplementedArticleServiceServer) RegisterReviewer(context.Context, *RegisterReviewerRequest) (*RegisterReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterReviewer not implemented")
+}
+func (UnimplementedArticleServiceServer) ListReviewers(context.Context, *ListReviewersRequest) (*ListReviewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewers not implemented")
+}
+func (UnimplementedArticleServiceServer) SuggestReviewers(context.Context, *SuggestReviewersRequest) (*SuggestReviewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestReviewers not implemented")
+}
+func (UnimplementedArticleServiceServer) AssignReviewer(context.Context, *AssignReviewerRequest) (*AssignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignReviewer not implemented")
+}
+func (UnimplementedArticleServiceServer) ListReviewAssignments(context.Context, *ListReviewAssignmentsRequest) (*ListReviewAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewAssignments not implemented")
+}
+func (UnimplementedArticleServiceServer) SubmitReviewReport(context.Context, *SubmitReviewReportRequest) (*SubmitReviewReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReviewReport not implemented")
+}
+func (UnimplementedArticleServiceServer) ListReviewReports(context.Context, *ListReviewReportsRequest) (*ListReviewReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewReports not implemented")
+}
+func (UnimplementedArticleServiceServer) RecordEditorDecision(context.Context, *RecordEditorDecisionRequest) (*RecordEditorDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEditorDecision not implemented")
+}
+func (UnimplementedArticleServiceServer) ListEditorDecisions(context.Context, *ListEditorDecisionsRequest) (*ListEditorDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEditorDecisions not implemented")
+}
+func (UnimplementedArticleServiceServer) PlaceArticle(context.Context, *PlaceArticleRequest) (*PlaceArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticlePlacement(context.Context, *GetArticlePlacementRequest) (*GetArticlePlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticlePlacement not implemented")
+}
+func (UnimplementedArticleServiceServer) ListIssueArticles(context.Context, *ListIssueArticlesRequest) (*ListIssueArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) SetArticleReferences(context.Context, *SetArticleReferencesRequest) (*SetArticleReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArticleReferences not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticleReferences(context.Context, *ListArticleReferencesRequest) (*ListArticleReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleReferences not implemented")
+}
+func (UnimplementedArticleServiceServer) ListCitingReferences(context.Context, *ListCitingReferencesRequest) (*ListCitingReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCitingReferences not implemented")
+}
+func (UnimplementedArticleServiceServer) GetCitationGraph(context.Context, *GetCitationGraphRequest) (*GetCitationGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCitationGraph not implemented")
+}
+func (UnimplementedArticleServiceServer) RegisterArticleDOI(context.Context, *RegisterArticleDOIRequest) (*RegisterArticleDOIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterArticleDOI not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleDepositXML(context.Context, *GetArticleDepositXMLRequest) (*GetArticleDepositXMLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleDepositXML not implemented")
+}
+func (UnimplementedArticleServiceServer) RefreshArticleDOI(context.Context, *RefreshArticleDOIRequest) (*RefreshArticleDOIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshArticleDOI not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticleByDOI(context.Context, *GetArticleByDOIRequest) (*GetArticleByDOIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleByDOI not implemented")
+}
+func (UnimplementedArticleServiceServer) ExportArticle(context.Context, *ExportArticleRequest) (*ExportArticleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ExportArticles(*ExportArticlesRequest, grpc.ServerStreamingServer[ExportArticlesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) ImportArticles(grpc.ClientStreamingServer[ImportArticlesRequest, ImportArticlesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) FindArticlesByTitle(context.Context, *FindArticlesByTitleRequest) (*FindArticlesByTitleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindArticlesByTitle not implemented")
+}
+func (UnimplementedArticleServiceServer) FindSimilarArticles(context.Context, *FindSimilarArticlesRequest) (*FindSimilarArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) GetRelatedArticles(context.Context, *GetRelatedArticlesRequest) (*GetRelatedArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) CreateSubjectTerm(context.Context, *CreateSubjectTermRequest) (*CreateSubjectTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubjectTerm not implemented")
+}
+func (UnimplementedArticleServiceServer) GetSubjectTerm(context.Context, *GetSubjectTermRequest) (*GetSubjectTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubjectTerm not implemented")
+}
+func (UnimplementedArticleServiceServer) ListSubjectTerms(context.Context, *ListSubjectTermsRequest) (*ListSubjectTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubjectTerms not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateSubjectTerm(context.Context, *UpdateSubjectTermRequest) (*UpdateSubjectTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubjectTerm not implemented")
+}
+func (UnimplementedArticleServiceServer) DeleteSubjectTerm(context.Context, *DeleteSubjectTermRequest) (*DeleteSubjectTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubjectTerm not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticlesBySubject(context.Context, *ListArticlesBySubjectRequest) (*ListArticlesBySubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticlesBySubject not implemented")
+}
+func (UnimplementedArticleServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedArticleServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedArticleServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedArticleServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedArticleServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedArticleServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedArticleServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
+func (UnimplementedArticleServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArticleServiceServer will
+// result in compilation errors.
+type UnsafeArticleServiceServer interface {
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+func RegisterArticleServiceServer(s grpc.ServiceRegistrar, srv ArticleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedArticleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ArticleService_ServiceDesc, srv)
+}
+
+func _ArticleService_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticle(ctx, req.(*GetArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_TransitionArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).TransitionArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_TransitionArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).TransitionArticle(ctx, req.(*TransitionArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetStatusHistory(ctx, req.(*GetStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CreateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateAuthor(ctx, req.(*CreateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateAuthor(ctx, req.(*UpdateAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListAuthors(ctx, req.(*ListAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticlesByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticlesByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticlesByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListArticlesByAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticlesByAuthor(ctx, req.(*ListArticlesByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_FindDuplicateAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicateAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).FindDuplicateAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_FindDuplicateAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).FindDuplicateAuthors(ctx, req.(*FindDuplicateAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_MergeAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).MergeAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_MergeAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).MergeAuthors(ctx, req.(*MergeAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UndoAuthorMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoAuthorMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UndoAuthorMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UndoAuthorMerge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UndoAuthorMerge(ctx, req.(*UndoAuthorMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListAuthorMerges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorMergesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListAuthorMerges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListAuthorMerges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListAuthorMerges(ctx, req.(*ListAuthorMergesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetAuthorMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetAuthorMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetAuthorMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetAuthorMetrics(ctx, req.(*GetAuthorMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetJournalLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetJournalLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetJournalLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetJournalLeaderboard(ctx, req.(*GetJournalLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RegisterReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RegisterReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RegisterReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RegisterReviewer(ctx, req.(*RegisterReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListReviewers(ctx, req.(*ListReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SuggestReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SuggestReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SuggestReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SuggestReviewers(ctx, req.(*SuggestReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_AssignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).AssignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_AssignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).AssignReviewer(ctx, req.(*AssignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListReviewAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListReviewAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListReviewAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListReviewAssignments(ctx, req.(*ListReviewAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SubmitReviewReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SubmitReviewReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SubmitReviewReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SubmitReviewReport(ctx, req.(*SubmitReviewReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListReviewReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListReviewReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListReviewReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListReviewReports(ctx, req.(*ListReviewReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RecordEditorDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEditorDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RecordEditorDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RecordEditorDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RecordEditorDecision(ctx, req.(*RecordEditorDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListEditorDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEditorDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListEditorDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListEditorDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListEditorDecisions(ctx, req.(*ListEditorDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_PlaceArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).PlaceArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_PlaceArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).PlaceArticle(ctx, req.(*PlaceArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticlePlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticlePlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticlePlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticlePlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticlePlacement(ctx, req.(*GetArticlePlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListIssueArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListIssueArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListIssueArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListIssueArticles(ctx, req.(*ListIssueArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SetArticleReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetArticleReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SetArticleReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SetArticleReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SetArticleReferences(ctx, req.(*SetArticleReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticleReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticleReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticleReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListArticleReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticleReferences(ctx, req.(*ListArticleReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListCitingReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCitingReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListCitingReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListCitingReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListCitingReferences(ctx, req.(*ListCitingReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetCitationGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCitationGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetCitationGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetCitationGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetCitationGraph(ctx, req.(*GetCitationGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RegisterArticleDOI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterArticleDOIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RegisterArticleDOI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RegisterArticleDOI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RegisterArticleDOI(ctx, req.(*RegisterArticleDOIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleDepositXML_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleDepositXMLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleDepositXML(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticleDepositXML_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleDepositXML(ctx, req.(*GetArticleDepositXMLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RefreshArticleDOI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshArticleDOIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RefreshArticleDOI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RefreshArticleDOI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RefreshArticleDOI(ctx, req.(*RefreshArticleDOIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticleByDOI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleByDOIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticleByDOI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticleByDOI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticleByDOI(ctx, req.(*GetArticleByDOIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ExportArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ExportArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ExportArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ExportArticle(ctx, req.(*ExportArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ExportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportArticlesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArticleServiceServer).ExportArticles(m, &grpc.GenericServerStream[ExportArticlesRequest, ExportArticlesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ExportArticlesServer = grpc.ServerStreamingServer[ExportArticlesResponse]
+
+func _ArticleService_ImportArticles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ArticleServiceServer).ImportArticles(&grpc.GenericServerStream[ImportArticlesRequest, ImportArticlesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArticleService_ImportArticlesServer = grpc.ClientStreamingServer[ImportArticlesRequest, ImportArticlesResponse]
+
+func _ArticleService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_FindArticlesByTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindArticlesByTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).FindArticlesByTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_FindArticlesByTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).FindArticlesByTitle(ctx, req.(*FindArticlesByTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_FindSimilarArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).FindSimilarArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_FindSimilarArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).FindSimilarArticles(ctx, req.(*FindSimilarArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetRelatedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetRelatedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetRelatedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetRelatedArticles(ctx, req.(*GetRelatedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CreateSubjectTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubjectTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateSubjectTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateSubjectTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateSubjectTerm(ctx, req.(*CreateSubjectTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetSubjectTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetSubjectTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetSubjectTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetSubjectTerm(ctx, req.(*GetSubjectTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListSubjectTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubjectTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListSubjectTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListSubjectTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListSubjectTerms(ctx, req.(*ListSubjectTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateSubjectTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubjectTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateSubjectTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateSubjectTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateSubjectTerm(ctx, req.(*UpdateSubjectTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DeleteSubjectTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubjectTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DeleteSubjectTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DeleteSubjectTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DeleteSubjectTerm(ctx, req.(*DeleteSubjectTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticlesBySubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticlesBySubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticlesBySubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListArticlesBySubject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticlesBySubject(ctx, req.(*ListArticlesBySubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArticleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "article.ArticleService",
+	HandlerType: (*ArticleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetArticle",
+			Handler:    _ArticleService_GetArticle_Handler,
+		},
+		{
+			MethodName: "CreateArticle",
+			Handler:    _ArticleService_CreateArticle_Handler,
+		},
+		{
+			MethodName: "UpdateArticle",
+			Handler:    _ArticleService_UpdateArticle_Handler,
+		},
+		{
+			MethodName: "TransitionArticle",
+			Handler:    _ArticleService_TransitionArticle_Handler,
+		},
+		{
+			MethodName: "GetStatusHistory",
+			Handler:    _ArticleService_GetStatusHistory_Handler,
+		},
+		{
+			MethodName: "CreateAuthor",
+			Handler:    _ArticleService_CreateAuthor_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _ArticleService_GetAuthor_Handler,
+		},
+		{
+			MethodName: "UpdateAuthor",
+			Handler:    _ArticleService_UpdateAuthor_Handler,
+		},
+		{
+			MethodName: "ListAuthors",
+			Handler:    _ArticleService_ListAuthors_Handler,
+		},
+		{
+			MethodName: "ListArticlesByAuthor",
+			Handler:    _ArticleService_ListArticlesByAuthor_Handler,
+		},
+		{
+			MethodName: "FindDuplicateAuthors",
+			Handler:    _ArticleService_FindDuplicateAuthors_Handler,
+		},
+		{
+			MethodName: "MergeAuthors",
+			Handler:    _ArticleService_MergeAuthors_Handler,
+		},
+		{
+			MethodName: "UndoAuthorMerge",
+			Handler:    _ArticleService_UndoAuthorMerge_Handler,
+		},
+		{
+			MethodName: "ListAuthorMerges",
+			Handler:    _ArticleService_ListAuthorMerges_Handler,
+		},
+		{
+			MethodName: "GetAuthorMetrics",
+			Handler:    _ArticleService_GetAuthorMetrics_Handler,
+		},
+		{
+			MethodName: "GetJournalLeaderboard",
+			Handler:    _ArticleService_GetJournalLeaderboard_Handler,
+		},
+		{
+			MethodName: "RegisterReviewer",
+			Handler:    _ArticleService_RegisterReviewer_Handler,
+		},
+		{
+			MethodName: "ListReviewers",
+			Handler:    _ArticleService_ListReviewers_Handler,
+		},
+		{
+			MethodName: "SuggestReviewers",
+			Handler:    _ArticleService_SuggestReviewers_Handler,
+		},
+		{
+			MethodName: "AssignReviewer",
+			Handler:    _ArticleService_AssignReviewer_Handler,
+		},
+		{
+			MethodName: "ListReviewAssignments",
+			Handler:    _ArticleService_ListReviewAssignments_Handler,
+		},
+		{
+			MethodName: "SubmitReviewReport",
+			Handler:    _ArticleService_SubmitReviewReport_Handler,
+		},
+		{
+			MethodName: "ListReviewReports",
+			Handler:    _ArticleService_ListReviewReports_Handler,
+		},
+		{
+			MethodName: "RecordEditorDecision",
+			Handler:    _ArticleService_RecordEditorDecision_Handler,
+		},
+		{
+			MethodName: "ListEditorDecisions",
+			Handler:    _ArticleService_ListEditorDecisions_Handler,
+		},
+		{
+			MethodName: "PlaceArticle",
+			Handler:    _ArticleService_PlaceArticle_Handler,
+		},
+		{
+			MethodName: "GetArticlePlacement",
+			Handler:    _ArticleService_GetArticlePlacement_Handler,
+		},
+		{
+			MethodName: "ListIssueArticles",
+			Handler:    _ArticleService_ListIssueArticles_Handler,
+		},
+		{
+			MethodName: "SetArticleReferences",
+			Handler:    _ArticleService_SetArticleReferences_Handler,
+		},
+		{
+			MethodName: "ListArticleReferences",
+			Handler:    _ArticleService_ListArticleReferences_Handler,
+		},
+		{
+			MethodName: "ListCitingReferences",
+			Handler:    _ArticleService_ListCitingReferences_Handler,
+		},
+		{
+			MethodName: "GetCitationGraph",
+			Handler:    _ArticleService_GetCitationGraph_Handler,
+		},
+		{
+			MethodName: "RegisterArticleDOI",
+			Handler:    _ArticleService_RegisterArticleDOI_Handler,
+		},
+		{
+			MethodName: "GetArticleDepositXML",
+			Handler:    _ArticleService_GetArticleDepositXML_Handler,
+		},
+		{
+			MethodName: "RefreshArticleDOI",
+			Handler:    _ArticleService_RefreshArticleDOI_Handler,
+		},
+		{
+			MethodName: "GetArticleByDOI",
+			Handler:    _ArticleService_GetArticleByDOI_Handler,
+		},
+		{
+			MethodName: "ExportArticle",
+			Handler:    _ArticleService_ExportArticle_Handler,
+		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _ArticleService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "FindArticlesByTitle",
+			Handler:    _ArticleService_FindArticlesByTitle_Handler,
+		},
+		{
+			MethodName: "FindSimilarArticles",
+			Handler:    _ArticleService_FindSimilarArticles_Handler,
+		},
+		{
+			MethodName: "GetRelatedArticles",
+			Handler:    _ArticleService_GetRelatedArticles_Handler,
+		},
+		{
+			MethodName: "CreateSubjectTerm",
+			Handler:    _ArticleService_CreateSubjectTerm_Handler,
+		},
+		{
+			MethodName: "GetSubjectTerm",
+			Handler:    _ArticleService_GetSubjectTerm_Handler,
+		},
+		{
+			MethodName: "ListSubjectTerms",
+			Handler:    _ArticleService_ListSubjectTerms_Handler,
+		},
+		{
+			MethodName: "UpdateSubjectTerm",
+			Handler:    _ArticleService_UpdateSubjectTerm_Handler,
+		},
+		{
+			MethodName: "DeleteSubjectTerm",
+			Handler:    _ArticleService_DeleteSubjectTerm_Handler,
+		},
+		{
+			MethodName: "ListArticlesBySubject",
+			Handler:    _ArticleService_ListArticlesBySubject_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _ArticleService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _ArticleService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _ArticleService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _ArticleService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _ArticleService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _ArticleService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _ArticleService_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportArticles",
+			Handler:       _ArticleService_ExportArticles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportArticles",
+			Handler:       _ArticleService_ImportArticles_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "article.proto",
+}
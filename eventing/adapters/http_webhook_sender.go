@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// HTTPWebhookSender posts webhook payloads with a bounded timeout. It only
+// connects to public addresses, checked after DNS resolution, so a host name
+// that resolves to an internal address (or a redirect to one) is refused.
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+func NewHTTPWebhookSender(timeout time.Duration) *HTTPWebhookSender {
+	dialer := &net.Dialer{Timeout: timeout, Control: dialPublicOnly}
+	transport := &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: timeout}
+	return &HTTPWebhookSender{client: &http.Client{Timeout: timeout, Transport: transport}}
+}
+
+func (s *HTTPWebhookSender) Send(url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// dialPublicOnly runs before each connection with the resolved address
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !eventing.IsPublicAddress(ip) {
+		return fmt.Errorf("%w: %s", eventing.ErrForbiddenWebhookAddress, host)
+	}
+	return nil
+}
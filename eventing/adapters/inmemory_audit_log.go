@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+type InMemoryAuditLog struct {
+	mu      sync.RWMutex
+	entries []eventing.AuditEntry
+	// byEvent maps event IDs to positions in entries
+	byEvent map[string]int
+}
+
+func NewInMemoryAuditLog() *InMemoryAuditLog {
+	return &InMemoryAuditLog{byEvent: make(map[string]int)}
+}
+
+func (l *InMemoryAuditLog) Append(entry eventing.AuditEntry) (eventing.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if i, ok := l.byEvent[entry.EventID]; ok && entry.EventID != "" {
+		return l.entries[i], nil
+	}
+
+	var prevSequence int64
+	var prevHash string
+	if n := len(l.entries); n > 0 {
+		prevSequence, prevHash = l.entries[n-1].Sequence, l.entries[n-1].Hash
+	}
+
+	entry = eventing.ChainAuditEntry(entry, prevSequence, prevHash)
+	if entry.EventID != "" {
+		l.byEvent[entry.EventID] = len(l.entries)
+	}
+	l.entries = append(l.entries, entry)
+	return entry, nil
+}
+
+func (l *InMemoryAuditLog) Query(query eventing.AuditQuery) ([]eventing.AuditEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var entries []eventing.AuditEntry
+	for _, entry := range l.entries {
+		if query.EntityID != "" && entry.EntityID != query.EntityID {
+			continue
+		}
+		if !query.From.IsZero() && entry.Timestamp.Before(query.From) {
+			continue
+		}
+		if !query.To.IsZero() && !entry.Timestamp.Before(query.To) {
+			continue
+		}
+		entries = append(entries, entry)
+		if query.Limit > 0 && len(entries) == query.Limit {
+			break
+		}
+	}
+	return entries, nil
+}
+
+func (l *InMemoryAuditLog) Entries(afterSequence int64, limit int) ([]eventing.AuditEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var entries []eventing.AuditEntry
+	for _, entry := range l.entries {
+		if entry.Sequence <= afterSequence {
+			continue
+		}
+		entries = append(entries, entry)
+		if len(entries) == limit {
+			break
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,159 @@
+package adapters
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// EventHandler consumes a published domain event
+type EventHandler func(event eventing.Event) error
+
+// DefaultHandlerRetryPolicy retries a failing handler for about two minutes
+// before its event is dead-lettered
+var DefaultHandlerRetryPolicy = eventing.RetryPolicy{
+	MaxAttempts: 6,
+	BaseDelay:   5 * time.Second,
+	MaxDelay:    time.Minute,
+}
+
+// HandlerDeadLetter is an event a subscriber gave up on
+type HandlerDeadLetter struct {
+	Subscriber     string
+	Event          eventing.Event
+	Attempts       int
+	LastError      string
+	DeadLetteredAt time.Time
+}
+
+// handlerDelivery is an event waiting in the backlog of a subscriber
+type handlerDelivery struct {
+	event         eventing.Event
+	attempts      int
+	lastError     string
+	nextAttemptAt time.Time
+}
+
+type subscriber struct {
+	name    string
+	handler EventHandler
+	backlog []handlerDelivery
+}
+
+// InMemoryEventPublisher fans events out to handlers in the same process.
+// It stands in for a broker such as Kafka or NATS when running locally.
+//
+// Like consumer groups on a broker, subscribers receive events independently:
+// an event a handler fails on goes into the backlog of that handler, which
+// is retried with backoff and dead-lettered after the policy's attempts,
+// while the other handlers carry on. Later events queue behind the backlog so
+// that each handler still sees events in order. Backlogs are kept in memory
+// and are lost on restart.
+type InMemoryEventPublisher struct {
+	mu          sync.Mutex
+	policy      eventing.RetryPolicy
+	subscribers []*subscriber
+	deadLetters []HandlerDeadLetter
+}
+
+func NewInMemoryEventPublisher(policy eventing.RetryPolicy) *InMemoryEventPublisher {
+	return &InMemoryEventPublisher{policy: policy}
+}
+
+// Subscribe registers a handler that receives every published event. The
+// name identifies the handler in logs and dead letters.
+func (p *InMemoryEventPublisher) Subscribe(name string, handler EventHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subscribers = append(p.subscribers, &subscriber{name: name, handler: handler})
+}
+
+// Publish hands the event to every subscriber. It does not fail when a
+// handler does, since the event then waits in that handler's backlog.
+func (p *InMemoryEventPublisher) Publish(event eventing.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now().UTC()
+	for _, s := range p.subscribers {
+		s.backlog = append(s.backlog, handlerDelivery{event: event, nextAttemptAt: now})
+		p.deliver(s, now)
+	}
+	return nil
+}
+
+// RetryDue attempts the backlogged events whose next attempt is due and
+// returns the number of events still waiting
+func (p *InMemoryEventPublisher) RetryDue() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now().UTC()
+	waiting := 0
+	for _, s := range p.subscribers {
+		p.deliver(s, now)
+		waiting += len(s.backlog)
+	}
+	return waiting
+}
+
+// Run retries backlogged events until the context is cancelled
+func (p *InMemoryEventPublisher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			p.RetryDue()
+		}
+	}
+}
+
+// DeadLetters returns the events handlers gave up on, oldest first
+func (p *InMemoryEventPublisher) DeadLetters() []HandlerDeadLetter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]HandlerDeadLetter(nil), p.deadLetters...)
+}
+
+// deliver works through the backlog of the subscriber in order until it is
+// empty or its first event is not yet due. It must be called with the lock
+// held.
+func (p *InMemoryEventPublisher) deliver(s *subscriber, now time.Time) {
+	for len(s.backlog) > 0 && !s.backlog[0].nextAttemptAt.After(now) {
+		delivery := &s.backlog[0]
+		err := s.handler(delivery.event)
+		if err == nil {
+			s.backlog = s.backlog[1:]
+			continue
+		}
+
+		delivery.attempts++
+		delivery.lastError = err.Error()
+		if delivery.attempts < p.policy.MaxAttempts {
+			delivery.nextAttemptAt = now.Add(p.policy.Backoff(delivery.attempts))
+			log.Printf("Event handler %s failed on event %s (attempt %d): %v",
+				s.name, delivery.event.ID, delivery.attempts, err)
+			return
+		}
+
+		log.Printf("Event %s dead-lettered for handler %s after %d attempts: %s",
+			delivery.event.ID, s.name, delivery.attempts, delivery.lastError)
+		p.deadLetters = append(p.deadLetters, HandlerDeadLetter{
+			Subscriber:     s.name,
+			Event:          delivery.event,
+			Attempts:       delivery.attempts,
+			LastError:      delivery.lastError,
+			DeadLetteredAt: now,
+		})
+		s.backlog = s.backlog[1:]
+	}
+}
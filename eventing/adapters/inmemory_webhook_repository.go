@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+type InMemoryWebhookRepository struct {
+	mu            sync.RWMutex
+	subscriptions map[string]eventing.WebhookSubscription
+	deliveries    map[string]eventing.WebhookDelivery
+}
+
+func NewInMemoryWebhookRepository() *InMemoryWebhookRepository {
+	return &InMemoryWebhookRepository{
+		subscriptions: make(map[string]eventing.WebhookSubscription),
+		deliveries:    make(map[string]eventing.WebhookDelivery),
+	}
+}
+
+func (r *InMemoryWebhookRepository) CreateSubscription(subscription eventing.WebhookSubscription) (eventing.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscriptions[subscription.ID] = subscription
+	return subscription, nil
+}
+
+func (r *InMemoryWebhookRepository) GetSubscription(id string) (eventing.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return eventing.WebhookSubscription{}, eventing.ErrWebhookNotFound
+	}
+	return subscription, nil
+}
+
+func (r *InMemoryWebhookRepository) ListSubscriptions() ([]eventing.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscriptions := make([]eventing.WebhookSubscription, 0, len(r.subscriptions))
+	for _, subscription := range r.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+	return subscriptions, nil
+}
+
+func (r *InMemoryWebhookRepository) DeleteSubscription(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.subscriptions[id]; !ok {
+		return eventing.ErrWebhookNotFound
+	}
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) EnqueueDelivery(delivery eventing.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deliveries[delivery.ID]; !ok {
+		r.deliveries[delivery.ID] = delivery
+	}
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) GetDelivery(id string) (eventing.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return eventing.WebhookDelivery{}, eventing.ErrDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+func (r *InMemoryWebhookRepository) SaveDelivery(delivery eventing.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *InMemoryWebhookRepository) DueDeliveries(now time.Time, limit int) ([]eventing.WebhookDelivery, error) {
+	deliveries, err := r.ListDeliveries(eventing.DeliveryQuery{Status: eventing.DeliveryPending})
+	if err != nil {
+		return nil, err
+	}
+
+	var due []eventing.WebhookDelivery
+	for _, delivery := range deliveries {
+		if len(due) >= limit {
+			break
+		}
+		if !delivery.NextAttemptAt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *InMemoryWebhookRepository) ListDeliveries(query eventing.DeliveryQuery) ([]eventing.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []eventing.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if query.SubscriptionID != "" && delivery.SubscriptionID != query.SubscriptionID {
+			continue
+		}
+		if query.Status != "" && delivery.Status != query.Status {
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		if !deliveries[i].CreatedAt.Equal(deliveries[j].CreatedAt) {
+			return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt)
+		}
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	if query.Limit > 0 && len(deliveries) > query.Limit {
+		deliveries = deliveries[:query.Limit]
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// prefixTables names the tables of a service in a query by replacing every
+// "{prefix}" with the service's table prefix
+func prefixTables(query, prefix string) string {
+	return strings.ReplaceAll(query, "{prefix}", prefix)
+}
+
+// ensureColumn adds a column to an existing table. MySQL has no
+// ADD COLUMN IF NOT EXISTS, so the column is looked up first.
+func ensureColumn(db *sql.DB, table, column, definition string) error {
+	query := `
+	SELECT COUNT(*) 
+	FROM information_schema.COLUMNS 
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?`
+
+	var count int
+	if err := db.QueryRow(query, table, column).Scan(&count); err != nil {
+		return fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add %s.%s: %w", table, column, err)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// MySQLAuditLog stores the audit chain in the <prefix>_audit_log table of a
+// service. Appends are serialized through a single-row head table so
+// concurrent writers cannot fork the chain.
+type MySQLAuditLog struct {
+	db     *sql.DB
+	prefix string
+}
+
+func NewMySQLAuditLog(db *sql.DB, prefix string) *MySQLAuditLog {
+	return &MySQLAuditLog{db: db, prefix: prefix}
+}
+
+// InitializeSchema creates the audit log tables if they don't exist
+func (l *MySQLAuditLog) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS {prefix}_audit_log (
+		sequence BIGINT PRIMARY KEY,
+		entity_id VARCHAR(255) NOT NULL,
+		actor VARCHAR(255) NOT NULL,
+		peer VARCHAR(255) NOT NULL DEFAULT '',
+		operation VARCHAR(100) NOT NULL,
+		before_state LONGTEXT NULL,
+		after_state LONGTEXT NULL,
+		timestamp TIMESTAMP(6) NOT NULL,
+		event_id VARCHAR(64) NULL UNIQUE,
+		prev_hash CHAR(64) NOT NULL,
+		hash CHAR(64) NOT NULL,
+		INDEX idx_{prefix}_audit_entity (entity_id, timestamp),
+		INDEX idx_{prefix}_audit_timestamp (timestamp)
+	)`
+
+	_, err := l.db.Exec(prefixTables(query, l.prefix))
+	if err != nil {
+		return fmt.Errorf("failed to create %s_audit_log table: %w", l.prefix, err)
+	}
+
+	for _, column := range []struct{ name, definition string }{
+		{"peer", "VARCHAR(255) NOT NULL DEFAULT ''"},
+		{"event_id", "VARCHAR(64) NULL UNIQUE"},
+	} {
+		if err := ensureColumn(l.db, l.prefix+"_audit_log", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+
+	query = `
+	CREATE TABLE IF NOT EXISTS {prefix}_audit_head (
+		id TINYINT PRIMARY KEY,
+		sequence BIGINT NOT NULL,
+		hash CHAR(64) NOT NULL
+	)`
+
+	_, err = l.db.Exec(prefixTables(query, l.prefix))
+	if err != nil {
+		return fmt.Errorf("failed to create %s_audit_head table: %w", l.prefix, err)
+	}
+
+	_, err = l.db.Exec(prefixTables(`INSERT IGNORE INTO {prefix}_audit_head (id, sequence, hash) VALUES (1, 0, '')`, l.prefix))
+	if err != nil {
+		return fmt.Errorf("failed to initialize %s_audit_head: %w", l.prefix, err)
+	}
+
+	return nil
+}
+
+func (l *MySQLAuditLog) Append(entry eventing.AuditEntry) (eventing.AuditEntry, error) {
+	tx, err := l.db.Begin()
+	if err != nil {
+		return eventing.AuditEntry{}, fmt.Errorf("failed to append audit entry: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var prevSequence int64
+	var prevHash string
+	err = tx.QueryRow(prefixTables(`SELECT sequence, hash FROM {prefix}_audit_head WHERE id = 1 FOR UPDATE`, l.prefix)).Scan(&prevSequence, &prevHash)
+	if err != nil {
+		return eventing.AuditEntry{}, fmt.Errorf("failed to lock audit chain head: %w", err)
+	}
+
+	// The head lock serializes appends, so an event recorded by a concurrent
+	// append is visible here
+	if entry.EventID != "" {
+		recorded, err := scanAuditEntry(tx.QueryRow(prefixTables(auditSelect+` WHERE event_id = ?`, l.prefix), entry.EventID))
+		if err == nil {
+			return recorded, nil
+		}
+		if err != sql.ErrNoRows {
+			return eventing.AuditEntry{}, fmt.Errorf("failed to look up audit event %s: %w", entry.EventID, err)
+		}
+	}
+
+	entry = eventing.ChainAuditEntry(entry, prevSequence, prevHash)
+
+	query := `
+	INSERT INTO {prefix}_audit_log 
+		(sequence, entity_id, actor, peer, operation, before_state, after_state, timestamp, event_id, prev_hash, hash) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULLIF(?, ''), ?, ?)`
+
+	_, err = tx.Exec(prefixTables(query, l.prefix), entry.Sequence, entry.EntityID, entry.Actor, entry.Peer, entry.Operation,
+		nullJSON(entry.Before), nullJSON(entry.After), entry.Timestamp, entry.EventID, entry.PrevHash, entry.Hash)
+	if err != nil {
+		return eventing.AuditEntry{}, fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	_, err = tx.Exec(prefixTables(`UPDATE {prefix}_audit_head SET sequence = ?, hash = ? WHERE id = 1`, l.prefix), entry.Sequence, entry.Hash)
+	if err != nil {
+		return eventing.AuditEntry{}, fmt.Errorf("failed to advance audit chain head: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return eventing.AuditEntry{}, fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (l *MySQLAuditLog) Query(query eventing.AuditQuery) ([]eventing.AuditEntry, error) {
+	var conditions []string
+	var args []any
+	if query.EntityID != "" {
+		conditions = append(conditions, "entity_id = ?")
+		args = append(args, query.EntityID)
+	}
+	if !query.From.IsZero() {
+		conditions = append(conditions, "timestamp >= ?")
+		args = append(args, query.From)
+	}
+	if !query.To.IsZero() {
+		conditions = append(conditions, "timestamp < ?")
+		args = append(args, query.To)
+	}
+
+	sqlQuery := auditSelect
+	if len(conditions) > 0 {
+		sqlQuery += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	sqlQuery += ` ORDER BY sequence`
+	if query.Limit > 0 {
+		sqlQuery += ` LIMIT ?`
+		args = append(args, query.Limit)
+	}
+
+	return l.queryEntries(sqlQuery, args...)
+}
+
+func (l *MySQLAuditLog) Entries(afterSequence int64, limit int) ([]eventing.AuditEntry, error) {
+	query := auditSelect + ` WHERE sequence > ? ORDER BY sequence LIMIT ?`
+	return l.queryEntries(query, afterSequence, limit)
+}
+
+const auditSelect = `
+	SELECT sequence, entity_id, actor, peer, operation, before_state, after_state, timestamp, event_id, prev_hash, hash 
+	FROM {prefix}_audit_log`
+
+func (l *MySQLAuditLog) queryEntries(query string, args ...any) ([]eventing.AuditEntry, error) {
+	rows, err := l.db.Query(prefixTables(query, l.prefix), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []eventing.AuditEntry
+	for rows.Next() {
+		entry, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func scanAuditEntry(row rowScanner) (eventing.AuditEntry, error) {
+	var entry eventing.AuditEntry
+	var before, after []byte
+	var eventID sql.NullString
+	err := row.Scan(&entry.Sequence, &entry.EntityID, &entry.Actor, &entry.Peer, &entry.Operation,
+		&before, &after, &entry.Timestamp, &eventID, &entry.PrevHash, &entry.Hash)
+	if err != nil {
+		return eventing.AuditEntry{}, err
+	}
+	entry.Before, entry.After = before, after
+	entry.EventID = eventID.String
+	return entry, nil
+}
+
+// nullJSON stores empty snapshots as NULL. Snapshots live in LONGTEXT rather
+// than JSON columns because MySQL normalizes JSON documents, which would
+// change the bytes covered by the entry hash.
+func nullJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// MySQLOutbox is the <prefix>_outbox table of a service. Repositories store
+// events with InsertOutboxEvents in the transaction of the entity write, and
+// the relay reads them back through PendingEvents and MarkEventPublished.
+type MySQLOutbox struct {
+	db     *sql.DB
+	prefix string
+}
+
+func NewMySQLOutbox(db *sql.DB, prefix string) *MySQLOutbox {
+	return &MySQLOutbox{db: db, prefix: prefix}
+}
+
+// InitializeSchema creates the outbox table if it doesn't exist
+func (o *MySQLOutbox) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS {prefix}_outbox (
+		seq BIGINT AUTO_INCREMENT PRIMARY KEY,
+		id VARCHAR(64) NOT NULL UNIQUE,
+		event_type VARCHAR(100) NOT NULL,
+		aggregate_id VARCHAR(255) NOT NULL,
+		payload JSON NOT NULL,
+		occurred_at TIMESTAMP(6) NOT NULL,
+		published_at TIMESTAMP(6) NULL,
+		INDEX idx_{prefix}_outbox_pending (published_at, seq)
+	)`
+
+	if _, err := o.db.Exec(prefixTables(query, o.prefix)); err != nil {
+		return fmt.Errorf("failed to create %s_outbox table: %w", o.prefix, err)
+	}
+
+	return nil
+}
+
+func (o *MySQLOutbox) PendingEvents(limit int) ([]eventing.Event, error) {
+	query := `
+	SELECT id, event_type, aggregate_id, payload, occurred_at 
+	FROM {prefix}_outbox 
+	WHERE published_at IS NULL 
+	ORDER BY seq 
+	LIMIT ?`
+
+	rows, err := o.db.Query(prefixTables(query, o.prefix), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []eventing.Event
+	for rows.Next() {
+		var event eventing.Event
+		if err := rows.Scan(&event.ID, &event.Type, &event.AggregateID, &event.Payload, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pending event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (o *MySQLOutbox) MarkEventPublished(id string) error {
+	query := `UPDATE {prefix}_outbox SET published_at = UTC_TIMESTAMP(6) WHERE id = ?`
+
+	if _, err := o.db.Exec(prefixTables(query, o.prefix), id); err != nil {
+		return fmt.Errorf("failed to mark event as published: %w", err)
+	}
+
+	return nil
+}
+
+// InsertOutboxEvents stores events in the <prefix>_outbox table as part of
+// the caller's transaction
+func InsertOutboxEvents(tx *sql.Tx, prefix string, events []eventing.Event) error {
+	query := `
+	INSERT INTO {prefix}_outbox (id, event_type, aggregate_id, payload, occurred_at) 
+	VALUES (?, ?, ?, ?, ?)`
+
+	query = prefixTables(query, prefix)
+	for _, event := range events {
+		if _, err := tx.Exec(query, event.ID, event.Type, event.AggregateID, []byte(event.Payload), event.OccurredAt); err != nil {
+			return fmt.Errorf("failed to store %s event in outbox: %w", event.Type, err)
+		}
+	}
+
+	return nil
+}
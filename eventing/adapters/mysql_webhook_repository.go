@@ -0,0 +1,285 @@
+package adapters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// MySQLWebhookRepository stores subscriptions and deliveries in the
+// <prefix>_webhook_subscriptions and <prefix>_webhook_deliveries tables
+type MySQLWebhookRepository struct {
+	db     *sql.DB
+	prefix string
+}
+
+func NewMySQLWebhookRepository(db *sql.DB, prefix string) *MySQLWebhookRepository {
+	return &MySQLWebhookRepository{db: db, prefix: prefix}
+}
+
+// InitializeSchema creates the webhook subscription and delivery tables if they don't exist
+func (r *MySQLWebhookRepository) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS {prefix}_webhook_subscriptions (
+		id VARCHAR(64) PRIMARY KEY,
+		url VARCHAR(2048) NOT NULL,
+		secret VARCHAR(255) NOT NULL,
+		event_types JSON NOT NULL,
+		created_at TIMESTAMP(6) NOT NULL
+	)`
+
+	_, err := r.db.Exec(prefixTables(query, r.prefix))
+	if err != nil {
+		return fmt.Errorf("failed to create %s_webhook_subscriptions table: %w", r.prefix, err)
+	}
+
+	query = `
+	CREATE TABLE IF NOT EXISTS {prefix}_webhook_deliveries (
+		id VARCHAR(160) PRIMARY KEY,
+		subscription_id VARCHAR(64) NOT NULL,
+		event JSON NOT NULL,
+		status VARCHAR(32) NOT NULL,
+		attempts INT NOT NULL DEFAULT 0,
+		last_status_code INT NOT NULL DEFAULT 0,
+		last_error TEXT,
+		next_attempt_at TIMESTAMP(6) NOT NULL,
+		created_at TIMESTAMP(6) NOT NULL,
+		updated_at TIMESTAMP(6) NOT NULL,
+		INDEX idx_{prefix}_webhook_due (status, next_attempt_at),
+		INDEX idx_{prefix}_webhook_subscription (subscription_id, created_at)
+	)`
+
+	_, err = r.db.Exec(prefixTables(query, r.prefix))
+	if err != nil {
+		return fmt.Errorf("failed to create %s_webhook_deliveries table: %w", r.prefix, err)
+	}
+
+	return nil
+}
+
+func (r *MySQLWebhookRepository) CreateSubscription(subscription eventing.WebhookSubscription) (eventing.WebhookSubscription, error) {
+	eventTypes, err := json.Marshal(eventTypesOrEmpty(subscription.EventTypes))
+	if err != nil {
+		return eventing.WebhookSubscription{}, fmt.Errorf("failed to encode event types: %w", err)
+	}
+
+	query := `
+	INSERT INTO {prefix}_webhook_subscriptions (id, url, secret, event_types, created_at) 
+	VALUES (?, ?, ?, ?, ?)`
+
+	_, err = r.db.Exec(prefixTables(query, r.prefix), subscription.ID, subscription.URL, subscription.Secret, eventTypes, subscription.CreatedAt)
+	if err != nil {
+		return eventing.WebhookSubscription{}, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *MySQLWebhookRepository) GetSubscription(id string) (eventing.WebhookSubscription, error) {
+	query := `
+	SELECT id, url, secret, event_types, created_at 
+	FROM {prefix}_webhook_subscriptions 
+	WHERE id = ?`
+
+	subscription, err := scanSubscription(r.db.QueryRow(prefixTables(query, r.prefix), id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return eventing.WebhookSubscription{}, eventing.ErrWebhookNotFound
+		}
+		return eventing.WebhookSubscription{}, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *MySQLWebhookRepository) ListSubscriptions() ([]eventing.WebhookSubscription, error) {
+	query := `
+	SELECT id, url, secret, event_types, created_at 
+	FROM {prefix}_webhook_subscriptions 
+	ORDER BY created_at`
+
+	rows, err := r.db.Query(prefixTables(query, r.prefix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []eventing.WebhookSubscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func (r *MySQLWebhookRepository) DeleteSubscription(id string) error {
+	result, err := r.db.Exec(prefixTables(`DELETE FROM {prefix}_webhook_subscriptions WHERE id = ?`, r.prefix), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if affected == 0 {
+		return eventing.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *MySQLWebhookRepository) EnqueueDelivery(delivery eventing.WebhookDelivery) error {
+	event, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	query := `
+	INSERT IGNORE INTO {prefix}_webhook_deliveries 
+		(id, subscription_id, event, status, attempts, last_status_code, last_error, next_attempt_at, created_at, updated_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_, err = r.db.Exec(prefixTables(query, r.prefix), delivery.ID, delivery.SubscriptionID, event, delivery.Status, delivery.Attempts,
+		delivery.LastStatusCode, delivery.LastError, delivery.NextAttemptAt, delivery.CreatedAt, delivery.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLWebhookRepository) GetDelivery(id string) (eventing.WebhookDelivery, error) {
+	query := deliverySelect + ` WHERE id = ?`
+
+	delivery, err := scanDelivery(r.db.QueryRow(prefixTables(query, r.prefix), id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return eventing.WebhookDelivery{}, eventing.ErrDeliveryNotFound
+		}
+		return eventing.WebhookDelivery{}, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *MySQLWebhookRepository) SaveDelivery(delivery eventing.WebhookDelivery) error {
+	query := `
+	UPDATE {prefix}_webhook_deliveries 
+	SET status = ?, attempts = ?, last_status_code = ?, last_error = ?, next_attempt_at = ?, updated_at = ? 
+	WHERE id = ?`
+
+	_, err := r.db.Exec(prefixTables(query, r.prefix), delivery.Status, delivery.Attempts, delivery.LastStatusCode,
+		delivery.LastError, delivery.NextAttemptAt, delivery.UpdatedAt, delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLWebhookRepository) DueDeliveries(now time.Time, limit int) ([]eventing.WebhookDelivery, error) {
+	query := deliverySelect + `
+	WHERE status = ? AND next_attempt_at <= ? 
+	ORDER BY next_attempt_at, id 
+	LIMIT ?`
+
+	return r.queryDeliveries(query, eventing.DeliveryPending, now, limit)
+}
+
+func (r *MySQLWebhookRepository) ListDeliveries(query eventing.DeliveryQuery) ([]eventing.WebhookDelivery, error) {
+	var conditions []string
+	var args []any
+	if query.SubscriptionID != "" {
+		conditions = append(conditions, "subscription_id = ?")
+		args = append(args, query.SubscriptionID)
+	}
+	if query.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, query.Status)
+	}
+
+	sqlQuery := deliverySelect
+	if len(conditions) > 0 {
+		sqlQuery += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	sqlQuery += ` ORDER BY created_at, id`
+	if query.Limit > 0 {
+		sqlQuery += ` LIMIT ?`
+		args = append(args, query.Limit)
+	}
+
+	return r.queryDeliveries(sqlQuery, args...)
+}
+
+const deliverySelect = `
+	SELECT id, subscription_id, event, status, attempts, last_status_code, last_error, 
+		next_attempt_at, created_at, updated_at 
+	FROM {prefix}_webhook_deliveries`
+
+func (r *MySQLWebhookRepository) queryDeliveries(query string, args ...any) ([]eventing.WebhookDelivery, error) {
+	rows, err := r.db.Query(prefixTables(query, r.prefix), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []eventing.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSubscription(row rowScanner) (eventing.WebhookSubscription, error) {
+	var subscription eventing.WebhookSubscription
+	var eventTypes []byte
+	if err := row.Scan(&subscription.ID, &subscription.URL, &subscription.Secret, &eventTypes, &subscription.CreatedAt); err != nil {
+		return eventing.WebhookSubscription{}, err
+	}
+	if err := json.Unmarshal(eventTypes, &subscription.EventTypes); err != nil {
+		return eventing.WebhookSubscription{}, fmt.Errorf("failed to decode event types: %w", err)
+	}
+	return subscription, nil
+}
+
+func scanDelivery(row rowScanner) (eventing.WebhookDelivery, error) {
+	var delivery eventing.WebhookDelivery
+	var event []byte
+	var lastError sql.NullString
+	err := row.Scan(&delivery.ID, &delivery.SubscriptionID, &event, &delivery.Status, &delivery.Attempts,
+		&delivery.LastStatusCode, &lastError, &delivery.NextAttemptAt, &delivery.CreatedAt, &delivery.UpdatedAt)
+	if err != nil {
+		return eventing.WebhookDelivery{}, err
+	}
+	if err := json.Unmarshal(event, &delivery.Event); err != nil {
+		return eventing.WebhookDelivery{}, fmt.Errorf("failed to decode webhook event: %w", err)
+	}
+	delivery.LastError = lastError.String
+	return delivery, nil
+}
+
+func eventTypesOrEmpty(eventTypes []eventing.EventType) []eventing.EventType {
+	if eventTypes == nil {
+		return []eventing.EventType{}
+	}
+	return eventTypes
+}
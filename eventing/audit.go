@@ -0,0 +1,216 @@
+package eventing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SystemActor is recorded for mutations that were not attributed to a user
+const SystemActor = "system"
+
+// Caller identifies who made a change. Actor is the name the caller gave,
+// which services take on trust; Peer is the network address the request
+// came from, when there was one.
+type Caller struct {
+	Actor string
+	Peer  string
+}
+
+// SystemCaller is the caller of unattributed mutations
+var SystemCaller = Caller{Actor: SystemActor}
+
+// AuditOperation names the mutating service call that produced an entry
+type AuditOperation string
+
+// EventAuditRecorded carries an audit entry through the outbox. Services
+// store it in the same transaction as the mutation it describes, and the
+// AuditService appends the entry to the log when the relay publishes it, so
+// a committed change cannot go unrecorded. It is never sent to webhooks.
+const EventAuditRecorded EventType = "audit.recorded"
+
+// AuditEntry records one mutation. Entries form a hash chain: each entry's
+// hash covers its own fields and the hash of the entry before it, so editing
+// or deleting a stored entry breaks every hash that follows.
+//
+// Actor is the name the caller claimed, which is not authenticated; Peer is
+// the network address the request came from.
+type AuditEntry struct {
+	Sequence  int64           `json:"sequence"`
+	EntityID  string          `json:"entity_id"`
+	Actor     string          `json:"actor"`
+	Peer      string          `json:"peer,omitempty"`
+	Operation AuditOperation  `json:"operation"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+	// EventID is the ID of the audit event the entry was recorded from
+	EventID  string `json:"event_id,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// AuditQuery filters audit entries. Zero values match everything.
+type AuditQuery struct {
+	EntityID string
+	From     time.Time
+	To       time.Time
+	Limit    int
+}
+
+// ComputeHash returns the SHA-256 over the entry's fields and PrevHash
+func (e AuditEntry) ComputeHash() string {
+	fields := []string{
+		strconv.FormatInt(e.Sequence, 10),
+		e.EntityID,
+		e.Actor,
+		string(e.Operation),
+		string(e.Before),
+		string(e.After),
+		e.Timestamp.UTC().Format(time.RFC3339Nano),
+		e.PrevHash,
+	}
+	// Fields added later are only covered when set, so that entries
+	// written before them still verify
+	if e.Peer != "" {
+		fields = append(fields, "peer", e.Peer)
+	}
+	if e.EventID != "" {
+		fields = append(fields, "event", e.EventID)
+	}
+
+	h := sha256.New()
+	for _, field := range fields {
+		// Length-prefix each field so that shifting bytes between fields changes the hash
+		h.Write([]byte(strconv.Itoa(len(field))))
+		h.Write([]byte{':'})
+		h.Write([]byte(field))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ChainAuditEntry links the entry to the previous head of the chain and
+// seals it. Adapters call it while holding the lock on the chain head.
+func ChainAuditEntry(entry AuditEntry, prevSequence int64, prevHash string) AuditEntry {
+	entry.Sequence = prevSequence + 1
+	entry.PrevHash = prevHash
+	entry.Hash = entry.ComputeHash()
+	return entry
+}
+
+// VerifyAuditChain checks consecutive entries ordered by sequence. It returns
+// ErrAuditChainBroken with the first sequence that does not verify.
+func VerifyAuditChain(entries []AuditEntry, prevSequence int64, prevHash string) error {
+	for _, entry := range entries {
+		if entry.Sequence != prevSequence+1 {
+			return fmt.Errorf("%w: expected sequence %d, found %d", ErrAuditChainBroken, prevSequence+1, entry.Sequence)
+		}
+		if entry.PrevHash != prevHash {
+			return fmt.Errorf("%w: entry %d does not link to its predecessor", ErrAuditChainBroken, entry.Sequence)
+		}
+		if entry.Hash != entry.ComputeHash() {
+			return fmt.Errorf("%w: entry %d has been modified", ErrAuditChainBroken, entry.Sequence)
+		}
+		prevSequence, prevHash = entry.Sequence, entry.Hash
+	}
+	return nil
+}
+
+// AuditService queries and verifies the audit log
+type AuditService struct {
+	log AuditLog
+}
+
+func NewAuditService(log AuditLog) *AuditService {
+	return &AuditService{log: log}
+}
+
+// HandleEvent appends the entry carried by an EventAuditRecorded event and
+// ignores other events. The log skips events it already recorded, so an
+// event the relay publishes twice is recorded once.
+func (s *AuditService) HandleEvent(event Event) error {
+	if event.Type != EventAuditRecorded {
+		return nil
+	}
+
+	var entry AuditEntry
+	if err := json.Unmarshal(event.Payload, &entry); err != nil {
+		return fmt.Errorf("failed to decode audit event %s: %w", event.ID, err)
+	}
+	entry.EventID = event.ID
+
+	if _, err := s.log.Append(entry); err != nil {
+		return fmt.Errorf("failed to record audit entry for %s: %w", entry.EntityID, err)
+	}
+	return nil
+}
+
+func (s *AuditService) ListEntries(query AuditQuery) ([]AuditEntry, error) {
+	return s.log.Query(query)
+}
+
+// VerifyChain walks the whole chain from the first entry and returns the
+// number of entries that were verified
+func (s *AuditService) VerifyChain() (int64, error) {
+	const batchSize = 500
+
+	var prevSequence int64
+	var prevHash string
+	for {
+		entries, err := s.log.Entries(prevSequence, batchSize)
+		if err != nil {
+			return prevSequence, err
+		}
+		if err := VerifyAuditChain(entries, prevSequence, prevHash); err != nil {
+			return prevSequence, err
+		}
+		if len(entries) < batchSize {
+			if len(entries) > 0 {
+				prevSequence = entries[len(entries)-1].Sequence
+			}
+			return prevSequence, nil
+		}
+		last := entries[len(entries)-1]
+		prevSequence, prevHash = last.Sequence, last.Hash
+	}
+}
+
+// NewAuditEvent builds an EventAuditRecorded event for a mutation, with JSON
+// snapshots of the entity before and after it. A nil snapshot is left empty.
+func NewAuditEvent(caller Caller, operation AuditOperation, entityID string, before, after any) (Event, error) {
+	entry, err := NewAuditEntry(caller, operation, entityID, before, after)
+	if err != nil {
+		return Event{}, err
+	}
+	return NewEvent(EventAuditRecorded, entityID, entry)
+}
+
+// NewAuditEntry builds an unsealed entry with JSON snapshots of the entity
+// before and after the mutation. A nil snapshot is left empty.
+func NewAuditEntry(caller Caller, operation AuditOperation, entityID string, before, after any) (AuditEntry, error) {
+	entry := AuditEntry{
+		EntityID:  entityID,
+		Actor:     caller.Actor,
+		Peer:      caller.Peer,
+		Operation: operation,
+		// Truncated to the precision adapters can store, so hashes verify on read
+		Timestamp: time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return AuditEntry{}, fmt.Errorf("failed to encode audit snapshot: %w", err)
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return AuditEntry{}, fmt.Errorf("failed to encode audit snapshot: %w", err)
+		}
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,126 @@
+package eventing_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+	"github.com/realBagher/hexaservice-go/eventing/adapters"
+)
+
+type thing struct {
+	Name string `json:"name"`
+}
+
+func TestAuditServiceRecordsAuditEvents(t *testing.T) {
+	log := adapters.NewInMemoryAuditLog()
+	audit := eventing.NewAuditService(log)
+
+	caller := eventing.Caller{Actor: "editor", Peer: "198.51.100.7:50000"}
+	event, err := eventing.NewAuditEvent(caller, "rename", "42", thing{Name: "old"}, thing{Name: "new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != eventing.EventAuditRecorded || event.AggregateID != "42" {
+		t.Fatalf("event = %s %s, want %s 42", event.Type, event.AggregateID, eventing.EventAuditRecorded)
+	}
+
+	// The relay delivers at least once, so the same event may arrive twice
+	for range 2 {
+		if err := audit.HandleEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	other, err := eventing.NewEvent(eventCreated, "42", thing{Name: "new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := audit.HandleEvent(other); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := audit.ListEntries(eventing.AuditQuery{EntityID: "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	entry := entries[0]
+	if entry.Actor != "editor" || entry.Peer != caller.Peer || entry.EventID != event.ID || entry.Operation != "rename" {
+		t.Errorf("entry = %+v", entry)
+	}
+	if string(entry.Before) != `{"name":"old"}` || string(entry.After) != `{"name":"new"}` {
+		t.Errorf("snapshots = %s, %s", entry.Before, entry.After)
+	}
+
+	verified, err := audit.VerifyChain()
+	if err != nil || verified != 1 {
+		t.Errorf("VerifyChain() = %d, %v, want 1, nil", verified, err)
+	}
+}
+
+func TestAuditServiceRejectsUndecodableEvents(t *testing.T) {
+	audit := eventing.NewAuditService(adapters.NewInMemoryAuditLog())
+	event := eventing.Event{ID: "1", Type: eventing.EventAuditRecorded, Payload: []byte("not json")}
+	if err := audit.HandleEvent(event); err == nil {
+		t.Error("HandleEvent() accepted a payload that is not an audit entry")
+	}
+}
+
+func TestAuditEntryHash(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	legacy := eventing.AuditEntry{Sequence: 1, EntityID: "42", Actor: "editor", Operation: "rename", Timestamp: at}
+	legacy.Hash = legacy.ComputeHash()
+
+	// Entries written before the peer and event were recorded still verify
+	if err := eventing.VerifyAuditChain([]eventing.AuditEntry{legacy}, 0, ""); err != nil {
+		t.Fatalf("legacy entry: %v", err)
+	}
+
+	withPeer := legacy
+	withPeer.Peer = "198.51.100.7:50000"
+	if withPeer.ComputeHash() == legacy.Hash {
+		t.Error("the peer is not covered by the hash")
+	}
+	withEvent := legacy
+	withEvent.EventID = "abc"
+	if withEvent.ComputeHash() == legacy.Hash {
+		t.Error("the event ID is not covered by the hash")
+	}
+
+	tampered := withPeer
+	tampered.Hash = legacy.Hash
+	err := eventing.VerifyAuditChain([]eventing.AuditEntry{tampered}, 0, "")
+	if !errors.Is(err, eventing.ErrAuditChainBroken) {
+		t.Errorf("VerifyAuditChain() = %v, want ErrAuditChainBroken", err)
+	}
+}
+
+func TestAuditChain(t *testing.T) {
+	log := adapters.NewInMemoryAuditLog()
+	for i := range 3 {
+		entry, err := eventing.NewAuditEntry(eventing.SystemCaller, "touch", "42", nil, thing{Name: string(rune('a' + i))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := log.Append(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := log.Entries(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[1].PrevHash != entries[0].Hash || entries[2].PrevHash != entries[1].Hash {
+		t.Fatalf("entries are not chained: %+v", entries)
+	}
+
+	entries[1].After = []byte(`{"name":"z"}`)
+	err = eventing.VerifyAuditChain(entries, 0, "")
+	if !errors.Is(err, eventing.ErrAuditChainBroken) {
+		t.Errorf("VerifyAuditChain() = %v, want ErrAuditChainBroken", err)
+	}
+}
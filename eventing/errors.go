@@ -0,0 +1,23 @@
+package eventing
+
+import "errors"
+
+var (
+	// ErrWebhookNotFound is returned when a webhook subscription is not found
+	ErrWebhookNotFound = errors.New("webhook subscription not found")
+
+	// ErrInvalidWebhook is returned when webhook subscription data is invalid
+	ErrInvalidWebhook = errors.New("invalid webhook subscription")
+
+	// ErrDeliveryNotFound is returned when a webhook delivery is not found
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// ErrForbiddenWebhookAddress is returned when a webhook would be sent to
+	// a loopback, link-local or private address
+	ErrForbiddenWebhookAddress = errors.New("webhook address not allowed")
+)
+
+var (
+	// ErrAuditChainBroken is returned when the audit hash chain does not verify
+	ErrAuditChainBroken = errors.New("audit chain broken")
+)
@@ -0,0 +1,51 @@
+// Package eventing holds the event plumbing that the journal and article
+// services share: domain events and the outbox relay, outbound webhooks and
+// the hash-chained audit log. Each service defines its own event types and
+// audit operations and keeps its tables apart through a table prefix.
+package eventing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EventType identifies the kind of domain event
+type EventType string
+
+// Event is a domain event raised by a service. The payload holds the JSON
+// encoded state of the aggregate at the time the event occurred.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        EventType       `json:"type"`
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+// NewEvent creates an event for the given aggregate with a JSON encoded payload
+func NewEvent(eventType EventType, aggregateID string, payload any) (Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("failed to encode %s event payload: %w", eventType, err)
+	}
+
+	return Event{
+		ID:          NewID(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     data,
+		OccurredAt:  time.Now().UTC(),
+	}, nil
+}
+
+// NewID returns a random 128-bit identifier encoded as hex
+func NewID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate random ID: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
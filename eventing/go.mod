@@ -0,0 +1,3 @@
+module github.com/realBagher/hexaservice-go/eventing
+
+go 1.24.3
@@ -0,0 +1,68 @@
+package eventing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const defaultRelayBatchSize = 100
+
+// OutboxRelay moves events from the outbox to an EventPublisher. Events are
+// only marked as published once the publisher accepted them, so delivery is
+// at-least-once and consumers must tolerate duplicates.
+type OutboxRelay struct {
+	outbox    OutboxRepository
+	publisher EventPublisher
+	interval  time.Duration
+	batchSize int
+}
+
+func NewOutboxRelay(outbox OutboxRepository, publisher EventPublisher, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: defaultRelayBatchSize,
+	}
+}
+
+// Run polls the outbox until the context is cancelled
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RelayPending(); err != nil {
+			log.Printf("Outbox relay: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes one batch of pending events in the order they were
+// stored. It stops at the first failure so that ordering is preserved and
+// returns the number of events that were published.
+func (r *OutboxRelay) RelayPending() (int, error) {
+	events, err := r.outbox.PendingEvents(r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load pending events: %w", err)
+	}
+
+	for i, event := range events {
+		if err := r.publisher.Publish(event); err != nil {
+			return i, fmt.Errorf("failed to publish event %s: %w", event.ID, err)
+		}
+		if err := r.outbox.MarkEventPublished(event.ID); err != nil {
+			return i, fmt.Errorf("failed to mark event %s as published: %w", event.ID, err)
+		}
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,213 @@
+package eventing_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+	"github.com/realBagher/hexaservice-go/eventing/adapters"
+)
+
+// sliceOutbox keeps events in the order they were stored
+type sliceOutbox struct {
+	events    []eventing.Event
+	published map[string]bool
+}
+
+func newSliceOutbox(t *testing.T, count int) *sliceOutbox {
+	t.Helper()
+	outbox := &sliceOutbox{published: make(map[string]bool)}
+	for i := range count {
+		event, err := eventing.NewEvent(eventCreated, strconv.Itoa(i), map[string]int{"n": i})
+		if err != nil {
+			t.Fatal(err)
+		}
+		outbox.events = append(outbox.events, event)
+	}
+	return outbox
+}
+
+func (o *sliceOutbox) PendingEvents(limit int) ([]eventing.Event, error) {
+	var pending []eventing.Event
+	for _, event := range o.events {
+		if len(pending) == limit {
+			break
+		}
+		if !o.published[event.ID] {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (o *sliceOutbox) MarkEventPublished(id string) error {
+	o.published[id] = true
+	return nil
+}
+
+// publisherFunc is a broker that accepts an event when the function does
+type publisherFunc func(event eventing.Event) error
+
+func (f publisherFunc) Publish(event eventing.Event) error {
+	return f(event)
+}
+
+func TestNewEvent(t *testing.T) {
+	event, err := eventing.NewEvent(eventCreated, "42", map[string]string{"name": "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(event.ID) != 32 || event.Type != eventCreated || event.AggregateID != "42" || string(event.Payload) != `{"name":"x"}` {
+		t.Errorf("event = %+v", event)
+	}
+	if time.Since(event.OccurredAt) > time.Minute || event.OccurredAt.Location() != time.UTC {
+		t.Errorf("occurred at %v", event.OccurredAt)
+	}
+
+	if _, err := eventing.NewEvent(eventCreated, "42", func() {}); err == nil {
+		t.Error("NewEvent() encoded a payload JSON cannot represent")
+	}
+}
+
+func TestOutboxRelayPublishesInBatches(t *testing.T) {
+	outbox := newSliceOutbox(t, 150)
+	publisher := adapters.NewInMemoryEventPublisher(adapters.DefaultHandlerRetryPolicy)
+	var received []string
+	publisher.Subscribe("test", func(event eventing.Event) error {
+		received = append(received, event.AggregateID)
+		return nil
+	})
+	relay := eventing.NewOutboxRelay(outbox, publisher, time.Second)
+
+	for _, want := range []int{100, 50, 0} {
+		relayed, err := relay.RelayPending()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if relayed != want {
+			t.Fatalf("RelayPending() = %d, want %d", relayed, want)
+		}
+	}
+
+	if len(received) != 150 {
+		t.Fatalf("received %d events, want 150", len(received))
+	}
+	for i, id := range received {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("event %d is %s; events were published out of order", i, id)
+		}
+	}
+}
+
+func TestOutboxRelayStopsAtTheFirstFailure(t *testing.T) {
+	outbox := newSliceOutbox(t, 5)
+	var received []string
+	failAt := "2"
+	publisher := publisherFunc(func(event eventing.Event) error {
+		if event.AggregateID == failAt {
+			return errors.New("broker unavailable")
+		}
+		received = append(received, event.AggregateID)
+		return nil
+	})
+	relay := eventing.NewOutboxRelay(outbox, publisher, time.Second)
+
+	relayed, err := relay.RelayPending()
+	if err == nil || relayed != 2 {
+		t.Fatalf("RelayPending() = %d, %v, want 2 and an error", relayed, err)
+	}
+	if outbox.published[outbox.events[2].ID] || outbox.published[outbox.events[3].ID] {
+		t.Fatal("events after the failure were marked as published")
+	}
+
+	// The failed event is retried first on the next pass
+	failAt = ""
+	if relayed, err = relay.RelayPending(); err != nil || relayed != 3 {
+		t.Fatalf("RelayPending() = %d, %v, want 3, nil", relayed, err)
+	}
+	if want := []string{"0", "1", "2", "3", "4"}; len(received) != len(want) {
+		t.Fatalf("received %v, want %v", received, want)
+	}
+	for i, id := range received {
+		if id != strconv.Itoa(i) {
+			t.Fatalf("received %v out of order", received)
+		}
+	}
+}
+
+func TestPublisherIsolatesFailingHandlers(t *testing.T) {
+	outbox := newSliceOutbox(t, 3)
+	publisher := adapters.NewInMemoryEventPublisher(eventing.RetryPolicy{MaxAttempts: 2, BaseDelay: 50 * time.Millisecond, MaxDelay: time.Second})
+	var audited, indexed []string
+	publisher.Subscribe("audit", func(event eventing.Event) error {
+		audited = append(audited, event.AggregateID)
+		return nil
+	})
+	publisher.Subscribe("search", func(event eventing.Event) error {
+		if event.AggregateID == "1" {
+			return errors.New("index unavailable")
+		}
+		indexed = append(indexed, event.AggregateID)
+		return nil
+	})
+	relay := eventing.NewOutboxRelay(outbox, publisher, time.Second)
+
+	// The failing handler keeps events 1 and 2 back, the other one does not
+	if relayed, err := relay.RelayPending(); err != nil || relayed != 3 {
+		t.Fatalf("RelayPending() = %d, %v, want 3, nil", relayed, err)
+	}
+	if strings.Join(audited, " ") != "0 1 2" || strings.Join(indexed, " ") != "0" {
+		t.Fatalf("audited %v and indexed %v", audited, indexed)
+	}
+
+	// After the last attempt event 1 is dead-lettered and event 2 follows
+	time.Sleep(60 * time.Millisecond)
+	if waiting := publisher.RetryDue(); waiting != 0 {
+		t.Fatalf("RetryDue() left %d events waiting", waiting)
+	}
+	if strings.Join(indexed, " ") != "0 2" {
+		t.Errorf("indexed %v, want 0 2", indexed)
+	}
+	deadLetters := publisher.DeadLetters()
+	if len(deadLetters) != 1 || deadLetters[0].Subscriber != "search" || deadLetters[0].Event.AggregateID != "1" ||
+		deadLetters[0].Attempts != 2 || deadLetters[0].LastError != "index unavailable" {
+		t.Errorf("DeadLetters() = %+v", deadLetters)
+	}
+}
+
+func TestPublisherRetriesInOrder(t *testing.T) {
+	publisher := adapters.NewInMemoryEventPublisher(eventing.RetryPolicy{MaxAttempts: 3, BaseDelay: 50 * time.Millisecond, MaxDelay: time.Second})
+	var handled []string
+	failures := 1
+	publisher.Subscribe("metrics", func(event eventing.Event) error {
+		if failures > 0 {
+			failures--
+			return errors.New("database unavailable")
+		}
+		handled = append(handled, event.AggregateID)
+		return nil
+	})
+
+	for _, event := range newSliceOutbox(t, 2).events {
+		if err := publisher.Publish(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Event 1 waits behind event 0 rather than overtaking it
+	if len(handled) != 0 {
+		t.Fatalf("handled %v before the retry", handled)
+	}
+	if waiting := publisher.RetryDue(); waiting != 2 {
+		t.Errorf("RetryDue() before the backoff = %d waiting, want 2", waiting)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if waiting := publisher.RetryDue(); waiting != 0 || strings.Join(handled, " ") != "0 1" {
+		t.Errorf("RetryDue() = %d waiting, handled %v", waiting, handled)
+	}
+	if deadLetters := publisher.DeadLetters(); len(deadLetters) != 0 {
+		t.Errorf("DeadLetters() = %+v", deadLetters)
+	}
+}
@@ -0,0 +1,46 @@
+package eventing
+
+import "time"
+
+// OutboxRepository gives the relay access to events that were stored
+// alongside entity writes but have not been published yet.
+type OutboxRepository interface {
+	PendingEvents(limit int) ([]Event, error)
+	MarkEventPublished(id string) error
+}
+
+// EventPublisher delivers domain events to a message broker
+type EventPublisher interface {
+	Publish(event Event) error
+}
+
+// WebhookRepository stores webhook subscriptions and the delivery log
+type WebhookRepository interface {
+	CreateSubscription(subscription WebhookSubscription) (WebhookSubscription, error)
+	GetSubscription(id string) (WebhookSubscription, error)
+	ListSubscriptions() ([]WebhookSubscription, error)
+	DeleteSubscription(id string) error
+	// EnqueueDelivery stores a new delivery and ignores IDs that already exist
+	EnqueueDelivery(delivery WebhookDelivery) error
+	GetDelivery(id string) (WebhookDelivery, error)
+	SaveDelivery(delivery WebhookDelivery) error
+	// DueDeliveries returns pending deliveries whose next attempt is before now
+	DueDeliveries(now time.Time, limit int) ([]WebhookDelivery, error)
+	ListDeliveries(query DeliveryQuery) ([]WebhookDelivery, error)
+}
+
+// WebhookSender performs the HTTP call for a webhook delivery
+type WebhookSender interface {
+	Send(url string, headers map[string]string, body []byte) (statusCode int, err error)
+}
+
+// AuditLog is an append-only store of audit entries
+type AuditLog interface {
+	// Append seals the entry with ChainAuditEntry against the current head
+	// of the chain and stores it atomically. An entry whose EventID is
+	// already in the log is not appended again; the stored entry is returned.
+	Append(entry AuditEntry) (AuditEntry, error)
+	Query(query AuditQuery) ([]AuditEntry, error)
+	// Entries returns up to limit entries with a sequence above afterSequence
+	Entries(afterSequence int64, limit int) ([]AuditEntry, error)
+}
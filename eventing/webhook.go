@@ -0,0 +1,365 @@
+package eventing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader carries the HMAC-SHA256 signature of a delivery
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookTimestampHeader carries the Unix time the delivery was signed at
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	// WebhookEventHeader carries the event type of a delivery
+	WebhookEventHeader = "X-Webhook-Event"
+	// WebhookDeliveryHeader carries the delivery ID, stable across retries
+	WebhookDeliveryHeader = "X-Webhook-Delivery"
+)
+
+// DeliveryStatus is the state of a webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryPending      DeliveryStatus = "pending"
+	DeliverySucceeded    DeliveryStatus = "succeeded"
+	DeliveryDeadLettered DeliveryStatus = "dead_lettered"
+)
+
+// WebhookSubscription registers a partner endpoint for domain events. An
+// empty EventTypes list subscribes to every event.
+type WebhookSubscription struct {
+	ID         string      `json:"id"`
+	URL        string      `json:"url"`
+	Secret     string      `json:"-"`
+	EventTypes []EventType `json:"event_types"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// Validate checks if the subscription data is valid. Event types must be
+// among the given ones, which are the events the service publishes.
+func (s WebhookSubscription) Validate(eventTypes []EventType) error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: URL must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+
+	// Host names are checked again when the sender dials, after they resolve
+	if !publicHost(u.Hostname()) {
+		return fmt.Errorf("%w: URL must not point to a loopback, link-local or private address", ErrInvalidWebhook)
+	}
+
+	if strings.TrimSpace(s.Secret) == "" {
+		return fmt.Errorf("%w: secret cannot be empty", ErrInvalidWebhook)
+	}
+
+	for _, t := range s.EventTypes {
+		if !containsEventType(eventTypes, t) {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, t)
+		}
+	}
+
+	return nil
+}
+
+// IsPublicAddress reports whether webhooks may be sent to the address. It
+// rejects loopback, link-local, private (RFC 1918 and RFC 4193) and
+// unspecified addresses, so that subscriptions cannot reach internal
+// services.
+func IsPublicAddress(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsPrivate() && !ip.IsUnspecified()
+}
+
+func publicHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return IsPublicAddress(ip)
+	}
+	return true
+}
+
+func containsEventType(eventTypes []EventType, eventType EventType) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches reports whether the subscription wants events of the given type
+func (s WebhookSubscription) Matches(eventType EventType) bool {
+	return len(s.EventTypes) == 0 || containsEventType(s.EventTypes, eventType)
+}
+
+// WebhookDelivery tracks sending one event to one subscription
+type WebhookDelivery struct {
+	ID             string         `json:"id"`
+	SubscriptionID string         `json:"subscription_id"`
+	Event          Event          `json:"event"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	LastStatusCode int            `json:"last_status_code"`
+	LastError      string         `json:"last_error"`
+	NextAttemptAt  time.Time      `json:"next_attempt_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+// DeliveryQuery filters the delivery log. Zero values match everything.
+type DeliveryQuery struct {
+	SubscriptionID string
+	Status         DeliveryStatus
+	Limit          int
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 of "timestamp.body"
+// keyed with the subscription secret. Receivers recompute it to verify that
+// the payload came from us and was not replayed with a different timestamp.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RetryPolicy controls exponential backoff between delivery attempts
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+const defaultDispatchBatchSize = 50
+
+// DefaultRetryPolicy retries for roughly an hour before dead-lettering
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 8,
+	BaseDelay:   30 * time.Second,
+	MaxDelay:    30 * time.Minute,
+}
+
+// Backoff returns the delay before the next attempt after the given number
+// of failed attempts
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return delay
+}
+
+// WebhookService manages subscriptions and turns domain events into
+// deliveries that are sent by DeliverDue. Only the given event types are
+// offered to subscribers; other events, such as the audit events that travel
+// through the same outbox, are never sent out.
+type WebhookService struct {
+	repository WebhookRepository
+	sender     WebhookSender
+	policy     RetryPolicy
+	eventTypes []EventType
+}
+
+func NewWebhookService(repository WebhookRepository, sender WebhookSender, policy RetryPolicy, eventTypes []EventType) *WebhookService {
+	return &WebhookService{repository: repository, sender: sender, policy: policy, eventTypes: eventTypes}
+}
+
+func (s *WebhookService) CreateSubscription(subscription WebhookSubscription) (WebhookSubscription, error) {
+	if err := subscription.Validate(s.eventTypes); err != nil {
+		return WebhookSubscription{}, err
+	}
+
+	subscription.ID = NewID()
+	subscription.CreatedAt = time.Now().UTC()
+	return s.repository.CreateSubscription(subscription)
+}
+
+func (s *WebhookService) ListSubscriptions() ([]WebhookSubscription, error) {
+	return s.repository.ListSubscriptions()
+}
+
+func (s *WebhookService) DeleteSubscription(id string) error {
+	return s.repository.DeleteSubscription(id)
+}
+
+func (s *WebhookService) ListDeliveries(query DeliveryQuery) ([]WebhookDelivery, error) {
+	return s.repository.ListDeliveries(query)
+}
+
+// Redeliver puts a delivery back in the queue, typically to replay a
+// dead-lettered delivery once the receiver has been fixed
+func (s *WebhookService) Redeliver(id string) (WebhookDelivery, error) {
+	delivery, err := s.repository.GetDelivery(id)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	now := time.Now().UTC()
+	delivery.Status = DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+	if err := s.repository.SaveDelivery(delivery); err != nil {
+		return WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+// HandleEvent queues a delivery for every subscription that matches the
+// event. Delivery IDs are derived from the subscription and event IDs, so
+// handling the same event twice does not send it twice.
+func (s *WebhookService) HandleEvent(event Event) error {
+	if !containsEventType(s.eventTypes, event.Type) {
+		return nil
+	}
+
+	subscriptions, err := s.repository.ListSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type) {
+			continue
+		}
+
+		delivery := WebhookDelivery{
+			ID:             subscription.ID + "-" + event.ID,
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Status:         DeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := s.repository.EnqueueDelivery(delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeliverDue attempts every pending delivery whose next attempt is due and
+// returns the number of deliveries that were attempted
+func (s *WebhookService) DeliverDue(limit int) (int, error) {
+	deliveries, err := s.repository.DueDeliveries(time.Now().UTC(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.attempt(delivery); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *WebhookService) attempt(delivery WebhookDelivery) error {
+	subscription, err := s.repository.GetSubscription(delivery.SubscriptionID)
+	if err == ErrWebhookNotFound {
+		// The subscription was deleted after the event was queued
+		delivery.LastError = err.Error()
+		return s.deadLetter(delivery)
+	}
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	headers := map[string]string{
+		"Content-Type":         "application/json",
+		WebhookSignatureHeader: SignWebhookPayload(subscription.Secret, timestamp, body),
+		WebhookTimestampHeader: strconv.FormatInt(timestamp, 10),
+		WebhookEventHeader:     string(delivery.Event.Type),
+		WebhookDeliveryHeader:  delivery.ID,
+	}
+
+	statusCode, sendErr := s.sender.Send(subscription.URL, headers, body)
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedAt = time.Now().UTC()
+
+	switch {
+	case sendErr == nil && statusCode >= 200 && statusCode < 300:
+		delivery.Status = DeliverySucceeded
+		delivery.LastError = ""
+	case sendErr != nil:
+		delivery.LastError = sendErr.Error()
+	default:
+		delivery.LastError = fmt.Sprintf("unexpected status code %d", statusCode)
+	}
+
+	if delivery.Status == DeliverySucceeded {
+		return s.repository.SaveDelivery(delivery)
+	}
+	if delivery.Attempts >= s.policy.MaxAttempts {
+		return s.deadLetter(delivery)
+	}
+
+	delivery.NextAttemptAt = delivery.UpdatedAt.Add(s.policy.Backoff(delivery.Attempts))
+	return s.repository.SaveDelivery(delivery)
+}
+
+func (s *WebhookService) deadLetter(delivery WebhookDelivery) error {
+	log.Printf("Webhook delivery %s dead-lettered after %d attempts: %s",
+		delivery.ID, delivery.Attempts, delivery.LastError)
+
+	delivery.Status = DeliveryDeadLettered
+	delivery.UpdatedAt = time.Now().UTC()
+	return s.repository.SaveDelivery(delivery)
+}
+
+// WebhookDispatcher periodically sends due webhook deliveries
+type WebhookDispatcher struct {
+	service   *WebhookService
+	interval  time.Duration
+	batchSize int
+}
+
+func NewWebhookDispatcher(service *WebhookService, interval time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{service: service, interval: interval, batchSize: defaultDispatchBatchSize}
+}
+
+// Run delivers due webhooks until the context is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.service.DeliverDue(d.batchSize); err != nil {
+			log.Printf("Webhook dispatcher: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package eventing_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+	"github.com/realBagher/hexaservice-go/eventing/adapters"
+)
+
+const (
+	eventCreated eventing.EventType = "thing.created"
+	eventUpdated eventing.EventType = "thing.updated"
+)
+
+var eventTypes = []eventing.EventType{eventCreated, eventUpdated}
+
+func TestWebhookSubscriptionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []eventing.EventType
+		wantErr    bool
+	}{
+		{name: "public host", url: "https://hooks.example.com/events"},
+		{name: "public address", url: "http://203.0.113.10:8080/hook"},
+		{name: "known event types", url: "https://hooks.example.com", eventTypes: []eventing.EventType{eventUpdated}},
+		{name: "relative URL", url: "/hook", wantErr: true},
+		{name: "unsupported scheme", url: "ftp://hooks.example.com", wantErr: true},
+		{name: "localhost", url: "http://localhost:8080/hook", wantErr: true},
+		{name: "localhost subdomain", url: "http://api.localhost/hook", wantErr: true},
+		{name: "loopback", url: "http://127.0.0.1/hook", wantErr: true},
+		{name: "IPv6 loopback", url: "http://[::1]/hook", wantErr: true},
+		{name: "IPv4-mapped loopback", url: "http://[::ffff:127.0.0.1]/hook", wantErr: true},
+		{name: "link-local metadata endpoint", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "IPv6 link-local", url: "http://[fe80::1]/hook", wantErr: true},
+		{name: "RFC 1918 10/8", url: "http://10.1.2.3/hook", wantErr: true},
+		{name: "RFC 1918 172.16/12", url: "http://172.20.0.5/hook", wantErr: true},
+		{name: "RFC 1918 192.168/16", url: "https://192.168.1.1/hook", wantErr: true},
+		{name: "unique local IPv6", url: "http://[fd00::1]/hook", wantErr: true},
+		{name: "unspecified", url: "http://0.0.0.0/hook", wantErr: true},
+		{name: "unknown event type", url: "https://hooks.example.com", eventTypes: []eventing.EventType{"thing.deleted"}, wantErr: true},
+		{name: "internal event type", url: "https://hooks.example.com", eventTypes: []eventing.EventType{eventing.EventAuditRecorded}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := eventing.WebhookSubscription{URL: tt.url, Secret: "secret", EventTypes: tt.eventTypes}
+			err := subscription.Validate(eventTypes)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, eventing.ErrInvalidWebhook) {
+				t.Fatalf("Validate() = %v, want ErrInvalidWebhook", err)
+			}
+		})
+	}
+}
+
+func TestWebhookSubscriptionValidateRequiresSecret(t *testing.T) {
+	subscription := eventing.WebhookSubscription{URL: "https://hooks.example.com", Secret: "  "}
+	if err := subscription.Validate(eventTypes); !errors.Is(err, eventing.ErrInvalidWebhook) {
+		t.Fatalf("Validate() = %v, want ErrInvalidWebhook", err)
+	}
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for address, want := range map[string]bool{
+		"203.0.113.10":    true,
+		"2001:db8::1":     true,
+		"127.0.0.53":      false,
+		"169.254.1.1":     false,
+		"172.31.255.255":  false,
+		"172.32.0.1":      true,
+		"fe80::abcd":      false,
+		"::":              false,
+		"::ffff:10.0.0.1": false,
+	} {
+		if got := eventing.IsPublicAddress(net.ParseIP(address)); got != want {
+			t.Errorf("IsPublicAddress(%s) = %t, want %t", address, got, want)
+		}
+	}
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000.{}"))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := eventing.SignWebhookPayload("secret", 1700000000, []byte("{}")); got != want {
+		t.Fatalf("SignWebhookPayload() = %s, want %s", got, want)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := eventing.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempts, want := range []time.Duration{time.Second, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := policy.Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+// recordingSender answers every request with the next status code
+type recordingSender struct {
+	statusCodes []int
+	requests    []map[string]string
+}
+
+func (s *recordingSender) Send(url string, headers map[string]string, body []byte) (int, error) {
+	s.requests = append(s.requests, headers)
+	status := s.statusCodes[0]
+	if len(s.statusCodes) > 1 {
+		s.statusCodes = s.statusCodes[1:]
+	}
+	return status, nil
+}
+
+func newWebhookService(t *testing.T, sender eventing.WebhookSender, policy eventing.RetryPolicy) (*eventing.WebhookService, eventing.WebhookSubscription) {
+	t.Helper()
+	service := eventing.NewWebhookService(adapters.NewInMemoryWebhookRepository(), sender, policy, eventTypes)
+	subscription, err := service.CreateSubscription(eventing.WebhookSubscription{URL: "https://hooks.example.com", Secret: "secret"})
+	if err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+	return service, subscription
+}
+
+func TestWebhookServiceDeliversSignedEvents(t *testing.T) {
+	sender := &recordingSender{statusCodes: []int{204}}
+	service, subscription := newWebhookService(t, sender, eventing.DefaultRetryPolicy)
+
+	event, err := eventing.NewEvent(eventCreated, "42", map[string]string{"id": "42"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Handling an event twice must not send it twice
+	for i := 0; i < 2; i++ {
+		if err := service.HandleEvent(event); err != nil {
+			t.Fatalf("HandleEvent: %v", err)
+		}
+	}
+
+	sent, err := service.DeliverDue(10)
+	if err != nil || sent != 1 {
+		t.Fatalf("DeliverDue() = %d, %v, want 1 delivery", sent, err)
+	}
+
+	headers := sender.requests[0]
+	if headers[eventing.WebhookEventHeader] != string(eventCreated) {
+		t.Errorf("event header = %q, want %q", headers[eventing.WebhookEventHeader], eventCreated)
+	}
+	if headers[eventing.WebhookDeliveryHeader] != subscription.ID+"-"+event.ID {
+		t.Errorf("delivery header = %q", headers[eventing.WebhookDeliveryHeader])
+	}
+	if _, err := strconv.ParseInt(headers[eventing.WebhookTimestampHeader], 10, 64); err != nil {
+		t.Errorf("timestamp header = %q", headers[eventing.WebhookTimestampHeader])
+	}
+
+	deliveries, err := service.ListDeliveries(eventing.DeliveryQuery{Status: eventing.DeliverySucceeded})
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("ListDeliveries() = %v, %v, want one succeeded delivery", deliveries, err)
+	}
+}
+
+func TestWebhookServiceSkipsUnpublishedEventTypes(t *testing.T) {
+	sender := &recordingSender{statusCodes: []int{200}}
+	service, _ := newWebhookService(t, sender, eventing.DefaultRetryPolicy)
+
+	event, err := eventing.NewEvent(eventing.EventAuditRecorded, "42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.HandleEvent(event); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	deliveries, err := service.ListDeliveries(eventing.DeliveryQuery{})
+	if err != nil || len(deliveries) != 0 {
+		t.Fatalf("ListDeliveries() = %v, %v, want no deliveries", deliveries, err)
+	}
+}
+
+func TestWebhookServiceDeadLettersAfterLastAttempt(t *testing.T) {
+	sender := &recordingSender{statusCodes: []int{500}}
+	policy := eventing.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Nanosecond, MaxDelay: time.Nanosecond}
+	service, _ := newWebhookService(t, sender, policy)
+
+	event, err := eventing.NewEvent(eventUpdated, "42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.HandleEvent(event); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		time.Sleep(time.Millisecond)
+		if _, err := service.DeliverDue(10); err != nil {
+			t.Fatalf("DeliverDue: %v", err)
+		}
+	}
+
+	deliveries, err := service.ListDeliveries(eventing.DeliveryQuery{Status: eventing.DeliveryDeadLettered})
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("ListDeliveries() = %v, %v, want one dead-lettered delivery", deliveries, err)
+	}
+	if got := deliveries[0]; got.Attempts != 2 || got.LastStatusCode != 500 {
+		t.Errorf("dead-lettered delivery = %+v", got)
+	}
+
+	redelivered, err := service.Redeliver(deliveries[0].ID)
+	if err != nil || redelivered.Status != eventing.DeliveryPending || redelivered.Attempts != 0 {
+		t.Fatalf("Redeliver() = %+v, %v", redelivered, err)
+	}
+}
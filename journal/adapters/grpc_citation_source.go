@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+	"github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// GRPCCitationSource loads citation data from the article service through the
+// CitationData gRPC API
+type GRPCCitationSource struct {
+	client  proto.CitationDataClient
+	timeout time.Duration
+}
+
+func NewGRPCCitationSource(conn grpc.ClientConnInterface, timeout time.Duration) *GRPCCitationSource {
+	return &GRPCCitationSource{client: proto.NewCitationDataClient(conn), timeout: timeout}
+}
+
+func (s *GRPCCitationSource) JournalCitations(journalID string) ([]core.CitableItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	res, err := s.client.GetJournalCitations(ctx, &proto.GetJournalCitationsRequest{JournalId: journalID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get citations of journal %s: %w", journalID, err)
+	}
+
+	items := make([]core.CitableItem, 0, len(res.Items))
+	for _, item := range res.Items {
+		citationYears := make([]int, 0, len(item.CitationYears))
+		for _, year := range item.CitationYears {
+			citationYears = append(citationYears, int(year))
+		}
+		items = append(items, core.CitableItem{ArticleID: item.ArticleId, Year: int(item.Year), CitationYears: citationYears})
+	}
+	return items, nil
+}
@@ -0,0 +1,19 @@
+package adapters
+
+import (
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+// InMemoryCitationSource serves fixed citation data, for running the journal
+// service without the article service
+type InMemoryCitationSource struct {
+	items map[string][]core.CitableItem
+}
+
+func NewInMemoryCitationSource(items map[string][]core.CitableItem) *InMemoryCitationSource {
+	return &InMemoryCitationSource{items: items}
+}
+
+func (s *InMemoryCitationSource) JournalCitations(journalID string) ([]core.CitableItem, error) {
+	return s.items[journalID], nil
+}
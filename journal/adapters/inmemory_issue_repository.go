@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+func (r *InMemoryJournalRepository) CreateVolume(volume core.Volume, events ...core.Event) (core.Volume, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.volumes[volume.ID] = volume
+	r.appendEvents(events)
+	return volume, nil
+}
+
+func (r *InMemoryJournalRepository) GetVolume(id string) (core.Volume, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	volume, ok := r.volumes[id]
+	if !ok {
+		return core.Volume{}, core.ErrVolumeNotFound
+	}
+	return volume, nil
+}
+
+func (r *InMemoryJournalRepository) ListVolumes(journalID string) ([]core.Volume, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var volumes []core.Volume
+	for _, volume := range r.volumes {
+		if volume.JournalID == journalID {
+			volumes = append(volumes, volume)
+		}
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Number < volumes[j].Number })
+	return volumes, nil
+}
+
+func (r *InMemoryJournalRepository) CreateIssue(issue core.Issue, events ...core.Event) (core.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.issues[issue.ID] = issue
+	r.appendEvents(events)
+	return issue, nil
+}
+
+func (r *InMemoryJournalRepository) GetIssue(id string) (core.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	issue, ok := r.issues[id]
+	if !ok {
+		return core.Issue{}, core.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+func (r *InMemoryJournalRepository) ListIssues(volumeID string) ([]core.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var issues []core.Issue
+	for _, issue := range r.issues {
+		if issue.VolumeID == volumeID {
+			issues = append(issues, issue)
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Number < issues[j].Number })
+	return issues, nil
+}
+
+func (r *InMemoryJournalRepository) UpdateIssue(issue core.Issue, expected core.IssueStatus, events ...core.Event) (core.Issue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.issues[issue.ID]
+	if !ok {
+		return core.Issue{}, core.ErrIssueNotFound
+	}
+	if stored.Status != expected {
+		return core.Issue{}, fmt.Errorf("%w: issue %s is %s", core.ErrIssueStatusChanged, issue.ID, stored.Status)
+	}
+	r.issues[issue.ID] = issue
+	r.appendEvents(events)
+	return issue, nil
+}
+
+func (r *InMemoryJournalRepository) DueIssues(now time.Time, limit int) ([]core.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var due []core.Issue
+	for _, issue := range r.issues {
+		if issue.Status == core.IssueScheduled && !issue.ScheduledFor.After(now) {
+			due = append(due, issue)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ScheduledFor.Before(*due[j].ScheduledFor) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+type metricsKey struct {
+	journalID string
+	year      int
+}
+
+// InMemoryMetricsRepository writes its events to the outbox of the journal
+// repository it is created with
+type InMemoryMetricsRepository struct {
+	mu       sync.RWMutex
+	metrics  map[metricsKey]core.JournalMetrics
+	journals *InMemoryJournalRepository
+}
+
+func NewInMemoryMetricsRepository(journals *InMemoryJournalRepository) *InMemoryMetricsRepository {
+	return &InMemoryMetricsRepository{metrics: make(map[metricsKey]core.JournalMetrics), journals: journals}
+}
+
+func (r *InMemoryMetricsRepository) SaveMetrics(metrics core.JournalMetrics, events ...core.Event) error {
+	r.journals.mu.Lock()
+	defer r.journals.mu.Unlock()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.metrics[metricsKey{metrics.JournalID, metrics.Year}] = metrics
+	r.journals.appendEvents(events)
+	return nil
+}
+
+func (r *InMemoryMetricsRepository) GetMetrics(journalID string, year int) (core.JournalMetrics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	metrics, ok := r.metrics[metricsKey{journalID, year}]
+	if !ok {
+		return core.JournalMetrics{}, core.ErrMetricsNotFound
+	}
+	return metrics, nil
+}
+
+func (r *InMemoryMetricsRepository) ListMetrics(journalID string) ([]core.JournalMetrics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var history []core.JournalMetrics
+	for key, metrics := range r.metrics {
+		if key.journalID == journalID {
+			history = append(history, metrics)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool { return history[i].Year < history[j].Year })
+	return history, nil
+}
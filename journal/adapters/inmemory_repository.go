@@ -1,26 +1,121 @@
 package adapters
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/realBagher/hexaservice-go/journal/core"
 )
 
+type outboxEntry struct {
+	event     core.Event
+	published bool
+}
+
 type InMemoryJournalRepository struct {
+	mu       sync.RWMutex
 	journals map[string]core.Journal
+	volumes  map[string]core.Volume
+	issues   map[string]core.Issue
+	outbox   []outboxEntry
 }
 
 func NewInMemoryJournalRepository() *InMemoryJournalRepository {
-	return &InMemoryJournalRepository{journals: make(map[string]core.Journal)}
+	return &InMemoryJournalRepository{
+		journals: make(map[string]core.Journal),
+		volumes:  make(map[string]core.Volume),
+		issues:   make(map[string]core.Issue),
+	}
 }
 
-func (r *InMemoryJournalRepository) CreateJournal(journal core.Journal) (core.Journal, error) {
+func (r *InMemoryJournalRepository) CreateJournal(journal core.Journal, events ...core.Event) (core.Journal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.journals[journal.ID] = journal
+	r.appendEvents(events)
 	return journal, nil
 }
 
 func (r *InMemoryJournalRepository) GetJournal(id string) (core.Journal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	journal, ok := r.journals[id]
 	if !ok {
 		return core.Journal{}, core.ErrJournalNotFound
 	}
 	return journal, nil
 }
+
+func (r *InMemoryJournalRepository) GetJournalByISSN(issn string) (core.Journal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, journal := range r.journals {
+		if journal.PrintISSN == issn || journal.ElectronicISSN == issn || journal.LinkingISSN == issn {
+			return journal, nil
+		}
+	}
+	return core.Journal{}, core.ErrJournalNotFound
+}
+
+func (r *InMemoryJournalRepository) ListJournals() ([]core.Journal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	journals := make([]core.Journal, 0, len(r.journals))
+	for _, journal := range r.journals {
+		journals = append(journals, journal)
+	}
+	sort.Slice(journals, func(i, j int) bool { return journals[i].ID < journals[j].ID })
+	return journals, nil
+}
+
+func (r *InMemoryJournalRepository) UpdateJournal(journal core.Journal, events ...core.Event) (core.Journal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.journals[journal.ID]; !ok {
+		return core.Journal{}, core.ErrJournalNotFound
+	}
+	r.journals[journal.ID] = journal
+	r.appendEvents(events)
+	return journal, nil
+}
+
+func (r *InMemoryJournalRepository) PendingEvents(limit int) ([]core.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []core.Event
+	for _, entry := range r.outbox {
+		if len(events) >= limit {
+			break
+		}
+		if !entry.published {
+			events = append(events, entry.event)
+		}
+	}
+	return events, nil
+}
+
+func (r *InMemoryJournalRepository) MarkEventPublished(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.outbox {
+		if r.outbox[i].event.ID == id {
+			r.outbox[i].published = true
+			return nil
+		}
+	}
+	return nil
+}
+
+// appendEvents must be called with the write lock held
+func (r *InMemoryJournalRepository) appendEvents(events []core.Event) {
+	for _, event := range events {
+		r.outbox = append(r.outbox, outboxEntry{event: event})
+	}
+}
@@ -0,0 +1,225 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+func (r *MySQLJournalRepository) initializeIssueSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS volumes (
+		id VARCHAR(64) PRIMARY KEY,
+		journal_id VARCHAR(255) NOT NULL,
+		number INT NOT NULL,
+		year INT NOT NULL,
+		created_at TIMESTAMP(6) NOT NULL,
+		UNIQUE KEY uq_volumes_journal_number (journal_id, number)
+	)`
+
+	_, err := r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create volumes table: %w", err)
+	}
+
+	query = `
+	CREATE TABLE IF NOT EXISTS issues (
+		id VARCHAR(64) PRIMARY KEY,
+		journal_id VARCHAR(255) NOT NULL,
+		volume_id VARCHAR(64) NOT NULL,
+		number INT NOT NULL,
+		title VARCHAR(512) NOT NULL DEFAULT '',
+		status VARCHAR(32) NOT NULL,
+		scheduled_for TIMESTAMP(6) NULL,
+		published_at TIMESTAMP(6) NULL,
+		created_at TIMESTAMP(6) NOT NULL,
+		UNIQUE KEY uq_issues_volume_number (volume_id, number),
+		INDEX idx_issues_due (status, scheduled_for)
+	)`
+
+	_, err = r.db.Exec(query)
+	if err != nil {
+		return fmt.Errorf("failed to create issues table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLJournalRepository) CreateVolume(volume core.Volume, events ...core.Event) (core.Volume, error) {
+	query := `
+	INSERT INTO volumes (id, journal_id, number, year, created_at) 
+	VALUES (?, ?, ?, ?, ?)`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(query, volume.ID, volume.JournalID, volume.Number, volume.Year, volume.CreatedAt); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err != nil {
+		return core.Volume{}, fmt.Errorf("failed to create volume: %w", err)
+	}
+
+	return volume, nil
+}
+
+func (r *MySQLJournalRepository) GetVolume(id string) (core.Volume, error) {
+	var volume core.Volume
+	query := `
+	SELECT id, journal_id, number, year, created_at 
+	FROM volumes 
+	WHERE id = ?`
+
+	err := r.db.QueryRow(query, id).Scan(&volume.ID, &volume.JournalID, &volume.Number, &volume.Year, &volume.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.Volume{}, core.ErrVolumeNotFound
+		}
+		return core.Volume{}, fmt.Errorf("failed to get volume: %w", err)
+	}
+
+	return volume, nil
+}
+
+func (r *MySQLJournalRepository) ListVolumes(journalID string) ([]core.Volume, error) {
+	query := `
+	SELECT id, journal_id, number, year, created_at 
+	FROM volumes 
+	WHERE journal_id = ? 
+	ORDER BY number`
+
+	rows, err := r.db.Query(query, journalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	defer rows.Close()
+
+	var volumes []core.Volume
+	for rows.Next() {
+		var volume core.Volume
+		if err := rows.Scan(&volume.ID, &volume.JournalID, &volume.Number, &volume.Year, &volume.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan volume: %w", err)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	return volumes, rows.Err()
+}
+
+func (r *MySQLJournalRepository) CreateIssue(issue core.Issue, events ...core.Event) (core.Issue, error) {
+	query := `
+	INSERT INTO issues (id, journal_id, volume_id, number, title, status, scheduled_for, published_at, created_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		_, err := tx.Exec(query, issue.ID, issue.JournalID, issue.VolumeID, issue.Number, issue.Title, issue.Status,
+			issue.ScheduledFor, issue.PublishedAt, issue.CreatedAt)
+		if err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err != nil {
+		return core.Issue{}, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return issue, nil
+}
+
+func (r *MySQLJournalRepository) GetIssue(id string) (core.Issue, error) {
+	issue, err := scanIssue(r.db.QueryRow(issueSelect+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.Issue{}, core.ErrIssueNotFound
+		}
+		return core.Issue{}, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	return issue, nil
+}
+
+func (r *MySQLJournalRepository) ListIssues(volumeID string) ([]core.Issue, error) {
+	return r.queryIssues(issueSelect+" WHERE volume_id = ? ORDER BY number", volumeID)
+}
+
+func (r *MySQLJournalRepository) UpdateIssue(issue core.Issue, expected core.IssueStatus, events ...core.Event) (core.Issue, error) {
+	query := `
+	UPDATE issues 
+	SET title = ?, status = ?, scheduled_for = ?, published_at = ? 
+	WHERE id = ?`
+
+	err := r.inTx(func(tx *sql.Tx) error {
+		var status core.IssueStatus
+		err := tx.QueryRow("SELECT status FROM issues WHERE id = ? FOR UPDATE", issue.ID).Scan(&status)
+		if err == sql.ErrNoRows {
+			return core.ErrIssueNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if status != expected {
+			return fmt.Errorf("%w: issue %s is %s", core.ErrIssueStatusChanged, issue.ID, status)
+		}
+
+		if _, err := tx.Exec(query, issue.Title, issue.Status, issue.ScheduledFor, issue.PublishedAt, issue.ID); err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if errors.Is(err, core.ErrIssueNotFound) || errors.Is(err, core.ErrIssueStatusChanged) {
+		return core.Issue{}, err
+	}
+	if err != nil {
+		return core.Issue{}, fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	return issue, nil
+}
+
+func (r *MySQLJournalRepository) DueIssues(now time.Time, limit int) ([]core.Issue, error) {
+	return r.queryIssues(issueSelect+" WHERE status = ? AND scheduled_for <= ? ORDER BY scheduled_for LIMIT ?",
+		core.IssueScheduled, now, limit)
+}
+
+const issueSelect = `
+	SELECT id, journal_id, volume_id, number, title, status, scheduled_for, published_at, created_at 
+	FROM issues`
+
+func (r *MySQLJournalRepository) queryIssues(query string, args ...any) ([]core.Issue, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query issues: %w", err)
+	}
+	defer rows.Close()
+
+	var issues []core.Issue
+	for rows.Next() {
+		issue, err := scanIssue(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan issue: %w", err)
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues, rows.Err()
+}
+
+func scanIssue(row rowScanner) (core.Issue, error) {
+	var issue core.Issue
+	var scheduledFor, publishedAt sql.NullTime
+	err := row.Scan(&issue.ID, &issue.JournalID, &issue.VolumeID, &issue.Number, &issue.Title, &issue.Status,
+		&scheduledFor, &publishedAt, &issue.CreatedAt)
+	if err != nil {
+		return core.Issue{}, err
+	}
+	if scheduledFor.Valid {
+		issue.ScheduledFor = &scheduledFor.Time
+	}
+	if publishedAt.Valid {
+		issue.PublishedAt = &publishedAt.Time
+	}
+	return issue, nil
+}
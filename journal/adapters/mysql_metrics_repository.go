@@ -0,0 +1,153 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+// MySQLMetricsRepository writes its events to the journal outbox, which lives
+// in the same database
+type MySQLMetricsRepository struct {
+	db *sql.DB
+}
+
+func NewMySQLMetricsRepository(db *sql.DB) *MySQLMetricsRepository {
+	return &MySQLMetricsRepository{db: db}
+}
+
+// InitializeSchema creates the journal metrics table if it doesn't exist
+func (r *MySQLMetricsRepository) InitializeSchema() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS journal_metrics (
+		journal_id VARCHAR(255) NOT NULL,
+		year INT NOT NULL,
+		citable_items INT NOT NULL DEFAULT 0,
+		citations INT NOT NULL DEFAULT 0,
+		impact_factor DOUBLE NULL,
+		immediacy_index DOUBLE NULL,
+		cited_half_life DOUBLE NULL,
+		override_value DOUBLE NULL,
+		override_reason TEXT,
+		override_actor VARCHAR(255),
+		override_at TIMESTAMP(6) NULL,
+		computed_at TIMESTAMP(6) NULL,
+		PRIMARY KEY (journal_id, year)
+	)`
+
+	if _, err := r.db.Exec(query); err != nil {
+		return fmt.Errorf("failed to create journal_metrics table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLMetricsRepository) SaveMetrics(metrics core.JournalMetrics, events ...core.Event) error {
+	query := `
+	REPLACE INTO journal_metrics (journal_id, year, citable_items, citations, impact_factor, immediacy_index, 
+		cited_half_life, override_value, override_reason, override_actor, override_at, computed_at) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	var overrideValue *float64
+	var overrideReason, overrideActor sql.NullString
+	var overrideAt sql.NullTime
+	if override := metrics.Override; override != nil {
+		overrideValue = &override.Value
+		overrideReason = sql.NullString{String: override.Reason, Valid: true}
+		overrideActor = sql.NullString{String: override.Actor, Valid: true}
+		overrideAt = sql.NullTime{Time: override.At, Valid: true}
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to save journal metrics: %w", err)
+	}
+	_, err = tx.Exec(query, metrics.JournalID, metrics.Year, metrics.CitableItems, metrics.Citations,
+		metrics.ImpactFactor, metrics.ImmediacyIndex, metrics.CitedHalfLife,
+		overrideValue, overrideReason, overrideActor, overrideAt, metrics.ComputedAt)
+	if err == nil {
+		err = insertOutboxEvents(tx, events)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to save journal metrics: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to save journal metrics: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MySQLMetricsRepository) GetMetrics(journalID string, year int) (core.JournalMetrics, error) {
+	metrics, err := scanMetrics(r.db.QueryRow(metricsSelect+" WHERE journal_id = ? AND year = ?", journalID, year))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.JournalMetrics{}, core.ErrMetricsNotFound
+		}
+		return core.JournalMetrics{}, fmt.Errorf("failed to get journal metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
+func (r *MySQLMetricsRepository) ListMetrics(journalID string) ([]core.JournalMetrics, error) {
+	rows, err := r.db.Query(metricsSelect+" WHERE journal_id = ? ORDER BY year", journalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal metrics: %w", err)
+	}
+	defer rows.Close()
+
+	var history []core.JournalMetrics
+	for rows.Next() {
+		metrics, err := scanMetrics(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal metrics: %w", err)
+		}
+		history = append(history, metrics)
+	}
+
+	return history, rows.Err()
+}
+
+const metricsSelect = `
+	SELECT journal_id, year, citable_items, citations, impact_factor, immediacy_index, cited_half_life, 
+		override_value, override_reason, override_actor, override_at, computed_at 
+	FROM journal_metrics`
+
+func scanMetrics(row rowScanner) (core.JournalMetrics, error) {
+	var metrics core.JournalMetrics
+	var impactFactor, immediacyIndex, citedHalfLife, overrideValue sql.NullFloat64
+	var overrideReason, overrideActor sql.NullString
+	var overrideAt, computedAt sql.NullTime
+	err := row.Scan(&metrics.JournalID, &metrics.Year, &metrics.CitableItems, &metrics.Citations,
+		&impactFactor, &immediacyIndex, &citedHalfLife,
+		&overrideValue, &overrideReason, &overrideActor, &overrideAt, &computedAt)
+	if err != nil {
+		return core.JournalMetrics{}, err
+	}
+
+	metrics.ImpactFactor = nullFloat(impactFactor)
+	metrics.ImmediacyIndex = nullFloat(immediacyIndex)
+	metrics.CitedHalfLife = nullFloat(citedHalfLife)
+	if overrideValue.Valid {
+		metrics.Override = &core.ImpactFactorOverride{
+			Value:  overrideValue.Float64,
+			Reason: overrideReason.String,
+			Actor:  overrideActor.String,
+			At:     overrideAt.Time,
+		}
+	}
+	if computedAt.Valid {
+		metrics.ComputedAt = &computedAt.Time
+	}
+	return metrics, nil
+}
+
+func nullFloat(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
@@ -2,23 +2,37 @@ package adapters
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
+	eventadapters "github.com/realBagher/hexaservice-go/eventing/adapters"
 	"github.com/realBagher/hexaservice-go/journal/core"
 )
 
+// TablePrefix names the outbox, webhook and audit tables of the journal
+// service, which the eventing adapters manage
+const TablePrefix = "journal"
+
 type MySQLJournalRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	outbox *eventadapters.MySQLOutbox
 }
 
 func NewMySQLJournalRepository(db *sql.DB) *MySQLJournalRepository {
-	return &MySQLJournalRepository{db: db}
+	return &MySQLJournalRepository{db: db, outbox: eventadapters.NewMySQLOutbox(db, TablePrefix)}
 }
 
 // NewMySQLConnection creates a new MySQL database connection
 func NewMySQLConnection(dsn string) (*sql.DB, error) {
-	db, err := sql.Open("mysql", dsn)
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database DSN: %w", err)
+	}
+	// Outbox timestamps are scanned into time.Time
+	cfg.ParseTime = true
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
@@ -30,14 +44,20 @@ func NewMySQLConnection(dsn string) (*sql.DB, error) {
 	return db, nil
 }
 
-// InitializeSchema creates the journals table if it doesn't exist
+// InitializeSchema creates the journals, outbox, volumes and issues tables
+// if they don't exist
 func (r *MySQLJournalRepository) InitializeSchema() error {
 	query := `
 	CREATE TABLE IF NOT EXISTS journals (
 		id VARCHAR(255) PRIMARY KEY,
 		name VARCHAR(255) NOT NULL,
 		description TEXT,
-		impact_factor DECIMAL(10,3)
+		impact_factor DECIMAL(10,3),
+		print_issn CHAR(9) NULL UNIQUE,
+		electronic_issn CHAR(9) NULL UNIQUE,
+		issn_l CHAR(9) NULL,
+		unique_article_titles BOOLEAN NOT NULL DEFAULT FALSE,
+		subject_scope JSON NULL
 	)`
 
 	_, err := r.db.Exec(query)
@@ -45,15 +65,43 @@ func (r *MySQLJournalRepository) InitializeSchema() error {
 		return fmt.Errorf("failed to create journals table: %w", err)
 	}
 
-	return nil
+	for column, definition := range map[string]string{
+		"print_issn":            "CHAR(9) NULL UNIQUE",
+		"electronic_issn":       "CHAR(9) NULL UNIQUE",
+		"issn_l":                "CHAR(9) NULL",
+		"unique_article_titles": "BOOLEAN NOT NULL DEFAULT FALSE",
+		"subject_scope":         "JSON NULL",
+	} {
+		if err := ensureColumn(r.db, "journals", column, definition); err != nil {
+			return err
+		}
+	}
+
+	if err := r.outbox.InitializeSchema(); err != nil {
+		return err
+	}
+
+	return r.initializeIssueSchema()
 }
 
-func (r *MySQLJournalRepository) CreateJournal(journal core.Journal) (core.Journal, error) {
+func (r *MySQLJournalRepository) CreateJournal(journal core.Journal, events ...core.Event) (core.Journal, error) {
 	query := `
-	INSERT INTO journals (id, name, description, impact_factor) 
-	VALUES (?, ?, ?, ?)`
+	INSERT INTO journals (id, name, description, impact_factor, print_issn, electronic_issn, issn_l, unique_article_titles, subject_scope) 
+	VALUES (?, ?, ?, ?, NULLIF(?, ''), NULLIF(?, ''), NULLIF(?, ''), ?, ?)`
+
+	scope, err := encodeSubjectScope(journal.SubjectScope)
+	if err != nil {
+		return core.Journal{}, err
+	}
 
-	_, err := r.db.Exec(query, journal.ID, journal.Name, journal.Description, journal.ImpactFactor)
+	err = r.inTx(func(tx *sql.Tx) error {
+		_, err := tx.Exec(query, journal.ID, journal.Name, journal.Description, journal.ImpactFactor,
+			journal.PrintISSN, journal.ElectronicISSN, journal.LinkingISSN, journal.UniqueArticleTitles, scope)
+		if err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
 	if err != nil {
 		return core.Journal{}, fmt.Errorf("failed to create journal: %w", err)
 	}
@@ -62,14 +110,45 @@ func (r *MySQLJournalRepository) CreateJournal(journal core.Journal) (core.Journ
 }
 
 func (r *MySQLJournalRepository) GetJournal(id string) (core.Journal, error) {
-	var journal core.Journal
+	return r.getJournal(`WHERE id = ?`, id)
+}
+
+// GetJournalByISSN only needs the print and electronic columns because the
+// ISSN-L is always one of the two
+func (r *MySQLJournalRepository) GetJournalByISSN(issn string) (core.Journal, error) {
+	return r.getJournal(`WHERE print_issn = ? OR electronic_issn = ?`, issn, issn)
+}
+
+func (r *MySQLJournalRepository) ListJournals() ([]core.Journal, error) {
 	query := `
-	SELECT id, name, description, impact_factor 
+	SELECT id, name, description, impact_factor, print_issn, electronic_issn, issn_l, unique_article_titles, subject_scope 
 	FROM journals 
-	WHERE id = ?`
+	ORDER BY id`
 
-	row := r.db.QueryRow(query, id)
-	err := row.Scan(&journal.ID, &journal.Name, &journal.Description, &journal.ImpactFactor)
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journals: %w", err)
+	}
+	defer rows.Close()
+
+	var journals []core.Journal
+	for rows.Next() {
+		journal, err := scanJournal(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal: %w", err)
+		}
+		journals = append(journals, journal)
+	}
+
+	return journals, rows.Err()
+}
+
+func (r *MySQLJournalRepository) getJournal(where string, args ...any) (core.Journal, error) {
+	query := `
+	SELECT id, name, description, impact_factor, print_issn, electronic_issn, issn_l, unique_article_titles, subject_scope 
+	FROM journals ` + where
+
+	journal, err := scanJournal(r.db.QueryRow(query, args...))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return core.Journal{}, core.ErrJournalNotFound
@@ -80,7 +159,143 @@ func (r *MySQLJournalRepository) GetJournal(id string) (core.Journal, error) {
 	return journal, nil
 }
 
+func (r *MySQLJournalRepository) UpdateJournal(journal core.Journal, events ...core.Event) (core.Journal, error) {
+	query := `
+	UPDATE journals 
+	SET name = ?, description = ?, impact_factor = ?, 
+		print_issn = NULLIF(?, ''), electronic_issn = NULLIF(?, ''), issn_l = NULLIF(?, ''), 
+		unique_article_titles = ?, subject_scope = ? 
+	WHERE id = ?`
+
+	scope, err := encodeSubjectScope(journal.SubjectScope)
+	if err != nil {
+		return core.Journal{}, err
+	}
+
+	err = r.inTx(func(tx *sql.Tx) error {
+		if err := lockRow(tx, "SELECT id FROM journals WHERE id = ? FOR UPDATE", journal.ID); err != nil {
+			if err == sql.ErrNoRows {
+				return core.ErrJournalNotFound
+			}
+			return err
+		}
+		_, err := tx.Exec(query, journal.Name, journal.Description, journal.ImpactFactor,
+			journal.PrintISSN, journal.ElectronicISSN, journal.LinkingISSN, journal.UniqueArticleTitles, scope, journal.ID)
+		if err != nil {
+			return err
+		}
+		return insertOutboxEvents(tx, events)
+	})
+	if err == core.ErrJournalNotFound {
+		return core.Journal{}, err
+	}
+	if err != nil {
+		return core.Journal{}, fmt.Errorf("failed to update journal: %w", err)
+	}
+
+	return journal, nil
+}
+
+func (r *MySQLJournalRepository) PendingEvents(limit int) ([]core.Event, error) {
+	return r.outbox.PendingEvents(limit)
+}
+
+func (r *MySQLJournalRepository) MarkEventPublished(id string) error {
+	return r.outbox.MarkEventPublished(id)
+}
+
 // Close closes the database connection
 func (r *MySQLJournalRepository) Close() error {
 	return r.db.Close()
 }
+
+func scanJournal(row rowScanner) (core.Journal, error) {
+	var journal core.Journal
+	var description, printISSN, electronicISSN, linkingISSN sql.NullString
+	var scope []byte
+	err := row.Scan(&journal.ID, &journal.Name, &description, &journal.ImpactFactor,
+		&printISSN, &electronicISSN, &linkingISSN, &journal.UniqueArticleTitles, &scope)
+	if err != nil {
+		return core.Journal{}, err
+	}
+	if len(scope) > 0 {
+		if err := json.Unmarshal(scope, &journal.SubjectScope); err != nil {
+			return core.Journal{}, fmt.Errorf("failed to decode subject scope: %w", err)
+		}
+	}
+	journal.Description = description.String
+	journal.PrintISSN = printISSN.String
+	journal.ElectronicISSN = electronicISSN.String
+	journal.LinkingISSN = linkingISSN.String
+	return journal, nil
+}
+
+// encodeSubjectScope stores empty scopes as NULL
+func encodeSubjectScope(scope []string) (any, error) {
+	if len(scope) == 0 {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode subject scope: %w", err)
+	}
+	return encoded, nil
+}
+
+func columnExists(db *sql.DB, table, column string) (bool, error) {
+	query := `
+	SELECT COUNT(*) 
+	FROM information_schema.COLUMNS 
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?`
+
+	var count int
+	if err := db.QueryRow(query, table, column).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to inspect %s.%s: %w", table, column, err)
+	}
+	return count > 0, nil
+}
+
+// ensureColumn adds a column to an existing table. MySQL has no
+// ADD COLUMN IF NOT EXISTS, so the column is looked up first.
+func ensureColumn(db *sql.DB, table, column, definition string) error {
+	exists, err := columnExists(db, table, column)
+	if err != nil || exists {
+		return err
+	}
+
+	if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("failed to add %s.%s: %w", table, column, err)
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction that is committed only if fn succeeds
+func (r *MySQLJournalRepository) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// lockRow runs a single-row SELECT ... FOR UPDATE and reports sql.ErrNoRows
+// when the row does not exist
+func lockRow(tx *sql.Tx, query string, args ...any) error {
+	var id string
+	return tx.QueryRow(query, args...).Scan(&id)
+}
+
+func insertOutboxEvents(tx *sql.Tx, events []core.Event) error {
+	return eventadapters.InsertOutboxEvents(tx, TablePrefix, events)
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
@@ -0,0 +1,21 @@
+package core
+
+import "github.com/realBagher/hexaservice-go/eventing"
+
+// SystemActor is recorded for mutations that were not attributed to a user
+const SystemActor = eventing.SystemActor
+
+// AuditOperation names the mutating service call that produced an entry
+type AuditOperation = eventing.AuditOperation
+
+const (
+	AuditCreateJournal AuditOperation = "create_journal"
+	AuditUpdateJournal AuditOperation = "update_journal"
+	AuditCreateVolume  AuditOperation = "create_volume"
+	AuditCreateIssue   AuditOperation = "create_issue"
+	AuditScheduleIssue AuditOperation = "schedule_issue"
+	AuditPublishIssue  AuditOperation = "publish_issue"
+
+	AuditOverrideImpactFactor      AuditOperation = "override_impact_factor"
+	AuditClearImpactFactorOverride AuditOperation = "clear_impact_factor_override"
+)
@@ -9,3 +9,32 @@ var (
 	// ErrInvalidJournal is returned when journal data is invalid
 	ErrInvalidJournal = errors.New("invalid journal data")
 )
+
+var (
+	// ErrVolumeNotFound is returned when a volume is not found
+	ErrVolumeNotFound = errors.New("volume not found")
+
+	// ErrInvalidVolume is returned when volume data is invalid
+	ErrInvalidVolume = errors.New("invalid volume data")
+
+	// ErrIssueNotFound is returned when an issue is not found
+	ErrIssueNotFound = errors.New("issue not found")
+
+	// ErrInvalidIssue is returned when issue data is invalid
+	ErrInvalidIssue = errors.New("invalid issue data")
+
+	// ErrIssuePublished is returned when a published issue would be changed
+	ErrIssuePublished = errors.New("issue already published")
+
+	// ErrIssueStatusChanged is returned when an issue's status changed
+	// between reading and updating it
+	ErrIssueStatusChanged = errors.New("issue status changed concurrently")
+)
+
+var (
+	// ErrMetricsNotFound is returned when a journal has no metrics for a year
+	ErrMetricsNotFound = errors.New("journal metrics not found")
+
+	// ErrInvalidMetrics is returned when a metrics request or override is invalid
+	ErrInvalidMetrics = errors.New("invalid journal metrics")
+)
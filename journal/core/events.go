@@ -0,0 +1,37 @@
+package core
+
+import "github.com/realBagher/hexaservice-go/eventing"
+
+// Event and EventType come from the eventing module, which the journal and
+// article services share
+type (
+	Event     = eventing.Event
+	EventType = eventing.EventType
+)
+
+const (
+	// EventJournalCreated is raised when a new journal is stored
+	EventJournalCreated EventType = "journal.created"
+
+	// EventJournalUpdated is raised when an existing journal is changed
+	EventJournalUpdated EventType = "journal.updated"
+
+	// EventIssueScheduled is raised when an issue gets a publication date
+	EventIssueScheduled EventType = "journal.issue_scheduled"
+
+	// EventIssuePublished is raised when an issue is published
+	EventIssuePublished EventType = "journal.issue_published"
+)
+
+// EventTypes lists the events webhooks can subscribe to
+var EventTypes = []EventType{EventJournalCreated, EventJournalUpdated, EventIssueScheduled, EventIssuePublished}
+
+// NewEvent creates an event for the given aggregate with a JSON encoded payload
+func NewEvent(eventType EventType, aggregateID string, payload any) (Event, error) {
+	return eventing.NewEvent(eventType, aggregateID, payload)
+}
+
+// NewID returns a random 128-bit identifier encoded as hex
+func NewID() string {
+	return eventing.NewID()
+}
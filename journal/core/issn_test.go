@@ -0,0 +1,102 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/realBagher/hexaservice-go/journal/adapters"
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+func TestValidateISSN(t *testing.T) {
+	tests := []struct {
+		issn  string
+		valid bool
+	}{
+		{"0028-0836", true},
+		{"1476-4687", true},
+		{"0000-006X", true},
+		{"0028-0837", false},
+		{"0000-0060", false},
+		{"00280836", false},
+		{"0028 0836", false},
+		{"0028-083", false},
+		{"002X-0836", false},
+		{"0000-006x", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		err := core.ValidateISSN(test.issn)
+		if (err == nil) != test.valid {
+			t.Errorf("ValidateISSN(%q) = %v, want valid %v", test.issn, err, test.valid)
+		}
+	}
+}
+
+func TestNormalizeISSN(t *testing.T) {
+	tests := map[string]string{
+		"00280836":    "0028-0836",
+		" 0028-0836 ": "0028-0836",
+		"0000006x":    "0000-006X",
+		"0028 0836":   "0028 0836",
+		"":            "",
+	}
+
+	for input, want := range tests {
+		if got := core.NormalizeISSN(input); got != want {
+			t.Errorf("NormalizeISSN(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestJournalISSNs(t *testing.T) {
+	service := core.NewJournalService(adapters.NewInMemoryJournalRepository())
+
+	nature, err := service.CreateJournal(core.Journal{ID: "j1", Name: "Nature", PrintISSN: "00280836", ElectronicISSN: "1476-4687"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nature.PrintISSN != "0028-0836" || nature.LinkingISSN != "0028-0836" {
+		t.Errorf("CreateJournal() = %+v, want the print ISSN normalized and used as ISSN-L", nature)
+	}
+
+	online, err := service.CreateJournal(core.Journal{ID: "j2", Name: "PeerJ", ElectronicISSN: "2049-3630"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if online.LinkingISSN != "2049-3630" {
+		t.Errorf("ISSN-L of an online-only journal = %q, want its electronic ISSN", online.LinkingISSN)
+	}
+
+	for _, issn := range []string{"0028-0836", "14764687"} {
+		found, err := service.GetJournalByISSN(issn)
+		if err != nil || found.ID != "j1" {
+			t.Errorf("GetJournalByISSN(%s) = %+v, %v, want j1", issn, found, err)
+		}
+	}
+	if _, err := service.GetJournalByISSN("0036-8075"); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("GetJournalByISSN() of an unknown ISSN = %v, want ErrJournalNotFound", err)
+	}
+	if _, err := service.GetJournalByISSN("0028-0837"); !errors.Is(err, core.ErrInvalidJournal) {
+		t.Errorf("GetJournalByISSN() of a bad ISSN = %v, want ErrInvalidJournal", err)
+	}
+
+	rejected := []core.Journal{
+		{ID: "j3", Name: "Bad check digit", PrintISSN: "0036-8076"},
+		{ID: "j3", Name: "Same ISSN twice", PrintISSN: "0036-8075", ElectronicISSN: "0036-8075"},
+		{ID: "j3", Name: "Foreign ISSN-L", PrintISSN: "0036-8075", LinkingISSN: "0028-0836"},
+		{ID: "j3", Name: "Taken ISSN", PrintISSN: "0036-8075", ElectronicISSN: "1476-4687"},
+	}
+	for _, journal := range rejected {
+		if _, err := service.CreateJournal(journal); !errors.Is(err, core.ErrInvalidJournal) {
+			t.Errorf("%s: CreateJournal() = %v, want ErrInvalidJournal", journal.Name, err)
+		}
+	}
+
+	// A journal keeps its own ISSNs when it is updated
+	nature.Description = "Weekly"
+	if _, err := service.UpdateJournal(nature); err != nil {
+		t.Errorf("UpdateJournal() with its own ISSNs: %v", err)
+	}
+}
@@ -0,0 +1,317 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+type Volume struct {
+	ID        string    `json:"id"`
+	JournalID string    `json:"journal_id"`
+	Number    int       `json:"number"`
+	Year      int       `json:"year"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Validate checks if the volume data is valid
+func (v Volume) Validate() error {
+	if strings.TrimSpace(v.ID) == "" {
+		return fmt.Errorf("%w: ID cannot be empty", ErrInvalidVolume)
+	}
+
+	if strings.TrimSpace(v.JournalID) == "" {
+		return fmt.Errorf("%w: journal ID cannot be empty", ErrInvalidVolume)
+	}
+
+	if v.Number < 1 {
+		return fmt.Errorf("%w: number must be positive", ErrInvalidVolume)
+	}
+
+	if v.Year < 1 {
+		return fmt.Errorf("%w: year must be positive", ErrInvalidVolume)
+	}
+
+	return nil
+}
+
+// IssueStatus tracks an issue from planning to publication
+type IssueStatus string
+
+const (
+	// IssueDraft issues are being assembled and have no publication date
+	IssueDraft IssueStatus = "draft"
+	// IssueScheduled issues are published by the scheduler at ScheduledFor
+	IssueScheduled IssueStatus = "scheduled"
+	IssuePublished IssueStatus = "published"
+)
+
+func (s IssueStatus) Valid() bool {
+	switch s {
+	case IssueDraft, IssueScheduled, IssuePublished:
+		return true
+	}
+	return false
+}
+
+type Issue struct {
+	ID           string      `json:"id"`
+	JournalID    string      `json:"journal_id"`
+	VolumeID     string      `json:"volume_id"`
+	Number       int         `json:"number"`
+	Title        string      `json:"title,omitempty"`
+	Status       IssueStatus `json:"status"`
+	ScheduledFor *time.Time  `json:"scheduled_for,omitempty"`
+	PublishedAt  *time.Time  `json:"published_at,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+// Validate checks if the issue data is valid
+func (i Issue) Validate() error {
+	if strings.TrimSpace(i.ID) == "" {
+		return fmt.Errorf("%w: ID cannot be empty", ErrInvalidIssue)
+	}
+
+	if strings.TrimSpace(i.VolumeID) == "" {
+		return fmt.Errorf("%w: volume ID cannot be empty", ErrInvalidIssue)
+	}
+
+	if i.Number < 1 {
+		return fmt.Errorf("%w: number must be positive", ErrInvalidIssue)
+	}
+
+	if !i.Status.Valid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidIssue, i.Status)
+	}
+
+	if i.Status == IssueScheduled && i.ScheduledFor == nil {
+		return fmt.Errorf("%w: scheduled issues need a publication date", ErrInvalidIssue)
+	}
+
+	return nil
+}
+
+// IssueService manages the volumes and issues of journals and their
+// publication schedule
+type IssueService struct {
+	issues   IssueRepository
+	journals *JournalService
+}
+
+func NewIssueService(issues IssueRepository, journals *JournalService) *IssueService {
+	return &IssueService{issues: issues, journals: journals}
+}
+
+// WithActor returns a copy of the service that attributes audit entries to
+// the given actor
+func (s *IssueService) WithActor(actor string) *IssueService {
+	scoped := *s
+	scoped.journals = s.journals.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that attributes audit entries to
+// the given caller
+func (s *IssueService) WithCaller(caller eventing.Caller) *IssueService {
+	scoped := *s
+	scoped.journals = s.journals.WithCaller(caller)
+	return &scoped
+}
+
+func (s *IssueService) CreateVolume(volume Volume) (Volume, error) {
+	volume.ID = NewID()
+	if err := volume.Validate(); err != nil {
+		return Volume{}, err
+	}
+	if _, err := s.journals.GetJournal(volume.JournalID); err != nil {
+		return Volume{}, err
+	}
+
+	volumes, err := s.issues.ListVolumes(volume.JournalID)
+	if err != nil {
+		return Volume{}, err
+	}
+	for _, existing := range volumes {
+		if existing.Number == volume.Number {
+			return Volume{}, fmt.Errorf("%w: journal %s already has volume %d", ErrInvalidVolume, volume.JournalID, volume.Number)
+		}
+	}
+
+	volume.CreatedAt = time.Now().UTC()
+	audit, err := s.journals.auditEvent(AuditCreateVolume, volume.ID, nil, volume)
+	if err != nil {
+		return Volume{}, err
+	}
+	return s.issues.CreateVolume(volume, audit)
+}
+
+func (s *IssueService) GetVolume(id string) (Volume, error) {
+	return s.issues.GetVolume(id)
+}
+
+func (s *IssueService) ListVolumes(journalID string) ([]Volume, error) {
+	return s.issues.ListVolumes(journalID)
+}
+
+// CreateIssue adds an issue to a volume. An issue with a publication date
+// is scheduled right away; otherwise it starts as a draft.
+func (s *IssueService) CreateIssue(issue Issue) (Issue, error) {
+	volume, err := s.issues.GetVolume(issue.VolumeID)
+	if err != nil {
+		return Issue{}, err
+	}
+	issue.ID = NewID()
+	issue.JournalID = volume.JournalID
+	issue.PublishedAt = nil
+	issue.Status = IssueDraft
+	if issue.ScheduledFor != nil {
+		scheduled := issue.ScheduledFor.UTC()
+		issue.ScheduledFor = &scheduled
+		issue.Status = IssueScheduled
+	}
+
+	if err := issue.Validate(); err != nil {
+		return Issue{}, err
+	}
+
+	issues, err := s.issues.ListIssues(issue.VolumeID)
+	if err != nil {
+		return Issue{}, err
+	}
+	for _, existing := range issues {
+		if existing.Number == issue.Number {
+			return Issue{}, fmt.Errorf("%w: volume %d already has issue %d", ErrInvalidIssue, volume.Number, issue.Number)
+		}
+	}
+
+	issue.CreatedAt = time.Now().UTC()
+	audit, err := s.journals.auditEvent(AuditCreateIssue, issue.ID, nil, issue)
+	if err != nil {
+		return Issue{}, err
+	}
+	return s.issues.CreateIssue(issue, audit)
+}
+
+func (s *IssueService) GetIssue(id string) (Issue, error) {
+	return s.issues.GetIssue(id)
+}
+
+func (s *IssueService) ListIssues(volumeID string) ([]Issue, error) {
+	return s.issues.ListIssues(volumeID)
+}
+
+// ScheduleIssue sets or moves the publication date of an unpublished issue
+func (s *IssueService) ScheduleIssue(id string, publishAt time.Time) (Issue, error) {
+	before, err := s.issues.GetIssue(id)
+	if err != nil {
+		return Issue{}, err
+	}
+	if before.Status == IssuePublished {
+		return Issue{}, fmt.Errorf("%w: issue %s", ErrIssuePublished, id)
+	}
+
+	issue := before
+	scheduled := publishAt.UTC()
+	issue.ScheduledFor = &scheduled
+	issue.Status = IssueScheduled
+
+	event, err := NewEvent(EventIssueScheduled, issue.ID, issue)
+	if err != nil {
+		return Issue{}, err
+	}
+	audit, err := s.journals.auditEvent(AuditScheduleIssue, issue.ID, before, issue)
+	if err != nil {
+		return Issue{}, err
+	}
+
+	return s.issues.UpdateIssue(issue, before.Status, event, audit)
+}
+
+// PublishIssue publishes an issue immediately
+func (s *IssueService) PublishIssue(id string) (Issue, error) {
+	issue, err := s.issues.GetIssue(id)
+	if err != nil {
+		return Issue{}, err
+	}
+	return s.publish(issue, time.Now().UTC())
+}
+
+// PublishDueIssues publishes up to limit scheduled issues whose date has
+// passed and returns how many were published. It keeps going after a
+// failure so one bad issue does not hold back the rest.
+func (s *IssueService) PublishDueIssues(now time.Time, limit int) (int, error) {
+	due, err := s.issues.DueIssues(now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load due issues: %w", err)
+	}
+
+	published := 0
+	var firstErr error
+	for _, issue := range due {
+		if _, err := s.publish(issue, now); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to publish issue %s: %w", issue.ID, err)
+			}
+			continue
+		}
+		published++
+	}
+	return published, firstErr
+}
+
+func (s *IssueService) publish(before Issue, now time.Time) (Issue, error) {
+	if before.Status == IssuePublished {
+		return Issue{}, fmt.Errorf("%w: issue %s", ErrIssuePublished, before.ID)
+	}
+
+	issue := before
+	publishedAt := now.UTC()
+	issue.Status = IssuePublished
+	issue.PublishedAt = &publishedAt
+
+	event, err := NewEvent(EventIssuePublished, issue.ID, issue)
+	if err != nil {
+		return Issue{}, err
+	}
+	audit, err := s.journals.auditEvent(AuditPublishIssue, issue.ID, before, issue)
+	if err != nil {
+		return Issue{}, err
+	}
+
+	return s.issues.UpdateIssue(issue, before.Status, event, audit)
+}
+
+const defaultSchedulerBatchSize = 50
+
+// PublicationScheduler publishes scheduled issues when their date arrives
+type PublicationScheduler struct {
+	issues    *IssueService
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPublicationScheduler(issues *IssueService, interval time.Duration) *PublicationScheduler {
+	return &PublicationScheduler{issues: issues, interval: interval, batchSize: defaultSchedulerBatchSize}
+}
+
+// Run checks for due issues until the context is cancelled
+func (p *PublicationScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.issues.PublishDueIssues(time.Now().UTC(), p.batchSize); err != nil {
+			log.Printf("Publication scheduler: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/journal/adapters"
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+// newIssueService returns an issue service over one journal, j1, with
+// volume 1
+func newIssueService(t *testing.T) (*core.IssueService, core.Volume) {
+	t.Helper()
+	repo := adapters.NewInMemoryJournalRepository()
+	journals := core.NewJournalService(repo)
+	if _, err := journals.CreateJournal(core.Journal{ID: "j1", Name: "Nature"}); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := core.NewIssueService(repo, journals)
+	volume, err := issues.CreateVolume(core.Volume{JournalID: "j1", Number: 1, Year: 2024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issues, volume
+}
+
+func TestCreateVolumeAndIssueAreChecked(t *testing.T) {
+	issues, volume := newIssueService(t)
+
+	if _, err := issues.CreateVolume(core.Volume{JournalID: "j1", Number: 1, Year: 2025}); !errors.Is(err, core.ErrInvalidVolume) {
+		t.Errorf("CreateVolume() with a taken number = %v, want ErrInvalidVolume", err)
+	}
+	if _, err := issues.CreateVolume(core.Volume{JournalID: "j1", Number: 0, Year: 2025}); !errors.Is(err, core.ErrInvalidVolume) {
+		t.Errorf("CreateVolume() numbered 0 = %v, want ErrInvalidVolume", err)
+	}
+	if _, err := issues.CreateVolume(core.Volume{JournalID: "j9", Number: 1, Year: 2025}); !errors.Is(err, core.ErrJournalNotFound) {
+		t.Errorf("CreateVolume() in an unknown journal = %v, want ErrJournalNotFound", err)
+	}
+
+	issue, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.JournalID != "j1" || issue.Status != core.IssueDraft {
+		t.Errorf("CreateIssue() = %+v, want a draft of j1", issue)
+	}
+	if _, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1}); !errors.Is(err, core.ErrInvalidIssue) {
+		t.Errorf("CreateIssue() with a taken number = %v, want ErrInvalidIssue", err)
+	}
+	if _, err := issues.CreateIssue(core.Issue{VolumeID: "v9", Number: 2}); !errors.Is(err, core.ErrVolumeNotFound) {
+		t.Errorf("CreateIssue() in an unknown volume = %v, want ErrVolumeNotFound", err)
+	}
+
+	publishAt := time.Date(2030, 1, 1, 9, 0, 0, 0, time.FixedZone("CET", 3600))
+	scheduled, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 2, ScheduledFor: &publishAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheduled.Status != core.IssueScheduled || scheduled.ScheduledFor.Location() != time.UTC || !scheduled.ScheduledFor.Equal(publishAt) {
+		t.Errorf("CreateIssue() with a date = %+v, want scheduled in UTC", scheduled)
+	}
+
+	listed, err := issues.ListIssues(volume.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 2 || listed[0].Number != 1 || listed[1].Number != 2 {
+		t.Errorf("ListIssues() = %+v", listed)
+	}
+}
+
+func TestPublishDueIssues(t *testing.T) {
+	issues, volume := newIssueService(t)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	at := func(offset time.Duration) *time.Time {
+		date := now.Add(offset)
+		return &date
+	}
+	due1, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1, ScheduledFor: at(-2 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	due2, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 2, ScheduledFor: at(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	later, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 3, ScheduledFor: at(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	draft, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The limit takes the earliest issue first
+	if published, err := issues.PublishDueIssues(now, 1); err != nil || published != 1 {
+		t.Fatalf("PublishDueIssues(limit 1) = %d, %v", published, err)
+	}
+	if published, err := issues.PublishDueIssues(now, 10); err != nil || published != 1 {
+		t.Fatalf("PublishDueIssues() = %d, %v", published, err)
+	}
+
+	want := map[string]core.IssueStatus{
+		due1.ID:  core.IssuePublished,
+		due2.ID:  core.IssuePublished,
+		later.ID: core.IssueScheduled,
+		draft.ID: core.IssueDraft,
+	}
+	for id, status := range want {
+		issue, err := issues.GetIssue(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issue.Status != status {
+			t.Errorf("issue %d is %s, want %s", issue.Number, issue.Status, status)
+		}
+		if status == core.IssuePublished && (issue.PublishedAt == nil || !issue.PublishedAt.Equal(now)) {
+			t.Errorf("issue %d published at %v, want %v", issue.Number, issue.PublishedAt, now)
+		}
+	}
+
+	if published, err := issues.PublishDueIssues(now, 10); err != nil || published != 0 {
+		t.Errorf("PublishDueIssues() again = %d, %v, want nothing", published, err)
+	}
+}
+
+func TestPublishedIssuesCannotBeRescheduled(t *testing.T) {
+	issues, volume := newIssueService(t)
+	issue, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publishAt := time.Now().Add(24 * time.Hour)
+	scheduled, err := issues.ScheduleIssue(issue.ID, publishAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheduled.Status != core.IssueScheduled {
+		t.Errorf("ScheduleIssue() status = %s, want scheduled", scheduled.Status)
+	}
+
+	if _, err := issues.PublishIssue(issue.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := issues.PublishIssue(issue.ID); !errors.Is(err, core.ErrIssuePublished) {
+		t.Errorf("PublishIssue() twice = %v, want ErrIssuePublished", err)
+	}
+	if _, err := issues.ScheduleIssue(issue.ID, publishAt); !errors.Is(err, core.ErrIssuePublished) {
+		t.Errorf("ScheduleIssue() after publication = %v, want ErrIssuePublished", err)
+	}
+}
+
+func TestPublicationSchedulerPublishesDueIssues(t *testing.T) {
+	issues, volume := newIssueService(t)
+	past := time.Now().Add(-time.Minute)
+	issue, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1, ScheduledFor: &past})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- core.NewPublicationScheduler(issues, 10*time.Millisecond).Run(ctx) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		current, err := issues.GetIssue(issue.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if current.Status == core.IssuePublished {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("scheduler did not publish the due issue")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+}
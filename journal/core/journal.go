@@ -3,13 +3,27 @@ package core
 import (
 	"fmt"
 	"strings"
+
+	"github.com/realBagher/hexaservice-go/eventing"
 )
 
 type Journal struct {
-	ID           string  `json:"id"`
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	ImpactFactor float64 `json:"impact_factor"`
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	Description    string  `json:"description"`
+	ImpactFactor   float64 `json:"impact_factor"`
+	PrintISSN      string  `json:"print_issn,omitempty"`
+	ElectronicISSN string  `json:"electronic_issn,omitempty"`
+	// LinkingISSN (ISSN-L) links the print and electronic editions. It is
+	// always one of the journal's own ISSNs.
+	LinkingISSN string `json:"issn_l,omitempty"`
+	// UniqueArticleTitles makes the article service refuse an article whose
+	// normalized title another article of the journal already has
+	UniqueArticleTitles bool `json:"unique_article_titles,omitempty"`
+	// SubjectScope lists the IDs of the subject terms the journal publishes
+	// in. Terms below them in the article service's taxonomy are in scope
+	// too; an empty scope accepts every subject.
+	SubjectScope []string `json:"subject_scope,omitempty"`
 }
 
 // Validate checks if the journal data is valid
@@ -26,25 +40,224 @@ func (j Journal) Validate() error {
 		return fmt.Errorf("%w: impact factor cannot be negative", ErrInvalidJournal)
 	}
 
+	for _, issn := range []string{j.PrintISSN, j.ElectronicISSN, j.LinkingISSN} {
+		if issn == "" {
+			continue
+		}
+		if err := ValidateISSN(issn); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidJournal, err)
+		}
+	}
+
+	if j.PrintISSN != "" && j.PrintISSN == j.ElectronicISSN {
+		return fmt.Errorf("%w: print and electronic ISSN must differ", ErrInvalidJournal)
+	}
+
+	if j.LinkingISSN != "" && j.LinkingISSN != j.PrintISSN && j.LinkingISSN != j.ElectronicISSN {
+		return fmt.Errorf("%w: ISSN-L %s must be the print or electronic ISSN", ErrInvalidJournal, j.LinkingISSN)
+	}
+
+	inScope := make(map[string]bool, len(j.SubjectScope))
+	for _, termID := range j.SubjectScope {
+		if strings.TrimSpace(termID) == "" {
+			return fmt.Errorf("%w: subject scope has an empty term ID", ErrInvalidJournal)
+		}
+		if inScope[termID] {
+			return fmt.Errorf("%w: subject %s is in the scope more than once", ErrInvalidJournal, termID)
+		}
+		inScope[termID] = true
+	}
+
 	return nil
 }
 
+// ISSNs returns the journal's print and electronic ISSNs that are set
+func (j Journal) ISSNs() []string {
+	var issns []string
+	for _, issn := range []string{j.PrintISSN, j.ElectronicISSN} {
+		if issn != "" {
+			issns = append(issns, issn)
+		}
+	}
+	return issns
+}
+
+// normalizeISSNs brings the ISSNs into canonical form and links the journal
+// to its print ISSN, or its electronic ISSN for online-only journals, when
+// no ISSN-L is given
+func (j *Journal) normalizeISSNs() {
+	j.PrintISSN = NormalizeISSN(j.PrintISSN)
+	j.ElectronicISSN = NormalizeISSN(j.ElectronicISSN)
+	j.LinkingISSN = NormalizeISSN(j.LinkingISSN)
+
+	if j.LinkingISSN == "" {
+		if issns := j.ISSNs(); len(issns) > 0 {
+			j.LinkingISSN = issns[0]
+		}
+	}
+}
+
 // JournalService contains the core business logic.
 type JournalService struct {
 	repository JournalRepository // Port interface
+	caller     eventing.Caller
 }
 
 func NewJournalService(repository JournalRepository) *JournalService {
-	return &JournalService{repository: repository}
+	return &JournalService{repository: repository, caller: eventing.SystemCaller}
+}
+
+// WithActor returns a copy of the service that attributes audit entries to
+// the given actor
+func (s *JournalService) WithActor(actor string) *JournalService {
+	return s.WithCaller(eventing.Caller{Actor: actor})
+}
+
+// WithCaller returns a copy of the service that attributes audit entries to
+// the given caller
+func (s *JournalService) WithCaller(caller eventing.Caller) *JournalService {
+	scoped := *s
+	scoped.caller = caller
+	return &scoped
 }
 
 func (s *JournalService) CreateJournal(journal Journal) (Journal, error) {
+	journal.normalizeISSNs()
 	if err := journal.Validate(); err != nil {
 		return Journal{}, err
 	}
-	return s.repository.CreateJournal(journal)
+	if err := s.checkISSNsAvailable(journal); err != nil {
+		return Journal{}, err
+	}
+
+	event, err := NewEvent(EventJournalCreated, journal.ID, journal)
+	if err != nil {
+		return Journal{}, err
+	}
+	audit, err := s.auditEvent(AuditCreateJournal, journal.ID, nil, journal)
+	if err != nil {
+		return Journal{}, err
+	}
+
+	return s.repository.CreateJournal(journal, event, audit)
 }
 
 func (s *JournalService) GetJournal(id string) (Journal, error) {
 	return s.repository.GetJournal(id)
 }
+
+func (s *JournalService) ListJournals() ([]Journal, error) {
+	return s.repository.ListJournals()
+}
+
+// FindJournalsByName returns the journals with the given name, ignoring case
+// and differences in whitespace
+func (s *JournalService) FindJournalsByName(name string) ([]Journal, error) {
+	journals, err := s.repository.ListJournals()
+	if err != nil {
+		return nil, err
+	}
+
+	name = normalizeJournalName(name)
+	var matches []Journal
+	for _, journal := range journals {
+		if normalizeJournalName(journal.Name) == name {
+			matches = append(matches, journal)
+		}
+	}
+	return matches, nil
+}
+
+func normalizeJournalName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// GetJournalByISSN finds the journal with the given print, electronic or
+// linking ISSN
+func (s *JournalService) GetJournalByISSN(issn string) (Journal, error) {
+	issn = NormalizeISSN(issn)
+	if err := ValidateISSN(issn); err != nil {
+		return Journal{}, fmt.Errorf("%w: %v", ErrInvalidJournal, err)
+	}
+	return s.repository.GetJournalByISSN(issn)
+}
+
+// UpdateJournal changes the journal's metadata. The impact factor is kept as
+// stored; it follows the metrics history maintained by MetricsService.
+func (s *JournalService) UpdateJournal(journal Journal) (Journal, error) {
+	before, err := s.repository.GetJournal(journal.ID)
+	if err != nil {
+		return Journal{}, err
+	}
+	journal.ImpactFactor = before.ImpactFactor
+
+	journal.normalizeISSNs()
+	if err := journal.Validate(); err != nil {
+		return Journal{}, err
+	}
+	if err := s.checkISSNsAvailable(journal); err != nil {
+		return Journal{}, err
+	}
+
+	event, err := NewEvent(EventJournalUpdated, journal.ID, journal)
+	if err != nil {
+		return Journal{}, err
+	}
+	audit, err := s.auditEvent(AuditUpdateJournal, journal.ID, before, journal)
+	if err != nil {
+		return Journal{}, err
+	}
+
+	return s.repository.UpdateJournal(journal, event, audit)
+}
+
+// setImpactFactor stores a new impact factor for the journal. It does
+// nothing if the value is unchanged.
+func (s *JournalService) setImpactFactor(id string, impactFactor float64) error {
+	before, err := s.repository.GetJournal(id)
+	if err != nil {
+		return err
+	}
+	if before.ImpactFactor == impactFactor {
+		return nil
+	}
+
+	journal := before
+	journal.ImpactFactor = impactFactor
+	event, err := NewEvent(EventJournalUpdated, journal.ID, journal)
+	if err != nil {
+		return err
+	}
+	audit, err := s.auditEvent(AuditUpdateJournal, journal.ID, before, journal)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repository.UpdateJournal(journal, event, audit)
+	return err
+}
+
+// checkISSNsAvailable rejects an ISSN that already belongs to another
+// journal, since an ISSN identifies exactly one serial
+func (s *JournalService) checkISSNsAvailable(journal Journal) error {
+	for _, issn := range journal.ISSNs() {
+		owner, err := s.repository.GetJournalByISSN(issn)
+		if err == ErrJournalNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if owner.ID != journal.ID {
+			return fmt.Errorf("%w: ISSN %s already belongs to journal %s", ErrInvalidJournal, issn, owner.ID)
+		}
+	}
+	return nil
+}
+
+// auditEvent builds the audit record of a mutation. It is stored in the
+// outbox together with the mutation, so the change cannot be committed
+// without it, and the relay appends it to the audit log.
+func (s *JournalService) auditEvent(operation AuditOperation, entityID string, before, after any) (Event, error) {
+	return eventing.NewAuditEvent(s.caller, operation, entityID, before, after)
+}
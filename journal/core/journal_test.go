@@ -0,0 +1,107 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+	"github.com/realBagher/hexaservice-go/journal/adapters"
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+// auditEntries returns the audit entries waiting in the outbox, in order
+func auditEntries(t *testing.T, outbox eventing.OutboxRepository) []eventing.AuditEntry {
+	t.Helper()
+	events, err := outbox.PendingEvents(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []eventing.AuditEntry
+	for _, event := range events {
+		if event.Type != eventing.EventAuditRecorded {
+			continue
+		}
+		var entry eventing.AuditEntry
+		if err := json.Unmarshal(event.Payload, &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestJournalChangesStoreTheirAuditRecord(t *testing.T) {
+	repo := adapters.NewInMemoryJournalRepository()
+	caller := eventing.Caller{Actor: "editor", Peer: "198.51.100.7:50000"}
+	service := core.NewJournalService(repo).WithCaller(caller)
+
+	journal, err := service.CreateJournal(core.Journal{ID: "j1", Name: "Nature"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	journal.Description = "Weekly"
+	if _, err := service.UpdateJournal(journal); err != nil {
+		t.Fatal(err)
+	}
+	// A rejected change leaves no audit record
+	if _, err := service.CreateJournal(core.Journal{ID: "j2"}); err == nil {
+		t.Fatal("CreateJournal() accepted a journal without a name")
+	}
+
+	entries := auditEntries(t, repo)
+	if len(entries) != 2 {
+		t.Fatalf("got %d audit records, want 2", len(entries))
+	}
+	for i, operation := range []eventing.AuditOperation{core.AuditCreateJournal, core.AuditUpdateJournal} {
+		entry := entries[i]
+		if entry.Operation != operation || entry.EntityID != "j1" || entry.Actor != "editor" || entry.Peer != caller.Peer {
+			t.Errorf("record %d = %+v", i, entry)
+		}
+	}
+}
+
+func TestIssueAndMetricsChangesStoreTheirAuditRecord(t *testing.T) {
+	repo := adapters.NewInMemoryJournalRepository()
+	journals := core.NewJournalService(repo).WithActor("editor")
+	if _, err := journals.CreateJournal(core.Journal{ID: "j1", Name: "Nature"}); err != nil {
+		t.Fatal(err)
+	}
+
+	issues := core.NewIssueService(repo, journals)
+	volume, err := issues.CreateVolume(core.Volume{JournalID: "j1", Number: 1, Year: 2024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issue, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1, Title: "Launch"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := issues.PublishIssue(issue.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	metrics := core.NewMetricsService(adapters.NewInMemoryMetricsRepository(repo), nil, journals)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := metrics.OverrideImpactFactor("j1", 2024, 3.5, "corrected count", now); err != nil {
+		t.Fatal(err)
+	}
+
+	var operations []eventing.AuditOperation
+	for _, entry := range auditEntries(t, repo) {
+		operations = append(operations, entry.Operation)
+	}
+	want := []eventing.AuditOperation{
+		core.AuditCreateJournal, core.AuditCreateVolume, core.AuditCreateIssue, core.AuditPublishIssue,
+		core.AuditOverrideImpactFactor, core.AuditUpdateJournal,
+	}
+	if len(operations) != len(want) {
+		t.Fatalf("audit operations = %v, want %v", operations, want)
+	}
+	for i := range want {
+		if operations[i] != want[i] {
+			t.Fatalf("audit operations = %v, want %v", operations, want)
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+)
+
+// CitableItem is an article published in a journal together with the
+// publication years of the articles that cite it, as reported by the article
+// service. A citing article that cites the item twice is listed twice.
+type CitableItem struct {
+	ArticleID     string `json:"article_id"`
+	Year          int    `json:"year"`
+	CitationYears []int  `json:"citation_years,omitempty"`
+}
+
+// ImpactFactorOverride replaces the computed impact factor of one year, for
+// example with the value published by an external index
+type ImpactFactorOverride struct {
+	Value  float64   `json:"value"`
+	Reason string    `json:"reason"`
+	Actor  string    `json:"actor"`
+	At     time.Time `json:"at"`
+}
+
+// JournalMetrics holds a journal's bibliometrics for one year. A metric is
+// nil when the year has no data to compute it from.
+type JournalMetrics struct {
+	JournalID string `json:"journal_id"`
+	Year      int    `json:"year"`
+	// CitableItems counts the items published in the two preceding years
+	// and Citations the citations they received in Year; their ratio is the
+	// two-year impact factor
+	CitableItems   int                   `json:"citable_items"`
+	Citations      int                   `json:"citations"`
+	ImpactFactor   *float64              `json:"impact_factor,omitempty"`
+	ImmediacyIndex *float64              `json:"immediacy_index,omitempty"`
+	CitedHalfLife  *float64              `json:"cited_half_life,omitempty"`
+	Override       *ImpactFactorOverride `json:"override,omitempty"`
+	ComputedAt     *time.Time            `json:"computed_at,omitempty"`
+}
+
+// CurrentImpactFactor returns the manual override if there is one and the
+// computed impact factor otherwise
+func (m JournalMetrics) CurrentImpactFactor() *float64 {
+	if m.Override != nil {
+		value := m.Override.Value
+		return &value
+	}
+	return m.ImpactFactor
+}
+
+// ComputeMetrics derives a journal's metrics for a year from its citable
+// items:
+//
+//   - the two-year impact factor divides the citations received in the year
+//     by items from the two preceding years by the number of those items
+//   - the immediacy index does the same for items published in the year
+//   - the cited half-life is the number of years, counting back from the
+//     year, that account for half of the citations the journal received in
+//     the year, interpolated linearly within the year that crosses the half
+func ComputeMetrics(journalID string, items []CitableItem, year int) JournalMetrics {
+	metrics := JournalMetrics{JournalID: journalID, Year: year}
+
+	var currentItems, currentCitations int
+	// citationsByAge[i] counts citations in year to items published i years earlier
+	var citationsByAge []int
+	for _, item := range items {
+		if item.Year > year {
+			continue
+		}
+
+		inYear := 0
+		for _, citationYear := range item.CitationYears {
+			if citationYear == year {
+				inYear++
+			}
+		}
+
+		switch item.Year {
+		case year:
+			currentItems++
+			currentCitations += inYear
+		case year - 1, year - 2:
+			metrics.CitableItems++
+			metrics.Citations += inYear
+		}
+
+		if inYear > 0 {
+			age := year - item.Year
+			for len(citationsByAge) <= age {
+				citationsByAge = append(citationsByAge, 0)
+			}
+			citationsByAge[age] += inYear
+		}
+	}
+
+	if metrics.CitableItems > 0 {
+		metrics.ImpactFactor = ratio(metrics.Citations, metrics.CitableItems)
+	}
+	if currentItems > 0 {
+		metrics.ImmediacyIndex = ratio(currentCitations, currentItems)
+	}
+	metrics.CitedHalfLife = citedHalfLife(citationsByAge)
+
+	return metrics
+}
+
+func ratio(numerator, denominator int) *float64 {
+	value := float64(numerator) / float64(denominator)
+	return &value
+}
+
+func citedHalfLife(citationsByAge []int) *float64 {
+	total := 0
+	for _, count := range citationsByAge {
+		total += count
+	}
+	if total == 0 {
+		return nil
+	}
+
+	half := float64(total) / 2
+	cumulative := 0
+	for age, count := range citationsByAge {
+		if float64(cumulative+count) >= half {
+			value := float64(age) + (half-float64(cumulative))/float64(count)
+			return &value
+		}
+		cumulative += count
+	}
+	return nil
+}
+
+// MetricsService keeps the per-year metrics history of journals and the
+// journal's current impact factor in step with it
+type MetricsService struct {
+	repository MetricsRepository
+	citations  CitationSource
+	journals   *JournalService
+}
+
+func NewMetricsService(repository MetricsRepository, citations CitationSource, journals *JournalService) *MetricsService {
+	return &MetricsService{repository: repository, citations: citations, journals: journals}
+}
+
+// WithActor returns a copy of the service that attributes overrides and
+// audit entries to the given actor
+func (s *MetricsService) WithActor(actor string) *MetricsService {
+	scoped := *s
+	scoped.journals = s.journals.WithActor(actor)
+	return &scoped
+}
+
+// WithCaller returns a copy of the service that attributes overrides and
+// audit entries to the given caller
+func (s *MetricsService) WithCaller(caller eventing.Caller) *MetricsService {
+	scoped := *s
+	scoped.journals = s.journals.WithCaller(caller)
+	return &scoped
+}
+
+func (s *MetricsService) GetMetrics(journalID string, year int) (JournalMetrics, error) {
+	return s.repository.GetMetrics(journalID, year)
+}
+
+// ListMetrics returns the journal's metrics history, oldest year first
+func (s *MetricsService) ListMetrics(journalID string) ([]JournalMetrics, error) {
+	if _, err := s.journals.GetJournal(journalID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListMetrics(journalID)
+}
+
+// Recalculate computes the journal's metrics for every year from its first
+// publication up to now, keeping manual overrides, and returns the history
+func (s *MetricsService) Recalculate(journalID string, now time.Time) ([]JournalMetrics, error) {
+	if _, err := s.journals.GetJournal(journalID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.citations.JournalCitations(journalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load citation data for journal %s: %w", journalID, err)
+	}
+	if len(items) == 0 {
+		return s.repository.ListMetrics(journalID)
+	}
+
+	first := now.Year()
+	for _, item := range items {
+		if item.Year < first {
+			first = item.Year
+		}
+	}
+
+	computedAt := now.UTC()
+	for year := first; year <= now.Year(); year++ {
+		metrics := ComputeMetrics(journalID, items, year)
+		metrics.ComputedAt = &computedAt
+
+		existing, err := s.repository.GetMetrics(journalID, year)
+		if err != nil && err != ErrMetricsNotFound {
+			return nil, err
+		}
+		metrics.Override = existing.Override
+
+		if err := s.repository.SaveMetrics(metrics); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.syncImpactFactor(journalID, now); err != nil {
+		return nil, err
+	}
+	return s.repository.ListMetrics(journalID)
+}
+
+// RecalculateAll recalculates the metrics of every journal and returns how
+// many journals were updated. It keeps going after a failure so one journal
+// does not hold back the rest.
+func (s *MetricsService) RecalculateAll(now time.Time) (int, error) {
+	journals, err := s.journals.ListJournals()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list journals: %w", err)
+	}
+
+	updated := 0
+	var firstErr error
+	for _, journal := range journals {
+		if _, err := s.Recalculate(journal.ID, now); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to recalculate metrics of journal %s: %w", journal.ID, err)
+			}
+			continue
+		}
+		updated++
+	}
+	return updated, firstErr
+}
+
+// OverrideImpactFactor sets a manual impact factor for a year, which takes
+// precedence over the computed value until it is cleared
+func (s *MetricsService) OverrideImpactFactor(journalID string, year int, value float64, reason string, now time.Time) (JournalMetrics, error) {
+	if value < 0 {
+		return JournalMetrics{}, fmt.Errorf("%w: impact factor cannot be negative", ErrInvalidMetrics)
+	}
+	if strings.TrimSpace(reason) == "" {
+		return JournalMetrics{}, fmt.Errorf("%w: an override needs a reason", ErrInvalidMetrics)
+	}
+
+	before, err := s.metricsForYear(journalID, year)
+	if err != nil {
+		return JournalMetrics{}, err
+	}
+
+	after := before
+	after.Override = &ImpactFactorOverride{Value: value, Reason: reason, Actor: s.journals.caller.Actor, At: now.UTC()}
+	return s.saveOverride(before, after, AuditOverrideImpactFactor, now)
+}
+
+// ClearImpactFactorOverride returns a year to its computed impact factor
+func (s *MetricsService) ClearImpactFactorOverride(journalID string, year int, now time.Time) (JournalMetrics, error) {
+	before, err := s.repository.GetMetrics(journalID, year)
+	if err != nil {
+		return JournalMetrics{}, err
+	}
+	if before.Override == nil {
+		return JournalMetrics{}, fmt.Errorf("%w: %d has no impact factor override", ErrInvalidMetrics, year)
+	}
+
+	after := before
+	after.Override = nil
+	return s.saveOverride(before, after, AuditClearImpactFactorOverride, now)
+}
+
+func (s *MetricsService) metricsForYear(journalID string, year int) (JournalMetrics, error) {
+	if year < 1 {
+		return JournalMetrics{}, fmt.Errorf("%w: year must be positive", ErrInvalidMetrics)
+	}
+	if _, err := s.journals.GetJournal(journalID); err != nil {
+		return JournalMetrics{}, err
+	}
+
+	metrics, err := s.repository.GetMetrics(journalID, year)
+	if err == ErrMetricsNotFound {
+		return JournalMetrics{JournalID: journalID, Year: year}, nil
+	}
+	return metrics, err
+}
+
+func (s *MetricsService) saveOverride(before, after JournalMetrics, operation AuditOperation, now time.Time) (JournalMetrics, error) {
+	audit, err := s.journals.auditEvent(operation, metricsEntityID(after), before, after)
+	if err != nil {
+		return JournalMetrics{}, err
+	}
+	if err := s.repository.SaveMetrics(after, audit); err != nil {
+		return JournalMetrics{}, err
+	}
+	return after, s.syncImpactFactor(after.JournalID, now)
+}
+
+// syncImpactFactor sets the journal's impact factor to the current value of
+// the latest complete year that has one
+func (s *MetricsService) syncImpactFactor(journalID string, now time.Time) error {
+	history, err := s.repository.ListMetrics(journalID)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(history, func(i, j int) bool { return history[i].Year > history[j].Year })
+	for _, metrics := range history {
+		if metrics.Year >= now.Year() {
+			continue
+		}
+		if value := metrics.CurrentImpactFactor(); value != nil {
+			return s.journals.setImpactFactor(journalID, *value)
+		}
+	}
+	return nil
+}
+
+// metricsEntityID identifies a year of a journal's metrics in the audit log
+func metricsEntityID(metrics JournalMetrics) string {
+	return fmt.Sprintf("%s/%d", metrics.JournalID, metrics.Year)
+}
+
+// MetricsCalculator recalculates the metrics of all journals periodically
+type MetricsCalculator struct {
+	metrics  *MetricsService
+	interval time.Duration
+}
+
+func NewMetricsCalculator(metrics *MetricsService, interval time.Duration) *MetricsCalculator {
+	return &MetricsCalculator{metrics: metrics, interval: interval}
+}
+
+// Run recalculates metrics until the context is cancelled
+func (c *MetricsCalculator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.metrics.RecalculateAll(time.Now().UTC()); err != nil {
+			log.Printf("Metrics calculator: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
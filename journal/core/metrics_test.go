@@ -0,0 +1,150 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/realBagher/hexaservice-go/journal/adapters"
+	"github.com/realBagher/hexaservice-go/journal/core"
+)
+
+// citableItems is the citation data of j1 used by the metrics tests
+var citableItems = []core.CitableItem{
+	{ArticleID: "i1", Year: 2022, CitationYears: []int{2023, 2024, 2024}},
+	{ArticleID: "i2", Year: 2023, CitationYears: []int{2024}},
+	{ArticleID: "i3", Year: 2023},
+	{ArticleID: "i4", Year: 2024, CitationYears: []int{2024, 2024}},
+	{ArticleID: "i5", Year: 2020, CitationYears: []int{2024}},
+	{ArticleID: "i6", Year: 2025, CitationYears: []int{2025}},
+}
+
+func equalMetric(got *float64, want float64) bool {
+	return got != nil && *got > want-1e-9 && *got < want+1e-9
+}
+
+func TestComputeMetrics(t *testing.T) {
+	metrics := core.ComputeMetrics("j1", citableItems, 2024)
+
+	if metrics.CitableItems != 3 || metrics.Citations != 3 {
+		t.Errorf("citable items = %d, citations = %d, want 3 and 3", metrics.CitableItems, metrics.Citations)
+	}
+	if !equalMetric(metrics.ImpactFactor, 1) {
+		t.Errorf("impact factor = %v, want 1", metrics.ImpactFactor)
+	}
+	if !equalMetric(metrics.ImmediacyIndex, 2) {
+		t.Errorf("immediacy index = %v, want 2", metrics.ImmediacyIndex)
+	}
+	// Six citations in 2024: two to 2024 items, one to 2023 and two to
+	// 2022, so the third falls exactly at the end of the second year
+	if !equalMetric(metrics.CitedHalfLife, 2) {
+		t.Errorf("cited half-life = %v, want 2", metrics.CitedHalfLife)
+	}
+}
+
+func TestComputeMetricsWithoutData(t *testing.T) {
+	metrics := core.ComputeMetrics("j1", citableItems, 2019)
+	if metrics.ImpactFactor != nil || metrics.ImmediacyIndex != nil || metrics.CitedHalfLife != nil {
+		t.Errorf("ComputeMetrics() before the first item = %+v, want no metrics", metrics)
+	}
+
+	// Items without citations give a zero impact factor but no half-life
+	metrics = core.ComputeMetrics("j1", []core.CitableItem{{ArticleID: "i1", Year: 2022}}, 2023)
+	if !equalMetric(metrics.ImpactFactor, 0) || metrics.CitedHalfLife != nil {
+		t.Errorf("ComputeMetrics() without citations = %+v", metrics)
+	}
+}
+
+func newMetricsService(t *testing.T) (*core.MetricsService, *core.JournalService) {
+	t.Helper()
+	repo := adapters.NewInMemoryJournalRepository()
+	journals := core.NewJournalService(repo)
+	if _, err := journals.CreateJournal(core.Journal{ID: "j1", Name: "Nature"}); err != nil {
+		t.Fatal(err)
+	}
+	citations := adapters.NewInMemoryCitationSource(map[string][]core.CitableItem{"j1": citableItems})
+	return core.NewMetricsService(adapters.NewInMemoryMetricsRepository(repo), citations, journals).WithActor("editor"), journals
+}
+
+func impactFactor(t *testing.T, journals *core.JournalService) float64 {
+	t.Helper()
+	journal, err := journals.GetJournal("j1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return journal.ImpactFactor
+}
+
+func TestRecalculateAndOverrideImpactFactor(t *testing.T) {
+	metrics, journals := newMetricsService(t)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	history, err := metrics.Recalculate("j1", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 6 || history[0].Year != 2020 || history[5].Year != 2025 {
+		t.Fatalf("Recalculate() = %d years, want 2020 to 2025", len(history))
+	}
+	// The current year is incomplete, so the journal shows 2024's value
+	if got := impactFactor(t, journals); got != 1 {
+		t.Errorf("journal impact factor = %v, want 1", got)
+	}
+
+	overridden, err := metrics.OverrideImpactFactor("j1", 2024, 3.5, "corrected count", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overridden.Override.Actor != "editor" || !equalMetric(overridden.CurrentImpactFactor(), 3.5) {
+		t.Errorf("OverrideImpactFactor() = %+v", overridden)
+	}
+	if got := impactFactor(t, journals); got != 3.5 {
+		t.Errorf("journal impact factor after override = %v, want 3.5", got)
+	}
+
+	// Recalculating keeps the override
+	if _, err := metrics.Recalculate("j1", now); err != nil {
+		t.Fatal(err)
+	}
+	kept, err := metrics.GetMetrics("j1", 2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.Override == nil || !equalMetric(kept.ImpactFactor, 1) {
+		t.Errorf("metrics after recalculation = %+v, want the override kept", kept)
+	}
+
+	if _, err := metrics.ClearImpactFactorOverride("j1", 2024, now); err != nil {
+		t.Fatal(err)
+	}
+	if got := impactFactor(t, journals); got != 1 {
+		t.Errorf("journal impact factor after clearing = %v, want 1", got)
+	}
+	if _, err := metrics.ClearImpactFactorOverride("j1", 2024, now); !errors.Is(err, core.ErrInvalidMetrics) {
+		t.Errorf("ClearImpactFactorOverride() twice = %v, want ErrInvalidMetrics", err)
+	}
+}
+
+func TestOverrideImpactFactorIsChecked(t *testing.T) {
+	metrics, _ := newMetricsService(t)
+	now := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		journalID string
+		year      int
+		value     float64
+		reason    string
+		want      error
+	}{
+		{"negative", "j1", 2024, -1, "typo", core.ErrInvalidMetrics},
+		{"no reason", "j1", 2024, 2, " ", core.ErrInvalidMetrics},
+		{"no year", "j1", 0, 2, "typo", core.ErrInvalidMetrics},
+		{"unknown journal", "j9", 2024, 2, "typo", core.ErrJournalNotFound},
+	}
+	for _, test := range tests {
+		if _, err := metrics.OverrideImpactFactor(test.journalID, test.year, test.value, test.reason, now); !errors.Is(err, test.want) {
+			t.Errorf("%s: OverrideImpactFactor() = %v, want %v", test.name, err, test.want)
+		}
+	}
+}
@@ -1,6 +1,52 @@
 package core
 
+import "time"
+
 type JournalRepository interface {
-	CreateJournal(journal Journal) (Journal, error)
+	// CreateJournal stores the journal together with the given events
+	CreateJournal(journal Journal, events ...Event) (Journal, error)
 	GetJournal(id string) (Journal, error)
+	// GetJournalByISSN matches the print, electronic or linking ISSN
+	GetJournalByISSN(issn string) (Journal, error)
+	ListJournals() ([]Journal, error)
+	// UpdateJournal replaces the stored journal together with the given events
+	UpdateJournal(journal Journal, events ...Event) (Journal, error)
+}
+
+// IssueRepository stores the volumes and issues of journals. Its writes
+// share the journal outbox.
+type IssueRepository interface {
+	// CreateVolume stores the volume together with the given events
+	CreateVolume(volume Volume, events ...Event) (Volume, error)
+	GetVolume(id string) (Volume, error)
+	// ListVolumes returns the journal's volumes ordered by number
+	ListVolumes(journalID string) ([]Volume, error)
+	// CreateIssue stores the issue together with the given events
+	CreateIssue(issue Issue, events ...Event) (Issue, error)
+	GetIssue(id string) (Issue, error)
+	// ListIssues returns the volume's issues ordered by number
+	ListIssues(volumeID string) ([]Issue, error)
+	// UpdateIssue stores the issue together with the given events if its
+	// stored status is still expected, and fails with ErrIssueStatusChanged
+	// otherwise
+	UpdateIssue(issue Issue, expected IssueStatus, events ...Event) (Issue, error)
+	// DueIssues returns scheduled issues whose publication date is not after now
+	DueIssues(now time.Time, limit int) ([]Issue, error)
+}
+
+// MetricsRepository stores the per-year metrics history of journals. Saves
+// share the journal outbox.
+type MetricsRepository interface {
+	// SaveMetrics creates or replaces the metrics of the journal and year
+	// together with the given events
+	SaveMetrics(metrics JournalMetrics, events ...Event) error
+	GetMetrics(journalID string, year int) (JournalMetrics, error)
+	// ListMetrics returns the journal's metrics ordered by year
+	ListMetrics(journalID string) ([]JournalMetrics, error)
+}
+
+// CitationSource provides the citation data of a journal's articles, which
+// the article service owns
+type CitationSource interface {
+	JournalCitations(journalID string) ([]CitableItem, error)
 }
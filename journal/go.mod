@@ -15,3 +15,7 @@ require (
 	golang.org/x/text v0.25.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
 )
+
+require github.com/realBagher/hexaservice-go/eventing v0.0.0
+
+replace github.com/realBagher/hexaservice-go/eventing => ../eventing
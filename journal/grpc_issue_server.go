@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+	"github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// CreateVolume implements the gRPC CreateVolume method
+func (s *JournalGRPCServer) CreateVolume(ctx context.Context, req *proto.CreateVolumeRequest) (*proto.CreateVolumeResponse, error) {
+	volume, err := s.issues.WithActor(actorFromContext(ctx)).CreateVolume(core.Volume{
+		JournalID: req.JournalId,
+		Number:    int(req.Number),
+		Year:      int(req.Year),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateVolumeResponse{Volume: toProtoVolume(volume)}, nil
+}
+
+// ListVolumes implements the gRPC ListVolumes method
+func (s *JournalGRPCServer) ListVolumes(ctx context.Context, req *proto.ListVolumesRequest) (*proto.ListVolumesResponse, error) {
+	volumes, err := s.issues.ListVolumes(req.JournalId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListVolumesResponse{}
+	for _, volume := range volumes {
+		resp.Volumes = append(resp.Volumes, toProtoVolume(volume))
+	}
+	return resp, nil
+}
+
+// CreateIssue implements the gRPC CreateIssue method
+func (s *JournalGRPCServer) CreateIssue(ctx context.Context, req *proto.CreateIssueRequest) (*proto.CreateIssueResponse, error) {
+	issue := core.Issue{
+		VolumeID: req.VolumeId,
+		Number:   int(req.Number),
+		Title:    req.Title,
+	}
+	if req.ScheduledFor != nil {
+		scheduledFor := req.ScheduledFor.AsTime()
+		issue.ScheduledFor = &scheduledFor
+	}
+
+	created, err := s.issues.WithActor(actorFromContext(ctx)).CreateIssue(issue)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateIssueResponse{Issue: toProtoIssue(created)}, nil
+}
+
+// GetIssue implements the gRPC GetIssue method
+func (s *JournalGRPCServer) GetIssue(ctx context.Context, req *proto.GetIssueRequest) (*proto.GetIssueResponse, error) {
+	issue, err := s.issues.GetIssue(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetIssueResponse{Issue: toProtoIssue(issue)}, nil
+}
+
+// ListIssues implements the gRPC ListIssues method
+func (s *JournalGRPCServer) ListIssues(ctx context.Context, req *proto.ListIssuesRequest) (*proto.ListIssuesResponse, error) {
+	issues, err := s.issues.ListIssues(req.VolumeId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListIssuesResponse{}
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, toProtoIssue(issue))
+	}
+	return resp, nil
+}
+
+// ScheduleIssue implements the gRPC ScheduleIssue method
+func (s *JournalGRPCServer) ScheduleIssue(ctx context.Context, req *proto.ScheduleIssueRequest) (*proto.ScheduleIssueResponse, error) {
+	if req.PublishAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "publish_at is required")
+	}
+
+	issue, err := s.issues.WithActor(actorFromContext(ctx)).ScheduleIssue(req.Id, req.PublishAt.AsTime())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ScheduleIssueResponse{Issue: toProtoIssue(issue)}, nil
+}
+
+// PublishIssue implements the gRPC PublishIssue method
+func (s *JournalGRPCServer) PublishIssue(ctx context.Context, req *proto.PublishIssueRequest) (*proto.PublishIssueResponse, error) {
+	issue, err := s.issues.WithActor(actorFromContext(ctx)).PublishIssue(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.PublishIssueResponse{Issue: toProtoIssue(issue)}, nil
+}
+
+func toProtoVolume(volume core.Volume) *proto.Volume {
+	return &proto.Volume{
+		Id:        volume.ID,
+		JournalId: volume.JournalID,
+		Number:    int32(volume.Number),
+		Year:      int32(volume.Year),
+		CreatedAt: timestamppb.New(volume.CreatedAt),
+	}
+}
+
+func toProtoIssue(issue core.Issue) *proto.Issue {
+	resp := &proto.Issue{
+		Id:        issue.ID,
+		JournalId: issue.JournalID,
+		VolumeId:  issue.VolumeID,
+		Number:    int32(issue.Number),
+		Title:     issue.Title,
+		Status:    string(issue.Status),
+		CreatedAt: timestamppb.New(issue.CreatedAt),
+	}
+	if issue.ScheduledFor != nil {
+		resp.ScheduledFor = timestamppb.New(*issue.ScheduledFor)
+	}
+	if issue.PublishedAt != nil {
+		resp.PublishedAt = timestamppb.New(*issue.PublishedAt)
+	}
+	return resp
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/journal/core"
+	"github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// GetJournalMetrics implements the gRPC GetJournalMetrics method
+func (s *JournalGRPCServer) GetJournalMetrics(ctx context.Context, req *proto.GetJournalMetricsRequest) (*proto.GetJournalMetricsResponse, error) {
+	metrics, err := s.metrics.GetMetrics(req.JournalId, int(req.Year))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.GetJournalMetricsResponse{Metrics: toProtoMetrics(metrics)}, nil
+}
+
+// ListJournalMetrics implements the gRPC ListJournalMetrics method
+func (s *JournalGRPCServer) ListJournalMetrics(ctx context.Context, req *proto.ListJournalMetricsRequest) (*proto.ListJournalMetricsResponse, error) {
+	history, err := s.metrics.ListMetrics(req.JournalId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ListJournalMetricsResponse{Metrics: toProtoMetricsHistory(history)}, nil
+}
+
+// RecalculateJournalMetrics implements the gRPC RecalculateJournalMetrics method
+func (s *JournalGRPCServer) RecalculateJournalMetrics(ctx context.Context, req *proto.RecalculateJournalMetricsRequest) (*proto.RecalculateJournalMetricsResponse, error) {
+	history, err := s.metrics.WithActor(actorFromContext(ctx)).Recalculate(req.JournalId, time.Now().UTC())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RecalculateJournalMetricsResponse{Metrics: toProtoMetricsHistory(history)}, nil
+}
+
+// OverrideImpactFactor implements the gRPC OverrideImpactFactor method
+func (s *JournalGRPCServer) OverrideImpactFactor(ctx context.Context, req *proto.OverrideImpactFactorRequest) (*proto.OverrideImpactFactorResponse, error) {
+	metrics, err := s.metrics.WithActor(actorFromContext(ctx)).OverrideImpactFactor(
+		req.JournalId, int(req.Year), req.ImpactFactor, req.Reason, time.Now().UTC())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.OverrideImpactFactorResponse{Metrics: toProtoMetrics(metrics)}, nil
+}
+
+// ClearImpactFactorOverride implements the gRPC ClearImpactFactorOverride method
+func (s *JournalGRPCServer) ClearImpactFactorOverride(ctx context.Context, req *proto.ClearImpactFactorOverrideRequest) (*proto.ClearImpactFactorOverrideResponse, error) {
+	metrics, err := s.metrics.WithActor(actorFromContext(ctx)).ClearImpactFactorOverride(req.JournalId, int(req.Year), time.Now().UTC())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.ClearImpactFactorOverrideResponse{Metrics: toProtoMetrics(metrics)}, nil
+}
+
+func toProtoMetricsHistory(history []core.JournalMetrics) []*proto.JournalMetrics {
+	resp := make([]*proto.JournalMetrics, 0, len(history))
+	for _, metrics := range history {
+		resp = append(resp, toProtoMetrics(metrics))
+	}
+	return resp
+}
+
+func toProtoMetrics(metrics core.JournalMetrics) *proto.JournalMetrics {
+	resp := &proto.JournalMetrics{
+		JournalId:            metrics.JournalID,
+		Year:                 int32(metrics.Year),
+		CitableItems:         int32(metrics.CitableItems),
+		Citations:            int32(metrics.Citations),
+		ImpactFactor:         metrics.CurrentImpactFactor(),
+		ComputedImpactFactor: metrics.ImpactFactor,
+		ImmediacyIndex:       metrics.ImmediacyIndex,
+		CitedHalfLife:        metrics.CitedHalfLife,
+	}
+	if override := metrics.Override; override != nil {
+		resp.Manual = true
+		resp.OverrideReason = override.Reason
+		resp.OverrideActor = override.Actor
+	}
+	if metrics.ComputedAt != nil {
+		resp.ComputedAt = timestamppb.New(*metrics.ComputedAt)
+	}
+	return resp
+}
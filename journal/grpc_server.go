@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/realBagher/hexaservice-go/eventing"
+	"github.com/realBagher/hexaservice-go/journal/core"
+	"github.com/realBagher/hexaservice-go/journal/proto"
+)
+
+// JournalGRPCServer implements the gRPC server interface
+type JournalGRPCServer struct {
+	proto.UnimplementedJournalServiceServer
+	service  *core.JournalService
+	webhooks *eventing.WebhookService
+	audit    *eventing.AuditService
+	issues   *core.IssueService
+	metrics  *core.MetricsService
+}
+
+// NewJournalGRPCServer creates a new gRPC server instance
+func NewJournalGRPCServer(service *core.JournalService, webhooks *eventing.WebhookService, audit *eventing.AuditService,
+	issues *core.IssueService, metrics *core.MetricsService) *JournalGRPCServer {
+	return &JournalGRPCServer{service: service, webhooks: webhooks, audit: audit, issues: issues, metrics: metrics}
+}
+
+// GetJournal implements the gRPC GetJournal method
+func (s *JournalGRPCServer) GetJournal(ctx context.Context, req *proto.GetJournalRequest) (*proto.GetJournalResponse, error) {
+	journal, err := s.service.GetJournal(req.Id)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &proto.GetJournalResponse{Journal: toProtoJournal(journal)}, nil
+}
+
+// GetJournalByISSN implements the gRPC GetJournalByISSN method
+func (s *JournalGRPCServer) GetJournalByISSN(ctx context.Context, req *proto.GetJournalByISSNRequest) (*proto.GetJournalByISSNResponse, error) {
+	journal, err := s.service.GetJournalByISSN(req.Issn)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &proto.GetJournalByISSNResponse{Journal: toProtoJournal(journal)}, nil
+}
+
+// ListJournals implements the gRPC ListJournals method
+func (s *JournalGRPCServer) ListJournals(ctx context.Context, req *proto.ListJournalsRequest) (*proto.ListJournalsResponse, error) {
+	var journals []core.Journal
+	var err error
+	if req.Name != "" {
+		journals, err = s.service.FindJournalsByName(req.Name)
+	} else {
+		journals, err = s.service.ListJournals()
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListJournalsResponse{}
+	for _, journal := range journals {
+		resp.Journals = append(resp.Journals, toProtoJournal(journal))
+	}
+	return resp, nil
+}
+
+// CreateJournal implements the gRPC CreateJournal method
+func (s *JournalGRPCServer) CreateJournal(ctx context.Context, req *proto.CreateJournalRequest) (*proto.CreateJournalResponse, error) {
+	journal, err := s.service.WithCaller(callerFromContext(ctx)).CreateJournal(fromProtoJournal(req.Journal))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.CreateJournalResponse{Journal: toProtoJournal(journal)}, nil
+}
+
+// UpdateJournal implements the gRPC UpdateJournal method
+func (s *JournalGRPCServer) UpdateJournal(ctx context.Context, req *proto.UpdateJournalRequest) (*proto.UpdateJournalResponse, error) {
+	journal, err := s.service.WithCaller(callerFromContext(ctx)).UpdateJournal(fromProtoJournal(req.Journal))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.UpdateJournalResponse{Journal: toProtoJournal(journal)}, nil
+}
+
+// ListAuditEntries implements the gRPC ListAuditEntries method
+func (s *JournalGRPCServer) ListAuditEntries(ctx context.Context, req *proto.ListAuditEntriesRequest) (*proto.ListAuditEntriesResponse, error) {
+	query := eventing.AuditQuery{EntityID: req.EntityId, Limit: int(req.Limit)}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+
+	entries, err := s.audit.ListEntries(query)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListAuditEntriesResponse{}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &proto.AuditEntry{
+			Sequence:  entry.Sequence,
+			EntityId:  entry.EntityID,
+			Actor:     entry.Actor,
+			Operation: string(entry.Operation),
+			Before:    string(entry.Before),
+			After:     string(entry.After),
+			Timestamp: timestamppb.New(entry.Timestamp),
+			PrevHash:  entry.PrevHash,
+			Hash:      entry.Hash,
+			Peer:      entry.Peer,
+			EventId:   entry.EventID,
+		})
+	}
+	return resp, nil
+}
+
+// VerifyAuditLog implements the gRPC VerifyAuditLog method
+func (s *JournalGRPCServer) VerifyAuditLog(ctx context.Context, req *proto.VerifyAuditLogRequest) (*proto.VerifyAuditLogResponse, error) {
+	verified, err := s.audit.VerifyChain()
+	if errors.Is(err, eventing.ErrAuditChainBroken) {
+		return &proto.VerifyAuditLogResponse{Valid: false, VerifiedEntries: verified, Error: err.Error()}, nil
+	}
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.VerifyAuditLogResponse{Valid: true, VerifiedEntries: verified}, nil
+}
+
+// CreateWebhookSubscription implements the gRPC CreateWebhookSubscription method
+func (s *JournalGRPCServer) CreateWebhookSubscription(ctx context.Context, req *proto.CreateWebhookSubscriptionRequest) (*proto.CreateWebhookSubscriptionResponse, error) {
+	eventTypes := make([]core.EventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		eventTypes = append(eventTypes, core.EventType(eventType))
+	}
+
+	subscription, err := s.webhooks.CreateSubscription(eventing.WebhookSubscription{
+		URL:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &proto.CreateWebhookSubscriptionResponse{Subscription: toProtoSubscription(subscription)}, nil
+}
+
+// ListWebhookSubscriptions implements the gRPC ListWebhookSubscriptions method
+func (s *JournalGRPCServer) ListWebhookSubscriptions(ctx context.Context, req *proto.ListWebhookSubscriptionsRequest) (*proto.ListWebhookSubscriptionsResponse, error) {
+	subscriptions, err := s.webhooks.ListSubscriptions()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListWebhookSubscriptionsResponse{}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, toProtoSubscription(subscription))
+	}
+	return resp, nil
+}
+
+// DeleteWebhookSubscription implements the gRPC DeleteWebhookSubscription method
+func (s *JournalGRPCServer) DeleteWebhookSubscription(ctx context.Context, req *proto.DeleteWebhookSubscriptionRequest) (*proto.DeleteWebhookSubscriptionResponse, error) {
+	if err := s.webhooks.DeleteSubscription(req.Id); err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+// ListWebhookDeliveries implements the gRPC ListWebhookDeliveries method
+func (s *JournalGRPCServer) ListWebhookDeliveries(ctx context.Context, req *proto.ListWebhookDeliveriesRequest) (*proto.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.webhooks.ListDeliveries(eventing.DeliveryQuery{
+		SubscriptionID: req.SubscriptionId,
+		Status:         eventing.DeliveryStatus(req.Status),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &proto.ListWebhookDeliveriesResponse{}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toProtoDelivery(delivery))
+	}
+	return resp, nil
+}
+
+// RedeliverWebhook implements the gRPC RedeliverWebhook method
+func (s *JournalGRPCServer) RedeliverWebhook(ctx context.Context, req *proto.RedeliverWebhookRequest) (*proto.RedeliverWebhookResponse, error) {
+	delivery, err := s.webhooks.Redeliver(req.DeliveryId)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &proto.RedeliverWebhookResponse{Delivery: toProtoDelivery(delivery)}, nil
+}
+
+func toProtoJournal(journal core.Journal) *proto.Journal {
+	return &proto.Journal{
+		Id:                  journal.ID,
+		Name:                journal.Name,
+		Description:         journal.Description,
+		ImpactFactor:        journal.ImpactFactor,
+		PrintIssn:           journal.PrintISSN,
+		ElectronicIssn:      journal.ElectronicISSN,
+		IssnL:               journal.LinkingISSN,
+		UniqueArticleTitles: journal.UniqueArticleTitles,
+		SubjectScope:        journal.SubjectScope,
+	}
+}
+
+func fromProtoJournal(journal *proto.Journal) core.Journal {
+	return core.Journal{
+		ID:                  journal.GetId(),
+		Name:                journal.GetName(),
+		Description:         journal.GetDescription(),
+		ImpactFactor:        journal.GetImpactFactor(),
+		PrintISSN:           journal.GetPrintIssn(),
+		ElectronicISSN:      journal.GetElectronicIssn(),
+		LinkingISSN:         journal.GetIssnL(),
+		UniqueArticleTitles: journal.GetUniqueArticleTitles(),
+		SubjectScope:        journal.GetSubjectScope(),
+	}
+}
+
+func toProtoSubscription(subscription eventing.WebhookSubscription) *proto.WebhookSubscription {
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	return &proto.WebhookSubscription{
+		Id:         subscription.ID,
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func toProtoDelivery(delivery eventing.WebhookDelivery) *proto.WebhookDelivery {
+	return &proto.WebhookDelivery{
+		Id:             delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.Event.ID,
+		EventType:      string(delivery.Event.Type),
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		LastStatusCode: int32(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+	}
+}
+
+// actorMetadataKey is the metadata key callers use to identify themselves
+// for the audit log
+const actorMetadataKey = "x-actor"
+
+// callerFromContext identifies the caller of a request for the audit log.
+// The actor is taken from the x-actor header, which the service does not
+// authenticate: any client can claim any actor, so the network address of
+// the peer is recorded alongside it.
+func callerFromContext(ctx context.Context) eventing.Caller {
+	caller := eventing.Caller{Actor: actorFromContext(ctx)}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		caller.Peer = p.Addr.String()
+	}
+	return caller
+}
+
+// actorFromContext returns the actor from the request metadata, falling back
+// to the system actor for unattributed calls
+func actorFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return core.SystemActor
+	}
+	if values := md.Get(actorMetadataKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	return core.SystemActor
+}
+
+// grpcError maps domain errors to gRPC status codes
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrJournalNotFound),
+		errors.Is(err, eventing.ErrWebhookNotFound),
+		errors.Is(err, eventing.ErrDeliveryNotFound),
+		errors.Is(err, core.ErrVolumeNotFound),
+		errors.Is(err, core.ErrIssueNotFound),
+		errors.Is(err, core.ErrMetricsNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrInvalidJournal),
+		errors.Is(err, eventing.ErrInvalidWebhook),
+		errors.Is(err, core.ErrInvalidVolume),
+		errors.Is(err, core.ErrInvalidIssue),
+		errors.Is(err, core.ErrInvalidMetrics):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, core.ErrIssuePublished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, core.ErrIssueStatusChanged):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -2,14 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/realBagher/hexaservice-go/eventing"
+	eventadapters "github.com/realBagher/hexaservice-go/eventing/adapters"
 	"github.com/realBagher/hexaservice-go/journal/adapters"
 	"github.com/realBagher/hexaservice-go/journal/core"
 	"github.com/realBagher/hexaservice-go/journal/proto"
@@ -20,35 +25,22 @@ const (
 	testJournalID  = "1"
 	mysqlJournalID = "mysql_1"
 	grpcPort       = ":50051"
+	articleAddr    = "localhost:50052"
+	articleTimeout = 5 * time.Second
+
+	relayInterval     = time.Second
+	dispatchInterval  = time.Second
+	schedulerInterval = time.Second
+	metricsInterval   = time.Hour
+	webhookTimeout    = 10 * time.Second
 )
 
-// JournalGRPCServer implements the gRPC server interface
-type JournalGRPCServer struct {
-	proto.UnimplementedJournalServiceServer
-	service *core.JournalService
-}
-
-// NewJournalGRPCServer creates a new gRPC server instance
-func NewJournalGRPCServer(service *core.JournalService) *JournalGRPCServer {
-	return &JournalGRPCServer{service: service}
-}
-
-// GetJournal implements the gRPC GetJournal method
-func (s *JournalGRPCServer) GetJournal(ctx context.Context, req *proto.GetJournalRequest) (*proto.GetJournalResponse, error) {
-	journal, err := s.service.GetJournal(req.Id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Convert core.Journal to proto.Journal
-	protoJournal := &proto.Journal{
-		Id:           journal.ID,
-		Name:         journal.Name,
-		Description:  journal.Description,
-		ImpactFactor: journal.ImpactFactor,
-	}
-
-	return &proto.GetJournalResponse{Journal: protoJournal}, nil
+// journalStore is implemented by repositories that keep an event outbox
+// next to the journals, volumes and issues tables
+type journalStore interface {
+	core.JournalRepository
+	core.IssueRepository
+	eventing.OutboxRepository
 }
 
 func main() {
@@ -84,29 +76,82 @@ func runDemo() error {
 	return nil
 }
 
-func startGRPCServer() error {
-	// Create a repository and service for the gRPC server
-	// Using in-memory repository for simplicity, but could be MySQL based on env var
-	var repo core.JournalRepository
-	if dsn := os.Getenv(mysqlDSNEnvVar); dsn != "" {
-		db, err := adapters.NewMySQLConnection(dsn)
-		if err != nil {
-			log.Printf("Failed to connect to MySQL, falling back to in-memory: %v", err)
-			repo = adapters.NewInMemoryJournalRepository()
-		} else {
-			mysqlRepo := adapters.NewMySQLJournalRepository(db)
-			if err := mysqlRepo.InitializeSchema(); err != nil {
-				log.Printf("Failed to initialize MySQL schema, falling back to in-memory: %v", err)
-				repo = adapters.NewInMemoryJournalRepository()
-			} else {
-				repo = mysqlRepo
-			}
+// repositories bundles the storage adapters used by the gRPC server
+type repositories struct {
+	journals journalStore
+	webhooks eventing.WebhookRepository
+	auditLog eventing.AuditLog
+	metrics  core.MetricsRepository
+}
+
+// newRepositories returns MySQL backed repositories when the DSN is set and
+// falls back to in-memory storage otherwise
+func newRepositories() repositories {
+	journals := adapters.NewInMemoryJournalRepository()
+	inMemory := repositories{
+		journals: journals,
+		webhooks: eventadapters.NewInMemoryWebhookRepository(),
+		auditLog: eventadapters.NewInMemoryAuditLog(),
+		metrics:  adapters.NewInMemoryMetricsRepository(journals),
+	}
+
+	dsn := os.Getenv(mysqlDSNEnvVar)
+	if dsn == "" {
+		return inMemory
+	}
+
+	db, err := adapters.NewMySQLConnection(dsn)
+	if err != nil {
+		log.Printf("Failed to connect to MySQL, falling back to in-memory: %v", err)
+		return inMemory
+	}
+
+	journalRepo := adapters.NewMySQLJournalRepository(db)
+	webhookRepo := eventadapters.NewMySQLWebhookRepository(db, adapters.TablePrefix)
+	auditLog := eventadapters.NewMySQLAuditLog(db, adapters.TablePrefix)
+	metricsRepo := adapters.NewMySQLMetricsRepository(db)
+	for _, repo := range []interface{ InitializeSchema() error }{journalRepo, webhookRepo, auditLog, metricsRepo} {
+		if err := repo.InitializeSchema(); err != nil {
+			log.Printf("Failed to initialize MySQL schema, falling back to in-memory: %v", err)
+			return inMemory
 		}
-	} else {
-		repo = adapters.NewInMemoryJournalRepository()
 	}
 
-	service := core.NewJournalService(repo)
+	return repositories{journals: journalRepo, webhooks: webhookRepo, auditLog: auditLog, metrics: metricsRepo}
+}
+
+func startGRPCServer() error {
+	// Create repositories and services for the gRPC server
+	repos := newRepositories()
+	articleConn, err := grpc.NewClient(articleAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to create article service client: %w", err)
+	}
+	citations := adapters.NewGRPCCitationSource(articleConn, articleTimeout)
+
+	service := core.NewJournalService(repos.journals)
+	audit := eventing.NewAuditService(repos.auditLog)
+	webhooks := eventing.NewWebhookService(repos.webhooks, eventadapters.NewHTTPWebhookSender(webhookTimeout), eventing.DefaultRetryPolicy, core.EventTypes)
+	issues := core.NewIssueService(repos.journals, service)
+	metrics := core.NewMetricsService(repos.metrics, citations, service)
+
+	// Relay outbox events to the local publisher, which feeds the audit log
+	// and the webhooks
+	publisher := eventadapters.NewInMemoryEventPublisher(eventadapters.DefaultHandlerRetryPolicy)
+	publisher.Subscribe("log", logEvent)
+	publisher.Subscribe("audit", audit.HandleEvent)
+	publisher.Subscribe("webhooks", webhooks.HandleEvent)
+	relay := eventing.NewOutboxRelay(repos.journals, publisher, relayInterval)
+	dispatcher := eventing.NewWebhookDispatcher(webhooks, dispatchInterval)
+	scheduler := core.NewPublicationScheduler(issues, schedulerInterval)
+	calculator := core.NewMetricsCalculator(metrics, metricsInterval)
+	runInBackground("Outbox relay", relay.Run)
+	runInBackground("Event handler retries", func(ctx context.Context) error {
+		return publisher.Run(ctx, relayInterval)
+	})
+	runInBackground("Webhook dispatcher", dispatcher.Run)
+	runInBackground("Publication scheduler", scheduler.Run)
+	runInBackground("Metrics calculator", calculator.Run)
 
 	// Pre-populate with a test journal for the article service to find
 	testJournal := createTestJournal("journal_1")
@@ -116,7 +161,7 @@ func startGRPCServer() error {
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
-	journalGRPCServer := NewJournalGRPCServer(service)
+	journalGRPCServer := NewJournalGRPCServer(service, webhooks, audit, issues, metrics)
 
 	proto.RegisterJournalServiceServer(grpcServer, journalGRPCServer)
 	reflection.Register(grpcServer)
@@ -131,15 +176,42 @@ func startGRPCServer() error {
 	return grpcServer.Serve(listener)
 }
 
+// runInBackground starts a worker that runs for the lifetime of the process
+func runInBackground(name string, run func(ctx context.Context) error) {
+	go func() {
+		if err := run(context.Background()); err != nil {
+			log.Printf("%s stopped: %v", name, err)
+		}
+	}()
+}
+
 func demonstrateInMemoryRepository() error {
 	fmt.Println("=== Using InMemory Repository ===")
 
 	repo := adapters.NewInMemoryJournalRepository()
-	service := core.NewJournalService(repo)
+	auditLog := eventadapters.NewInMemoryAuditLog()
+	service := core.NewJournalService(repo).WithActor("demo-editor")
 
 	testJournal := createTestJournal(testJournalID)
 
-	return demonstrateJournalOperations(service, testJournal)
+	if err := demonstrateJournalOperations(service, testJournal); err != nil {
+		return err
+	}
+	if err := demonstrateISSNLookup(service, testJournal.ID); err != nil {
+		return err
+	}
+	issues := core.NewIssueService(repo, service)
+	if err := demonstrateIssueScheduling(issues, testJournal.ID); err != nil {
+		return err
+	}
+	metrics := core.NewMetricsService(adapters.NewInMemoryMetricsRepository(repo), demoCitationSource(testJournal.ID), service)
+	if err := demonstrateMetrics(metrics, testJournal.ID); err != nil {
+		return err
+	}
+	if err := demonstrateOutboxRelay(repo, auditLog); err != nil {
+		return err
+	}
+	return demonstrateAuditLog(auditLog, testJournal.ID)
 }
 
 func demonstrateMySQLRepository(dsn string) error {
@@ -160,10 +232,36 @@ func demonstrateMySQLRepository(dsn string) error {
 		return fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
-	service := core.NewJournalService(repo)
+	auditLog := eventadapters.NewMySQLAuditLog(db, adapters.TablePrefix)
+	if err := auditLog.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize audit schema: %w", err)
+	}
+
+	service := core.NewJournalService(repo).WithActor("demo-editor")
 	testJournal := createTestJournal(mysqlJournalID)
 
-	return demonstrateJournalOperations(service, testJournal)
+	if err := demonstrateJournalOperations(service, testJournal); err != nil {
+		return err
+	}
+	if err := demonstrateISSNLookup(service, testJournal.ID); err != nil {
+		return err
+	}
+	issues := core.NewIssueService(repo, service)
+	if err := demonstrateIssueScheduling(issues, testJournal.ID); err != nil {
+		return err
+	}
+	metricsRepo := adapters.NewMySQLMetricsRepository(db)
+	if err := metricsRepo.InitializeSchema(); err != nil {
+		return fmt.Errorf("failed to initialize metrics schema: %w", err)
+	}
+	metrics := core.NewMetricsService(metricsRepo, demoCitationSource(testJournal.ID), service)
+	if err := demonstrateMetrics(metrics, testJournal.ID); err != nil {
+		return err
+	}
+	if err := demonstrateOutboxRelay(repo, auditLog); err != nil {
+		return err
+	}
+	return demonstrateAuditLog(auditLog, testJournal.ID)
 }
 
 func createTestJournal(id string) core.Journal {
@@ -190,5 +288,170 @@ func demonstrateJournalOperations(service *core.JournalService, journal core.Jou
 	}
 	fmt.Printf("Retrieved journal: %+v\n", retrievedJournal)
 
+	// Update journal
+	retrievedJournal.Description = "International weekly journal of science"
+	updatedJournal, err := service.UpdateJournal(retrievedJournal)
+	if err != nil {
+		return fmt.Errorf("failed to update journal: %w", err)
+	}
+	fmt.Printf("Updated journal: %+v\n", updatedJournal)
+
+	return nil
+}
+
+// demonstrateISSNLookup registers Nature's ISSNs on the test journal and finds
+// it again by its electronic ISSN
+func demonstrateISSNLookup(service *core.JournalService, journalID string) error {
+	journal, err := service.GetJournal(journalID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve journal: %w", err)
+	}
+
+	// The check character of an ISSN catches typos
+	journal.PrintISSN = "0028-0837"
+	if _, err := service.UpdateJournal(journal); errors.Is(err, core.ErrInvalidJournal) {
+		fmt.Printf("Rejected ISSN: %v\n", err)
+	}
+
+	journal.PrintISSN = "0028-0836"
+	journal.ElectronicISSN = "1476-4687"
+	if journal, err = service.UpdateJournal(journal); err != nil {
+		return fmt.Errorf("failed to set ISSNs: %w", err)
+	}
+	fmt.Printf("Journal %s has print ISSN %s, electronic ISSN %s and ISSN-L %s\n",
+		journal.ID, journal.PrintISSN, journal.ElectronicISSN, journal.LinkingISSN)
+
+	found, err := service.GetJournalByISSN("14764687")
+	if err != nil {
+		return fmt.Errorf("failed to find journal by ISSN: %w", err)
+	}
+	fmt.Printf("Found journal %s by ISSN 14764687\n", found.ID)
+
+	return nil
+}
+
+// demoCitationSource stands in for the article service with a few articles
+// published over the last three years
+func demoCitationSource(journalID string) *adapters.InMemoryCitationSource {
+	year := time.Now().Year()
+	return adapters.NewInMemoryCitationSource(map[string][]core.CitableItem{
+		journalID: {
+			{ArticleID: "a1", Year: year - 3, CitationYears: []int{year - 2, year - 1, year - 1}},
+			{ArticleID: "a2", Year: year - 3, CitationYears: []int{year - 1}},
+			{ArticleID: "a3", Year: year - 2, CitationYears: []int{year - 1, year - 1, year - 1}},
+			{ArticleID: "a4", Year: year - 2, CitationYears: []int{year - 1}},
+			{ArticleID: "a5", Year: year - 1, CitationYears: []int{year - 1, year}},
+		},
+	})
+}
+
+func demonstrateMetrics(metrics *core.MetricsService, journalID string) error {
+	now := time.Now().UTC()
+	history, err := metrics.Recalculate(journalID, now)
+	if err != nil {
+		return fmt.Errorf("failed to recalculate metrics: %w", err)
+	}
+	for _, year := range history {
+		fmt.Printf("Metrics %d: impact factor %s, immediacy index %s, cited half-life %s\n", year.Year,
+			formatMetric(year.ImpactFactor), formatMetric(year.ImmediacyIndex), formatMetric(year.CitedHalfLife))
+	}
+
+	lastYear := now.Year() - 1
+	overridden, err := metrics.OverrideImpactFactor(journalID, lastYear, 2.1, "value published by the index", now)
+	if err != nil {
+		return fmt.Errorf("failed to override impact factor: %w", err)
+	}
+	fmt.Printf("Overrode %d impact factor with %s (computed %s)\n", lastYear,
+		formatMetric(overridden.CurrentImpactFactor()), formatMetric(overridden.ImpactFactor))
+
+	return nil
+}
+
+func formatMetric(value *float64) string {
+	if value == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.3f", *value)
+}
+
+func demonstrateAuditLog(auditLog eventing.AuditLog, journalID string) error {
+	audit := eventing.NewAuditService(auditLog)
+
+	entries, err := audit.ListEntries(eventing.AuditQuery{EntityID: journalID})
+	if err != nil {
+		return fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	for _, entry := range entries {
+		fmt.Printf("Audit #%d: %s by %s (event %s) before=%s after=%s\n",
+			entry.Sequence, entry.Operation, entry.Actor, entry.EventID, string(entry.Before), string(entry.After))
+	}
+
+	verified, err := audit.VerifyChain()
+	if err != nil {
+		return fmt.Errorf("audit chain verification failed: %w", err)
+	}
+	fmt.Printf("Verified %d audit entries\n", verified)
+
+	return nil
+}
+
+func demonstrateIssueScheduling(issues *core.IssueService, journalID string) error {
+	volume, err := issues.CreateVolume(core.Volume{JournalID: journalID, Number: 1, Year: time.Now().Year()})
+	if err != nil {
+		return fmt.Errorf("failed to create volume: %w", err)
+	}
+	fmt.Printf("Created volume %d (%d)\n", volume.Number, volume.Year)
+
+	// Scheduled in the past so the scheduler publishes it on its first pass
+	publishAt := time.Now().UTC().Add(-time.Minute)
+	issue, err := issues.CreateIssue(core.Issue{VolumeID: volume.ID, Number: 1, Title: "Launch issue", ScheduledFor: &publishAt})
+	if err != nil {
+		return fmt.Errorf("failed to create issue: %w", err)
+	}
+	fmt.Printf("Created issue %d: %s for %s\n", issue.Number, issue.Status, issue.ScheduledFor.Format(time.RFC3339))
+
+	published, err := issues.PublishDueIssues(time.Now().UTC(), 10)
+	if err != nil {
+		return fmt.Errorf("failed to publish due issues: %w", err)
+	}
+	issue, err = issues.GetIssue(issue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve issue: %w", err)
+	}
+	fmt.Printf("Scheduler published %d issue(s); issue %d is %s\n", published, issue.Number, issue.Status)
+
+	return nil
+}
+
+// demonstrateOutboxRelay drains the outbox, which also appends the audit
+// entries stored with each change to the audit log
+func demonstrateOutboxRelay(outbox eventing.OutboxRepository, auditLog eventing.AuditLog) error {
+	publisher := eventadapters.NewInMemoryEventPublisher(eventadapters.DefaultHandlerRetryPolicy)
+	publisher.Subscribe("log", func(event core.Event) error {
+		if event.Type != eventing.EventAuditRecorded {
+			fmt.Printf("Published event: %s %s %s\n", event.Type, event.AggregateID, event.Payload)
+		}
+		return nil
+	})
+	publisher.Subscribe("audit", eventing.NewAuditService(auditLog).HandleEvent)
+
+	relay := eventing.NewOutboxRelay(outbox, publisher, relayInterval)
+	for {
+		relayed, err := relay.RelayPending()
+		if err != nil {
+			return fmt.Errorf("failed to relay outbox events: %w", err)
+		}
+		if relayed == 0 {
+			break
+		}
+	}
+	if waiting := publisher.RetryDue(); waiting > 0 {
+		return fmt.Errorf("event handlers failed on %d event(s)", waiting)
+	}
+	return nil
+}
+
+func logEvent(event core.Event) error {
+	log.Printf("Event %s: %s %s", event.ID, event.Type, event.AggregateID)
 	return nil
 }
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,11 +23,24 @@ const (
 )
 
 type Journal struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ImpactFactor  float64                `protobuf:"fixed64,4,opt,name=impact_factor,json=impactFactor,proto3" json:"impact_factor,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImpactFactor float64                `protobuf:"fixed64,4,opt,name=impact_factor,json=impactFactor,proto3" json:"impact_factor,omitempty"`
+	// ISSNs in the form 0000-0000; the check character is validated
+	PrintIssn      string `protobuf:"bytes,5,opt,name=print_issn,json=printIssn,proto3" json:"print_issn,omitempty"`
+	ElectronicIssn string `protobuf:"bytes,6,opt,name=electronic_issn,json=electronicIssn,proto3" json:"electronic_issn,omitempty"`
+	// ISSN-L linking the print and electronic editions. It must be one of the
+	// two and defaults to the print ISSN, or the electronic one if there is none.
+	IssnL string `protobuf:"bytes,7,opt,name=issn_l,json=issnL,proto3" json:"issn_l,omitempty"`
+	// When set, no two articles of the journal may have the same title,
+	// ignoring case, accents, punctuation and whitespace
+	UniqueArticleTitles bool `protobuf:"varint,8,opt,name=unique_article_titles,json=uniqueArticleTitles,proto3" json:"unique_article_titles,omitempty"`
+	// IDs of the subject terms the journal publishes in, including the terms
+	// below them; articles with other subjects are flagged. Empty accepts
+	// every subject.
+	SubjectScope  []string `protobuf:"bytes,9,rep,name=subject_scope,json=subjectScope,proto3" json:"subject_scope,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +103,41 @@ func (x *Journal) GetImpactFactor() float64 {
 	return 0
 }
 
+func (x *Journal) GetPrintIssn() string {
+	if x != nil {
+		return x.PrintIssn
+	}
+	return ""
+}
+
+func (x *Journal) GetElectronicIssn() string {
+	if x != nil {
+		return x.ElectronicIssn
+	}
+	return ""
+}
+
+func (x *Journal) GetIssnL() string {
+	if x != nil {
+		return x.IssnL
+	}
+	return ""
+}
+
+func (x *Journal) GetUniqueArticleTitles() bool {
+	if x != nil {
+		return x.UniqueArticleTitles
+	}
+	return false
+}
+
+func (x *Journal) GetSubjectScope() []string {
+	if x != nil {
+		return x.SubjectScope
+	}
+	return nil
+}
+
 type GetJournalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -177,23 +226,3225 @@ func (x *GetJournalResponse) GetJournal() *Journal {
 	return nil
 }
 
-var File_journal_proto protoreflect.FileDescriptor
+type GetJournalByISSNRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Print, electronic or linking ISSN; the hyphen is optional
+	Issn          string `protobuf:"bytes,1,opt,name=issn,proto3" json:"issn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_journal_proto_rawDesc = "" +
-	"\n" +
-	"\rjournal.proto\x12\ajournal\"t\n" +
-	"\aJournal\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
-	"\rimpact_factor\x18\x04 \x01(\x01R\fimpactFactor\"#\n" +
-	"\x11GetJournalRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
-	"\x12GetJournalResponse\x12*\n" +
-	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal2W\n" +
-	"\x0eJournalService\x12E\n" +
+func (x *GetJournalByISSNRequest) Reset() {
+	*x = GetJournalByISSNRequest{}
+	mi := &file_journal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalByISSNRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalByISSNRequest) ProtoMessage() {}
+
+func (x *GetJournalByISSNRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalByISSNRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalByISSNRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetJournalByISSNRequest) GetIssn() string {
+	if x != nil {
+		return x.Issn
+	}
+	return ""
+}
+
+type GetJournalByISSNResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journal       *Journal               `protobuf:"bytes,1,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalByISSNResponse) Reset() {
+	*x = GetJournalByISSNResponse{}
+	mi := &file_journal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalByISSNResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalByISSNResponse) ProtoMessage() {}
+
+func (x *GetJournalByISSNResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalByISSNResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalByISSNResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetJournalByISSNResponse) GetJournal() *Journal {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type ListJournalsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When set, only journals with this name are returned, ignoring case
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalsRequest) Reset() {
+	*x = ListJournalsRequest{}
+	mi := &file_journal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalsRequest) ProtoMessage() {}
+
+func (x *ListJournalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalsRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListJournalsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListJournalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journals      []*Journal             `protobuf:"bytes,1,rep,name=journals,proto3" json:"journals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalsResponse) Reset() {
+	*x = ListJournalsResponse{}
+	mi := &file_journal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalsResponse) ProtoMessage() {}
+
+func (x *ListJournalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalsResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListJournalsResponse) GetJournals() []*Journal {
+	if x != nil {
+		return x.Journals
+	}
+	return nil
+}
+
+type CreateJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journal       *Journal               `protobuf:"bytes,1,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalRequest) Reset() {
+	*x = CreateJournalRequest{}
+	mi := &file_journal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalRequest) ProtoMessage() {}
+
+func (x *CreateJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalRequest.ProtoReflect.Descriptor instead.
+func (*CreateJournalRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateJournalRequest) GetJournal() *Journal {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type CreateJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journal       *Journal               `protobuf:"bytes,1,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalResponse) Reset() {
+	*x = CreateJournalResponse{}
+	mi := &file_journal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalResponse) ProtoMessage() {}
+
+func (x *CreateJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalResponse.ProtoReflect.Descriptor instead.
+func (*CreateJournalResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateJournalResponse) GetJournal() *Journal {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type UpdateJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journal       *Journal               `protobuf:"bytes,1,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJournalRequest) Reset() {
+	*x = UpdateJournalRequest{}
+	mi := &file_journal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalRequest) ProtoMessage() {}
+
+func (x *UpdateJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJournalRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateJournalRequest) GetJournal() *Journal {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type UpdateJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Journal       *Journal               `protobuf:"bytes,1,opt,name=journal,proto3" json:"journal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJournalResponse) Reset() {
+	*x = UpdateJournalResponse{}
+	mi := &file_journal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalResponse) ProtoMessage() {}
+
+func (x *UpdateJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJournalResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateJournalResponse) GetJournal() *Journal {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_journal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WebhookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret used to HMAC-sign payloads; it is never returned by the API
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Event types to deliver; empty subscribes to all events
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_journal_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_journal_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_journal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{14}
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_journal_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_journal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_journal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{17}
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// One of "pending", "succeeded" or "dead_lettered"
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_journal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Filter by status; use "dead_lettered" to inspect the dead-letter store
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_journal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_journal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_journal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_journal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Sequence  int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EntityId  string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Actor     string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operation string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	// JSON snapshots of the entity; before is empty for creates
+	Before    string                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After     string                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PrevHash  string                 `protobuf:"bytes,8,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash      string                 `protobuf:"bytes,9,opt,name=hash,proto3" json:"hash,omitempty"`
+	// network address of the caller; actor comes from the unauthenticated
+	// x-actor header
+	Peer string `protobuf:"bytes,10,opt,name=peer,proto3" json:"peer,omitempty"`
+	// outbox event the entry was derived from
+	EventId       string `protobuf:"bytes,11,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_journal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuditEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EntityId string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Inclusive lower and exclusive upper bound; unset bounds are open
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_journal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuditEntriesRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_journal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_journal_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{26}
+}
+
+type VerifyAuditLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Number of entries verified before the first broken link
+	VerifiedEntries int64  `protobuf:"varint,2,opt,name=verified_entries,json=verifiedEntries,proto3" json:"verified_entries,omitempty"`
+	Error           string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_journal_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetVerifiedEntries() int64 {
+	if x != nil {
+		return x.VerifiedEntries
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Volume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JournalId     string                 `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Year          int32                  `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_journal_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Volume) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Volume) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *Volume) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Volume) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Volume) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Issue struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JournalId string                 `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	VolumeId  string                 `protobuf:"bytes,3,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Number    int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Title     string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// One of "draft", "scheduled" or "published"
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_journal_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Issue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Issue) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *Issue) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *Issue) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Issue) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Issue) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *Issue) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Issue) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Year          int32                  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_journal_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateVolumeRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CreateVolumeRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_journal_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_journal_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListVolumesRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_journal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type CreateIssueRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Number   int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Optional; when set the issue is scheduled for publication at this time
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueRequest) Reset() {
+	*x = CreateIssueRequest{}
+	mi := &file_journal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueRequest) ProtoMessage() {}
+
+func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateIssueRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CreateIssueRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+type CreateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueResponse) Reset() {
+	*x = CreateIssueResponse{}
+	mi := &file_journal_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueResponse) ProtoMessage() {}
+
+func (x *CreateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type GetIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueRequest) Reset() {
+	*x = GetIssueRequest{}
+	mi := &file_journal_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueRequest) ProtoMessage() {}
+
+func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueResponse) Reset() {
+	*x = GetIssueResponse{}
+	mi := &file_journal_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResponse) ProtoMessage() {}
+
+func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_journal_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListIssuesRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesResponse) Reset() {
+	*x = ListIssuesResponse{}
+	mi := &file_journal_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesResponse) ProtoMessage() {}
+
+func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ScheduleIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleIssueRequest) Reset() {
+	*x = ScheduleIssueRequest{}
+	mi := &file_journal_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleIssueRequest) ProtoMessage() {}
+
+func (x *ScheduleIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleIssueRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleIssueRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ScheduleIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleIssueRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type ScheduleIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleIssueResponse) Reset() {
+	*x = ScheduleIssueResponse{}
+	mi := &file_journal_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleIssueResponse) ProtoMessage() {}
+
+func (x *ScheduleIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleIssueResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleIssueResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScheduleIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type PublishIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishIssueRequest) Reset() {
+	*x = PublishIssueRequest{}
+	mi := &file_journal_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishIssueRequest) ProtoMessage() {}
+
+func (x *PublishIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishIssueRequest.ProtoReflect.Descriptor instead.
+func (*PublishIssueRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PublishIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublishIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishIssueResponse) Reset() {
+	*x = PublishIssueResponse{}
+	mi := &file_journal_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishIssueResponse) ProtoMessage() {}
+
+func (x *PublishIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishIssueResponse.ProtoReflect.Descriptor instead.
+func (*PublishIssueResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PublishIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type JournalMetrics struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JournalId string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Year      int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// Items published in the two preceding years and the citations they
+	// received in the year
+	CitableItems int32 `protobuf:"varint,3,opt,name=citable_items,json=citableItems,proto3" json:"citable_items,omitempty"`
+	Citations    int32 `protobuf:"varint,4,opt,name=citations,proto3" json:"citations,omitempty"`
+	// Current impact factor: the manual override if there is one, otherwise
+	// the computed value. Unset metrics have no data for the year.
+	ImpactFactor         *float64 `protobuf:"fixed64,5,opt,name=impact_factor,json=impactFactor,proto3,oneof" json:"impact_factor,omitempty"`
+	ComputedImpactFactor *float64 `protobuf:"fixed64,6,opt,name=computed_impact_factor,json=computedImpactFactor,proto3,oneof" json:"computed_impact_factor,omitempty"`
+	ImmediacyIndex       *float64 `protobuf:"fixed64,7,opt,name=immediacy_index,json=immediacyIndex,proto3,oneof" json:"immediacy_index,omitempty"`
+	CitedHalfLife        *float64 `protobuf:"fixed64,8,opt,name=cited_half_life,json=citedHalfLife,proto3,oneof" json:"cited_half_life,omitempty"`
+	// Set when impact_factor is a manual override
+	Manual         bool                   `protobuf:"varint,9,opt,name=manual,proto3" json:"manual,omitempty"`
+	OverrideReason string                 `protobuf:"bytes,10,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	OverrideActor  string                 `protobuf:"bytes,11,opt,name=override_actor,json=overrideActor,proto3" json:"override_actor,omitempty"`
+	ComputedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JournalMetrics) Reset() {
+	*x = JournalMetrics{}
+	mi := &file_journal_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalMetrics) ProtoMessage() {}
+
+func (x *JournalMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalMetrics.ProtoReflect.Descriptor instead.
+func (*JournalMetrics) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JournalMetrics) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *JournalMetrics) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetCitableItems() int32 {
+	if x != nil {
+		return x.CitableItems
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetCitations() int32 {
+	if x != nil {
+		return x.Citations
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetImpactFactor() float64 {
+	if x != nil && x.ImpactFactor != nil {
+		return *x.ImpactFactor
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetComputedImpactFactor() float64 {
+	if x != nil && x.ComputedImpactFactor != nil {
+		return *x.ComputedImpactFactor
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetImmediacyIndex() float64 {
+	if x != nil && x.ImmediacyIndex != nil {
+		return *x.ImmediacyIndex
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetCitedHalfLife() float64 {
+	if x != nil && x.CitedHalfLife != nil {
+		return *x.CitedHalfLife
+	}
+	return 0
+}
+
+func (x *JournalMetrics) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *JournalMetrics) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+func (x *JournalMetrics) GetOverrideActor() string {
+	if x != nil {
+		return x.OverrideActor
+	}
+	return ""
+}
+
+func (x *JournalMetrics) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetJournalMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalMetricsRequest) Reset() {
+	*x = GetJournalMetricsRequest{}
+	mi := &file_journal_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalMetricsRequest) ProtoMessage() {}
+
+func (x *GetJournalMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetJournalMetricsRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *GetJournalMetricsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetJournalMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *JournalMetrics        `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalMetricsResponse) Reset() {
+	*x = GetJournalMetricsResponse{}
+	mi := &file_journal_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalMetricsResponse) ProtoMessage() {}
+
+func (x *GetJournalMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetJournalMetricsResponse) GetMetrics() *JournalMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type ListJournalMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalMetricsRequest) Reset() {
+	*x = ListJournalMetricsRequest{}
+	mi := &file_journal_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalMetricsRequest) ProtoMessage() {}
+
+func (x *ListJournalMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalMetricsRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListJournalMetricsRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+type ListJournalMetricsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest year first
+	Metrics       []*JournalMetrics `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalMetricsResponse) Reset() {
+	*x = ListJournalMetricsResponse{}
+	mi := &file_journal_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalMetricsResponse) ProtoMessage() {}
+
+func (x *ListJournalMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalMetricsResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListJournalMetricsResponse) GetMetrics() []*JournalMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type RecalculateJournalMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateJournalMetricsRequest) Reset() {
+	*x = RecalculateJournalMetricsRequest{}
+	mi := &file_journal_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateJournalMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateJournalMetricsRequest) ProtoMessage() {}
+
+func (x *RecalculateJournalMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateJournalMetricsRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateJournalMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RecalculateJournalMetricsRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+type RecalculateJournalMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*JournalMetrics      `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateJournalMetricsResponse) Reset() {
+	*x = RecalculateJournalMetricsResponse{}
+	mi := &file_journal_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateJournalMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateJournalMetricsResponse) ProtoMessage() {}
+
+func (x *RecalculateJournalMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateJournalMetricsResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateJournalMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RecalculateJournalMetricsResponse) GetMetrics() []*JournalMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type OverrideImpactFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	ImpactFactor  float64                `protobuf:"fixed64,3,opt,name=impact_factor,json=impactFactor,proto3" json:"impact_factor,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideImpactFactorRequest) Reset() {
+	*x = OverrideImpactFactorRequest{}
+	mi := &file_journal_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideImpactFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideImpactFactorRequest) ProtoMessage() {}
+
+func (x *OverrideImpactFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideImpactFactorRequest.ProtoReflect.Descriptor instead.
+func (*OverrideImpactFactorRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OverrideImpactFactorRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *OverrideImpactFactorRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *OverrideImpactFactorRequest) GetImpactFactor() float64 {
+	if x != nil {
+		return x.ImpactFactor
+	}
+	return 0
+}
+
+func (x *OverrideImpactFactorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OverrideImpactFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *JournalMetrics        `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideImpactFactorResponse) Reset() {
+	*x = OverrideImpactFactorResponse{}
+	mi := &file_journal_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideImpactFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideImpactFactorResponse) ProtoMessage() {}
+
+func (x *OverrideImpactFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideImpactFactorResponse.ProtoReflect.Descriptor instead.
+func (*OverrideImpactFactorResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *OverrideImpactFactorResponse) GetMetrics() *JournalMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type ClearImpactFactorOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearImpactFactorOverrideRequest) Reset() {
+	*x = ClearImpactFactorOverrideRequest{}
+	mi := &file_journal_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearImpactFactorOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearImpactFactorOverrideRequest) ProtoMessage() {}
+
+func (x *ClearImpactFactorOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearImpactFactorOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ClearImpactFactorOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ClearImpactFactorOverrideRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *ClearImpactFactorOverrideRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type ClearImpactFactorOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *JournalMetrics        `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearImpactFactorOverrideResponse) Reset() {
+	*x = ClearImpactFactorOverrideResponse{}
+	mi := &file_journal_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearImpactFactorOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearImpactFactorOverrideResponse) ProtoMessage() {}
+
+func (x *ClearImpactFactorOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearImpactFactorOverrideResponse.ProtoReflect.Descriptor instead.
+func (*ClearImpactFactorOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClearImpactFactorOverrideResponse) GetMetrics() *JournalMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type CitableItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId string                 `protobuf:"bytes,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Year      int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// Publication year of each citing article, repeated for multiple citations
+	CitationYears []int32 `protobuf:"varint,3,rep,packed,name=citation_years,json=citationYears,proto3" json:"citation_years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitableItem) Reset() {
+	*x = CitableItem{}
+	mi := &file_journal_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CitableItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitableItem) ProtoMessage() {}
+
+func (x *CitableItem) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitableItem.ProtoReflect.Descriptor instead.
+func (*CitableItem) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CitableItem) GetArticleId() string {
+	if x != nil {
+		return x.ArticleId
+	}
+	return ""
+}
+
+func (x *CitableItem) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CitableItem) GetCitationYears() []int32 {
+	if x != nil {
+		return x.CitationYears
+	}
+	return nil
+}
+
+type GetJournalCitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalId     string                 `protobuf:"bytes,1,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalCitationsRequest) Reset() {
+	*x = GetJournalCitationsRequest{}
+	mi := &file_journal_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalCitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalCitationsRequest) ProtoMessage() {}
+
+func (x *GetJournalCitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalCitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalCitationsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetJournalCitationsRequest) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+type GetJournalCitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CitableItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalCitationsResponse) Reset() {
+	*x = GetJournalCitationsResponse{}
+	mi := &file_journal_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalCitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalCitationsResponse) ProtoMessage() {}
+
+func (x *GetJournalCitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalCitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalCitationsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetJournalCitationsResponse) GetItems() []*CitableItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_journal_proto protoreflect.FileDescriptor
+
+const file_journal_proto_rawDesc = "" +
+	"\n" +
+	"\rjournal.proto\x12\ajournal\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x02\n" +
+	"\aJournal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rimpact_factor\x18\x04 \x01(\x01R\fimpactFactor\x12\x1d\n" +
+	"\n" +
+	"print_issn\x18\x05 \x01(\tR\tprintIssn\x12'\n" +
+	"\x0felectronic_issn\x18\x06 \x01(\tR\x0eelectronicIssn\x12\x15\n" +
+	"\x06issn_l\x18\a \x01(\tR\x05issnL\x122\n" +
+	"\x15unique_article_titles\x18\b \x01(\bR\x13uniqueArticleTitles\x12#\n" +
+	"\rsubject_scope\x18\t \x03(\tR\fsubjectScope\"#\n" +
+	"\x11GetJournalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x12GetJournalResponse\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\"-\n" +
+	"\x17GetJournalByISSNRequest\x12\x12\n" +
+	"\x04issn\x18\x01 \x01(\tR\x04issn\"F\n" +
+	"\x18GetJournalByISSNResponse\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\")\n" +
+	"\x13ListJournalsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x14ListJournalsResponse\x12,\n" +
+	"\bjournals\x18\x01 \x03(\v2\x10.journal.JournalR\bjournals\"B\n" +
+	"\x14CreateJournalRequest\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\"C\n" +
+	"\x15CreateJournalResponse\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\"B\n" +
+	"\x14UpdateJournalRequest\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\"C\n" +
+	"\x15UpdateJournalResponse\x12*\n" +
+	"\ajournal\x18\x01 \x01(\v2\x10.journal.JournalR\ajournal\"\x93\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"m\n" +
+	" CreateWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"e\n" +
+	"!CreateWebhookSubscriptionResponse\x12@\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1c.journal.WebhookSubscriptionR\fsubscription\"!\n" +
+	"\x1fListWebhookSubscriptionsRequest\"f\n" +
+	" ListWebhookSubscriptionsResponse\x12B\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1c.journal.WebhookSubscriptionR\rsubscriptions\"2\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"#\n" +
+	"!DeleteWebhookSubscriptionResponse\"\xbb\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\a \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"u\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tR\x0esubscriptionId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Y\n" +
+	"\x1dListWebhookDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.journal.WebhookDeliveryR\n" +
+	"deliveries\":\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"P\n" +
+	"\x18RedeliverWebhookResponse\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.journal.WebhookDeliveryR\bdelivery\"\xc1\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x16\n" +
+	"\x06before\x18\x05 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x06 \x01(\tR\x05after\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n" +
+	"\tprev_hash\x18\b \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\t \x01(\tR\x04hash\x12\x12\n" +
+	"\x04peer\x18\n" +
+	" \x01(\tR\x04peer\x12\x19\n" +
+	"\bevent_id\x18\v \x01(\tR\aeventId\"\xa8\x01\n" +
+	"\x17ListAuditEntriesRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"I\n" +
+	"\x18ListAuditEntriesResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.journal.AuditEntryR\aentries\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"o\n" +
+	"\x16VerifyAuditLogResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12)\n" +
+	"\x10verified_entries\x18\x02 \x01(\x03R\x0fverifiedEntries\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x9e\x01\n" +
+	"\x06Volume\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\tR\tjournalId\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04year\x18\x04 \x01(\x05R\x04year\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd4\x02\n" +
+	"\x05Issue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x02 \x01(\tR\tjournalId\x12\x1b\n" +
+	"\tvolume_id\x18\x03 \x01(\tR\bvolumeId\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12?\n" +
+	"\rscheduled_for\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x12=\n" +
+	"\fpublished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"`\n" +
+	"\x13CreateVolumeRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\"?\n" +
+	"\x14CreateVolumeResponse\x12'\n" +
+	"\x06volume\x18\x01 \x01(\v2\x0f.journal.VolumeR\x06volume\"3\n" +
+	"\x12ListVolumesRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\"@\n" +
+	"\x13ListVolumesResponse\x12)\n" +
+	"\avolumes\x18\x01 \x03(\v2\x0f.journal.VolumeR\avolumes\"\xa0\x01\n" +
+	"\x12CreateIssueRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12?\n" +
+	"\rscheduled_for\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\";\n" +
+	"\x13CreateIssueResponse\x12$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.journal.IssueR\x05issue\"!\n" +
+	"\x0fGetIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x10GetIssueResponse\x12$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.journal.IssueR\x05issue\"0\n" +
+	"\x11ListIssuesRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\"<\n" +
+	"\x12ListIssuesResponse\x12&\n" +
+	"\x06issues\x18\x01 \x03(\v2\x0e.journal.IssueR\x06issues\"a\n" +
+	"\x14ScheduleIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\"=\n" +
+	"\x15ScheduleIssueResponse\x12$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.journal.IssueR\x05issue\"%\n" +
+	"\x13PublishIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x14PublishIssueResponse\x12$\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.journal.IssueR\x05issue\"\xc0\x04\n" +
+	"\x0eJournalMetrics\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12#\n" +
+	"\rcitable_items\x18\x03 \x01(\x05R\fcitableItems\x12\x1c\n" +
+	"\tcitations\x18\x04 \x01(\x05R\tcitations\x12(\n" +
+	"\rimpact_factor\x18\x05 \x01(\x01H\x00R\fimpactFactor\x88\x01\x01\x129\n" +
+	"\x16computed_impact_factor\x18\x06 \x01(\x01H\x01R\x14computedImpactFactor\x88\x01\x01\x12,\n" +
+	"\x0fimmediacy_index\x18\a \x01(\x01H\x02R\x0eimmediacyIndex\x88\x01\x01\x12+\n" +
+	"\x0fcited_half_life\x18\b \x01(\x01H\x03R\rcitedHalfLife\x88\x01\x01\x12\x16\n" +
+	"\x06manual\x18\t \x01(\bR\x06manual\x12'\n" +
+	"\x0foverride_reason\x18\n" +
+	" \x01(\tR\x0eoverrideReason\x12%\n" +
+	"\x0eoverride_actor\x18\v \x01(\tR\roverrideActor\x12;\n" +
+	"\vcomputed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAtB\x10\n" +
+	"\x0e_impact_factorB\x19\n" +
+	"\x17_computed_impact_factorB\x12\n" +
+	"\x10_immediacy_indexB\x12\n" +
+	"\x10_cited_half_life\"M\n" +
+	"\x18GetJournalMetricsRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"N\n" +
+	"\x19GetJournalMetricsResponse\x121\n" +
+	"\ametrics\x18\x01 \x01(\v2\x17.journal.JournalMetricsR\ametrics\":\n" +
+	"\x19ListJournalMetricsRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\"O\n" +
+	"\x1aListJournalMetricsResponse\x121\n" +
+	"\ametrics\x18\x01 \x03(\v2\x17.journal.JournalMetricsR\ametrics\"A\n" +
+	" RecalculateJournalMetricsRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\"V\n" +
+	"!RecalculateJournalMetricsResponse\x121\n" +
+	"\ametrics\x18\x01 \x03(\v2\x17.journal.JournalMetricsR\ametrics\"\x8d\x01\n" +
+	"\x1bOverrideImpactFactorRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12#\n" +
+	"\rimpact_factor\x18\x03 \x01(\x01R\fimpactFactor\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"Q\n" +
+	"\x1cOverrideImpactFactorResponse\x121\n" +
+	"\ametrics\x18\x01 \x01(\v2\x17.journal.JournalMetricsR\ametrics\"U\n" +
+	" ClearImpactFactorOverrideRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"V\n" +
+	"!ClearImpactFactorOverrideResponse\x121\n" +
+	"\ametrics\x18\x01 \x01(\v2\x17.journal.JournalMetricsR\ametrics\"g\n" +
+	"\vCitableItem\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\tR\tarticleId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12%\n" +
+	"\x0ecitation_years\x18\x03 \x03(\x05R\rcitationYears\";\n" +
+	"\x1aGetJournalCitationsRequest\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\x01 \x01(\tR\tjournalId\"I\n" +
+	"\x1bGetJournalCitationsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.journal.CitableItemR\x05items2\xf1\x10\n" +
+	"\x0eJournalService\x12E\n" +
+	"\n" +
+	"GetJournal\x12\x1a.journal.GetJournalRequest\x1a\x1b.journal.GetJournalResponse\x12W\n" +
+	"\x10GetJournalByISSN\x12 .journal.GetJournalByISSNRequest\x1a!.journal.GetJournalByISSNResponse\x12K\n" +
+	"\fListJournals\x12\x1c.journal.ListJournalsRequest\x1a\x1d.journal.ListJournalsResponse\x12N\n" +
+	"\rCreateJournal\x12\x1d.journal.CreateJournalRequest\x1a\x1e.journal.CreateJournalResponse\x12N\n" +
+	"\rUpdateJournal\x12\x1d.journal.UpdateJournalRequest\x1a\x1e.journal.UpdateJournalResponse\x12W\n" +
+	"\x10ListAuditEntries\x12 .journal.ListAuditEntriesRequest\x1a!.journal.ListAuditEntriesResponse\x12Q\n" +
+	"\x0eVerifyAuditLog\x12\x1e.journal.VerifyAuditLogRequest\x1a\x1f.journal.VerifyAuditLogResponse\x12K\n" +
+	"\fCreateVolume\x12\x1c.journal.CreateVolumeRequest\x1a\x1d.journal.CreateVolumeResponse\x12H\n" +
+	"\vListVolumes\x12\x1b.journal.ListVolumesRequest\x1a\x1c.journal.ListVolumesResponse\x12H\n" +
+	"\vCreateIssue\x12\x1b.journal.CreateIssueRequest\x1a\x1c.journal.CreateIssueResponse\x12?\n" +
+	"\bGetIssue\x12\x18.journal.GetIssueRequest\x1a\x19.journal.GetIssueResponse\x12E\n" +
 	"\n" +
-	"GetJournal\x12\x1a.journal.GetJournalRequest\x1a\x1b.journal.GetJournalResponseB\tZ\a./protob\x06proto3"
+	"ListIssues\x12\x1a.journal.ListIssuesRequest\x1a\x1b.journal.ListIssuesResponse\x12N\n" +
+	"\rScheduleIssue\x12\x1d.journal.ScheduleIssueRequest\x1a\x1e.journal.ScheduleIssueResponse\x12K\n" +
+	"\fPublishIssue\x12\x1c.journal.PublishIssueRequest\x1a\x1d.journal.PublishIssueResponse\x12Z\n" +
+	"\x11GetJournalMetrics\x12!.journal.GetJournalMetricsRequest\x1a\".journal.GetJournalMetricsResponse\x12]\n" +
+	"\x12ListJournalMetrics\x12\".journal.ListJournalMetricsRequest\x1a#.journal.ListJournalMetricsResponse\x12r\n" +
+	"\x19RecalculateJournalMetrics\x12).journal.RecalculateJournalMetricsRequest\x1a*.journal.RecalculateJournalMetricsResponse\x12c\n" +
+	"\x14OverrideImpactFactor\x12$.journal.OverrideImpactFactorRequest\x1a%.journal.OverrideImpactFactorResponse\x12r\n" +
+	"\x19ClearImpactFactorOverride\x12).journal.ClearImpactFactorOverrideRequest\x1a*.journal.ClearImpactFactorOverrideResponse\x12r\n" +
+	"\x19CreateWebhookSubscription\x12).journal.CreateWebhookSubscriptionRequest\x1a*.journal.CreateWebhookSubscriptionResponse\x12o\n" +
+	"\x18ListWebhookSubscriptions\x12(.journal.ListWebhookSubscriptionsRequest\x1a).journal.ListWebhookSubscriptionsResponse\x12r\n" +
+	"\x19DeleteWebhookSubscription\x12).journal.DeleteWebhookSubscriptionRequest\x1a*.journal.DeleteWebhookSubscriptionResponse\x12f\n" +
+	"\x15ListWebhookDeliveries\x12%.journal.ListWebhookDeliveriesRequest\x1a&.journal.ListWebhookDeliveriesResponse\x12W\n" +
+	"\x10RedeliverWebhook\x12 .journal.RedeliverWebhookRequest\x1a!.journal.RedeliverWebhookResponse2p\n" +
+	"\fCitationData\x12`\n" +
+	"\x13GetJournalCitations\x12#.journal.GetJournalCitationsRequest\x1a$.journal.GetJournalCitationsResponseB\tZ\a./protob\x06proto3"
 
 var (
 	file_journal_proto_rawDescOnce sync.Once
@@ -207,21 +3458,163 @@ func file_journal_proto_rawDescGZIP() []byte {
 	return file_journal_proto_rawDescData
 }
 
-var file_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_journal_proto_goTypes = []any{
-	(*Journal)(nil),            // 0: journal.Journal
-	(*GetJournalRequest)(nil),  // 1: journal.GetJournalRequest
-	(*GetJournalResponse)(nil), // 2: journal.GetJournalResponse
+	(*Journal)(nil),                           // 0: journal.Journal
+	(*GetJournalRequest)(nil),                 // 1: journal.GetJournalRequest
+	(*GetJournalResponse)(nil),                // 2: journal.GetJournalResponse
+	(*GetJournalByISSNRequest)(nil),           // 3: journal.GetJournalByISSNRequest
+	(*GetJournalByISSNResponse)(nil),          // 4: journal.GetJournalByISSNResponse
+	(*ListJournalsRequest)(nil),               // 5: journal.ListJournalsRequest
+	(*ListJournalsResponse)(nil),              // 6: journal.ListJournalsResponse
+	(*CreateJournalRequest)(nil),              // 7: journal.CreateJournalRequest
+	(*CreateJournalResponse)(nil),             // 8: journal.CreateJournalResponse
+	(*UpdateJournalRequest)(nil),              // 9: journal.UpdateJournalRequest
+	(*UpdateJournalResponse)(nil),             // 10: journal.UpdateJournalResponse
+	(*WebhookSubscription)(nil),               // 11: journal.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),  // 12: journal.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 13: journal.CreateWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsRequest)(nil),   // 14: journal.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 15: journal.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 16: journal.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 17: journal.DeleteWebhookSubscriptionResponse
+	(*WebhookDelivery)(nil),                   // 18: journal.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),      // 19: journal.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 20: journal.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),           // 21: journal.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),          // 22: journal.RedeliverWebhookResponse
+	(*AuditEntry)(nil),                        // 23: journal.AuditEntry
+	(*ListAuditEntriesRequest)(nil),           // 24: journal.ListAuditEntriesRequest
+	(*ListAuditEntriesResponse)(nil),          // 25: journal.ListAuditEntriesResponse
+	(*VerifyAuditLogRequest)(nil),             // 26: journal.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil),            // 27: journal.VerifyAuditLogResponse
+	(*Volume)(nil),                            // 28: journal.Volume
+	(*Issue)(nil),                             // 29: journal.Issue
+	(*CreateVolumeRequest)(nil),               // 30: journal.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),              // 31: journal.CreateVolumeResponse
+	(*ListVolumesRequest)(nil),                // 32: journal.ListVolumesRequest
+	(*ListVolumesResponse)(nil),               // 33: journal.ListVolumesResponse
+	(*CreateIssueRequest)(nil),                // 34: journal.CreateIssueRequest
+	(*CreateIssueResponse)(nil),               // 35: journal.CreateIssueResponse
+	(*GetIssueRequest)(nil),                   // 36: journal.GetIssueRequest
+	(*GetIssueResponse)(nil),                  // 37: journal.GetIssueResponse
+	(*ListIssuesRequest)(nil),                 // 38: journal.ListIssuesRequest
+	(*ListIssuesResponse)(nil),                // 39: journal.ListIssuesResponse
+	(*ScheduleIssueRequest)(nil),              // 40: journal.ScheduleIssueRequest
+	(*ScheduleIssueResponse)(nil),             // 41: journal.ScheduleIssueResponse
+	(*PublishIssueRequest)(nil),               // 42: journal.PublishIssueRequest
+	(*PublishIssueResponse)(nil),              // 43: journal.PublishIssueResponse
+	(*JournalMetrics)(nil),                    // 44: journal.JournalMetrics
+	(*GetJournalMetricsRequest)(nil),          // 45: journal.GetJournalMetricsRequest
+	(*GetJournalMetricsResponse)(nil),         // 46: journal.GetJournalMetricsResponse
+	(*ListJournalMetricsRequest)(nil),         // 47: journal.ListJournalMetricsRequest
+	(*ListJournalMetricsResponse)(nil),        // 48: journal.ListJournalMetricsResponse
+	(*RecalculateJournalMetricsRequest)(nil),  // 49: journal.RecalculateJournalMetricsRequest
+	(*RecalculateJournalMetricsResponse)(nil), // 50: journal.RecalculateJournalMetricsResponse
+	(*OverrideImpactFactorRequest)(nil),       // 51: journal.OverrideImpactFactorRequest
+	(*OverrideImpactFactorResponse)(nil),      // 52: journal.OverrideImpactFactorResponse
+	(*ClearImpactFactorOverrideRequest)(nil),  // 53: journal.ClearImpactFactorOverrideRequest
+	(*ClearImpactFactorOverrideResponse)(nil), // 54: journal.ClearImpactFactorOverrideResponse
+	(*CitableItem)(nil),                       // 55: journal.CitableItem
+	(*GetJournalCitationsRequest)(nil),        // 56: journal.GetJournalCitationsRequest
+	(*GetJournalCitationsResponse)(nil),       // 57: journal.GetJournalCitationsResponse
+	(*timestamppb.Timestamp)(nil),             // 58: google.protobuf.Timestamp
 }
 var file_journal_proto_depIdxs = []int32{
-	0, // 0: journal.GetJournalResponse.journal:type_name -> journal.Journal
-	1, // 1: journal.JournalService.GetJournal:input_type -> journal.GetJournalRequest
-	2, // 2: journal.JournalService.GetJournal:output_type -> journal.GetJournalResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: journal.GetJournalResponse.journal:type_name -> journal.Journal
+	0,  // 1: journal.GetJournalByISSNResponse.journal:type_name -> journal.Journal
+	0,  // 2: journal.ListJournalsResponse.journals:type_name -> journal.Journal
+	0,  // 3: journal.CreateJournalRequest.journal:type_name -> journal.Journal
+	0,  // 4: journal.CreateJournalResponse.journal:type_name -> journal.Journal
+	0,  // 5: journal.UpdateJournalRequest.journal:type_name -> journal.Journal
+	0,  // 6: journal.UpdateJournalResponse.journal:type_name -> journal.Journal
+	58, // 7: journal.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	11, // 8: journal.CreateWebhookSubscriptionResponse.subscription:type_name -> journal.WebhookSubscription
+	11, // 9: journal.ListWebhookSubscriptionsResponse.subscriptions:type_name -> journal.WebhookSubscription
+	58, // 10: journal.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	58, // 11: journal.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	58, // 12: journal.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 13: journal.ListWebhookDeliveriesResponse.deliveries:type_name -> journal.WebhookDelivery
+	18, // 14: journal.RedeliverWebhookResponse.delivery:type_name -> journal.WebhookDelivery
+	58, // 15: journal.AuditEntry.timestamp:type_name -> google.protobuf.Timestamp
+	58, // 16: journal.ListAuditEntriesRequest.from:type_name -> google.protobuf.Timestamp
+	58, // 17: journal.ListAuditEntriesRequest.to:type_name -> google.protobuf.Timestamp
+	23, // 18: journal.ListAuditEntriesResponse.entries:type_name -> journal.AuditEntry
+	58, // 19: journal.Volume.created_at:type_name -> google.protobuf.Timestamp
+	58, // 20: journal.Issue.scheduled_for:type_name -> google.protobuf.Timestamp
+	58, // 21: journal.Issue.published_at:type_name -> google.protobuf.Timestamp
+	58, // 22: journal.Issue.created_at:type_name -> google.protobuf.Timestamp
+	28, // 23: journal.CreateVolumeResponse.volume:type_name -> journal.Volume
+	28, // 24: journal.ListVolumesResponse.volumes:type_name -> journal.Volume
+	58, // 25: journal.CreateIssueRequest.scheduled_for:type_name -> google.protobuf.Timestamp
+	29, // 26: journal.CreateIssueResponse.issue:type_name -> journal.Issue
+	29, // 27: journal.GetIssueResponse.issue:type_name -> journal.Issue
+	29, // 28: journal.ListIssuesResponse.issues:type_name -> journal.Issue
+	58, // 29: journal.ScheduleIssueRequest.publish_at:type_name -> google.protobuf.Timestamp
+	29, // 30: journal.ScheduleIssueResponse.issue:type_name -> journal.Issue
+	29, // 31: journal.PublishIssueResponse.issue:type_name -> journal.Issue
+	58, // 32: journal.JournalMetrics.computed_at:type_name -> google.protobuf.Timestamp
+	44, // 33: journal.GetJournalMetricsResponse.metrics:type_name -> journal.JournalMetrics
+	44, // 34: journal.ListJournalMetricsResponse.metrics:type_name -> journal.JournalMetrics
+	44, // 35: journal.RecalculateJournalMetricsResponse.metrics:type_name -> journal.JournalMetrics
+	44, // 36: journal.OverrideImpactFactorResponse.metrics:type_name -> journal.JournalMetrics
+	44, // 37: journal.ClearImpactFactorOverrideResponse.metrics:type_name -> journal.JournalMetrics
+	55, // 38: journal.GetJournalCitationsResponse.items:type_name -> journal.CitableItem
+	1,  // 39: journal.JournalService.GetJournal:input_type -> journal.GetJournalRequest
+	3,  // 40: journal.JournalService.GetJournalByISSN:input_type -> journal.GetJournalByISSNRequest
+	5,  // 41: journal.JournalService.ListJournals:input_type -> journal.ListJournalsRequest
+	7,  // 42: journal.JournalService.CreateJournal:input_type -> journal.CreateJournalRequest
+	9,  // 43: journal.JournalService.UpdateJournal:input_type -> journal.UpdateJournalRequest
+	24, // 44: journal.JournalService.ListAuditEntries:input_type -> journal.ListAuditEntriesRequest
+	26, // 45: journal.JournalService.VerifyAuditLog:input_type -> journal.VerifyAuditLogRequest
+	30, // 46: journal.JournalService.CreateVolume:input_type -> journal.CreateVolumeRequest
+	32, // 47: journal.JournalService.ListVolumes:input_type -> journal.ListVolumesRequest
+	34, // 48: journal.JournalService.CreateIssue:input_type -> journal.CreateIssueRequest
+	36, // 49: journal.JournalService.GetIssue:input_type -> journal.GetIssueRequest
+	38, // 50: journal.JournalService.ListIssues:input_type -> journal.ListIssuesRequest
+	40, // 51: journal.JournalService.ScheduleIssue:input_type -> journal.ScheduleIssueRequest
+	42, // 52: journal.JournalService.PublishIssue:input_type -> journal.PublishIssueRequest
+	45, // 53: journal.JournalService.GetJournalMetrics:input_type -> journal.GetJournalMetricsRequest
+	47, // 54: journal.JournalService.ListJournalMetrics:input_type -> journal.ListJournalMetricsRequest
+	49, // 55: journal.JournalService.RecalculateJournalMetrics:input_type -> journal.RecalculateJournalMetricsRequest
+	51, // 56: journal.JournalService.OverrideImpactFactor:input_type -> journal.OverrideImpactFactorRequest
+	53, // 57: journal.JournalService.ClearImpactFactorOverride:input_type -> journal.ClearImpactFactorOverrideRequest
+	12, // 58: journal.JournalService.CreateWebhookSubscription:input_type -> journal.CreateWebhookSubscriptionRequest
+	14, // 59: journal.JournalService.ListWebhookSubscriptions:input_type -> journal.ListWebhookSubscriptionsRequest
+	16, // 60: journal.JournalService.DeleteWebhookSubscription:input_type -> journal.DeleteWebhookSubscriptionRequest
+	19, // 61: journal.JournalService.ListWebhookDeliveries:input_type -> journal.ListWebhookDeliveriesRequest
+	21, // 62: journal.JournalService.RedeliverWebhook:input_type -> journal.RedeliverWebhookRequest
+	56, // 63: journal.CitationData.GetJournalCitations:input_type -> journal.GetJournalCitationsRequest
+	2,  // 64: journal.JournalService.GetJournal:output_type -> journal.GetJournalResponse
+	4,  // 65: journal.JournalService.GetJournalByISSN:output_type -> journal.GetJournalByISSNResponse
+	6,  // 66: journal.JournalService.ListJournals:output_type -> journal.ListJournalsResponse
+	8,  // 67: journal.JournalService.CreateJournal:output_type -> journal.CreateJournalResponse
+	10, // 68: journal.JournalService.UpdateJournal:output_type -> journal.UpdateJournalResponse
+	25, // 69: journal.JournalService.ListAuditEntries:output_type -> journal.ListAuditEntriesResponse
+	27, // 70: journal.JournalService.VerifyAuditLog:output_type -> journal.VerifyAuditLogResponse
+	31, // 71: journal.JournalService.CreateVolume:output_type -> journal.CreateVolumeResponse
+	33, // 72: journal.JournalService.ListVolumes:output_type -> journal.ListVolumesResponse
+	35, // 73: journal.JournalService.CreateIssue:output_type -> journal.CreateIssueResponse
+	37, // 74: journal.JournalService.GetIssue:output_type -> journal.GetIssueResponse
+	39, // 75: journal.JournalService.ListIssues:output_type -> journal.ListIssuesResponse
+	41, // 76: journal.JournalService.ScheduleIssue:output_type -> journal.ScheduleIssueResponse
+	43, // 77: journal.JournalService.PublishIssue:output_type -> journal.PublishIssueResponse
+	46, // 78: journal.JournalService.GetJournalMetrics:output_type -> journal.GetJournalMetricsResponse
+	48, // 79: journal.JournalService.ListJournalMetrics:output_type -> journal.ListJournalMetricsResponse
+	50, // 80: journal.JournalService.RecalculateJournalMetrics:output_type -> journal.RecalculateJournalMetricsResponse
+	52, // 81: journal.JournalService.OverrideImpactFactor:output_type -> journal.OverrideImpactFactorResponse
+	54, // 82: journal.JournalService.ClearImpactFactorOverride:output_type -> journal.ClearImpactFactorOverrideResponse
+	13, // 83: journal.JournalService.CreateWebhookSubscription:output_type -> journal.CreateWebhookSubscriptionResponse
+	15, // 84: journal.JournalService.ListWebhookSubscriptions:output_type -> journal.ListWebhookSubscriptionsResponse
+	17, // 85: journal.JournalService.DeleteWebhookSubscription:output_type -> journal.DeleteWebhookSubscriptionResponse
+	20, // 86: journal.JournalService.ListWebhookDeliveries:output_type -> journal.ListWebhookDeliveriesResponse
+	22, // 87: journal.JournalService.RedeliverWebhook:output_type -> journal.RedeliverWebhookResponse
+	57, // 88: journal.CitationData.GetJournalCitations:output_type -> journal.GetJournalCitationsResponse
+	64, // [64:89] is the sub-list for method output_type
+	39, // [39:64] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_journal_proto_init() }
@@ -229,15 +3622,16 @@ func file_journal_proto_init() {
 	if File_journal_proto != nil {
 		return
 	}
+	file_journal_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_journal_proto_rawDesc), len(file_journal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   58,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_journal_proto_goTypes,
 		DependencyIndexes: file_journal_proto_depIdxs,
@@ -19,7 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JournalService_GetJournal_FullMethodName = "/journal.JournalService/GetJournal"
+	JournalService_GetJournal_FullMethodName                = "/journal.JournalService/GetJournal"
+	JournalService_GetJournalByISSN_FullMethodName          = "/journal.JournalService/GetJournalByISSN"
+	JournalService_ListJournals_FullMethodName              = "/journal.JournalService/ListJournals"
+	JournalService_CreateJournal_FullMethodName             = "/journal.JournalService/CreateJournal"
+	JournalService_UpdateJournal_FullMethodName             = "/journal.JournalService/UpdateJournal"
+	JournalService_ListAuditEntries_FullMethodName          = "/journal.JournalService/ListAuditEntries"
+	JournalService_VerifyAuditLog_FullMethodName            = "/journal.JournalService/VerifyAuditLog"
+	JournalService_CreateVolume_FullMethodName              = "/journal.JournalService/CreateVolume"
+	JournalService_ListVolumes_FullMethodName               = "/journal.JournalService/ListVolumes"
+	JournalService_CreateIssue_FullMethodName               = "/journal.JournalService/CreateIssue"
+	JournalService_GetIssue_FullMethodName                  = "/journal.JournalService/GetIssue"
+	JournalService_ListIssues_FullMethodName                = "/journal.JournalService/ListIssues"
+	JournalService_ScheduleIssue_FullMethodName             = "/journal.JournalService/ScheduleIssue"
+	JournalService_PublishIssue_FullMethodName              = "/journal.JournalService/PublishIssue"
+	JournalService_GetJournalMetrics_FullMethodName         = "/journal.JournalService/GetJournalMetrics"
+	JournalService_ListJournalMetrics_FullMethodName        = "/journal.JournalService/ListJournalMetrics"
+	JournalService_RecalculateJournalMetrics_FullMethodName = "/journal.JournalService/RecalculateJournalMetrics"
+	JournalService_OverrideImpactFactor_FullMethodName      = "/journal.JournalService/OverrideImpactFactor"
+	JournalService_ClearImpactFactorOverride_FullMethodName = "/journal.JournalService/ClearImpactFactorOverride"
+	JournalService_CreateWebhookSubscription_FullMethodName = "/journal.JournalService/CreateWebhookSubscription"
+	JournalService_ListWebhookSubscriptions_FullMethodName  = "/journal.JournalService/ListWebhookSubscriptions"
+	JournalService_DeleteWebhookSubscription_FullMethodName = "/journal.JournalService/DeleteWebhookSubscription"
+	JournalService_ListWebhookDeliveries_FullMethodName     = "/journal.JournalService/ListWebhookDeliveries"
+	JournalService_RedeliverWebhook_FullMethodName          = "/journal.JournalService/RedeliverWebhook"
 )
 
 // JournalServiceClient is the client API for JournalService service.
@@ -27,6 +50,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type JournalServiceClient interface {
 	GetJournal(ctx context.Context, in *GetJournalRequest, opts ...grpc.CallOption) (*GetJournalResponse, error)
+	GetJournalByISSN(ctx context.Context, in *GetJournalByISSNRequest, opts ...grpc.CallOption) (*GetJournalByISSNResponse, error)
+	ListJournals(ctx context.Context, in *ListJournalsRequest, opts ...grpc.CallOption) (*ListJournalsResponse, error)
+	// Mutating calls are attributed to the actor in the "x-actor" metadata key
+	CreateJournal(ctx context.Context, in *CreateJournalRequest, opts ...grpc.CallOption) (*CreateJournalResponse, error)
+	// UpdateJournal ignores impact_factor, which follows the journal metrics
+	UpdateJournal(ctx context.Context, in *UpdateJournalRequest, opts ...grpc.CallOption) (*UpdateJournalResponse, error)
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	CreateIssue(ctx context.Context, in *CreateIssueRequest, opts ...grpc.CallOption) (*CreateIssueResponse, error)
+	GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
+	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
+	ScheduleIssue(ctx context.Context, in *ScheduleIssueRequest, opts ...grpc.CallOption) (*ScheduleIssueResponse, error)
+	// PublishIssue publishes immediately; scheduled issues are published automatically
+	PublishIssue(ctx context.Context, in *PublishIssueRequest, opts ...grpc.CallOption) (*PublishIssueResponse, error)
+	GetJournalMetrics(ctx context.Context, in *GetJournalMetricsRequest, opts ...grpc.CallOption) (*GetJournalMetricsResponse, error)
+	ListJournalMetrics(ctx context.Context, in *ListJournalMetricsRequest, opts ...grpc.CallOption) (*ListJournalMetricsResponse, error)
+	// RecalculateJournalMetrics runs the metrics calculation for one journal
+	// now instead of waiting for the batch job
+	RecalculateJournalMetrics(ctx context.Context, in *RecalculateJournalMetricsRequest, opts ...grpc.CallOption) (*RecalculateJournalMetricsResponse, error)
+	OverrideImpactFactor(ctx context.Context, in *OverrideImpactFactorRequest, opts ...grpc.CallOption) (*OverrideImpactFactorResponse, error)
+	ClearImpactFactorOverride(ctx context.Context, in *ClearImpactFactorOverrideRequest, opts ...grpc.CallOption) (*ClearImpactFactorOverrideResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
 }
 
 type journalServiceClient struct {
@@ -47,11 +98,269 @@ func (c *journalServiceClient) GetJournal(ctx context.Context, in *GetJournalReq
 	return out, nil
 }
 
+func (c *journalServiceClient) GetJournalByISSN(ctx context.Context, in *GetJournalByISSNRequest, opts ...grpc.CallOption) (*GetJournalByISSNResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalByISSNResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetJournalByISSN_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListJournals(ctx context.Context, in *ListJournalsRequest, opts ...grpc.CallOption) (*ListJournalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalsResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListJournals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) CreateJournal(ctx context.Context, in *CreateJournalRequest, opts ...grpc.CallOption) (*CreateJournalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateJournalResponse)
+	err := c.cc.Invoke(ctx, JournalService_CreateJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) UpdateJournal(ctx context.Context, in *UpdateJournalRequest, opts ...grpc.CallOption) (*UpdateJournalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateJournalResponse)
+	err := c.cc.Invoke(ctx, JournalService_UpdateJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListAuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, JournalService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVolumeResponse)
+	err := c.cc.Invoke(ctx, JournalService_CreateVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListVolumes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) CreateIssue(ctx context.Context, in *CreateIssueRequest, opts ...grpc.CallOption) (*CreateIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIssueResponse)
+	err := c.cc.Invoke(ctx, JournalService_CreateIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssuesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ScheduleIssue(ctx context.Context, in *ScheduleIssueRequest, opts ...grpc.CallOption) (*ScheduleIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleIssueResponse)
+	err := c.cc.Invoke(ctx, JournalService_ScheduleIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) PublishIssue(ctx context.Context, in *PublishIssueRequest, opts ...grpc.CallOption) (*PublishIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishIssueResponse)
+	err := c.cc.Invoke(ctx, JournalService_PublishIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) GetJournalMetrics(ctx context.Context, in *GetJournalMetricsRequest, opts ...grpc.CallOption) (*GetJournalMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalMetricsResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetJournalMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListJournalMetrics(ctx context.Context, in *ListJournalMetricsRequest, opts ...grpc.CallOption) (*ListJournalMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalMetricsResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListJournalMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) RecalculateJournalMetrics(ctx context.Context, in *RecalculateJournalMetricsRequest, opts ...grpc.CallOption) (*RecalculateJournalMetricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecalculateJournalMetricsResponse)
+	err := c.cc.Invoke(ctx, JournalService_RecalculateJournalMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) OverrideImpactFactor(ctx context.Context, in *OverrideImpactFactorRequest, opts ...grpc.CallOption) (*OverrideImpactFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OverrideImpactFactorResponse)
+	err := c.cc.Invoke(ctx, JournalService_OverrideImpactFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ClearImpactFactorOverride(ctx context.Context, in *ClearImpactFactorOverrideRequest, opts ...grpc.CallOption) (*ClearImpactFactorOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearImpactFactorOverrideResponse)
+	err := c.cc.Invoke(ctx, JournalService_ClearImpactFactorOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, JournalService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, JournalService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, JournalService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JournalServiceServer is the server API for JournalService service.
 // All implementations must embed UnimplementedJournalServiceServer
 // for forward compatibility.
 type JournalServiceServer interface {
 	GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error)
+	GetJournalByISSN(context.Context, *GetJournalByISSNRequest) (*GetJournalByISSNResponse, error)
+	ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error)
+	// Mutating calls are attributed to the actor in the "x-actor" metadata key
+	CreateJournal(context.Context, *CreateJournalRequest) (*CreateJournalResponse, error)
+	// UpdateJournal ignores impact_factor, which follows the journal metrics
+	UpdateJournal(context.Context, *UpdateJournalRequest) (*UpdateJournalResponse, error)
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error)
+	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+	ScheduleIssue(context.Context, *ScheduleIssueRequest) (*ScheduleIssueResponse, error)
+	// PublishIssue publishes immediately; scheduled issues are published automatically
+	PublishIssue(context.Context, *PublishIssueRequest) (*PublishIssueResponse, error)
+	GetJournalMetrics(context.Context, *GetJournalMetricsRequest) (*GetJournalMetricsResponse, error)
+	ListJournalMetrics(context.Context, *ListJournalMetricsRequest) (*ListJournalMetricsResponse, error)
+	// RecalculateJournalMetrics runs the metrics calculation for one journal
+	// now instead of waiting for the batch job
+	RecalculateJournalMetrics(context.Context, *RecalculateJournalMetricsRequest) (*RecalculateJournalMetricsResponse, error)
+	OverrideImpactFactor(context.Context, *OverrideImpactFactorRequest) (*OverrideImpactFactorResponse, error)
+	ClearImpactFactorOverride(context.Context, *ClearImpactFactorOverrideRequest) (*ClearImpactFactorOverrideResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
 	mustEmbedUnimplementedJournalServiceServer()
 }
 
@@ -65,6 +374,75 @@ type UnimplementedJournalServiceServer struct{}
 func (UnimplementedJournalServiceServer) GetJournal(context.Context, *GetJournalRequest) (*GetJournalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJournal not implemented")
 }
+func (UnimplementedJournalServiceServer) GetJournalByISSN(context.Context, *GetJournalByISSNRequest) (*GetJournalByISSNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalByISSN not implemented")
+}
+func (UnimplementedJournalServiceServer) ListJournals(context.Context, *ListJournalsRequest) (*ListJournalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournals not implemented")
+}
+func (UnimplementedJournalServiceServer) CreateJournal(context.Context, *CreateJournalRequest) (*CreateJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJournal not implemented")
+}
+func (UnimplementedJournalServiceServer) UpdateJournal(context.Context, *UpdateJournalRequest) (*UpdateJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournal not implemented")
+}
+func (UnimplementedJournalServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedJournalServiceServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (UnimplementedJournalServiceServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedJournalServiceServer) CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIssue not implemented")
+}
+func (UnimplementedJournalServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssue not implemented")
+}
+func (UnimplementedJournalServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
+}
+func (UnimplementedJournalServiceServer) ScheduleIssue(context.Context, *ScheduleIssueRequest) (*ScheduleIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleIssue not implemented")
+}
+func (UnimplementedJournalServiceServer) PublishIssue(context.Context, *PublishIssueRequest) (*PublishIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishIssue not implemented")
+}
+func (UnimplementedJournalServiceServer) GetJournalMetrics(context.Context, *GetJournalMetricsRequest) (*GetJournalMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalMetrics not implemented")
+}
+func (UnimplementedJournalServiceServer) ListJournalMetrics(context.Context, *ListJournalMetricsRequest) (*ListJournalMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalMetrics not implemented")
+}
+func (UnimplementedJournalServiceServer) RecalculateJournalMetrics(context.Context, *RecalculateJournalMetricsRequest) (*RecalculateJournalMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecalculateJournalMetrics not implemented")
+}
+func (UnimplementedJournalServiceServer) OverrideImpactFactor(context.Context, *OverrideImpactFactorRequest) (*OverrideImpactFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideImpactFactor not implemented")
+}
+func (UnimplementedJournalServiceServer) ClearImpactFactorOverride(context.Context, *ClearImpactFactorOverrideRequest) (*ClearImpactFactorOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearImpactFactorOverride not implemented")
+}
+func (UnimplementedJournalServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedJournalServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedJournalServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedJournalServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedJournalServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
 func (UnimplementedJournalServiceServer) mustEmbedUnimplementedJournalServiceServer() {}
 func (UnimplementedJournalServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,16 +482,630 @@ func _JournalService_GetJournal_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// JournalService_ServiceDesc is the grpc.ServiceDesc for JournalService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var JournalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "journal.JournalService",
-	HandlerType: (*JournalServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetJournal",
-			Handler:    _JournalService_GetJournal_Handler,
+func _JournalService_GetJournalByISSN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalByISSNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetJournalByISSN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetJournalByISSN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetJournalByISSN(ctx, req.(*GetJournalByISSNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListJournals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListJournals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListJournals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListJournals(ctx, req.(*ListJournalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_CreateJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).CreateJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_CreateJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).CreateJournal(ctx, req.(*CreateJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_UpdateJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).UpdateJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_UpdateJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).UpdateJournal(ctx, req.(*UpdateJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_CreateVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListVolumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListVolumes(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_CreateIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).CreateIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_CreateIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).CreateIssue(ctx, req.(*CreateIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_GetIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetIssue(ctx, req.(*GetIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListIssues(ctx, req.(*ListIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ScheduleIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ScheduleIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ScheduleIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ScheduleIssue(ctx, req.(*ScheduleIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_PublishIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).PublishIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_PublishIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).PublishIssue(ctx, req.(*PublishIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_GetJournalMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetJournalMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetJournalMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetJournalMetrics(ctx, req.(*GetJournalMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListJournalMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListJournalMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListJournalMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListJournalMetrics(ctx, req.(*ListJournalMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_RecalculateJournalMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateJournalMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).RecalculateJournalMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_RecalculateJournalMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).RecalculateJournalMetrics(ctx, req.(*RecalculateJournalMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_OverrideImpactFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideImpactFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).OverrideImpactFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_OverrideImpactFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).OverrideImpactFactor(ctx, req.(*OverrideImpactFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ClearImpactFactorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearImpactFactorOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ClearImpactFactorOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ClearImpactFactorOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ClearImpactFactorOverride(ctx, req.(*ClearImpactFactorOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JournalService_ServiceDesc is the grpc.ServiceDesc for JournalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JournalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "journal.JournalService",
+	HandlerType: (*JournalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJournal",
+			Handler:    _JournalService_GetJournal_Handler,
+		},
+		{
+			MethodName: "GetJournalByISSN",
+			Handler:    _JournalService_GetJournalByISSN_Handler,
+		},
+		{
+			MethodName: "ListJournals",
+			Handler:    _JournalService_ListJournals_Handler,
+		},
+		{
+			MethodName: "CreateJournal",
+			Handler:    _JournalService_CreateJournal_Handler,
+		},
+		{
+			MethodName: "UpdateJournal",
+			Handler:    _JournalService_UpdateJournal_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _JournalService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _JournalService_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _JournalService_CreateVolume_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _JournalService_ListVolumes_Handler,
+		},
+		{
+			MethodName: "CreateIssue",
+			Handler:    _JournalService_CreateIssue_Handler,
+		},
+		{
+			MethodName: "GetIssue",
+			Handler:    _JournalService_GetIssue_Handler,
+		},
+		{
+			MethodName: "ListIssues",
+			Handler:    _JournalService_ListIssues_Handler,
+		},
+		{
+			MethodName: "ScheduleIssue",
+			Handler:    _JournalService_ScheduleIssue_Handler,
+		},
+		{
+			MethodName: "PublishIssue",
+			Handler:    _JournalService_PublishIssue_Handler,
+		},
+		{
+			MethodName: "GetJournalMetrics",
+			Handler:    _JournalService_GetJournalMetrics_Handler,
+		},
+		{
+			MethodName: "ListJournalMetrics",
+			Handler:    _JournalService_ListJournalMetrics_Handler,
+		},
+		{
+			MethodName: "RecalculateJournalMetrics",
+			Handler:    _JournalService_RecalculateJournalMetrics_Handler,
+		},
+		{
+			MethodName: "OverrideImpactFactor",
+			Handler:    _JournalService_OverrideImpactFactor_Handler,
+		},
+		{
+			MethodName: "ClearImpactFactorOverride",
+			Handler:    _JournalService_ClearImpactFactorOverride_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _JournalService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _JournalService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _JournalService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _JournalService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _JournalService_RedeliverWebhook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "journal.proto",
+}
+
+const (
+	CitationData_GetJournalCitations_FullMethodName = "/journal.CitationData/GetJournalCitations"
+)
+
+// CitationDataClient is the client API for CitationData service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CitationData is served by the article service, which owns citations, and
+// feeds the journal metrics calculation
+type CitationDataClient interface {
+	GetJournalCitations(ctx context.Context, in *GetJournalCitationsRequest, opts ...grpc.CallOption) (*GetJournalCitationsResponse, error)
+}
+
+type citationDataClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCitationDataClient(cc grpc.ClientConnInterface) CitationDataClient {
+	return &citationDataClient{cc}
+}
+
+func (c *citationDataClient) GetJournalCitations(ctx context.Context, in *GetJournalCitationsRequest, opts ...grpc.CallOption) (*GetJournalCitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalCitationsResponse)
+	err := c.cc.Invoke(ctx, CitationData_GetJournalCitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CitationDataServer is the server API for CitationData service.
+// All implementations must embed UnimplementedCitationDataServer
+// for forward compatibility.
+//
+// CitationData is served by the article service, which owns citations, and
+// feeds the journal metrics calculation
+type CitationDataServer interface {
+	GetJournalCitations(context.Context, *GetJournalCitationsRequest) (*GetJournalCitationsResponse, error)
+	mustEmbedUnimplementedCitationDataServer()
+}
+
+// UnimplementedCitationDataServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCitationDataServer struct{}
+
+func (UnimplementedCitationDataServer) GetJournalCitations(context.Context, *GetJournalCitationsRequest) (*GetJournalCitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalCitations not implemented")
+}
+func (UnimplementedCitationDataServer) mustEmbedUnimplementedCitationDataServer() {}
+func (UnimplementedCitationDataServer) testEmbeddedByValue()                      {}
+
+// UnsafeCitationDataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CitationDataServer will
+// result in compilation errors.
+type UnsafeCitationDataServer interface {
+	mustEmbedUnimplementedCitationDataServer()
+}
+
+func RegisterCitationDataServer(s grpc.ServiceRegistrar, srv CitationDataServer) {
+	// If the following call pancis, it indicates UnimplementedCitationDataServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CitationData_ServiceDesc, srv)
+}
+
+func _CitationData_GetJournalCitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalCitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitationDataServer).GetJournalCitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitationData_GetJournalCitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitationDataServer).GetJournalCitations(ctx, req.(*GetJournalCitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CitationData_ServiceDesc is the grpc.ServiceDesc for CitationData service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CitationData_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "journal.CitationData",
+	HandlerType: (*CitationDataServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJournalCitations",
+			Handler:    _CitationData_GetJournalCitations_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},